@@ -0,0 +1,248 @@
+// Package apperr holds the errors services and handlers return for failures
+// the client should hear about. Each carries the HTTP status and the stable
+// code the API answers with; the central error handler turns them into a
+// models.ApiResponse, so handlers just return them.
+package apperr
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Stable error codes shared by the REST and GraphQL APIs
+const (
+	CodeValidation        = "VALIDATION_ERROR"
+	CodeNotFound          = "NOT_FOUND"
+	CodeAlreadyExists     = "ALREADY_EXISTS"
+	CodeStateConflict     = "STATE_CONFLICT"
+	CodeInsufficientFunds = "INSUFFICIENT_FUNDS"
+	CodeAuthRequired      = "AUTH_REQUIRED"
+	CodeAuthInvalid       = "AUTH_INVALID"
+	CodePermissionDenied  = "PERMISSION_DENIED"
+	CodeInvalidToken      = "INVALID_TOKEN"
+	CodeRateLimited       = "RATE_LIMITED"
+	CodeTimeout           = "TIMEOUT"
+	CodeInternal          = "INTERNAL_ERROR"
+
+	// Sessions and logins
+	CodeAuthRevoked            = "AUTH_REVOKED"
+	CodeAuthLocked             = "AUTH_LOCKED"
+	CodeAuthTokenReused        = "AUTH_TOKEN_REUSED"
+	CodeEmailNotVerified       = "EMAIL_NOT_VERIFIED"
+	CodeAlreadyVerified        = "ALREADY_VERIFIED"
+	CodeImpersonationForbidden = "IMPERSONATION_FORBIDDEN"
+	CodeInvalidState           = "INVALID_STATE"
+	CodeProviderError          = "PROVIDER_ERROR"
+	CodeAccountExists          = "ACCOUNT_EXISTS"
+
+	// Two-factor authentication
+	CodeMFAInvalid            = "MFA_INVALID"
+	CodeMFARequired           = "MFA_REQUIRED"
+	CodeMFAEnrollmentRequired = "MFA_ENROLLMENT_REQUIRED"
+	CodeMFAAlreadyEnabled     = "MFA_ALREADY_ENABLED"
+	CodeMFANotEnabled         = "MFA_NOT_ENABLED"
+
+	// Everything else
+	CodeExportNotAvailable = "EXPORT_NOT_AVAILABLE"
+	CodeEmailFailed        = "EMAIL_FAILED"
+	CodeConnectionError    = "CONNECTION_ERROR"
+	CodeSyncError          = "SYNC_ERROR"
+	CodeUpgradeRequired    = "UPGRADE_REQUIRED"
+)
+
+// Error is a failure with the status, code and message the client gets
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	// Details explains the failure further and is always shown
+	Details string
+	// Fields maps request fields to what is wrong with them
+	Fields map[string]string
+	// Data is sent as the response data, e.g. what the client needs to retry
+	Data interface{}
+	// Err is the underlying cause. It is logged, and only shown to clients in development.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails returns a copy of the error with client-facing details
+func (e *Error) WithDetails(details string) *Error {
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// WithData returns a copy of the error that sends data along with it
+func (e *Error) WithData(data interface{}) *Error {
+	copied := *e
+	copied.Data = data
+	return &copied
+}
+
+// Wrap returns a copy of the error caused by err
+func (e *Error) Wrap(err error) *Error {
+	copied := *e
+	copied.Err = err
+	return &copied
+}
+
+// New builds an error for codes without a constructor of their own
+func New(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+func NotFound(message string) *Error {
+	return New(http.StatusNotFound, CodeNotFound, message)
+}
+
+// Conflict reports something that already exists, e.g. a taken slug or email
+func Conflict(message string) *Error {
+	return New(http.StatusConflict, CodeAlreadyExists, message)
+}
+
+// StateConflict reports an action the resource's current state does not allow,
+// e.g. completing a transaction that is no longer pending
+func StateConflict(message string) *Error {
+	return New(http.StatusConflict, CodeStateConflict, message)
+}
+
+// Validation reports a bad request, with what is wrong per field when known
+func Validation(message string, fields map[string]string) *Error {
+	e := New(http.StatusBadRequest, CodeValidation, message)
+	e.Fields = fields
+	return e
+}
+
+// Invalid reports a request body that failed to parse or validate. Failed
+// validator rules become per-field errors; anything else is the details.
+func Invalid(message string, err error) *Error {
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		return Validation(message, FieldErrors(fieldErrs))
+	}
+	e := Validation(message, nil)
+	if err != nil {
+		e.Details = err.Error()
+	}
+	return e
+}
+
+func InsufficientFunds(message string) *Error {
+	return New(http.StatusPaymentRequired, CodeInsufficientFunds, message)
+}
+
+// Unauthorized reports a missing or unusable credential
+func Unauthorized(code, message string) *Error {
+	return New(http.StatusUnauthorized, code, message)
+}
+
+// Forbidden reports a caller who may not do what they asked
+func Forbidden(message string) *Error {
+	return New(http.StatusForbidden, CodePermissionDenied, message)
+}
+
+func RateLimited(message string) *Error {
+	return New(http.StatusTooManyRequests, CodeRateLimited, message)
+}
+
+func Timeout() *Error {
+	return New(http.StatusGatewayTimeout, CodeTimeout, "The request took too long to complete")
+}
+
+// Internal reports a failure that is not the client's fault. The message
+// says what failed; err is hidden from clients outside development. When
+// err already is an *Error, e.g. a NotFound from a service, that error is
+// returned instead so handlers can pass service errors through unchanged.
+func Internal(message string, err error) *Error {
+	if e, ok := As(err); ok {
+		return e
+	}
+	return New(http.StatusInternalServerError, CodeInternal, message).Wrap(err)
+}
+
+// As returns the *Error in err's chain, if any
+func As(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
+
+// Code returns the code of the *Error in err's chain, or "" if there is none
+func Code(err error) string {
+	if e, ok := As(err); ok {
+		return e.Code
+	}
+	return ""
+}
+
+// IsNotFound reports whether err is a NotFound error
+func IsNotFound(err error) bool {
+	return Code(err) == CodeNotFound
+}
+
+// FieldErrors describes each failed validator rule, keyed by the field's
+// snake_case name as it appears in request bodies
+func FieldErrors(errs validator.ValidationErrors) map[string]string {
+	fields := make(map[string]string, len(errs))
+	for _, fe := range errs {
+		fields[snakeCase(fe.Field())] = ruleMessage(fe)
+	}
+	return fields
+}
+
+func ruleMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	case "min":
+		return "must be at least " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param()
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "gte":
+		return "must be at least " + fe.Param()
+	case "len":
+		return "must have length " + fe.Param()
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	default:
+		return "failed the " + fe.Tag() + " rule"
+	}
+}
+
+// snakeCase turns a Go field name like CurrentPassword or APIEndpoint into current_password or api_endpoint
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			startsWord := i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+			if startsWord {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrMiss is returned by Get when the key does not exist
+var ErrMiss = errors.New("cache miss")
+
+// redisRetryDelay is how long Redis is skipped after a failed call
+const redisRetryDelay = 30 * time.Second
+
+// sweepInterval is how often expired items are dropped from memory
+const sweepInterval = time.Minute
+
+// Cache stores short-lived values in Redis and falls back to process memory
+// while Redis is unreachable, so the API keeps working without it
+type Cache struct {
+	redis *redis.Client
+
+	mu        sync.Mutex
+	downUntil time.Time
+	items     map[string]memoryItem
+	nextSweep time.Time
+}
+
+type memoryItem struct {
+	value     string
+	expiresAt time.Time
+}
+
+func New(client *redis.Client) *Cache {
+	return &Cache{
+		redis: client,
+		items: make(map[string]memoryItem),
+	}
+}
+
+// Get returns the value stored under key or ErrMiss
+func (c *Cache) Get(ctx context.Context, key string) (string, error) {
+	if c.redisAvailable() {
+		value, err := c.redis.Get(ctx, key).Result()
+		if err == nil {
+			return value, nil
+		}
+		if err == redis.Nil {
+			return "", ErrMiss
+		}
+		c.markRedisDown(ctx, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.items[key]
+	if !ok || time.Now().After(item.expiresAt) {
+		delete(c.items, key)
+		return "", ErrMiss
+	}
+	return item.value, nil
+}
+
+// Set stores value under key for ttl
+func (c *Cache) Set(ctx context.Context, key, value string, ttl time.Duration) {
+	if c.redisAvailable() {
+		err := c.redis.Set(ctx, key, value, ttl).Err()
+		if err == nil {
+			return
+		}
+		c.markRedisDown(ctx, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sweep()
+	c.items[key] = memoryItem{value: value, expiresAt: time.Now().Add(ttl)}
+}
+
+// Incr increments the counter under key and returns its new value.
+// The ttl starts when the counter is created and is not extended by later increments.
+func (c *Cache) Incr(ctx context.Context, key string, ttl time.Duration) int64 {
+	count, _ := c.Hit(ctx, key, ttl)
+	return count
+}
+
+// hitScript increments a counter, sets its ttl when it is created and returns
+// the new count with the milliseconds left, in one atomic step
+var hitScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// Hit increments the counter under key like Incr and also returns how long
+// the counter lives on, which is when a limit based on it lifts
+func (c *Cache) Hit(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration) {
+	if c.redisAvailable() {
+		result, err := hitScript.Run(ctx, c.redis, []string{key}, ttl.Milliseconds()).Int64Slice()
+		if err == nil && len(result) == 2 {
+			left := time.Duration(result[1]) * time.Millisecond
+			if left <= 0 {
+				left = ttl
+			}
+			return result[0], left
+		}
+		if err == nil {
+			err = errors.New("unexpected reply to counter script")
+		}
+		c.markRedisDown(ctx, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sweep()
+	now := time.Now()
+	item, ok := c.items[key]
+	if !ok || now.After(item.expiresAt) {
+		item = memoryItem{value: "0", expiresAt: now.Add(ttl)}
+	}
+	count, _ := strconv.ParseInt(item.value, 10, 64)
+	count++
+	item.value = strconv.FormatInt(count, 10)
+	c.items[key] = item
+	return count, item.expiresAt.Sub(now)
+}
+
+// Delete removes keys from both Redis and memory
+func (c *Cache) Delete(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	if c.redisAvailable() {
+		if err := c.redis.Del(ctx, keys...).Err(); err != nil {
+			c.markRedisDown(ctx, err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.items, key)
+	}
+}
+
+// sweep drops expired items from memory at most once per sweepInterval, so
+// keys that are written once and never read again do not pile up.
+// It runs on writes and must be called with c.mu held.
+func (c *Cache) sweep() {
+	now := time.Now()
+	if now.Before(c.nextSweep) {
+		return
+	}
+	c.nextSweep = now.Add(sweepInterval)
+
+	for key, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, key)
+		}
+	}
+}
+
+func (c *Cache) redisAvailable() bool {
+	if c.redis == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().After(c.downUntil)
+}
+
+// markRedisDown skips Redis for redisRetryDelay after a failed call. A call
+// cut short by its own context, e.g. a client that left or a request deadline,
+// says nothing about Redis, and would otherwise move every instance's shared
+// counters into memory.
+func (c *Cache) markRedisDown(ctx context.Context, err error) {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().After(c.downUntil) {
+		log.Printf("Warning: Redis unavailable, using in-memory cache for %s: %v", redisRetryDelay, err)
+	}
+	c.downUntil = time.Now().Add(redisRetryDelay)
+}
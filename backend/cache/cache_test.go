@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func TestIncr(t *testing.T) {
+	c := New(nil)
+
+	if got := c.Incr(t.Context(), "n", 50*time.Millisecond); got != 1 {
+		t.Fatalf("first Incr = %d", got)
+	}
+	count, left := c.Hit(t.Context(), "n", time.Hour)
+	if count != 2 || left <= 0 || left > 50*time.Millisecond {
+		t.Fatalf("Hit = %d, %v; the ttl must not be extended", count, left)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if got := c.Incr(t.Context(), "n", time.Hour); got != 1 {
+		t.Errorf("Incr after expiry = %d", got)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	c := New(nil)
+	c.Set(t.Context(), "old", "1", time.Millisecond)
+	c.Set(t.Context(), "fresh", "1", time.Hour)
+
+	time.Sleep(5 * time.Millisecond)
+	c.nextSweep = time.Time{}
+	c.Incr(t.Context(), "other", time.Hour)
+
+	if _, ok := c.items["old"]; ok {
+		t.Error("expired item was not swept")
+	}
+	if value, err := c.Get(t.Context(), "fresh"); err != nil || value != "1" {
+		t.Errorf("Get(fresh) = %q, %v", value, err)
+	}
+}
+
+func TestRedisDown(t *testing.T) {
+	// Nothing listens on the discard port, so every call fails
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:9", MaxRetries: -1})
+	defer client.Close()
+	c := New(client)
+
+	cancelled, cancel := context.WithCancel(t.Context())
+	cancel()
+	c.Set(cancelled, "key", "1", time.Hour)
+	expired, cancel := context.WithTimeout(t.Context(), -time.Second)
+	defer cancel()
+	c.Incr(expired, "counter", time.Hour)
+	if !c.redisAvailable() {
+		t.Fatal("a cancelled request marked Redis down")
+	}
+
+	c.Set(t.Context(), "key", "1", time.Hour)
+	if c.redisAvailable() {
+		t.Fatal("a failed call did not mark Redis down")
+	}
+	if value, err := c.Get(t.Context(), "key"); err != nil || value != "1" {
+		t.Errorf("Get from memory = %q, %v", value, err)
+	}
+}
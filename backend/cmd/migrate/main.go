@@ -0,0 +1,112 @@
+// Command migrate applies and rolls back the SQL migrations in backend/migrations.
+//
+//	go run ./cmd/migrate up       apply every pending migration
+//	go run ./cmd/migrate down     roll back the last applied migration
+//	go run ./cmd/migrate redo     roll back the last applied migration and apply it again
+//	go run ./cmd/migrate status   list the migrations and whether they are applied
+//
+// The database is configured by the same environment variables (or .env
+// file) as the server.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/joho/godotenv"
+
+	"zplus_web/backend/config"
+	"zplus_web/backend/database"
+	"zplus_web/backend/migrate"
+	"zplus_web/backend/migrations"
+)
+
+const usage = "usage: migrate up|down|status|redo"
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal(usage)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	pg, err := database.ConnectPostgreSQL(config.Load())
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pg.Close()
+
+	migrator, err := migrate.New(pg, migrations.Files)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			log.Printf("Applied %s", m)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(applied) == 0 {
+			log.Println("The database is up to date")
+		}
+	case "down":
+		m, err := migrator.Down(ctx)
+		if errors.Is(err, migrate.ErrNothingToRollBack) {
+			log.Println("No migration has been applied")
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Rolled back %s", m)
+	case "redo":
+		m, err := migrator.Redo(ctx)
+		if errors.Is(err, migrate.ErrNothingToRollBack) {
+			log.Println("No migration has been applied")
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Rolled back and applied %s", m)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printStatus(statuses)
+	default:
+		log.Fatal(usage)
+	}
+}
+
+func printStatus(statuses []migrate.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT\tNOTE")
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if s.Applied() {
+			appliedAt = s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		note := ""
+		switch {
+		case s.Missing:
+			note = "no migration file"
+		case s.Modified:
+			note = "file changed since it was applied"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, appliedAt, note)
+	}
+	w.Flush()
+}
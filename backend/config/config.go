@@ -2,6 +2,9 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -10,11 +13,46 @@ type Config struct {
 	DBUser     string
 	DBPassword string
 	DBName     string
+	MigrateOnStart bool
 	RedisHost  string
 	RedisPort  string
 	RedisPassword string
 	Port       string
 	Env        string
+	RequestTimeout time.Duration
+	AppURL     string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	JWTSecret    string
+	JWTKeys      string
+	JWTActiveKID string
+	MFAIssuer    string
+	OAuthProviders []OAuthProvider
+	PasswordHash        string
+	PasswordBcryptCost  int
+	PasswordMinLength   int
+	PasswordMinClasses  int
+	PasswordCheckCommon bool
+	PasswordHistory     int
+	GraphQLMaxDepth      int
+	GraphQLMaxComplexity int
+}
+
+// OAuthProvider configures a social / OpenID Connect login provider.
+// Known providers (google, github, facebook) only need a client id and secret;
+// any other OpenID Connect provider is configured with its issuer URL.
+type OAuthProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Issuer       string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	Scopes       []string
 }
 
 func Load() *Config {
@@ -24,12 +62,59 @@ func Load() *Config {
 		DBUser:     getEnv("DB_USER", "postgres"),
 		DBPassword: getEnv("DB_PASSWORD", "password"),
 		DBName:     getEnv("DB_NAME", "zplus_web"),
+		MigrateOnStart: getEnvBool("MIGRATE_ON_START", true),
 		RedisHost:  getEnv("REDIS_HOST", "localhost"),
 		RedisPort:  getEnv("REDIS_PORT", "6379"),
 		RedisPassword: getEnv("REDIS_PASSWORD", ""),
 		Port:       getEnv("PORT", "3000"),
 		Env:        getEnv("ENV", "development"),
+		RequestTimeout: getEnvDuration("REQUEST_TIMEOUT", 15*time.Second),
+		AppURL:     getEnv("APP_URL", "http://localhost:3001"),
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnv("SMTP_PORT", "1025"),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		MailFrom:     getEnv("MAIL_FROM", "ZPlus <no-reply@zplus.com>"),
+		JWTSecret:    getEnv("JWT_SECRET", ""),
+		JWTKeys:      getEnv("JWT_KEYS", ""),
+		JWTActiveKID: getEnv("JWT_ACTIVE_KID", ""),
+		MFAIssuer:    getEnv("MFA_ISSUER", "ZPlus"),
+		OAuthProviders: loadOAuthProviders(),
+		PasswordHash:        getEnv("PASSWORD_HASH", "bcrypt"),
+		PasswordBcryptCost:  getEnvInt("PASSWORD_BCRYPT_COST", 10),
+		PasswordMinLength:   getEnvInt("PASSWORD_MIN_LENGTH", 8),
+		PasswordMinClasses:  getEnvInt("PASSWORD_MIN_CLASSES", 3),
+		PasswordCheckCommon: getEnvBool("PASSWORD_CHECK_COMMON", true),
+		PasswordHistory:     getEnvInt("PASSWORD_HISTORY", 5),
+		GraphQLMaxDepth:      getEnvInt("GRAPHQL_MAX_DEPTH", 8),
+		GraphQLMaxComplexity: getEnvInt("GRAPHQL_MAX_COMPLEXITY", 1000),
+	}
+}
+
+// loadOAuthProviders reads the providers listed in OAUTH_PROVIDERS, each one
+// from OAUTH_<NAME>_CLIENT_ID, _CLIENT_SECRET and optional _ISSUER,
+// _AUTH_URL, _TOKEN_URL, _USERINFO_URL and _SCOPES (space separated)
+func loadOAuthProviders() []OAuthProvider {
+	var providers []OAuthProvider
+	for _, name := range strings.Split(getEnv("OAUTH_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+		providers = append(providers, OAuthProvider{
+			Name:         name,
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			Issuer:       getEnv(prefix+"ISSUER", ""),
+			AuthURL:      getEnv(prefix+"AUTH_URL", ""),
+			TokenURL:     getEnv(prefix+"TOKEN_URL", ""),
+			UserInfoURL:  getEnv(prefix+"USERINFO_URL", ""),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "")),
+		})
 	}
+	return providers
 }
 
 func getEnv(key, defaultValue string) string {
@@ -37,4 +122,24 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
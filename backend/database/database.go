@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"zplus_web/backend/config"
+	"zplus_web/backend/ent"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/go-redis/redis/v8"
 	_ "github.com/lib/pq"
 )
@@ -21,21 +24,51 @@ type Database struct {
 func NewDatabase(cfg *config.Config) (*Database, error) {
 	db := &Database{}
 
-	// PostgreSQL connection with retry logic
+	var err error
+	db.PostgreSQL, err = ConnectPostgreSQL(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redis connection
+	db.Redis = redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort),
+		Password: cfg.RedisPassword,
+		DB:       0,
+	})
+
+	// Test Redis connection
+	ctx := context.Background()
+	_, err = db.Redis.Ping(ctx).Result()
+	if err != nil {
+		log.Printf("Warning: Failed to connect to Redis: %v", err)
+		log.Println("Redis features will be disabled")
+	} else {
+		log.Println("Connected to Redis successfully")
+	}
+
+	return db, nil
+}
+
+// ConnectPostgreSQL opens the PostgreSQL connection pool, retrying while the
+// server is starting up
+func ConnectPostgreSQL(cfg *config.Config) (*sql.DB, error) {
 	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName)
 
+	var pg *sql.DB
 	var err error
 	maxRetries := 5
 	for i := 0; i < maxRetries; i++ {
-		db.PostgreSQL, err = sql.Open("postgres", psqlInfo)
+		pg, err = sql.Open("postgres", psqlInfo)
 		if err != nil {
 			log.Printf("Attempt %d: Failed to connect to PostgreSQL: %v", i+1, err)
 			time.Sleep(time.Second * 2)
 			continue
 		}
 
-		if err = db.PostgreSQL.Ping(); err != nil {
+		if err = pg.Ping(); err != nil {
+			pg.Close()
 			log.Printf("Attempt %d: Failed to ping PostgreSQL: %v", i+1, err)
 			time.Sleep(time.Second * 2)
 			continue
@@ -49,30 +82,18 @@ func NewDatabase(cfg *config.Config) (*Database, error) {
 	}
 
 	// Set connection pool settings
-	db.PostgreSQL.SetMaxOpenConns(25)
-	db.PostgreSQL.SetMaxIdleConns(25)
-	db.PostgreSQL.SetConnMaxLifetime(5 * time.Minute)
+	pg.SetMaxOpenConns(25)
+	pg.SetMaxIdleConns(25)
+	pg.SetConnMaxLifetime(5 * time.Minute)
 
 	log.Println("Connected to PostgreSQL successfully")
+	return pg, nil
+}
 
-	// Redis connection
-	db.Redis = redis.NewClient(&redis.Options{
-		Addr:     fmt.Sprintf("%s:%s", cfg.RedisHost, cfg.RedisPort),
-		Password: cfg.RedisPassword,
-		DB:       0,
-	})
-
-	// Test Redis connection
-	ctx := context.Background()
-	_, err = db.Redis.Ping(ctx).Result()
-	if err != nil {
-		log.Printf("Warning: Failed to connect to Redis: %v", err)
-		log.Println("Redis features will be disabled")
-	} else {
-		log.Println("Connected to Redis successfully")
-	}
-
-	return db, nil
+// NewEntClient returns an ent client sharing the PostgreSQL connection pool.
+// It is not closed separately, closing the pool is enough.
+func NewEntClient(pg *sql.DB) *ent.Client {
+	return ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, pg)))
 }
 
 func (db *Database) Close() {
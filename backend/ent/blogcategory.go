@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/blogcategory"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BlogCategory is the model entity for the BlogCategory schema.
+type BlogCategory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogCategoryQuery when eager-loading is set.
+	Edges        BlogCategoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlogCategoryEdges holds the relations/edges for other nodes in the graph.
+type BlogCategoryEdges struct {
+	// Posts holds the value of the posts edge.
+	Posts []*BlogPost `json:"posts,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostsOrErr returns the Posts value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogCategoryEdges) PostsOrErr() ([]*BlogPost, error) {
+	if e.loadedTypes[0] {
+		return e.Posts, nil
+	}
+	return nil, &NotLoadedError{edge: "posts"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlogCategory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blogcategory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case blogcategory.FieldName, blogcategory.FieldSlug, blogcategory.FieldDescription:
+			values[i] = new(sql.NullString)
+		case blogcategory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlogCategory fields.
+func (bc *BlogCategory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blogcategory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bc.ID = int(value.Int64)
+		case blogcategory.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				bc.Name = value.String
+			}
+		case blogcategory.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				bc.Slug = value.String
+			}
+		case blogcategory.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				bc.Description = new(string)
+				*bc.Description = value.String
+			}
+		case blogcategory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bc.CreatedAt = value.Time
+			}
+		default:
+			bc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlogCategory.
+// This includes values selected through modifiers, order, etc.
+func (bc *BlogCategory) Value(name string) (ent.Value, error) {
+	return bc.selectValues.Get(name)
+}
+
+// QueryPosts queries the "posts" edge of the BlogCategory entity.
+func (bc *BlogCategory) QueryPosts() *BlogPostQuery {
+	return NewBlogCategoryClient(bc.config).QueryPosts(bc)
+}
+
+// Update returns a builder for updating this BlogCategory.
+// Note that you need to call BlogCategory.Unwrap() before calling this method if this BlogCategory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bc *BlogCategory) Update() *BlogCategoryUpdateOne {
+	return NewBlogCategoryClient(bc.config).UpdateOne(bc)
+}
+
+// Unwrap unwraps the BlogCategory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bc *BlogCategory) Unwrap() *BlogCategory {
+	_tx, ok := bc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlogCategory is not a transactional entity")
+	}
+	bc.config.driver = _tx.drv
+	return bc
+}
+
+// String implements the fmt.Stringer.
+func (bc *BlogCategory) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlogCategory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bc.ID))
+	builder.WriteString("name=")
+	builder.WriteString(bc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(bc.Slug)
+	builder.WriteString(", ")
+	if v := bc.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlogCategories is a parsable slice of BlogCategory.
+type BlogCategories []*BlogCategory
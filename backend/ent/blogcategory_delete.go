@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogCategoryDelete is the builder for deleting a BlogCategory entity.
+type BlogCategoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlogCategoryMutation
+}
+
+// Where appends a list predicates to the BlogCategoryDelete builder.
+func (bcd *BlogCategoryDelete) Where(ps ...predicate.BlogCategory) *BlogCategoryDelete {
+	bcd.mutation.Where(ps...)
+	return bcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bcd *BlogCategoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bcd.sqlExec, bcd.mutation, bcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcd *BlogCategoryDelete) ExecX(ctx context.Context) int {
+	n, err := bcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bcd *BlogCategoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blogcategory.Table, sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt))
+	if ps := bcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bcd.mutation.done = true
+	return affected, err
+}
+
+// BlogCategoryDeleteOne is the builder for deleting a single BlogCategory entity.
+type BlogCategoryDeleteOne struct {
+	bcd *BlogCategoryDelete
+}
+
+// Where appends a list predicates to the BlogCategoryDelete builder.
+func (bcdo *BlogCategoryDeleteOne) Where(ps ...predicate.BlogCategory) *BlogCategoryDeleteOne {
+	bcdo.bcd.mutation.Where(ps...)
+	return bcdo
+}
+
+// Exec executes the deletion query.
+func (bcdo *BlogCategoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := bcdo.bcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blogcategory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcdo *BlogCategoryDeleteOne) ExecX(ctx context.Context) {
+	if err := bcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
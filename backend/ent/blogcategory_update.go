@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogCategoryUpdate is the builder for updating BlogCategory entities.
+type BlogCategoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlogCategoryMutation
+}
+
+// Where appends a list predicates to the BlogCategoryUpdate builder.
+func (bcu *BlogCategoryUpdate) Where(ps ...predicate.BlogCategory) *BlogCategoryUpdate {
+	bcu.mutation.Where(ps...)
+	return bcu
+}
+
+// SetName sets the "name" field.
+func (bcu *BlogCategoryUpdate) SetName(s string) *BlogCategoryUpdate {
+	bcu.mutation.SetName(s)
+	return bcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bcu *BlogCategoryUpdate) SetNillableName(s *string) *BlogCategoryUpdate {
+	if s != nil {
+		bcu.SetName(*s)
+	}
+	return bcu
+}
+
+// SetSlug sets the "slug" field.
+func (bcu *BlogCategoryUpdate) SetSlug(s string) *BlogCategoryUpdate {
+	bcu.mutation.SetSlug(s)
+	return bcu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (bcu *BlogCategoryUpdate) SetNillableSlug(s *string) *BlogCategoryUpdate {
+	if s != nil {
+		bcu.SetSlug(*s)
+	}
+	return bcu
+}
+
+// SetDescription sets the "description" field.
+func (bcu *BlogCategoryUpdate) SetDescription(s string) *BlogCategoryUpdate {
+	bcu.mutation.SetDescription(s)
+	return bcu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (bcu *BlogCategoryUpdate) SetNillableDescription(s *string) *BlogCategoryUpdate {
+	if s != nil {
+		bcu.SetDescription(*s)
+	}
+	return bcu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (bcu *BlogCategoryUpdate) ClearDescription() *BlogCategoryUpdate {
+	bcu.mutation.ClearDescription()
+	return bcu
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (bcu *BlogCategoryUpdate) AddPostIDs(ids ...int) *BlogCategoryUpdate {
+	bcu.mutation.AddPostIDs(ids...)
+	return bcu
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (bcu *BlogCategoryUpdate) AddPosts(b ...*BlogPost) *BlogCategoryUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bcu.AddPostIDs(ids...)
+}
+
+// Mutation returns the BlogCategoryMutation object of the builder.
+func (bcu *BlogCategoryUpdate) Mutation() *BlogCategoryMutation {
+	return bcu.mutation
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (bcu *BlogCategoryUpdate) ClearPosts() *BlogCategoryUpdate {
+	bcu.mutation.ClearPosts()
+	return bcu
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (bcu *BlogCategoryUpdate) RemovePostIDs(ids ...int) *BlogCategoryUpdate {
+	bcu.mutation.RemovePostIDs(ids...)
+	return bcu
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (bcu *BlogCategoryUpdate) RemovePosts(b ...*BlogPost) *BlogCategoryUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bcu.RemovePostIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bcu *BlogCategoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bcu.sqlSave, bcu.mutation, bcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcu *BlogCategoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := bcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bcu *BlogCategoryUpdate) Exec(ctx context.Context) error {
+	_, err := bcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcu *BlogCategoryUpdate) ExecX(ctx context.Context) {
+	if err := bcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcu *BlogCategoryUpdate) check() error {
+	if v, ok := bcu.mutation.Name(); ok {
+		if err := blogcategory.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlogCategory.name": %w`, err)}
+		}
+	}
+	if v, ok := bcu.mutation.Slug(); ok {
+		if err := blogcategory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "BlogCategory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bcu *BlogCategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogcategory.Table, blogcategory.Columns, sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt))
+	if ps := bcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcu.mutation.Name(); ok {
+		_spec.SetField(blogcategory.FieldName, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.Slug(); ok {
+		_spec.SetField(blogcategory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.Description(); ok {
+		_spec.SetField(blogcategory.FieldDescription, field.TypeString, value)
+	}
+	if bcu.mutation.DescriptionCleared() {
+		_spec.ClearField(blogcategory.FieldDescription, field.TypeString)
+	}
+	if bcu.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcu.mutation.RemovedPostsIDs(); len(nodes) > 0 && !bcu.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcu.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogcategory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bcu.mutation.done = true
+	return n, nil
+}
+
+// BlogCategoryUpdateOne is the builder for updating a single BlogCategory entity.
+type BlogCategoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlogCategoryMutation
+}
+
+// SetName sets the "name" field.
+func (bcuo *BlogCategoryUpdateOne) SetName(s string) *BlogCategoryUpdateOne {
+	bcuo.mutation.SetName(s)
+	return bcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bcuo *BlogCategoryUpdateOne) SetNillableName(s *string) *BlogCategoryUpdateOne {
+	if s != nil {
+		bcuo.SetName(*s)
+	}
+	return bcuo
+}
+
+// SetSlug sets the "slug" field.
+func (bcuo *BlogCategoryUpdateOne) SetSlug(s string) *BlogCategoryUpdateOne {
+	bcuo.mutation.SetSlug(s)
+	return bcuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (bcuo *BlogCategoryUpdateOne) SetNillableSlug(s *string) *BlogCategoryUpdateOne {
+	if s != nil {
+		bcuo.SetSlug(*s)
+	}
+	return bcuo
+}
+
+// SetDescription sets the "description" field.
+func (bcuo *BlogCategoryUpdateOne) SetDescription(s string) *BlogCategoryUpdateOne {
+	bcuo.mutation.SetDescription(s)
+	return bcuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (bcuo *BlogCategoryUpdateOne) SetNillableDescription(s *string) *BlogCategoryUpdateOne {
+	if s != nil {
+		bcuo.SetDescription(*s)
+	}
+	return bcuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (bcuo *BlogCategoryUpdateOne) ClearDescription() *BlogCategoryUpdateOne {
+	bcuo.mutation.ClearDescription()
+	return bcuo
+}
+
+// AddPostIDs adds the "posts" edge to the BlogPost entity by IDs.
+func (bcuo *BlogCategoryUpdateOne) AddPostIDs(ids ...int) *BlogCategoryUpdateOne {
+	bcuo.mutation.AddPostIDs(ids...)
+	return bcuo
+}
+
+// AddPosts adds the "posts" edges to the BlogPost entity.
+func (bcuo *BlogCategoryUpdateOne) AddPosts(b ...*BlogPost) *BlogCategoryUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bcuo.AddPostIDs(ids...)
+}
+
+// Mutation returns the BlogCategoryMutation object of the builder.
+func (bcuo *BlogCategoryUpdateOne) Mutation() *BlogCategoryMutation {
+	return bcuo.mutation
+}
+
+// ClearPosts clears all "posts" edges to the BlogPost entity.
+func (bcuo *BlogCategoryUpdateOne) ClearPosts() *BlogCategoryUpdateOne {
+	bcuo.mutation.ClearPosts()
+	return bcuo
+}
+
+// RemovePostIDs removes the "posts" edge to BlogPost entities by IDs.
+func (bcuo *BlogCategoryUpdateOne) RemovePostIDs(ids ...int) *BlogCategoryUpdateOne {
+	bcuo.mutation.RemovePostIDs(ids...)
+	return bcuo
+}
+
+// RemovePosts removes "posts" edges to BlogPost entities.
+func (bcuo *BlogCategoryUpdateOne) RemovePosts(b ...*BlogPost) *BlogCategoryUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bcuo.RemovePostIDs(ids...)
+}
+
+// Where appends a list predicates to the BlogCategoryUpdate builder.
+func (bcuo *BlogCategoryUpdateOne) Where(ps ...predicate.BlogCategory) *BlogCategoryUpdateOne {
+	bcuo.mutation.Where(ps...)
+	return bcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bcuo *BlogCategoryUpdateOne) Select(field string, fields ...string) *BlogCategoryUpdateOne {
+	bcuo.fields = append([]string{field}, fields...)
+	return bcuo
+}
+
+// Save executes the query and returns the updated BlogCategory entity.
+func (bcuo *BlogCategoryUpdateOne) Save(ctx context.Context) (*BlogCategory, error) {
+	return withHooks(ctx, bcuo.sqlSave, bcuo.mutation, bcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcuo *BlogCategoryUpdateOne) SaveX(ctx context.Context) *BlogCategory {
+	node, err := bcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bcuo *BlogCategoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := bcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcuo *BlogCategoryUpdateOne) ExecX(ctx context.Context) {
+	if err := bcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcuo *BlogCategoryUpdateOne) check() error {
+	if v, ok := bcuo.mutation.Name(); ok {
+		if err := blogcategory.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlogCategory.name": %w`, err)}
+		}
+	}
+	if v, ok := bcuo.mutation.Slug(); ok {
+		if err := blogcategory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "BlogCategory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bcuo *BlogCategoryUpdateOne) sqlSave(ctx context.Context) (_node *BlogCategory, err error) {
+	if err := bcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogcategory.Table, blogcategory.Columns, sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt))
+	id, ok := bcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlogCategory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogcategory.FieldID)
+		for _, f := range fields {
+			if !blogcategory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blogcategory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcuo.mutation.Name(); ok {
+		_spec.SetField(blogcategory.FieldName, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.Slug(); ok {
+		_spec.SetField(blogcategory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.Description(); ok {
+		_spec.SetField(blogcategory.FieldDescription, field.TypeString, value)
+	}
+	if bcuo.mutation.DescriptionCleared() {
+		_spec.ClearField(blogcategory.FieldDescription, field.TypeString)
+	}
+	if bcuo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcuo.mutation.RemovedPostsIDs(); len(nodes) > 0 && !bcuo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcuo.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blogcategory.PostsTable,
+			Columns: blogcategory.PostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlogCategory{config: bcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogcategory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bcuo.mutation.done = true
+	return _node, nil
+}
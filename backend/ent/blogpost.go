@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BlogPost is the model entity for the BlogPost schema.
+type BlogPost struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Excerpt holds the value of the "excerpt" field.
+	Excerpt *string `json:"excerpt,omitempty"`
+	// FeaturedImage holds the value of the "featured_image" field.
+	FeaturedImage *string `json:"featured_image,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID *int `json:"author_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status blogpost.Status `json:"status,omitempty"`
+	// IsFeatured holds the value of the "is_featured" field.
+	IsFeatured bool `json:"is_featured,omitempty"`
+	// ViewCount holds the value of the "view_count" field.
+	ViewCount int `json:"view_count,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogPostQuery when eager-loading is set.
+	Edges        BlogPostEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlogPostEdges holds the relations/edges for other nodes in the graph.
+type BlogPostEdges struct {
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*BlogCategory `json:"categories,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogPostEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogPostEdges) CategoriesOrErr() ([]*BlogCategory, error) {
+	if e.loadedTypes[1] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlogPost) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blogpost.FieldIsFeatured:
+			values[i] = new(sql.NullBool)
+		case blogpost.FieldID, blogpost.FieldAuthorID, blogpost.FieldViewCount:
+			values[i] = new(sql.NullInt64)
+		case blogpost.FieldTitle, blogpost.FieldSlug, blogpost.FieldContent, blogpost.FieldExcerpt, blogpost.FieldFeaturedImage, blogpost.FieldStatus:
+			values[i] = new(sql.NullString)
+		case blogpost.FieldPublishedAt, blogpost.FieldCreatedAt, blogpost.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlogPost fields.
+func (bp *BlogPost) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blogpost.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bp.ID = int(value.Int64)
+		case blogpost.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				bp.Title = value.String
+			}
+		case blogpost.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				bp.Slug = value.String
+			}
+		case blogpost.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				bp.Content = value.String
+			}
+		case blogpost.FieldExcerpt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
+			} else if value.Valid {
+				bp.Excerpt = new(string)
+				*bp.Excerpt = value.String
+			}
+		case blogpost.FieldFeaturedImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field featured_image", values[i])
+			} else if value.Valid {
+				bp.FeaturedImage = new(string)
+				*bp.FeaturedImage = value.String
+			}
+		case blogpost.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				bp.AuthorID = new(int)
+				*bp.AuthorID = int(value.Int64)
+			}
+		case blogpost.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				bp.Status = blogpost.Status(value.String)
+			}
+		case blogpost.FieldIsFeatured:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_featured", values[i])
+			} else if value.Valid {
+				bp.IsFeatured = value.Bool
+			}
+		case blogpost.FieldViewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field view_count", values[i])
+			} else if value.Valid {
+				bp.ViewCount = int(value.Int64)
+			}
+		case blogpost.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				bp.PublishedAt = new(time.Time)
+				*bp.PublishedAt = value.Time
+			}
+		case blogpost.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bp.CreatedAt = value.Time
+			}
+		case blogpost.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bp.UpdatedAt = value.Time
+			}
+		default:
+			bp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlogPost.
+// This includes values selected through modifiers, order, etc.
+func (bp *BlogPost) Value(name string) (ent.Value, error) {
+	return bp.selectValues.Get(name)
+}
+
+// QueryAuthor queries the "author" edge of the BlogPost entity.
+func (bp *BlogPost) QueryAuthor() *UserQuery {
+	return NewBlogPostClient(bp.config).QueryAuthor(bp)
+}
+
+// QueryCategories queries the "categories" edge of the BlogPost entity.
+func (bp *BlogPost) QueryCategories() *BlogCategoryQuery {
+	return NewBlogPostClient(bp.config).QueryCategories(bp)
+}
+
+// Update returns a builder for updating this BlogPost.
+// Note that you need to call BlogPost.Unwrap() before calling this method if this BlogPost
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bp *BlogPost) Update() *BlogPostUpdateOne {
+	return NewBlogPostClient(bp.config).UpdateOne(bp)
+}
+
+// Unwrap unwraps the BlogPost entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bp *BlogPost) Unwrap() *BlogPost {
+	_tx, ok := bp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlogPost is not a transactional entity")
+	}
+	bp.config.driver = _tx.drv
+	return bp
+}
+
+// String implements the fmt.Stringer.
+func (bp *BlogPost) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlogPost(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bp.ID))
+	builder.WriteString("title=")
+	builder.WriteString(bp.Title)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(bp.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(bp.Content)
+	builder.WriteString(", ")
+	if v := bp.Excerpt; v != nil {
+		builder.WriteString("excerpt=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := bp.FeaturedImage; v != nil {
+		builder.WriteString("featured_image=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := bp.AuthorID; v != nil {
+		builder.WriteString("author_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Status))
+	builder.WriteString(", ")
+	builder.WriteString("is_featured=")
+	builder.WriteString(fmt.Sprintf("%v", bp.IsFeatured))
+	builder.WriteString(", ")
+	builder.WriteString("view_count=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ViewCount))
+	builder.WriteString(", ")
+	if v := bp.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlogPosts is a parsable slice of BlogPost.
+type BlogPosts []*BlogPost
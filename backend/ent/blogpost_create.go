@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogPostCreate is the builder for creating a BlogPost entity.
+type BlogPostCreate struct {
+	config
+	mutation *BlogPostMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (bpc *BlogPostCreate) SetTitle(s string) *BlogPostCreate {
+	bpc.mutation.SetTitle(s)
+	return bpc
+}
+
+// SetSlug sets the "slug" field.
+func (bpc *BlogPostCreate) SetSlug(s string) *BlogPostCreate {
+	bpc.mutation.SetSlug(s)
+	return bpc
+}
+
+// SetContent sets the "content" field.
+func (bpc *BlogPostCreate) SetContent(s string) *BlogPostCreate {
+	bpc.mutation.SetContent(s)
+	return bpc
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (bpc *BlogPostCreate) SetExcerpt(s string) *BlogPostCreate {
+	bpc.mutation.SetExcerpt(s)
+	return bpc
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableExcerpt(s *string) *BlogPostCreate {
+	if s != nil {
+		bpc.SetExcerpt(*s)
+	}
+	return bpc
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (bpc *BlogPostCreate) SetFeaturedImage(s string) *BlogPostCreate {
+	bpc.mutation.SetFeaturedImage(s)
+	return bpc
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableFeaturedImage(s *string) *BlogPostCreate {
+	if s != nil {
+		bpc.SetFeaturedImage(*s)
+	}
+	return bpc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (bpc *BlogPostCreate) SetAuthorID(i int) *BlogPostCreate {
+	bpc.mutation.SetAuthorID(i)
+	return bpc
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableAuthorID(i *int) *BlogPostCreate {
+	if i != nil {
+		bpc.SetAuthorID(*i)
+	}
+	return bpc
+}
+
+// SetStatus sets the "status" field.
+func (bpc *BlogPostCreate) SetStatus(b blogpost.Status) *BlogPostCreate {
+	bpc.mutation.SetStatus(b)
+	return bpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableStatus(b *blogpost.Status) *BlogPostCreate {
+	if b != nil {
+		bpc.SetStatus(*b)
+	}
+	return bpc
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (bpc *BlogPostCreate) SetIsFeatured(b bool) *BlogPostCreate {
+	bpc.mutation.SetIsFeatured(b)
+	return bpc
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableIsFeatured(b *bool) *BlogPostCreate {
+	if b != nil {
+		bpc.SetIsFeatured(*b)
+	}
+	return bpc
+}
+
+// SetViewCount sets the "view_count" field.
+func (bpc *BlogPostCreate) SetViewCount(i int) *BlogPostCreate {
+	bpc.mutation.SetViewCount(i)
+	return bpc
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableViewCount(i *int) *BlogPostCreate {
+	if i != nil {
+		bpc.SetViewCount(*i)
+	}
+	return bpc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (bpc *BlogPostCreate) SetPublishedAt(t time.Time) *BlogPostCreate {
+	bpc.mutation.SetPublishedAt(t)
+	return bpc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillablePublishedAt(t *time.Time) *BlogPostCreate {
+	if t != nil {
+		bpc.SetPublishedAt(*t)
+	}
+	return bpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bpc *BlogPostCreate) SetCreatedAt(t time.Time) *BlogPostCreate {
+	bpc.mutation.SetCreatedAt(t)
+	return bpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableCreatedAt(t *time.Time) *BlogPostCreate {
+	if t != nil {
+		bpc.SetCreatedAt(*t)
+	}
+	return bpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpc *BlogPostCreate) SetUpdatedAt(t time.Time) *BlogPostCreate {
+	bpc.mutation.SetUpdatedAt(t)
+	return bpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bpc *BlogPostCreate) SetNillableUpdatedAt(t *time.Time) *BlogPostCreate {
+	if t != nil {
+		bpc.SetUpdatedAt(*t)
+	}
+	return bpc
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpc *BlogPostCreate) SetAuthor(u *User) *BlogPostCreate {
+	return bpc.SetAuthorID(u.ID)
+}
+
+// AddCategoryIDs adds the "categories" edge to the BlogCategory entity by IDs.
+func (bpc *BlogPostCreate) AddCategoryIDs(ids ...int) *BlogPostCreate {
+	bpc.mutation.AddCategoryIDs(ids...)
+	return bpc
+}
+
+// AddCategories adds the "categories" edges to the BlogCategory entity.
+func (bpc *BlogPostCreate) AddCategories(b ...*BlogCategory) *BlogPostCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpc.AddCategoryIDs(ids...)
+}
+
+// Mutation returns the BlogPostMutation object of the builder.
+func (bpc *BlogPostCreate) Mutation() *BlogPostMutation {
+	return bpc.mutation
+}
+
+// Save creates the BlogPost in the database.
+func (bpc *BlogPostCreate) Save(ctx context.Context) (*BlogPost, error) {
+	bpc.defaults()
+	return withHooks(ctx, bpc.sqlSave, bpc.mutation, bpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bpc *BlogPostCreate) SaveX(ctx context.Context) *BlogPost {
+	v, err := bpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpc *BlogPostCreate) Exec(ctx context.Context) error {
+	_, err := bpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpc *BlogPostCreate) ExecX(ctx context.Context) {
+	if err := bpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpc *BlogPostCreate) defaults() {
+	if _, ok := bpc.mutation.Status(); !ok {
+		v := blogpost.DefaultStatus
+		bpc.mutation.SetStatus(v)
+	}
+	if _, ok := bpc.mutation.IsFeatured(); !ok {
+		v := blogpost.DefaultIsFeatured
+		bpc.mutation.SetIsFeatured(v)
+	}
+	if _, ok := bpc.mutation.ViewCount(); !ok {
+		v := blogpost.DefaultViewCount
+		bpc.mutation.SetViewCount(v)
+	}
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		v := blogpost.DefaultCreatedAt()
+		bpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		v := blogpost.DefaultUpdatedAt()
+		bpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpc *BlogPostCreate) check() error {
+	if _, ok := bpc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "BlogPost.title"`)}
+	}
+	if v, ok := bpc.mutation.Title(); ok {
+		if err := blogpost.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BlogPost.title": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "BlogPost.slug"`)}
+	}
+	if v, ok := bpc.mutation.Slug(); ok {
+		if err := blogpost.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "BlogPost.slug": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "BlogPost.content"`)}
+	}
+	if v, ok := bpc.mutation.Content(); ok {
+		if err := blogpost.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BlogPost.status"`)}
+	}
+	if v, ok := bpc.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.IsFeatured(); !ok {
+		return &ValidationError{Name: "is_featured", err: errors.New(`ent: missing required field "BlogPost.is_featured"`)}
+	}
+	if _, ok := bpc.mutation.ViewCount(); !ok {
+		return &ValidationError{Name: "view_count", err: errors.New(`ent: missing required field "BlogPost.view_count"`)}
+	}
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlogPost.created_at"`)}
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BlogPost.updated_at"`)}
+	}
+	return nil
+}
+
+func (bpc *BlogPostCreate) sqlSave(ctx context.Context) (*BlogPost, error) {
+	if err := bpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bpc.mutation.id = &_node.ID
+	bpc.mutation.done = true
+	return _node, nil
+}
+
+func (bpc *BlogPostCreate) createSpec() (*BlogPost, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlogPost{config: bpc.config}
+		_spec = sqlgraph.NewCreateSpec(blogpost.Table, sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt))
+	)
+	if value, ok := bpc.mutation.Title(); ok {
+		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := bpc.mutation.Slug(); ok {
+		_spec.SetField(blogpost.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := bpc.mutation.Content(); ok {
+		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := bpc.mutation.Excerpt(); ok {
+		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
+		_node.Excerpt = &value
+	}
+	if value, ok := bpc.mutation.FeaturedImage(); ok {
+		_spec.SetField(blogpost.FieldFeaturedImage, field.TypeString, value)
+		_node.FeaturedImage = &value
+	}
+	if value, ok := bpc.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := bpc.mutation.IsFeatured(); ok {
+		_spec.SetField(blogpost.FieldIsFeatured, field.TypeBool, value)
+		_node.IsFeatured = value
+	}
+	if value, ok := bpc.mutation.ViewCount(); ok {
+		_spec.SetField(blogpost.FieldViewCount, field.TypeInt, value)
+		_node.ViewCount = value
+	}
+	if value, ok := bpc.mutation.PublishedAt(); ok {
+		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := bpc.mutation.CreatedAt(); ok {
+		_spec.SetField(blogpost.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blogpost.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := bpc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bpc.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlogPostCreateBulk is the builder for creating many BlogPost entities in bulk.
+type BlogPostCreateBulk struct {
+	config
+	err      error
+	builders []*BlogPostCreate
+}
+
+// Save creates the BlogPost entities in the database.
+func (bpcb *BlogPostCreateBulk) Save(ctx context.Context) ([]*BlogPost, error) {
+	if bpcb.err != nil {
+		return nil, bpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bpcb.builders))
+	nodes := make([]*BlogPost, len(bpcb.builders))
+	mutators := make([]Mutator, len(bpcb.builders))
+	for i := range bpcb.builders {
+		func(i int, root context.Context) {
+			builder := bpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlogPostMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpcb *BlogPostCreateBulk) SaveX(ctx context.Context) []*BlogPost {
+	v, err := bpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpcb *BlogPostCreateBulk) Exec(ctx context.Context) error {
+	_, err := bpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpcb *BlogPostCreateBulk) ExecX(ctx context.Context) {
+	if err := bpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
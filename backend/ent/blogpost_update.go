@@ -0,0 +1,932 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogPostUpdate is the builder for updating BlogPost entities.
+type BlogPostUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlogPostMutation
+}
+
+// Where appends a list predicates to the BlogPostUpdate builder.
+func (bpu *BlogPostUpdate) Where(ps ...predicate.BlogPost) *BlogPostUpdate {
+	bpu.mutation.Where(ps...)
+	return bpu
+}
+
+// SetTitle sets the "title" field.
+func (bpu *BlogPostUpdate) SetTitle(s string) *BlogPostUpdate {
+	bpu.mutation.SetTitle(s)
+	return bpu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableTitle(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetTitle(*s)
+	}
+	return bpu
+}
+
+// SetSlug sets the "slug" field.
+func (bpu *BlogPostUpdate) SetSlug(s string) *BlogPostUpdate {
+	bpu.mutation.SetSlug(s)
+	return bpu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableSlug(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetSlug(*s)
+	}
+	return bpu
+}
+
+// SetContent sets the "content" field.
+func (bpu *BlogPostUpdate) SetContent(s string) *BlogPostUpdate {
+	bpu.mutation.SetContent(s)
+	return bpu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableContent(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetContent(*s)
+	}
+	return bpu
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (bpu *BlogPostUpdate) SetExcerpt(s string) *BlogPostUpdate {
+	bpu.mutation.SetExcerpt(s)
+	return bpu
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableExcerpt(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetExcerpt(*s)
+	}
+	return bpu
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (bpu *BlogPostUpdate) ClearExcerpt() *BlogPostUpdate {
+	bpu.mutation.ClearExcerpt()
+	return bpu
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (bpu *BlogPostUpdate) SetFeaturedImage(s string) *BlogPostUpdate {
+	bpu.mutation.SetFeaturedImage(s)
+	return bpu
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableFeaturedImage(s *string) *BlogPostUpdate {
+	if s != nil {
+		bpu.SetFeaturedImage(*s)
+	}
+	return bpu
+}
+
+// ClearFeaturedImage clears the value of the "featured_image" field.
+func (bpu *BlogPostUpdate) ClearFeaturedImage() *BlogPostUpdate {
+	bpu.mutation.ClearFeaturedImage()
+	return bpu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (bpu *BlogPostUpdate) SetAuthorID(i int) *BlogPostUpdate {
+	bpu.mutation.SetAuthorID(i)
+	return bpu
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableAuthorID(i *int) *BlogPostUpdate {
+	if i != nil {
+		bpu.SetAuthorID(*i)
+	}
+	return bpu
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (bpu *BlogPostUpdate) ClearAuthorID() *BlogPostUpdate {
+	bpu.mutation.ClearAuthorID()
+	return bpu
+}
+
+// SetStatus sets the "status" field.
+func (bpu *BlogPostUpdate) SetStatus(b blogpost.Status) *BlogPostUpdate {
+	bpu.mutation.SetStatus(b)
+	return bpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableStatus(b *blogpost.Status) *BlogPostUpdate {
+	if b != nil {
+		bpu.SetStatus(*b)
+	}
+	return bpu
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (bpu *BlogPostUpdate) SetIsFeatured(b bool) *BlogPostUpdate {
+	bpu.mutation.SetIsFeatured(b)
+	return bpu
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableIsFeatured(b *bool) *BlogPostUpdate {
+	if b != nil {
+		bpu.SetIsFeatured(*b)
+	}
+	return bpu
+}
+
+// SetViewCount sets the "view_count" field.
+func (bpu *BlogPostUpdate) SetViewCount(i int) *BlogPostUpdate {
+	bpu.mutation.ResetViewCount()
+	bpu.mutation.SetViewCount(i)
+	return bpu
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillableViewCount(i *int) *BlogPostUpdate {
+	if i != nil {
+		bpu.SetViewCount(*i)
+	}
+	return bpu
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (bpu *BlogPostUpdate) AddViewCount(i int) *BlogPostUpdate {
+	bpu.mutation.AddViewCount(i)
+	return bpu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (bpu *BlogPostUpdate) SetPublishedAt(t time.Time) *BlogPostUpdate {
+	bpu.mutation.SetPublishedAt(t)
+	return bpu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (bpu *BlogPostUpdate) SetNillablePublishedAt(t *time.Time) *BlogPostUpdate {
+	if t != nil {
+		bpu.SetPublishedAt(*t)
+	}
+	return bpu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (bpu *BlogPostUpdate) ClearPublishedAt() *BlogPostUpdate {
+	bpu.mutation.ClearPublishedAt()
+	return bpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpu *BlogPostUpdate) SetUpdatedAt(t time.Time) *BlogPostUpdate {
+	bpu.mutation.SetUpdatedAt(t)
+	return bpu
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpu *BlogPostUpdate) SetAuthor(u *User) *BlogPostUpdate {
+	return bpu.SetAuthorID(u.ID)
+}
+
+// AddCategoryIDs adds the "categories" edge to the BlogCategory entity by IDs.
+func (bpu *BlogPostUpdate) AddCategoryIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.AddCategoryIDs(ids...)
+	return bpu
+}
+
+// AddCategories adds the "categories" edges to the BlogCategory entity.
+func (bpu *BlogPostUpdate) AddCategories(b ...*BlogCategory) *BlogPostUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpu.AddCategoryIDs(ids...)
+}
+
+// Mutation returns the BlogPostMutation object of the builder.
+func (bpu *BlogPostUpdate) Mutation() *BlogPostMutation {
+	return bpu.mutation
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (bpu *BlogPostUpdate) ClearAuthor() *BlogPostUpdate {
+	bpu.mutation.ClearAuthor()
+	return bpu
+}
+
+// ClearCategories clears all "categories" edges to the BlogCategory entity.
+func (bpu *BlogPostUpdate) ClearCategories() *BlogPostUpdate {
+	bpu.mutation.ClearCategories()
+	return bpu
+}
+
+// RemoveCategoryIDs removes the "categories" edge to BlogCategory entities by IDs.
+func (bpu *BlogPostUpdate) RemoveCategoryIDs(ids ...int) *BlogPostUpdate {
+	bpu.mutation.RemoveCategoryIDs(ids...)
+	return bpu
+}
+
+// RemoveCategories removes "categories" edges to BlogCategory entities.
+func (bpu *BlogPostUpdate) RemoveCategories(b ...*BlogCategory) *BlogPostUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpu.RemoveCategoryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bpu *BlogPostUpdate) Save(ctx context.Context) (int, error) {
+	bpu.defaults()
+	return withHooks(ctx, bpu.sqlSave, bpu.mutation, bpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpu *BlogPostUpdate) SaveX(ctx context.Context) int {
+	affected, err := bpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bpu *BlogPostUpdate) Exec(ctx context.Context) error {
+	_, err := bpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpu *BlogPostUpdate) ExecX(ctx context.Context) {
+	if err := bpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpu *BlogPostUpdate) defaults() {
+	if _, ok := bpu.mutation.UpdatedAt(); !ok {
+		v := blogpost.UpdateDefaultUpdatedAt()
+		bpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpu *BlogPostUpdate) check() error {
+	if v, ok := bpu.mutation.Title(); ok {
+		if err := blogpost.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BlogPost.title": %w`, err)}
+		}
+	}
+	if v, ok := bpu.mutation.Slug(); ok {
+		if err := blogpost.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "BlogPost.slug": %w`, err)}
+		}
+	}
+	if v, ok := bpu.mutation.Content(); ok {
+		if err := blogpost.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
+		}
+	}
+	if v, ok := bpu.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bpu *BlogPostUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogpost.Table, blogpost.Columns, sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt))
+	if ps := bpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpu.mutation.Title(); ok {
+		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Slug(); ok {
+		_spec.SetField(blogpost.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Content(); ok {
+		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.Excerpt(); ok {
+		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
+	}
+	if bpu.mutation.ExcerptCleared() {
+		_spec.ClearField(blogpost.FieldExcerpt, field.TypeString)
+	}
+	if value, ok := bpu.mutation.FeaturedImage(); ok {
+		_spec.SetField(blogpost.FieldFeaturedImage, field.TypeString, value)
+	}
+	if bpu.mutation.FeaturedImageCleared() {
+		_spec.ClearField(blogpost.FieldFeaturedImage, field.TypeString)
+	}
+	if value, ok := bpu.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bpu.mutation.IsFeatured(); ok {
+		_spec.SetField(blogpost.FieldIsFeatured, field.TypeBool, value)
+	}
+	if value, ok := bpu.mutation.ViewCount(); ok {
+		_spec.SetField(blogpost.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedViewCount(); ok {
+		_spec.AddField(blogpost.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.PublishedAt(); ok {
+		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
+	}
+	if bpu.mutation.PublishedAtCleared() {
+		_spec.ClearField(blogpost.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := bpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(blogpost.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if bpu.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !bpu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogpost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bpu.mutation.done = true
+	return n, nil
+}
+
+// BlogPostUpdateOne is the builder for updating a single BlogPost entity.
+type BlogPostUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlogPostMutation
+}
+
+// SetTitle sets the "title" field.
+func (bpuo *BlogPostUpdateOne) SetTitle(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetTitle(s)
+	return bpuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableTitle(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetTitle(*s)
+	}
+	return bpuo
+}
+
+// SetSlug sets the "slug" field.
+func (bpuo *BlogPostUpdateOne) SetSlug(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetSlug(s)
+	return bpuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableSlug(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetSlug(*s)
+	}
+	return bpuo
+}
+
+// SetContent sets the "content" field.
+func (bpuo *BlogPostUpdateOne) SetContent(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetContent(s)
+	return bpuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableContent(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetContent(*s)
+	}
+	return bpuo
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (bpuo *BlogPostUpdateOne) SetExcerpt(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetExcerpt(s)
+	return bpuo
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableExcerpt(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetExcerpt(*s)
+	}
+	return bpuo
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (bpuo *BlogPostUpdateOne) ClearExcerpt() *BlogPostUpdateOne {
+	bpuo.mutation.ClearExcerpt()
+	return bpuo
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (bpuo *BlogPostUpdateOne) SetFeaturedImage(s string) *BlogPostUpdateOne {
+	bpuo.mutation.SetFeaturedImage(s)
+	return bpuo
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableFeaturedImage(s *string) *BlogPostUpdateOne {
+	if s != nil {
+		bpuo.SetFeaturedImage(*s)
+	}
+	return bpuo
+}
+
+// ClearFeaturedImage clears the value of the "featured_image" field.
+func (bpuo *BlogPostUpdateOne) ClearFeaturedImage() *BlogPostUpdateOne {
+	bpuo.mutation.ClearFeaturedImage()
+	return bpuo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (bpuo *BlogPostUpdateOne) SetAuthorID(i int) *BlogPostUpdateOne {
+	bpuo.mutation.SetAuthorID(i)
+	return bpuo
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableAuthorID(i *int) *BlogPostUpdateOne {
+	if i != nil {
+		bpuo.SetAuthorID(*i)
+	}
+	return bpuo
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (bpuo *BlogPostUpdateOne) ClearAuthorID() *BlogPostUpdateOne {
+	bpuo.mutation.ClearAuthorID()
+	return bpuo
+}
+
+// SetStatus sets the "status" field.
+func (bpuo *BlogPostUpdateOne) SetStatus(b blogpost.Status) *BlogPostUpdateOne {
+	bpuo.mutation.SetStatus(b)
+	return bpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableStatus(b *blogpost.Status) *BlogPostUpdateOne {
+	if b != nil {
+		bpuo.SetStatus(*b)
+	}
+	return bpuo
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (bpuo *BlogPostUpdateOne) SetIsFeatured(b bool) *BlogPostUpdateOne {
+	bpuo.mutation.SetIsFeatured(b)
+	return bpuo
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableIsFeatured(b *bool) *BlogPostUpdateOne {
+	if b != nil {
+		bpuo.SetIsFeatured(*b)
+	}
+	return bpuo
+}
+
+// SetViewCount sets the "view_count" field.
+func (bpuo *BlogPostUpdateOne) SetViewCount(i int) *BlogPostUpdateOne {
+	bpuo.mutation.ResetViewCount()
+	bpuo.mutation.SetViewCount(i)
+	return bpuo
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillableViewCount(i *int) *BlogPostUpdateOne {
+	if i != nil {
+		bpuo.SetViewCount(*i)
+	}
+	return bpuo
+}
+
+// AddViewCount adds i to the "view_count" field.
+func (bpuo *BlogPostUpdateOne) AddViewCount(i int) *BlogPostUpdateOne {
+	bpuo.mutation.AddViewCount(i)
+	return bpuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (bpuo *BlogPostUpdateOne) SetPublishedAt(t time.Time) *BlogPostUpdateOne {
+	bpuo.mutation.SetPublishedAt(t)
+	return bpuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (bpuo *BlogPostUpdateOne) SetNillablePublishedAt(t *time.Time) *BlogPostUpdateOne {
+	if t != nil {
+		bpuo.SetPublishedAt(*t)
+	}
+	return bpuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (bpuo *BlogPostUpdateOne) ClearPublishedAt() *BlogPostUpdateOne {
+	bpuo.mutation.ClearPublishedAt()
+	return bpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpuo *BlogPostUpdateOne) SetUpdatedAt(t time.Time) *BlogPostUpdateOne {
+	bpuo.mutation.SetUpdatedAt(t)
+	return bpuo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (bpuo *BlogPostUpdateOne) SetAuthor(u *User) *BlogPostUpdateOne {
+	return bpuo.SetAuthorID(u.ID)
+}
+
+// AddCategoryIDs adds the "categories" edge to the BlogCategory entity by IDs.
+func (bpuo *BlogPostUpdateOne) AddCategoryIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.AddCategoryIDs(ids...)
+	return bpuo
+}
+
+// AddCategories adds the "categories" edges to the BlogCategory entity.
+func (bpuo *BlogPostUpdateOne) AddCategories(b ...*BlogCategory) *BlogPostUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpuo.AddCategoryIDs(ids...)
+}
+
+// Mutation returns the BlogPostMutation object of the builder.
+func (bpuo *BlogPostUpdateOne) Mutation() *BlogPostMutation {
+	return bpuo.mutation
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (bpuo *BlogPostUpdateOne) ClearAuthor() *BlogPostUpdateOne {
+	bpuo.mutation.ClearAuthor()
+	return bpuo
+}
+
+// ClearCategories clears all "categories" edges to the BlogCategory entity.
+func (bpuo *BlogPostUpdateOne) ClearCategories() *BlogPostUpdateOne {
+	bpuo.mutation.ClearCategories()
+	return bpuo
+}
+
+// RemoveCategoryIDs removes the "categories" edge to BlogCategory entities by IDs.
+func (bpuo *BlogPostUpdateOne) RemoveCategoryIDs(ids ...int) *BlogPostUpdateOne {
+	bpuo.mutation.RemoveCategoryIDs(ids...)
+	return bpuo
+}
+
+// RemoveCategories removes "categories" edges to BlogCategory entities.
+func (bpuo *BlogPostUpdateOne) RemoveCategories(b ...*BlogCategory) *BlogPostUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bpuo.RemoveCategoryIDs(ids...)
+}
+
+// Where appends a list predicates to the BlogPostUpdate builder.
+func (bpuo *BlogPostUpdateOne) Where(ps ...predicate.BlogPost) *BlogPostUpdateOne {
+	bpuo.mutation.Where(ps...)
+	return bpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bpuo *BlogPostUpdateOne) Select(field string, fields ...string) *BlogPostUpdateOne {
+	bpuo.fields = append([]string{field}, fields...)
+	return bpuo
+}
+
+// Save executes the query and returns the updated BlogPost entity.
+func (bpuo *BlogPostUpdateOne) Save(ctx context.Context) (*BlogPost, error) {
+	bpuo.defaults()
+	return withHooks(ctx, bpuo.sqlSave, bpuo.mutation, bpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpuo *BlogPostUpdateOne) SaveX(ctx context.Context) *BlogPost {
+	node, err := bpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bpuo *BlogPostUpdateOne) Exec(ctx context.Context) error {
+	_, err := bpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpuo *BlogPostUpdateOne) ExecX(ctx context.Context) {
+	if err := bpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpuo *BlogPostUpdateOne) defaults() {
+	if _, ok := bpuo.mutation.UpdatedAt(); !ok {
+		v := blogpost.UpdateDefaultUpdatedAt()
+		bpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpuo *BlogPostUpdateOne) check() error {
+	if v, ok := bpuo.mutation.Title(); ok {
+		if err := blogpost.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BlogPost.title": %w`, err)}
+		}
+	}
+	if v, ok := bpuo.mutation.Slug(); ok {
+		if err := blogpost.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "BlogPost.slug": %w`, err)}
+		}
+	}
+	if v, ok := bpuo.mutation.Content(); ok {
+		if err := blogpost.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "BlogPost.content": %w`, err)}
+		}
+	}
+	if v, ok := bpuo.mutation.Status(); ok {
+		if err := blogpost.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BlogPost.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bpuo *BlogPostUpdateOne) sqlSave(ctx context.Context) (_node *BlogPost, err error) {
+	if err := bpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogpost.Table, blogpost.Columns, sqlgraph.NewFieldSpec(blogpost.FieldID, field.TypeInt))
+	id, ok := bpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlogPost.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogpost.FieldID)
+		for _, f := range fields {
+			if !blogpost.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blogpost.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpuo.mutation.Title(); ok {
+		_spec.SetField(blogpost.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Slug(); ok {
+		_spec.SetField(blogpost.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Content(); ok {
+		_spec.SetField(blogpost.FieldContent, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.Excerpt(); ok {
+		_spec.SetField(blogpost.FieldExcerpt, field.TypeString, value)
+	}
+	if bpuo.mutation.ExcerptCleared() {
+		_spec.ClearField(blogpost.FieldExcerpt, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.FeaturedImage(); ok {
+		_spec.SetField(blogpost.FieldFeaturedImage, field.TypeString, value)
+	}
+	if bpuo.mutation.FeaturedImageCleared() {
+		_spec.ClearField(blogpost.FieldFeaturedImage, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.Status(); ok {
+		_spec.SetField(blogpost.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bpuo.mutation.IsFeatured(); ok {
+		_spec.SetField(blogpost.FieldIsFeatured, field.TypeBool, value)
+	}
+	if value, ok := bpuo.mutation.ViewCount(); ok {
+		_spec.SetField(blogpost.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedViewCount(); ok {
+		_spec.AddField(blogpost.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.PublishedAt(); ok {
+		_spec.SetField(blogpost.FieldPublishedAt, field.TypeTime, value)
+	}
+	if bpuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(blogpost.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := bpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blogpost.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if bpuo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogpost.AuthorTable,
+			Columns: []string{blogpost.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bpuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !bpuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blogpost.CategoriesTable,
+			Columns: blogpost.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlogPost{config: bpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogpost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bpuo.mutation.done = true
+	return _node, nil
+}
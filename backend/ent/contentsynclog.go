@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ContentSyncLog is the model entity for the ContentSyncLog schema.
+type ContentSyncLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SiteID holds the value of the "site_id" field.
+	SiteID int `json:"site_id,omitempty"`
+	// SyncType holds the value of the "sync_type" field.
+	SyncType string `json:"sync_type,omitempty"`
+	// LocalContentID holds the value of the "local_content_id" field.
+	LocalContentID *int `json:"local_content_id,omitempty"`
+	// RemoteContentID holds the value of the "remote_content_id" field.
+	RemoteContentID *int `json:"remote_content_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status contentsynclog.Status `json:"status,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentSyncLogQuery when eager-loading is set.
+	Edges        ContentSyncLogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentSyncLogEdges holds the relations/edges for other nodes in the graph.
+type ContentSyncLogEdges struct {
+	// Site holds the value of the site edge.
+	Site *WordPressSite `json:"site,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SiteOrErr returns the Site value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentSyncLogEdges) SiteOrErr() (*WordPressSite, error) {
+	if e.Site != nil {
+		return e.Site, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: wordpresssite.Label}
+	}
+	return nil, &NotLoadedError{edge: "site"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentSyncLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentsynclog.FieldID, contentsynclog.FieldSiteID, contentsynclog.FieldLocalContentID, contentsynclog.FieldRemoteContentID:
+			values[i] = new(sql.NullInt64)
+		case contentsynclog.FieldSyncType, contentsynclog.FieldStatus, contentsynclog.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case contentsynclog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentSyncLog fields.
+func (csl *ContentSyncLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentsynclog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			csl.ID = int(value.Int64)
+		case contentsynclog.FieldSiteID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field site_id", values[i])
+			} else if value.Valid {
+				csl.SiteID = int(value.Int64)
+			}
+		case contentsynclog.FieldSyncType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sync_type", values[i])
+			} else if value.Valid {
+				csl.SyncType = value.String
+			}
+		case contentsynclog.FieldLocalContentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field local_content_id", values[i])
+			} else if value.Valid {
+				csl.LocalContentID = new(int)
+				*csl.LocalContentID = int(value.Int64)
+			}
+		case contentsynclog.FieldRemoteContentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_content_id", values[i])
+			} else if value.Valid {
+				csl.RemoteContentID = new(int)
+				*csl.RemoteContentID = int(value.Int64)
+			}
+		case contentsynclog.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				csl.Status = contentsynclog.Status(value.String)
+			}
+		case contentsynclog.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				csl.ErrorMessage = new(string)
+				*csl.ErrorMessage = value.String
+			}
+		case contentsynclog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				csl.CreatedAt = value.Time
+			}
+		default:
+			csl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentSyncLog.
+// This includes values selected through modifiers, order, etc.
+func (csl *ContentSyncLog) Value(name string) (ent.Value, error) {
+	return csl.selectValues.Get(name)
+}
+
+// QuerySite queries the "site" edge of the ContentSyncLog entity.
+func (csl *ContentSyncLog) QuerySite() *WordPressSiteQuery {
+	return NewContentSyncLogClient(csl.config).QuerySite(csl)
+}
+
+// Update returns a builder for updating this ContentSyncLog.
+// Note that you need to call ContentSyncLog.Unwrap() before calling this method if this ContentSyncLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (csl *ContentSyncLog) Update() *ContentSyncLogUpdateOne {
+	return NewContentSyncLogClient(csl.config).UpdateOne(csl)
+}
+
+// Unwrap unwraps the ContentSyncLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (csl *ContentSyncLog) Unwrap() *ContentSyncLog {
+	_tx, ok := csl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentSyncLog is not a transactional entity")
+	}
+	csl.config.driver = _tx.drv
+	return csl
+}
+
+// String implements the fmt.Stringer.
+func (csl *ContentSyncLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentSyncLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", csl.ID))
+	builder.WriteString("site_id=")
+	builder.WriteString(fmt.Sprintf("%v", csl.SiteID))
+	builder.WriteString(", ")
+	builder.WriteString("sync_type=")
+	builder.WriteString(csl.SyncType)
+	builder.WriteString(", ")
+	if v := csl.LocalContentID; v != nil {
+		builder.WriteString("local_content_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := csl.RemoteContentID; v != nil {
+		builder.WriteString("remote_content_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", csl.Status))
+	builder.WriteString(", ")
+	if v := csl.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(csl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentSyncLogs is a parsable slice of ContentSyncLog.
+type ContentSyncLogs []*ContentSyncLog
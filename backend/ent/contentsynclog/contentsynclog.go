@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentsynclog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the contentsynclog type in the database.
+	Label = "content_sync_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSiteID holds the string denoting the site_id field in the database.
+	FieldSiteID = "site_id"
+	// FieldSyncType holds the string denoting the sync_type field in the database.
+	FieldSyncType = "sync_type"
+	// FieldLocalContentID holds the string denoting the local_content_id field in the database.
+	FieldLocalContentID = "local_content_id"
+	// FieldRemoteContentID holds the string denoting the remote_content_id field in the database.
+	FieldRemoteContentID = "remote_content_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeSite holds the string denoting the site edge name in mutations.
+	EdgeSite = "site"
+	// Table holds the table name of the contentsynclog in the database.
+	Table = "content_sync_logs"
+	// SiteTable is the table that holds the site relation/edge.
+	SiteTable = "content_sync_logs"
+	// SiteInverseTable is the table name for the WordPressSite entity.
+	// It exists in this package in order to avoid circular dependency with the "wordpresssite" package.
+	SiteInverseTable = "wordpress_sites"
+	// SiteColumn is the table column denoting the site relation/edge.
+	SiteColumn = "site_id"
+)
+
+// Columns holds all SQL columns for contentsynclog fields.
+var Columns = []string{
+	FieldID,
+	FieldSiteID,
+	FieldSyncType,
+	FieldLocalContentID,
+	FieldRemoteContentID,
+	FieldStatus,
+	FieldErrorMessage,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SyncTypeValidator is a validator for the "sync_type" field. It is called by the builders before save.
+	SyncTypeValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusSuccess Status = "success"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSuccess, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("contentsynclog: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ContentSyncLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySiteID orders the results by the site_id field.
+func BySiteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSiteID, opts...).ToFunc()
+}
+
+// BySyncType orders the results by the sync_type field.
+func BySyncType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSyncType, opts...).ToFunc()
+}
+
+// ByLocalContentID orders the results by the local_content_id field.
+func ByLocalContentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocalContentID, opts...).ToFunc()
+}
+
+// ByRemoteContentID orders the results by the remote_content_id field.
+func ByRemoteContentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemoteContentID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySiteField orders the results by site field.
+func BySiteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSiteStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSiteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SiteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SiteTable, SiteColumn),
+	)
+}
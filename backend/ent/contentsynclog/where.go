@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentsynclog
+
+import (
+	"time"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldID, id))
+}
+
+// SiteID applies equality check predicate on the "site_id" field. It's identical to SiteIDEQ.
+func SiteID(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SyncType applies equality check predicate on the "sync_type" field. It's identical to SyncTypeEQ.
+func SyncType(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldSyncType, v))
+}
+
+// LocalContentID applies equality check predicate on the "local_content_id" field. It's identical to LocalContentIDEQ.
+func LocalContentID(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldLocalContentID, v))
+}
+
+// RemoteContentID applies equality check predicate on the "remote_content_id" field. It's identical to RemoteContentIDEQ.
+func RemoteContentID(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldRemoteContentID, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SiteIDEQ applies the EQ predicate on the "site_id" field.
+func SiteIDEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldSiteID, v))
+}
+
+// SiteIDNEQ applies the NEQ predicate on the "site_id" field.
+func SiteIDNEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldSiteID, v))
+}
+
+// SiteIDIn applies the In predicate on the "site_id" field.
+func SiteIDIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldSiteID, vs...))
+}
+
+// SiteIDNotIn applies the NotIn predicate on the "site_id" field.
+func SiteIDNotIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldSiteID, vs...))
+}
+
+// SyncTypeEQ applies the EQ predicate on the "sync_type" field.
+func SyncTypeEQ(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldSyncType, v))
+}
+
+// SyncTypeNEQ applies the NEQ predicate on the "sync_type" field.
+func SyncTypeNEQ(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldSyncType, v))
+}
+
+// SyncTypeIn applies the In predicate on the "sync_type" field.
+func SyncTypeIn(vs ...string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldSyncType, vs...))
+}
+
+// SyncTypeNotIn applies the NotIn predicate on the "sync_type" field.
+func SyncTypeNotIn(vs ...string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldSyncType, vs...))
+}
+
+// SyncTypeGT applies the GT predicate on the "sync_type" field.
+func SyncTypeGT(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldSyncType, v))
+}
+
+// SyncTypeGTE applies the GTE predicate on the "sync_type" field.
+func SyncTypeGTE(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldSyncType, v))
+}
+
+// SyncTypeLT applies the LT predicate on the "sync_type" field.
+func SyncTypeLT(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldSyncType, v))
+}
+
+// SyncTypeLTE applies the LTE predicate on the "sync_type" field.
+func SyncTypeLTE(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldSyncType, v))
+}
+
+// SyncTypeContains applies the Contains predicate on the "sync_type" field.
+func SyncTypeContains(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldContains(FieldSyncType, v))
+}
+
+// SyncTypeHasPrefix applies the HasPrefix predicate on the "sync_type" field.
+func SyncTypeHasPrefix(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldHasPrefix(FieldSyncType, v))
+}
+
+// SyncTypeHasSuffix applies the HasSuffix predicate on the "sync_type" field.
+func SyncTypeHasSuffix(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldHasSuffix(FieldSyncType, v))
+}
+
+// SyncTypeEqualFold applies the EqualFold predicate on the "sync_type" field.
+func SyncTypeEqualFold(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEqualFold(FieldSyncType, v))
+}
+
+// SyncTypeContainsFold applies the ContainsFold predicate on the "sync_type" field.
+func SyncTypeContainsFold(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldContainsFold(FieldSyncType, v))
+}
+
+// LocalContentIDEQ applies the EQ predicate on the "local_content_id" field.
+func LocalContentIDEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldLocalContentID, v))
+}
+
+// LocalContentIDNEQ applies the NEQ predicate on the "local_content_id" field.
+func LocalContentIDNEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldLocalContentID, v))
+}
+
+// LocalContentIDIn applies the In predicate on the "local_content_id" field.
+func LocalContentIDIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldLocalContentID, vs...))
+}
+
+// LocalContentIDNotIn applies the NotIn predicate on the "local_content_id" field.
+func LocalContentIDNotIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldLocalContentID, vs...))
+}
+
+// LocalContentIDGT applies the GT predicate on the "local_content_id" field.
+func LocalContentIDGT(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldLocalContentID, v))
+}
+
+// LocalContentIDGTE applies the GTE predicate on the "local_content_id" field.
+func LocalContentIDGTE(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldLocalContentID, v))
+}
+
+// LocalContentIDLT applies the LT predicate on the "local_content_id" field.
+func LocalContentIDLT(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldLocalContentID, v))
+}
+
+// LocalContentIDLTE applies the LTE predicate on the "local_content_id" field.
+func LocalContentIDLTE(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldLocalContentID, v))
+}
+
+// LocalContentIDIsNil applies the IsNil predicate on the "local_content_id" field.
+func LocalContentIDIsNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIsNull(FieldLocalContentID))
+}
+
+// LocalContentIDNotNil applies the NotNil predicate on the "local_content_id" field.
+func LocalContentIDNotNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotNull(FieldLocalContentID))
+}
+
+// RemoteContentIDEQ applies the EQ predicate on the "remote_content_id" field.
+func RemoteContentIDEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDNEQ applies the NEQ predicate on the "remote_content_id" field.
+func RemoteContentIDNEQ(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDIn applies the In predicate on the "remote_content_id" field.
+func RemoteContentIDIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldRemoteContentID, vs...))
+}
+
+// RemoteContentIDNotIn applies the NotIn predicate on the "remote_content_id" field.
+func RemoteContentIDNotIn(vs ...int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldRemoteContentID, vs...))
+}
+
+// RemoteContentIDGT applies the GT predicate on the "remote_content_id" field.
+func RemoteContentIDGT(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDGTE applies the GTE predicate on the "remote_content_id" field.
+func RemoteContentIDGTE(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDLT applies the LT predicate on the "remote_content_id" field.
+func RemoteContentIDLT(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDLTE applies the LTE predicate on the "remote_content_id" field.
+func RemoteContentIDLTE(v int) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldRemoteContentID, v))
+}
+
+// RemoteContentIDIsNil applies the IsNil predicate on the "remote_content_id" field.
+func RemoteContentIDIsNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIsNull(FieldRemoteContentID))
+}
+
+// RemoteContentIDNotNil applies the NotNil predicate on the "remote_content_id" field.
+func RemoteContentIDNotNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotNull(FieldRemoteContentID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSite applies the HasEdge predicate on the "site" edge.
+func HasSite() predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SiteTable, SiteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSiteWith applies the HasEdge predicate on the "site" edge with a given conditions (other predicates).
+func HasSiteWith(preds ...predicate.WordPressSite) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(func(s *sql.Selector) {
+		step := newSiteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentSyncLog) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentSyncLog) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentSyncLog) predicate.ContentSyncLog {
+	return predicate.ContentSyncLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSyncLogCreate is the builder for creating a ContentSyncLog entity.
+type ContentSyncLogCreate struct {
+	config
+	mutation *ContentSyncLogMutation
+	hooks    []Hook
+}
+
+// SetSiteID sets the "site_id" field.
+func (cslc *ContentSyncLogCreate) SetSiteID(i int) *ContentSyncLogCreate {
+	cslc.mutation.SetSiteID(i)
+	return cslc
+}
+
+// SetSyncType sets the "sync_type" field.
+func (cslc *ContentSyncLogCreate) SetSyncType(s string) *ContentSyncLogCreate {
+	cslc.mutation.SetSyncType(s)
+	return cslc
+}
+
+// SetLocalContentID sets the "local_content_id" field.
+func (cslc *ContentSyncLogCreate) SetLocalContentID(i int) *ContentSyncLogCreate {
+	cslc.mutation.SetLocalContentID(i)
+	return cslc
+}
+
+// SetNillableLocalContentID sets the "local_content_id" field if the given value is not nil.
+func (cslc *ContentSyncLogCreate) SetNillableLocalContentID(i *int) *ContentSyncLogCreate {
+	if i != nil {
+		cslc.SetLocalContentID(*i)
+	}
+	return cslc
+}
+
+// SetRemoteContentID sets the "remote_content_id" field.
+func (cslc *ContentSyncLogCreate) SetRemoteContentID(i int) *ContentSyncLogCreate {
+	cslc.mutation.SetRemoteContentID(i)
+	return cslc
+}
+
+// SetNillableRemoteContentID sets the "remote_content_id" field if the given value is not nil.
+func (cslc *ContentSyncLogCreate) SetNillableRemoteContentID(i *int) *ContentSyncLogCreate {
+	if i != nil {
+		cslc.SetRemoteContentID(*i)
+	}
+	return cslc
+}
+
+// SetStatus sets the "status" field.
+func (cslc *ContentSyncLogCreate) SetStatus(c contentsynclog.Status) *ContentSyncLogCreate {
+	cslc.mutation.SetStatus(c)
+	return cslc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cslc *ContentSyncLogCreate) SetNillableStatus(c *contentsynclog.Status) *ContentSyncLogCreate {
+	if c != nil {
+		cslc.SetStatus(*c)
+	}
+	return cslc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (cslc *ContentSyncLogCreate) SetErrorMessage(s string) *ContentSyncLogCreate {
+	cslc.mutation.SetErrorMessage(s)
+	return cslc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (cslc *ContentSyncLogCreate) SetNillableErrorMessage(s *string) *ContentSyncLogCreate {
+	if s != nil {
+		cslc.SetErrorMessage(*s)
+	}
+	return cslc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cslc *ContentSyncLogCreate) SetCreatedAt(t time.Time) *ContentSyncLogCreate {
+	cslc.mutation.SetCreatedAt(t)
+	return cslc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cslc *ContentSyncLogCreate) SetNillableCreatedAt(t *time.Time) *ContentSyncLogCreate {
+	if t != nil {
+		cslc.SetCreatedAt(*t)
+	}
+	return cslc
+}
+
+// SetSite sets the "site" edge to the WordPressSite entity.
+func (cslc *ContentSyncLogCreate) SetSite(w *WordPressSite) *ContentSyncLogCreate {
+	return cslc.SetSiteID(w.ID)
+}
+
+// Mutation returns the ContentSyncLogMutation object of the builder.
+func (cslc *ContentSyncLogCreate) Mutation() *ContentSyncLogMutation {
+	return cslc.mutation
+}
+
+// Save creates the ContentSyncLog in the database.
+func (cslc *ContentSyncLogCreate) Save(ctx context.Context) (*ContentSyncLog, error) {
+	cslc.defaults()
+	return withHooks(ctx, cslc.sqlSave, cslc.mutation, cslc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cslc *ContentSyncLogCreate) SaveX(ctx context.Context) *ContentSyncLog {
+	v, err := cslc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cslc *ContentSyncLogCreate) Exec(ctx context.Context) error {
+	_, err := cslc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cslc *ContentSyncLogCreate) ExecX(ctx context.Context) {
+	if err := cslc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cslc *ContentSyncLogCreate) defaults() {
+	if _, ok := cslc.mutation.Status(); !ok {
+		v := contentsynclog.DefaultStatus
+		cslc.mutation.SetStatus(v)
+	}
+	if _, ok := cslc.mutation.CreatedAt(); !ok {
+		v := contentsynclog.DefaultCreatedAt()
+		cslc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cslc *ContentSyncLogCreate) check() error {
+	if _, ok := cslc.mutation.SiteID(); !ok {
+		return &ValidationError{Name: "site_id", err: errors.New(`ent: missing required field "ContentSyncLog.site_id"`)}
+	}
+	if _, ok := cslc.mutation.SyncType(); !ok {
+		return &ValidationError{Name: "sync_type", err: errors.New(`ent: missing required field "ContentSyncLog.sync_type"`)}
+	}
+	if v, ok := cslc.mutation.SyncType(); ok {
+		if err := contentsynclog.SyncTypeValidator(v); err != nil {
+			return &ValidationError{Name: "sync_type", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.sync_type": %w`, err)}
+		}
+	}
+	if _, ok := cslc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ContentSyncLog.status"`)}
+	}
+	if v, ok := cslc.mutation.Status(); ok {
+		if err := contentsynclog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.status": %w`, err)}
+		}
+	}
+	if _, ok := cslc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContentSyncLog.created_at"`)}
+	}
+	if len(cslc.mutation.SiteIDs()) == 0 {
+		return &ValidationError{Name: "site", err: errors.New(`ent: missing required edge "ContentSyncLog.site"`)}
+	}
+	return nil
+}
+
+func (cslc *ContentSyncLogCreate) sqlSave(ctx context.Context) (*ContentSyncLog, error) {
+	if err := cslc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cslc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cslc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cslc.mutation.id = &_node.ID
+	cslc.mutation.done = true
+	return _node, nil
+}
+
+func (cslc *ContentSyncLogCreate) createSpec() (*ContentSyncLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentSyncLog{config: cslc.config}
+		_spec = sqlgraph.NewCreateSpec(contentsynclog.Table, sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt))
+	)
+	if value, ok := cslc.mutation.SyncType(); ok {
+		_spec.SetField(contentsynclog.FieldSyncType, field.TypeString, value)
+		_node.SyncType = value
+	}
+	if value, ok := cslc.mutation.LocalContentID(); ok {
+		_spec.SetField(contentsynclog.FieldLocalContentID, field.TypeInt, value)
+		_node.LocalContentID = &value
+	}
+	if value, ok := cslc.mutation.RemoteContentID(); ok {
+		_spec.SetField(contentsynclog.FieldRemoteContentID, field.TypeInt, value)
+		_node.RemoteContentID = &value
+	}
+	if value, ok := cslc.mutation.Status(); ok {
+		_spec.SetField(contentsynclog.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cslc.mutation.ErrorMessage(); ok {
+		_spec.SetField(contentsynclog.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := cslc.mutation.CreatedAt(); ok {
+		_spec.SetField(contentsynclog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cslc.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentsynclog.SiteTable,
+			Columns: []string{contentsynclog.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SiteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContentSyncLogCreateBulk is the builder for creating many ContentSyncLog entities in bulk.
+type ContentSyncLogCreateBulk struct {
+	config
+	err      error
+	builders []*ContentSyncLogCreate
+}
+
+// Save creates the ContentSyncLog entities in the database.
+func (cslcb *ContentSyncLogCreateBulk) Save(ctx context.Context) ([]*ContentSyncLog, error) {
+	if cslcb.err != nil {
+		return nil, cslcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cslcb.builders))
+	nodes := make([]*ContentSyncLog, len(cslcb.builders))
+	mutators := make([]Mutator, len(cslcb.builders))
+	for i := range cslcb.builders {
+		func(i int, root context.Context) {
+			builder := cslcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentSyncLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cslcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cslcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cslcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cslcb *ContentSyncLogCreateBulk) SaveX(ctx context.Context) []*ContentSyncLog {
+	v, err := cslcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cslcb *ContentSyncLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := cslcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cslcb *ContentSyncLogCreateBulk) ExecX(ctx context.Context) {
+	if err := cslcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSyncLogDelete is the builder for deleting a ContentSyncLog entity.
+type ContentSyncLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *ContentSyncLogMutation
+}
+
+// Where appends a list predicates to the ContentSyncLogDelete builder.
+func (csld *ContentSyncLogDelete) Where(ps ...predicate.ContentSyncLog) *ContentSyncLogDelete {
+	csld.mutation.Where(ps...)
+	return csld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csld *ContentSyncLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csld.sqlExec, csld.mutation, csld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csld *ContentSyncLogDelete) ExecX(ctx context.Context) int {
+	n, err := csld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csld *ContentSyncLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(contentsynclog.Table, sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt))
+	if ps := csld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csld.mutation.done = true
+	return affected, err
+}
+
+// ContentSyncLogDeleteOne is the builder for deleting a single ContentSyncLog entity.
+type ContentSyncLogDeleteOne struct {
+	csld *ContentSyncLogDelete
+}
+
+// Where appends a list predicates to the ContentSyncLogDelete builder.
+func (csldo *ContentSyncLogDeleteOne) Where(ps ...predicate.ContentSyncLog) *ContentSyncLogDeleteOne {
+	csldo.csld.mutation.Where(ps...)
+	return csldo
+}
+
+// Exec executes the deletion query.
+func (csldo *ContentSyncLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := csldo.csld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{contentsynclog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csldo *ContentSyncLogDeleteOne) ExecX(ctx context.Context) {
+	if err := csldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSyncLogQuery is the builder for querying ContentSyncLog entities.
+type ContentSyncLogQuery struct {
+	config
+	ctx        *QueryContext
+	order      []contentsynclog.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ContentSyncLog
+	withSite   *WordPressSiteQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ContentSyncLogQuery builder.
+func (cslq *ContentSyncLogQuery) Where(ps ...predicate.ContentSyncLog) *ContentSyncLogQuery {
+	cslq.predicates = append(cslq.predicates, ps...)
+	return cslq
+}
+
+// Limit the number of records to be returned by this query.
+func (cslq *ContentSyncLogQuery) Limit(limit int) *ContentSyncLogQuery {
+	cslq.ctx.Limit = &limit
+	return cslq
+}
+
+// Offset to start from.
+func (cslq *ContentSyncLogQuery) Offset(offset int) *ContentSyncLogQuery {
+	cslq.ctx.Offset = &offset
+	return cslq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cslq *ContentSyncLogQuery) Unique(unique bool) *ContentSyncLogQuery {
+	cslq.ctx.Unique = &unique
+	return cslq
+}
+
+// Order specifies how the records should be ordered.
+func (cslq *ContentSyncLogQuery) Order(o ...contentsynclog.OrderOption) *ContentSyncLogQuery {
+	cslq.order = append(cslq.order, o...)
+	return cslq
+}
+
+// QuerySite chains the current query on the "site" edge.
+func (cslq *ContentSyncLogQuery) QuerySite() *WordPressSiteQuery {
+	query := (&WordPressSiteClient{config: cslq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cslq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cslq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentsynclog.Table, contentsynclog.FieldID, selector),
+			sqlgraph.To(wordpresssite.Table, wordpresssite.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentsynclog.SiteTable, contentsynclog.SiteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cslq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ContentSyncLog entity from the query.
+// Returns a *NotFoundError when no ContentSyncLog was found.
+func (cslq *ContentSyncLogQuery) First(ctx context.Context) (*ContentSyncLog, error) {
+	nodes, err := cslq.Limit(1).All(setContextOp(ctx, cslq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{contentsynclog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) FirstX(ctx context.Context) *ContentSyncLog {
+	node, err := cslq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ContentSyncLog ID from the query.
+// Returns a *NotFoundError when no ContentSyncLog ID was found.
+func (cslq *ContentSyncLogQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cslq.Limit(1).IDs(setContextOp(ctx, cslq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{contentsynclog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) FirstIDX(ctx context.Context) int {
+	id, err := cslq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ContentSyncLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ContentSyncLog entity is found.
+// Returns a *NotFoundError when no ContentSyncLog entities are found.
+func (cslq *ContentSyncLogQuery) Only(ctx context.Context) (*ContentSyncLog, error) {
+	nodes, err := cslq.Limit(2).All(setContextOp(ctx, cslq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{contentsynclog.Label}
+	default:
+		return nil, &NotSingularError{contentsynclog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) OnlyX(ctx context.Context) *ContentSyncLog {
+	node, err := cslq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ContentSyncLog ID in the query.
+// Returns a *NotSingularError when more than one ContentSyncLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cslq *ContentSyncLogQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cslq.Limit(2).IDs(setContextOp(ctx, cslq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{contentsynclog.Label}
+	default:
+		err = &NotSingularError{contentsynclog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cslq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ContentSyncLogs.
+func (cslq *ContentSyncLogQuery) All(ctx context.Context) ([]*ContentSyncLog, error) {
+	ctx = setContextOp(ctx, cslq.ctx, ent.OpQueryAll)
+	if err := cslq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ContentSyncLog, *ContentSyncLogQuery]()
+	return withInterceptors[[]*ContentSyncLog](ctx, cslq, qr, cslq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) AllX(ctx context.Context) []*ContentSyncLog {
+	nodes, err := cslq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ContentSyncLog IDs.
+func (cslq *ContentSyncLogQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cslq.ctx.Unique == nil && cslq.path != nil {
+		cslq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cslq.ctx, ent.OpQueryIDs)
+	if err = cslq.Select(contentsynclog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) IDsX(ctx context.Context) []int {
+	ids, err := cslq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cslq *ContentSyncLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cslq.ctx, ent.OpQueryCount)
+	if err := cslq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cslq, querierCount[*ContentSyncLogQuery](), cslq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) CountX(ctx context.Context) int {
+	count, err := cslq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cslq *ContentSyncLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cslq.ctx, ent.OpQueryExist)
+	switch _, err := cslq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cslq *ContentSyncLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := cslq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ContentSyncLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cslq *ContentSyncLogQuery) Clone() *ContentSyncLogQuery {
+	if cslq == nil {
+		return nil
+	}
+	return &ContentSyncLogQuery{
+		config:     cslq.config,
+		ctx:        cslq.ctx.Clone(),
+		order:      append([]contentsynclog.OrderOption{}, cslq.order...),
+		inters:     append([]Interceptor{}, cslq.inters...),
+		predicates: append([]predicate.ContentSyncLog{}, cslq.predicates...),
+		withSite:   cslq.withSite.Clone(),
+		// clone intermediate query.
+		sql:  cslq.sql.Clone(),
+		path: cslq.path,
+	}
+}
+
+// WithSite tells the query-builder to eager-load the nodes that are connected to
+// the "site" edge. The optional arguments are used to configure the query builder of the edge.
+func (cslq *ContentSyncLogQuery) WithSite(opts ...func(*WordPressSiteQuery)) *ContentSyncLogQuery {
+	query := (&WordPressSiteClient{config: cslq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cslq.withSite = query
+	return cslq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SiteID int `json:"site_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ContentSyncLog.Query().
+//		GroupBy(contentsynclog.FieldSiteID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cslq *ContentSyncLogQuery) GroupBy(field string, fields ...string) *ContentSyncLogGroupBy {
+	cslq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ContentSyncLogGroupBy{build: cslq}
+	grbuild.flds = &cslq.ctx.Fields
+	grbuild.label = contentsynclog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SiteID int `json:"site_id,omitempty"`
+//	}
+//
+//	client.ContentSyncLog.Query().
+//		Select(contentsynclog.FieldSiteID).
+//		Scan(ctx, &v)
+func (cslq *ContentSyncLogQuery) Select(fields ...string) *ContentSyncLogSelect {
+	cslq.ctx.Fields = append(cslq.ctx.Fields, fields...)
+	sbuild := &ContentSyncLogSelect{ContentSyncLogQuery: cslq}
+	sbuild.label = contentsynclog.Label
+	sbuild.flds, sbuild.scan = &cslq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ContentSyncLogSelect configured with the given aggregations.
+func (cslq *ContentSyncLogQuery) Aggregate(fns ...AggregateFunc) *ContentSyncLogSelect {
+	return cslq.Select().Aggregate(fns...)
+}
+
+func (cslq *ContentSyncLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cslq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cslq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cslq.ctx.Fields {
+		if !contentsynclog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cslq.path != nil {
+		prev, err := cslq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cslq.sql = prev
+	}
+	return nil
+}
+
+func (cslq *ContentSyncLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ContentSyncLog, error) {
+	var (
+		nodes       = []*ContentSyncLog{}
+		_spec       = cslq.querySpec()
+		loadedTypes = [1]bool{
+			cslq.withSite != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ContentSyncLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ContentSyncLog{config: cslq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cslq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cslq.withSite; query != nil {
+		if err := cslq.loadSite(ctx, query, nodes, nil,
+			func(n *ContentSyncLog, e *WordPressSite) { n.Edges.Site = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cslq *ContentSyncLogQuery) loadSite(ctx context.Context, query *WordPressSiteQuery, nodes []*ContentSyncLog, init func(*ContentSyncLog), assign func(*ContentSyncLog, *WordPressSite)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ContentSyncLog)
+	for i := range nodes {
+		fk := nodes[i].SiteID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(wordpresssite.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "site_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cslq *ContentSyncLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cslq.querySpec()
+	_spec.Node.Columns = cslq.ctx.Fields
+	if len(cslq.ctx.Fields) > 0 {
+		_spec.Unique = cslq.ctx.Unique != nil && *cslq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cslq.driver, _spec)
+}
+
+func (cslq *ContentSyncLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(contentsynclog.Table, contentsynclog.Columns, sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt))
+	_spec.From = cslq.sql
+	if unique := cslq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cslq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cslq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentsynclog.FieldID)
+		for i := range fields {
+			if fields[i] != contentsynclog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cslq.withSite != nil {
+			_spec.Node.AddColumnOnce(contentsynclog.FieldSiteID)
+		}
+	}
+	if ps := cslq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cslq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cslq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cslq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cslq *ContentSyncLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cslq.driver.Dialect())
+	t1 := builder.Table(contentsynclog.Table)
+	columns := cslq.ctx.Fields
+	if len(columns) == 0 {
+		columns = contentsynclog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cslq.sql != nil {
+		selector = cslq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cslq.ctx.Unique != nil && *cslq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cslq.predicates {
+		p(selector)
+	}
+	for _, p := range cslq.order {
+		p(selector)
+	}
+	if offset := cslq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cslq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ContentSyncLogGroupBy is the group-by builder for ContentSyncLog entities.
+type ContentSyncLogGroupBy struct {
+	selector
+	build *ContentSyncLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cslgb *ContentSyncLogGroupBy) Aggregate(fns ...AggregateFunc) *ContentSyncLogGroupBy {
+	cslgb.fns = append(cslgb.fns, fns...)
+	return cslgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cslgb *ContentSyncLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cslgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cslgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentSyncLogQuery, *ContentSyncLogGroupBy](ctx, cslgb.build, cslgb, cslgb.build.inters, v)
+}
+
+func (cslgb *ContentSyncLogGroupBy) sqlScan(ctx context.Context, root *ContentSyncLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cslgb.fns))
+	for _, fn := range cslgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cslgb.flds)+len(cslgb.fns))
+		for _, f := range *cslgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cslgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cslgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ContentSyncLogSelect is the builder for selecting fields of ContentSyncLog entities.
+type ContentSyncLogSelect struct {
+	*ContentSyncLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (csls *ContentSyncLogSelect) Aggregate(fns ...AggregateFunc) *ContentSyncLogSelect {
+	csls.fns = append(csls.fns, fns...)
+	return csls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csls *ContentSyncLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csls.ctx, ent.OpQuerySelect)
+	if err := csls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ContentSyncLogQuery, *ContentSyncLogSelect](ctx, csls.ContentSyncLogQuery, csls, csls.inters, v)
+}
+
+func (csls *ContentSyncLogSelect) sqlScan(ctx context.Context, root *ContentSyncLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(csls.fns))
+	for _, fn := range csls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*csls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSyncLogUpdate is the builder for updating ContentSyncLog entities.
+type ContentSyncLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentSyncLogMutation
+}
+
+// Where appends a list predicates to the ContentSyncLogUpdate builder.
+func (cslu *ContentSyncLogUpdate) Where(ps ...predicate.ContentSyncLog) *ContentSyncLogUpdate {
+	cslu.mutation.Where(ps...)
+	return cslu
+}
+
+// SetSiteID sets the "site_id" field.
+func (cslu *ContentSyncLogUpdate) SetSiteID(i int) *ContentSyncLogUpdate {
+	cslu.mutation.SetSiteID(i)
+	return cslu
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableSiteID(i *int) *ContentSyncLogUpdate {
+	if i != nil {
+		cslu.SetSiteID(*i)
+	}
+	return cslu
+}
+
+// SetSyncType sets the "sync_type" field.
+func (cslu *ContentSyncLogUpdate) SetSyncType(s string) *ContentSyncLogUpdate {
+	cslu.mutation.SetSyncType(s)
+	return cslu
+}
+
+// SetNillableSyncType sets the "sync_type" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableSyncType(s *string) *ContentSyncLogUpdate {
+	if s != nil {
+		cslu.SetSyncType(*s)
+	}
+	return cslu
+}
+
+// SetLocalContentID sets the "local_content_id" field.
+func (cslu *ContentSyncLogUpdate) SetLocalContentID(i int) *ContentSyncLogUpdate {
+	cslu.mutation.ResetLocalContentID()
+	cslu.mutation.SetLocalContentID(i)
+	return cslu
+}
+
+// SetNillableLocalContentID sets the "local_content_id" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableLocalContentID(i *int) *ContentSyncLogUpdate {
+	if i != nil {
+		cslu.SetLocalContentID(*i)
+	}
+	return cslu
+}
+
+// AddLocalContentID adds i to the "local_content_id" field.
+func (cslu *ContentSyncLogUpdate) AddLocalContentID(i int) *ContentSyncLogUpdate {
+	cslu.mutation.AddLocalContentID(i)
+	return cslu
+}
+
+// ClearLocalContentID clears the value of the "local_content_id" field.
+func (cslu *ContentSyncLogUpdate) ClearLocalContentID() *ContentSyncLogUpdate {
+	cslu.mutation.ClearLocalContentID()
+	return cslu
+}
+
+// SetRemoteContentID sets the "remote_content_id" field.
+func (cslu *ContentSyncLogUpdate) SetRemoteContentID(i int) *ContentSyncLogUpdate {
+	cslu.mutation.ResetRemoteContentID()
+	cslu.mutation.SetRemoteContentID(i)
+	return cslu
+}
+
+// SetNillableRemoteContentID sets the "remote_content_id" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableRemoteContentID(i *int) *ContentSyncLogUpdate {
+	if i != nil {
+		cslu.SetRemoteContentID(*i)
+	}
+	return cslu
+}
+
+// AddRemoteContentID adds i to the "remote_content_id" field.
+func (cslu *ContentSyncLogUpdate) AddRemoteContentID(i int) *ContentSyncLogUpdate {
+	cslu.mutation.AddRemoteContentID(i)
+	return cslu
+}
+
+// ClearRemoteContentID clears the value of the "remote_content_id" field.
+func (cslu *ContentSyncLogUpdate) ClearRemoteContentID() *ContentSyncLogUpdate {
+	cslu.mutation.ClearRemoteContentID()
+	return cslu
+}
+
+// SetStatus sets the "status" field.
+func (cslu *ContentSyncLogUpdate) SetStatus(c contentsynclog.Status) *ContentSyncLogUpdate {
+	cslu.mutation.SetStatus(c)
+	return cslu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableStatus(c *contentsynclog.Status) *ContentSyncLogUpdate {
+	if c != nil {
+		cslu.SetStatus(*c)
+	}
+	return cslu
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (cslu *ContentSyncLogUpdate) SetErrorMessage(s string) *ContentSyncLogUpdate {
+	cslu.mutation.SetErrorMessage(s)
+	return cslu
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (cslu *ContentSyncLogUpdate) SetNillableErrorMessage(s *string) *ContentSyncLogUpdate {
+	if s != nil {
+		cslu.SetErrorMessage(*s)
+	}
+	return cslu
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (cslu *ContentSyncLogUpdate) ClearErrorMessage() *ContentSyncLogUpdate {
+	cslu.mutation.ClearErrorMessage()
+	return cslu
+}
+
+// SetSite sets the "site" edge to the WordPressSite entity.
+func (cslu *ContentSyncLogUpdate) SetSite(w *WordPressSite) *ContentSyncLogUpdate {
+	return cslu.SetSiteID(w.ID)
+}
+
+// Mutation returns the ContentSyncLogMutation object of the builder.
+func (cslu *ContentSyncLogUpdate) Mutation() *ContentSyncLogMutation {
+	return cslu.mutation
+}
+
+// ClearSite clears the "site" edge to the WordPressSite entity.
+func (cslu *ContentSyncLogUpdate) ClearSite() *ContentSyncLogUpdate {
+	cslu.mutation.ClearSite()
+	return cslu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cslu *ContentSyncLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cslu.sqlSave, cslu.mutation, cslu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cslu *ContentSyncLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := cslu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cslu *ContentSyncLogUpdate) Exec(ctx context.Context) error {
+	_, err := cslu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cslu *ContentSyncLogUpdate) ExecX(ctx context.Context) {
+	if err := cslu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cslu *ContentSyncLogUpdate) check() error {
+	if v, ok := cslu.mutation.SyncType(); ok {
+		if err := contentsynclog.SyncTypeValidator(v); err != nil {
+			return &ValidationError{Name: "sync_type", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.sync_type": %w`, err)}
+		}
+	}
+	if v, ok := cslu.mutation.Status(); ok {
+		if err := contentsynclog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.status": %w`, err)}
+		}
+	}
+	if cslu.mutation.SiteCleared() && len(cslu.mutation.SiteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentSyncLog.site"`)
+	}
+	return nil
+}
+
+func (cslu *ContentSyncLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cslu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentsynclog.Table, contentsynclog.Columns, sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt))
+	if ps := cslu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cslu.mutation.SyncType(); ok {
+		_spec.SetField(contentsynclog.FieldSyncType, field.TypeString, value)
+	}
+	if value, ok := cslu.mutation.LocalContentID(); ok {
+		_spec.SetField(contentsynclog.FieldLocalContentID, field.TypeInt, value)
+	}
+	if value, ok := cslu.mutation.AddedLocalContentID(); ok {
+		_spec.AddField(contentsynclog.FieldLocalContentID, field.TypeInt, value)
+	}
+	if cslu.mutation.LocalContentIDCleared() {
+		_spec.ClearField(contentsynclog.FieldLocalContentID, field.TypeInt)
+	}
+	if value, ok := cslu.mutation.RemoteContentID(); ok {
+		_spec.SetField(contentsynclog.FieldRemoteContentID, field.TypeInt, value)
+	}
+	if value, ok := cslu.mutation.AddedRemoteContentID(); ok {
+		_spec.AddField(contentsynclog.FieldRemoteContentID, field.TypeInt, value)
+	}
+	if cslu.mutation.RemoteContentIDCleared() {
+		_spec.ClearField(contentsynclog.FieldRemoteContentID, field.TypeInt)
+	}
+	if value, ok := cslu.mutation.Status(); ok {
+		_spec.SetField(contentsynclog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cslu.mutation.ErrorMessage(); ok {
+		_spec.SetField(contentsynclog.FieldErrorMessage, field.TypeString, value)
+	}
+	if cslu.mutation.ErrorMessageCleared() {
+		_spec.ClearField(contentsynclog.FieldErrorMessage, field.TypeString)
+	}
+	if cslu.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentsynclog.SiteTable,
+			Columns: []string{contentsynclog.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cslu.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentsynclog.SiteTable,
+			Columns: []string{contentsynclog.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cslu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentsynclog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cslu.mutation.done = true
+	return n, nil
+}
+
+// ContentSyncLogUpdateOne is the builder for updating a single ContentSyncLog entity.
+type ContentSyncLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentSyncLogMutation
+}
+
+// SetSiteID sets the "site_id" field.
+func (csluo *ContentSyncLogUpdateOne) SetSiteID(i int) *ContentSyncLogUpdateOne {
+	csluo.mutation.SetSiteID(i)
+	return csluo
+}
+
+// SetNillableSiteID sets the "site_id" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableSiteID(i *int) *ContentSyncLogUpdateOne {
+	if i != nil {
+		csluo.SetSiteID(*i)
+	}
+	return csluo
+}
+
+// SetSyncType sets the "sync_type" field.
+func (csluo *ContentSyncLogUpdateOne) SetSyncType(s string) *ContentSyncLogUpdateOne {
+	csluo.mutation.SetSyncType(s)
+	return csluo
+}
+
+// SetNillableSyncType sets the "sync_type" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableSyncType(s *string) *ContentSyncLogUpdateOne {
+	if s != nil {
+		csluo.SetSyncType(*s)
+	}
+	return csluo
+}
+
+// SetLocalContentID sets the "local_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) SetLocalContentID(i int) *ContentSyncLogUpdateOne {
+	csluo.mutation.ResetLocalContentID()
+	csluo.mutation.SetLocalContentID(i)
+	return csluo
+}
+
+// SetNillableLocalContentID sets the "local_content_id" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableLocalContentID(i *int) *ContentSyncLogUpdateOne {
+	if i != nil {
+		csluo.SetLocalContentID(*i)
+	}
+	return csluo
+}
+
+// AddLocalContentID adds i to the "local_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) AddLocalContentID(i int) *ContentSyncLogUpdateOne {
+	csluo.mutation.AddLocalContentID(i)
+	return csluo
+}
+
+// ClearLocalContentID clears the value of the "local_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) ClearLocalContentID() *ContentSyncLogUpdateOne {
+	csluo.mutation.ClearLocalContentID()
+	return csluo
+}
+
+// SetRemoteContentID sets the "remote_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) SetRemoteContentID(i int) *ContentSyncLogUpdateOne {
+	csluo.mutation.ResetRemoteContentID()
+	csluo.mutation.SetRemoteContentID(i)
+	return csluo
+}
+
+// SetNillableRemoteContentID sets the "remote_content_id" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableRemoteContentID(i *int) *ContentSyncLogUpdateOne {
+	if i != nil {
+		csluo.SetRemoteContentID(*i)
+	}
+	return csluo
+}
+
+// AddRemoteContentID adds i to the "remote_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) AddRemoteContentID(i int) *ContentSyncLogUpdateOne {
+	csluo.mutation.AddRemoteContentID(i)
+	return csluo
+}
+
+// ClearRemoteContentID clears the value of the "remote_content_id" field.
+func (csluo *ContentSyncLogUpdateOne) ClearRemoteContentID() *ContentSyncLogUpdateOne {
+	csluo.mutation.ClearRemoteContentID()
+	return csluo
+}
+
+// SetStatus sets the "status" field.
+func (csluo *ContentSyncLogUpdateOne) SetStatus(c contentsynclog.Status) *ContentSyncLogUpdateOne {
+	csluo.mutation.SetStatus(c)
+	return csluo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableStatus(c *contentsynclog.Status) *ContentSyncLogUpdateOne {
+	if c != nil {
+		csluo.SetStatus(*c)
+	}
+	return csluo
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (csluo *ContentSyncLogUpdateOne) SetErrorMessage(s string) *ContentSyncLogUpdateOne {
+	csluo.mutation.SetErrorMessage(s)
+	return csluo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (csluo *ContentSyncLogUpdateOne) SetNillableErrorMessage(s *string) *ContentSyncLogUpdateOne {
+	if s != nil {
+		csluo.SetErrorMessage(*s)
+	}
+	return csluo
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (csluo *ContentSyncLogUpdateOne) ClearErrorMessage() *ContentSyncLogUpdateOne {
+	csluo.mutation.ClearErrorMessage()
+	return csluo
+}
+
+// SetSite sets the "site" edge to the WordPressSite entity.
+func (csluo *ContentSyncLogUpdateOne) SetSite(w *WordPressSite) *ContentSyncLogUpdateOne {
+	return csluo.SetSiteID(w.ID)
+}
+
+// Mutation returns the ContentSyncLogMutation object of the builder.
+func (csluo *ContentSyncLogUpdateOne) Mutation() *ContentSyncLogMutation {
+	return csluo.mutation
+}
+
+// ClearSite clears the "site" edge to the WordPressSite entity.
+func (csluo *ContentSyncLogUpdateOne) ClearSite() *ContentSyncLogUpdateOne {
+	csluo.mutation.ClearSite()
+	return csluo
+}
+
+// Where appends a list predicates to the ContentSyncLogUpdate builder.
+func (csluo *ContentSyncLogUpdateOne) Where(ps ...predicate.ContentSyncLog) *ContentSyncLogUpdateOne {
+	csluo.mutation.Where(ps...)
+	return csluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csluo *ContentSyncLogUpdateOne) Select(field string, fields ...string) *ContentSyncLogUpdateOne {
+	csluo.fields = append([]string{field}, fields...)
+	return csluo
+}
+
+// Save executes the query and returns the updated ContentSyncLog entity.
+func (csluo *ContentSyncLogUpdateOne) Save(ctx context.Context) (*ContentSyncLog, error) {
+	return withHooks(ctx, csluo.sqlSave, csluo.mutation, csluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csluo *ContentSyncLogUpdateOne) SaveX(ctx context.Context) *ContentSyncLog {
+	node, err := csluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csluo *ContentSyncLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := csluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csluo *ContentSyncLogUpdateOne) ExecX(ctx context.Context) {
+	if err := csluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csluo *ContentSyncLogUpdateOne) check() error {
+	if v, ok := csluo.mutation.SyncType(); ok {
+		if err := contentsynclog.SyncTypeValidator(v); err != nil {
+			return &ValidationError{Name: "sync_type", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.sync_type": %w`, err)}
+		}
+	}
+	if v, ok := csluo.mutation.Status(); ok {
+		if err := contentsynclog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ContentSyncLog.status": %w`, err)}
+		}
+	}
+	if csluo.mutation.SiteCleared() && len(csluo.mutation.SiteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentSyncLog.site"`)
+	}
+	return nil
+}
+
+func (csluo *ContentSyncLogUpdateOne) sqlSave(ctx context.Context) (_node *ContentSyncLog, err error) {
+	if err := csluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentsynclog.Table, contentsynclog.Columns, sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt))
+	id, ok := csluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentSyncLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentsynclog.FieldID)
+		for _, f := range fields {
+			if !contentsynclog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentsynclog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csluo.mutation.SyncType(); ok {
+		_spec.SetField(contentsynclog.FieldSyncType, field.TypeString, value)
+	}
+	if value, ok := csluo.mutation.LocalContentID(); ok {
+		_spec.SetField(contentsynclog.FieldLocalContentID, field.TypeInt, value)
+	}
+	if value, ok := csluo.mutation.AddedLocalContentID(); ok {
+		_spec.AddField(contentsynclog.FieldLocalContentID, field.TypeInt, value)
+	}
+	if csluo.mutation.LocalContentIDCleared() {
+		_spec.ClearField(contentsynclog.FieldLocalContentID, field.TypeInt)
+	}
+	if value, ok := csluo.mutation.RemoteContentID(); ok {
+		_spec.SetField(contentsynclog.FieldRemoteContentID, field.TypeInt, value)
+	}
+	if value, ok := csluo.mutation.AddedRemoteContentID(); ok {
+		_spec.AddField(contentsynclog.FieldRemoteContentID, field.TypeInt, value)
+	}
+	if csluo.mutation.RemoteContentIDCleared() {
+		_spec.ClearField(contentsynclog.FieldRemoteContentID, field.TypeInt)
+	}
+	if value, ok := csluo.mutation.Status(); ok {
+		_spec.SetField(contentsynclog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := csluo.mutation.ErrorMessage(); ok {
+		_spec.SetField(contentsynclog.FieldErrorMessage, field.TypeString, value)
+	}
+	if csluo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(contentsynclog.FieldErrorMessage, field.TypeString)
+	}
+	if csluo.mutation.SiteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentsynclog.SiteTable,
+			Columns: []string{contentsynclog.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csluo.mutation.SiteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentsynclog.SiteTable,
+			Columns: []string{contentsynclog.SiteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContentSyncLog{config: csluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentsynclog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csluo.mutation.done = true
+	return _node, nil
+}
@@ -8,7 +8,18 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/order"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+	"zplus_web/backend/ent/productfile"
+	"zplus_web/backend/ent/project"
 	"zplus_web/backend/ent/user"
+	"zplus_web/backend/ent/wallettransaction"
+	"zplus_web/backend/ent/wordpresssite"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -73,7 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			blogcategory.Table:      blogcategory.ValidColumn,
+			blogpost.Table:          blogpost.ValidColumn,
+			contentsynclog.Table:    contentsynclog.ValidColumn,
+			order.Table:             order.ValidColumn,
+			orderitem.Table:         orderitem.ValidColumn,
+			product.Table:           product.ValidColumn,
+			productcategory.Table:   productcategory.ValidColumn,
+			productfile.Table:       productfile.ValidColumn,
+			project.Table:           project.ValidColumn,
+			user.Table:              user.ValidColumn,
+			wallettransaction.Table: wallettransaction.ValidColumn,
+			wordpresssite.Table:     wordpresssite.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -1,16 +1,5 @@
-//go:build ignore
+package ent
 
-package main
-
-import (
-	"log"
-
-	"entgo.io/ent/entc"
-	"entgo.io/ent/entc/gen"
-)
-
-func main() {
-	if err := entc.Generate("./schema", &gen.Config{}); err != nil {
-		log.Fatal("running ent codegen:", err)
-	}
-}
+// The schema itself is owned by the SQL migrations in backend/migrations;
+// keep ent/schema in step with them and never run Client.Schema.Create.
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
@@ -3,23 +3,305 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// BlogCategoriesColumns holds the columns for the "blog_categories" table.
+	BlogCategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BlogCategoriesTable holds the schema information for the "blog_categories" table.
+	BlogCategoriesTable = &schema.Table{
+		Name:       "blog_categories",
+		Columns:    BlogCategoriesColumns,
+		PrimaryKey: []*schema.Column{BlogCategoriesColumns[0]},
+	}
+	// BlogPostsColumns holds the columns for the "blog_posts" table.
+	BlogPostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "excerpt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "featured_image", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "private"}, Default: "draft"},
+		{Name: "is_featured", Type: field.TypeBool, Default: false},
+		{Name: "view_count", Type: field.TypeInt, Default: 0},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "author_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BlogPostsTable holds the schema information for the "blog_posts" table.
+	BlogPostsTable = &schema.Table{
+		Name:       "blog_posts",
+		Columns:    BlogPostsColumns,
+		PrimaryKey: []*schema.Column{BlogPostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_posts_author_id_fkey",
+				Columns:    []*schema.Column{BlogPostsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_blog_posts_status",
+				Unique:  false,
+				Columns: []*schema.Column{BlogPostsColumns[6]},
+			},
+			{
+				Name:    "idx_blog_posts_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlogPostsColumns[9]},
+			},
+		},
+	}
+	// ContentSyncLogsColumns holds the columns for the "content_sync_logs" table.
+	ContentSyncLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sync_type", Type: field.TypeString, Size: 50},
+		{Name: "local_content_id", Type: field.TypeInt, Nullable: true},
+		{Name: "remote_content_id", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "success", "failed"}, Default: "pending"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "site_id", Type: field.TypeInt},
+	}
+	// ContentSyncLogsTable holds the schema information for the "content_sync_logs" table.
+	ContentSyncLogsTable = &schema.Table{
+		Name:       "content_sync_logs",
+		Columns:    ContentSyncLogsColumns,
+		PrimaryKey: []*schema.Column{ContentSyncLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_sync_logs_site_id_fkey",
+				Columns:    []*schema.Column{ContentSyncLogsColumns[7]},
+				RefColumns: []*schema.Column{WordpressSitesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "order_number", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "total_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "discount_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "final_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "payment_method", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "payment_status", Type: field.TypeEnum, Enums: []string{"pending", "paid", "failed", "refunded"}, Default: "pending"},
+		{Name: "order_status", Type: field.TypeEnum, Enums: []string{"processing", "completed", "cancelled"}, Default: "processing"},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_user_id_fkey",
+				Columns:    []*schema.Column{OrdersColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_orders_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[11]},
+			},
+			{
+				Name:    "idx_orders_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[7]},
+			},
+		},
+	}
+	// OrderItemsColumns holds the columns for the "order_items" table.
+	OrderItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "product_name", Type: field.TypeString, Size: 255},
+		{Name: "price", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "quantity", Type: field.TypeInt, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeInt, Nullable: true},
+	}
+	// OrderItemsTable holds the schema information for the "order_items" table.
+	OrderItemsTable = &schema.Table{
+		Name:       "order_items",
+		Columns:    OrderItemsColumns,
+		PrimaryKey: []*schema.Column{OrderItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_items_order_id_fkey",
+				Columns:    []*schema.Column{OrderItemsColumns[5]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "order_items_product_id_fkey",
+				Columns:    []*schema.Column{OrderItemsColumns[6]},
+				RefColumns: []*schema.Column{SoftwareProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SoftwareProductsColumns holds the columns for the "software_products" table.
+	SoftwareProductsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "short_description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "featured_image", Type: field.TypeString, Nullable: true},
+		{Name: "gallery_images", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "text[]"}},
+		{Name: "price", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "discount_price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "version", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "requirements", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "features", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "text[]"}},
+		{Name: "download_url", Type: field.TypeString, Nullable: true},
+		{Name: "file_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "download_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "is_featured", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SoftwareProductsTable holds the schema information for the "software_products" table.
+	SoftwareProductsTable = &schema.Table{
+		Name:       "software_products",
+		Columns:    SoftwareProductsColumns,
+		PrimaryKey: []*schema.Column{SoftwareProductsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "software_products_category_id_fkey",
+				Columns:    []*schema.Column{SoftwareProductsColumns[19]},
+				RefColumns: []*schema.Column{ProductCategoriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_software_products_active",
+				Unique:  false,
+				Columns: []*schema.Column{SoftwareProductsColumns[15]},
+			},
+		},
+	}
+	// ProductCategoriesColumns holds the columns for the "product_categories" table.
+	ProductCategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ProductCategoriesTable holds the schema information for the "product_categories" table.
+	ProductCategoriesTable = &schema.Table{
+		Name:       "product_categories",
+		Columns:    ProductCategoriesColumns,
+		PrimaryKey: []*schema.Column{ProductCategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_categories_product_categories_children",
+				Columns:    []*schema.Column{ProductCategoriesColumns[5]},
+				RefColumns: []*schema.Column{ProductCategoriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ProductFilesColumns holds the columns for the "product_files" table.
+	ProductFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "original_filename", Type: field.TypeString, Size: 255},
+		{Name: "file_path", Type: field.TypeString, Size: 500},
+		{Name: "file_size", Type: field.TypeInt64},
+		{Name: "file_type", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "is_main", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// ProductFilesTable holds the schema information for the "product_files" table.
+	ProductFilesTable = &schema.Table{
+		Name:       "product_files",
+		Columns:    ProductFilesColumns,
+		PrimaryKey: []*schema.Column{ProductFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_files_product_id_fkey",
+				Columns:    []*schema.Column{ProductFilesColumns[8]},
+				RefColumns: []*schema.Column{SoftwareProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProjectsColumns holds the columns for the "projects" table.
+	ProjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "short_description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "featured_image", Type: field.TypeString, Nullable: true},
+		{Name: "gallery_images", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "text[]"}},
+		{Name: "technologies", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "text[]"}},
+		{Name: "project_url", Type: field.TypeString, Nullable: true},
+		{Name: "github_url", Type: field.TypeString, Nullable: true},
+		{Name: "demo_url", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"planning", "development", "completed", "maintenance"}, Default: "development"},
+		{Name: "start_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "is_featured", Type: field.TypeBool, Default: false},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProjectsTable holds the schema information for the "projects" table.
+	ProjectsTable = &schema.Table{
+		Name:       "projects",
+		Columns:    ProjectsColumns,
+		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_projects_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[11]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
-		{Name: "id", Type: field.TypeUUID},
-		{Name: "email", Type: field.TypeString, Unique: true},
-		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "email", Type: field.TypeString, Unique: true, Size: 100},
 		{Name: "password_hash", Type: field.TypeString},
-		{Name: "first_name", Type: field.TypeString, Nullable: true},
-		{Name: "last_name", Type: field.TypeString, Nullable: true},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user"}, Default: "user"},
+		{Name: "role", Type: field.TypeString, Size: 50, Default: "user"},
+		{Name: "full_name", Type: field.TypeString, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
-		{Name: "points", Type: field.TypeInt, Default: 0},
-		{Name: "wallet_balance", Type: field.TypeFloat64, Default: 0},
+		{Name: "is_service_account", Type: field.TypeBool, Default: false},
+		{Name: "erased_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -30,22 +312,158 @@ var (
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "user_email",
+				Name:    "idx_users_email",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[2]},
 			},
 			{
-				Name:    "user_username",
+				Name:    "idx_users_role",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[2]},
+				Columns: []*schema.Column{UsersColumns[4]},
+			},
+		},
+	}
+	// WalletTransactionsColumns holds the columns for the "wallet_transactions" table.
+	WalletTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "transaction_type", Type: field.TypeEnum, Enums: []string{"deposit", "purchase", "refund"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "balance_after", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(10,2)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reference_id", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "failed"}, Default: "completed"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// WalletTransactionsTable holds the schema information for the "wallet_transactions" table.
+	WalletTransactionsTable = &schema.Table{
+		Name:       "wallet_transactions",
+		Columns:    WalletTransactionsColumns,
+		PrimaryKey: []*schema.Column{WalletTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_transactions_user_id_fkey",
+				Columns:    []*schema.Column{WalletTransactionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_wallet_transactions_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[8]},
+			},
+		},
+	}
+	// WordpressSitesColumns holds the columns for the "wordpress_sites" table.
+	WordpressSitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "url", Type: field.TypeString, Size: 255},
+		{Name: "api_endpoint", Type: field.TypeString, Size: 255},
+		{Name: "username", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "application_password", Type: field.TypeString, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "last_sync_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WordpressSitesTable holds the schema information for the "wordpress_sites" table.
+	WordpressSitesTable = &schema.Table{
+		Name:       "wordpress_sites",
+		Columns:    WordpressSitesColumns,
+		PrimaryKey: []*schema.Column{WordpressSitesColumns[0]},
+	}
+	// BlogPostCategoriesColumns holds the columns for the "blog_post_categories" table.
+	BlogPostCategoriesColumns = []*schema.Column{
+		{Name: "post_id", Type: field.TypeInt},
+		{Name: "category_id", Type: field.TypeInt},
+	}
+	// BlogPostCategoriesTable holds the schema information for the "blog_post_categories" table.
+	BlogPostCategoriesTable = &schema.Table{
+		Name:       "blog_post_categories",
+		Columns:    BlogPostCategoriesColumns,
+		PrimaryKey: []*schema.Column{BlogPostCategoriesColumns[0], BlogPostCategoriesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_post_categories_post_id_fkey",
+				Columns:    []*schema.Column{BlogPostCategoriesColumns[0]},
+				RefColumns: []*schema.Column{BlogPostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "blog_post_categories_category_id_fkey",
+				Columns:    []*schema.Column{BlogPostCategoriesColumns[1]},
+				RefColumns: []*schema.Column{BlogCategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BlogCategoriesTable,
+		BlogPostsTable,
+		ContentSyncLogsTable,
+		OrdersTable,
+		OrderItemsTable,
+		SoftwareProductsTable,
+		ProductCategoriesTable,
+		ProductFilesTable,
+		ProjectsTable,
 		UsersTable,
+		WalletTransactionsTable,
+		WordpressSitesTable,
+		BlogPostCategoriesTable,
 	}
 )
 
 func init() {
+	BlogCategoriesTable.Annotation = &entsql.Annotation{
+		Table: "blog_categories",
+	}
+	BlogPostsTable.ForeignKeys[0].RefTable = UsersTable
+	BlogPostsTable.Annotation = &entsql.Annotation{
+		Table: "blog_posts",
+	}
+	ContentSyncLogsTable.ForeignKeys[0].RefTable = WordpressSitesTable
+	ContentSyncLogsTable.Annotation = &entsql.Annotation{
+		Table: "content_sync_logs",
+	}
+	OrdersTable.ForeignKeys[0].RefTable = UsersTable
+	OrdersTable.Annotation = &entsql.Annotation{
+		Table: "orders",
+	}
+	OrderItemsTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderItemsTable.ForeignKeys[1].RefTable = SoftwareProductsTable
+	OrderItemsTable.Annotation = &entsql.Annotation{
+		Table: "order_items",
+	}
+	SoftwareProductsTable.ForeignKeys[0].RefTable = ProductCategoriesTable
+	SoftwareProductsTable.Annotation = &entsql.Annotation{
+		Table: "software_products",
+	}
+	ProductCategoriesTable.ForeignKeys[0].RefTable = ProductCategoriesTable
+	ProductCategoriesTable.Annotation = &entsql.Annotation{
+		Table: "product_categories",
+	}
+	ProductFilesTable.ForeignKeys[0].RefTable = SoftwareProductsTable
+	ProductFilesTable.Annotation = &entsql.Annotation{
+		Table: "product_files",
+	}
+	ProjectsTable.Annotation = &entsql.Annotation{
+		Table: "projects",
+	}
+	UsersTable.Annotation = &entsql.Annotation{
+		Table: "users",
+	}
+	WalletTransactionsTable.ForeignKeys[0].RefTable = UsersTable
+	WalletTransactionsTable.Annotation = &entsql.Annotation{
+		Table: "wallet_transactions",
+	}
+	WordpressSitesTable.Annotation = &entsql.Annotation{
+		Table: "wordpress_sites",
+	}
+	BlogPostCategoriesTable.ForeignKeys[0].RefTable = BlogPostsTable
+	BlogPostCategoriesTable.ForeignKeys[1].RefTable = BlogCategoriesTable
 }
@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/order"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Order is the model entity for the Order schema.
+type Order struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrderNumber holds the value of the "order_number" field.
+	OrderNumber string `json:"order_number,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// TotalAmount holds the value of the "total_amount" field.
+	TotalAmount float64 `json:"total_amount,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount float64 `json:"discount_amount,omitempty"`
+	// FinalAmount holds the value of the "final_amount" field.
+	FinalAmount float64 `json:"final_amount,omitempty"`
+	// PaymentMethod holds the value of the "payment_method" field.
+	PaymentMethod *string `json:"payment_method,omitempty"`
+	// PaymentStatus holds the value of the "payment_status" field.
+	PaymentStatus order.PaymentStatus `json:"payment_status,omitempty"`
+	// OrderStatus holds the value of the "order_status" field.
+	OrderStatus order.OrderStatus `json:"order_status,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderQuery when eager-loading is set.
+	Edges        OrderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderEdges holds the relations/edges for other nodes in the graph.
+type OrderEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*OrderItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) ItemsOrErr() ([]*OrderItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Order) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case order.FieldTotalAmount, order.FieldDiscountAmount, order.FieldFinalAmount:
+			values[i] = new(sql.NullFloat64)
+		case order.FieldID, order.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case order.FieldOrderNumber, order.FieldPaymentMethod, order.FieldPaymentStatus, order.FieldOrderStatus, order.FieldNotes:
+			values[i] = new(sql.NullString)
+		case order.FieldCreatedAt, order.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Order fields.
+func (o *Order) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case order.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = int(value.Int64)
+		case order.FieldOrderNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_number", values[i])
+			} else if value.Valid {
+				o.OrderNumber = value.String
+			}
+		case order.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				o.UserID = new(int)
+				*o.UserID = int(value.Int64)
+			}
+		case order.FieldTotalAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
+			} else if value.Valid {
+				o.TotalAmount = value.Float64
+			}
+		case order.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				o.DiscountAmount = value.Float64
+			}
+		case order.FieldFinalAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field final_amount", values[i])
+			} else if value.Valid {
+				o.FinalAmount = value.Float64
+			}
+		case order.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				o.PaymentMethod = new(string)
+				*o.PaymentMethod = value.String
+			}
+		case order.FieldPaymentStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_status", values[i])
+			} else if value.Valid {
+				o.PaymentStatus = order.PaymentStatus(value.String)
+			}
+		case order.FieldOrderStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_status", values[i])
+			} else if value.Valid {
+				o.OrderStatus = order.OrderStatus(value.String)
+			}
+		case order.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				o.Notes = new(string)
+				*o.Notes = value.String
+			}
+		case order.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		case order.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				o.UpdatedAt = value.Time
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Order.
+// This includes values selected through modifiers, order, etc.
+func (o *Order) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Order entity.
+func (o *Order) QueryUser() *UserQuery {
+	return NewOrderClient(o.config).QueryUser(o)
+}
+
+// QueryItems queries the "items" edge of the Order entity.
+func (o *Order) QueryItems() *OrderItemQuery {
+	return NewOrderClient(o.config).QueryItems(o)
+}
+
+// Update returns a builder for updating this Order.
+// Note that you need to call Order.Unwrap() before calling this method if this Order
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Order) Update() *OrderUpdateOne {
+	return NewOrderClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Order entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Order) Unwrap() *Order {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Order is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Order) String() string {
+	var builder strings.Builder
+	builder.WriteString("Order(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("order_number=")
+	builder.WriteString(o.OrderNumber)
+	builder.WriteString(", ")
+	if v := o.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("total_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.TotalAmount))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("final_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.FinalAmount))
+	builder.WriteString(", ")
+	if v := o.PaymentMethod; v != nil {
+		builder.WriteString("payment_method=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payment_status=")
+	builder.WriteString(fmt.Sprintf("%v", o.PaymentStatus))
+	builder.WriteString(", ")
+	builder.WriteString("order_status=")
+	builder.WriteString(fmt.Sprintf("%v", o.OrderStatus))
+	builder.WriteString(", ")
+	if v := o.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(o.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Orders is a parsable slice of Order.
+type Orders []*Order
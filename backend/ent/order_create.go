@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/order"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderCreate is the builder for creating a Order entity.
+type OrderCreate struct {
+	config
+	mutation *OrderMutation
+	hooks    []Hook
+}
+
+// SetOrderNumber sets the "order_number" field.
+func (oc *OrderCreate) SetOrderNumber(s string) *OrderCreate {
+	oc.mutation.SetOrderNumber(s)
+	return oc
+}
+
+// SetUserID sets the "user_id" field.
+func (oc *OrderCreate) SetUserID(i int) *OrderCreate {
+	oc.mutation.SetUserID(i)
+	return oc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableUserID(i *int) *OrderCreate {
+	if i != nil {
+		oc.SetUserID(*i)
+	}
+	return oc
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (oc *OrderCreate) SetTotalAmount(f float64) *OrderCreate {
+	oc.mutation.SetTotalAmount(f)
+	return oc
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (oc *OrderCreate) SetDiscountAmount(f float64) *OrderCreate {
+	oc.mutation.SetDiscountAmount(f)
+	return oc
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDiscountAmount(f *float64) *OrderCreate {
+	if f != nil {
+		oc.SetDiscountAmount(*f)
+	}
+	return oc
+}
+
+// SetFinalAmount sets the "final_amount" field.
+func (oc *OrderCreate) SetFinalAmount(f float64) *OrderCreate {
+	oc.mutation.SetFinalAmount(f)
+	return oc
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (oc *OrderCreate) SetPaymentMethod(s string) *OrderCreate {
+	oc.mutation.SetPaymentMethod(s)
+	return oc
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePaymentMethod(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetPaymentMethod(*s)
+	}
+	return oc
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (oc *OrderCreate) SetPaymentStatus(os order.PaymentStatus) *OrderCreate {
+	oc.mutation.SetPaymentStatus(os)
+	return oc
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePaymentStatus(os *order.PaymentStatus) *OrderCreate {
+	if os != nil {
+		oc.SetPaymentStatus(*os)
+	}
+	return oc
+}
+
+// SetOrderStatus sets the "order_status" field.
+func (oc *OrderCreate) SetOrderStatus(os order.OrderStatus) *OrderCreate {
+	oc.mutation.SetOrderStatus(os)
+	return oc
+}
+
+// SetNillableOrderStatus sets the "order_status" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableOrderStatus(os *order.OrderStatus) *OrderCreate {
+	if os != nil {
+		oc.SetOrderStatus(*os)
+	}
+	return oc
+}
+
+// SetNotes sets the "notes" field.
+func (oc *OrderCreate) SetNotes(s string) *OrderCreate {
+	oc.mutation.SetNotes(s)
+	return oc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableNotes(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetNotes(*s)
+	}
+	return oc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OrderCreate) SetCreatedAt(t time.Time) *OrderCreate {
+	oc.mutation.SetCreatedAt(t)
+	return oc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableCreatedAt(t *time.Time) *OrderCreate {
+	if t != nil {
+		oc.SetCreatedAt(*t)
+	}
+	return oc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oc *OrderCreate) SetUpdatedAt(t time.Time) *OrderCreate {
+	oc.mutation.SetUpdatedAt(t)
+	return oc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableUpdatedAt(t *time.Time) *OrderCreate {
+	if t != nil {
+		oc.SetUpdatedAt(*t)
+	}
+	return oc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (oc *OrderCreate) SetUser(u *User) *OrderCreate {
+	return oc.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
+func (oc *OrderCreate) AddItemIDs(ids ...int) *OrderCreate {
+	oc.mutation.AddItemIDs(ids...)
+	return oc
+}
+
+// AddItems adds the "items" edges to the OrderItem entity.
+func (oc *OrderCreate) AddItems(o ...*OrderItem) *OrderCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddItemIDs(ids...)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (oc *OrderCreate) Mutation() *OrderMutation {
+	return oc.mutation
+}
+
+// Save creates the Order in the database.
+func (oc *OrderCreate) Save(ctx context.Context) (*Order, error) {
+	oc.defaults()
+	return withHooks(ctx, oc.sqlSave, oc.mutation, oc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OrderCreate) SaveX(ctx context.Context) *Order {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OrderCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OrderCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oc *OrderCreate) defaults() {
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		v := order.DefaultDiscountAmount
+		oc.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := oc.mutation.PaymentStatus(); !ok {
+		v := order.DefaultPaymentStatus
+		oc.mutation.SetPaymentStatus(v)
+	}
+	if _, ok := oc.mutation.OrderStatus(); !ok {
+		v := order.DefaultOrderStatus
+		oc.mutation.SetOrderStatus(v)
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		v := order.DefaultCreatedAt()
+		oc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		v := order.DefaultUpdatedAt()
+		oc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OrderCreate) check() error {
+	if _, ok := oc.mutation.OrderNumber(); !ok {
+		return &ValidationError{Name: "order_number", err: errors.New(`ent: missing required field "Order.order_number"`)}
+	}
+	if v, ok := oc.mutation.OrderNumber(); ok {
+		if err := order.OrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "order_number", err: fmt.Errorf(`ent: validator failed for field "Order.order_number": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.TotalAmount(); !ok {
+		return &ValidationError{Name: "total_amount", err: errors.New(`ent: missing required field "Order.total_amount"`)}
+	}
+	if v, ok := oc.mutation.TotalAmount(); ok {
+		if err := order.TotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Order.discount_amount"`)}
+	}
+	if v, ok := oc.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.FinalAmount(); !ok {
+		return &ValidationError{Name: "final_amount", err: errors.New(`ent: missing required field "Order.final_amount"`)}
+	}
+	if v, ok := oc.mutation.FinalAmount(); ok {
+		if err := order.FinalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "final_amount", err: fmt.Errorf(`ent: validator failed for field "Order.final_amount": %w`, err)}
+		}
+	}
+	if v, ok := oc.mutation.PaymentMethod(); ok {
+		if err := order.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Order.payment_method": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.PaymentStatus(); !ok {
+		return &ValidationError{Name: "payment_status", err: errors.New(`ent: missing required field "Order.payment_status"`)}
+	}
+	if v, ok := oc.mutation.PaymentStatus(); ok {
+		if err := order.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.OrderStatus(); !ok {
+		return &ValidationError{Name: "order_status", err: errors.New(`ent: missing required field "Order.order_status"`)}
+	}
+	if v, ok := oc.mutation.OrderStatus(); ok {
+		if err := order.OrderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "order_status", err: fmt.Errorf(`ent: validator failed for field "Order.order_status": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Order.created_at"`)}
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Order.updated_at"`)}
+	}
+	return nil
+}
+
+func (oc *OrderCreate) sqlSave(ctx context.Context) (*Order, error) {
+	if err := oc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	oc.mutation.id = &_node.ID
+	oc.mutation.done = true
+	return _node, nil
+}
+
+func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Order{config: oc.config}
+		_spec = sqlgraph.NewCreateSpec(order.Table, sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt))
+	)
+	if value, ok := oc.mutation.OrderNumber(); ok {
+		_spec.SetField(order.FieldOrderNumber, field.TypeString, value)
+		_node.OrderNumber = value
+	}
+	if value, ok := oc.mutation.TotalAmount(); ok {
+		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_node.TotalAmount = value
+	}
+	if value, ok := oc.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := oc.mutation.FinalAmount(); ok {
+		_spec.SetField(order.FieldFinalAmount, field.TypeFloat64, value)
+		_node.FinalAmount = value
+	}
+	if value, ok := oc.mutation.PaymentMethod(); ok {
+		_spec.SetField(order.FieldPaymentMethod, field.TypeString, value)
+		_node.PaymentMethod = &value
+	}
+	if value, ok := oc.mutation.PaymentStatus(); ok {
+		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
+		_node.PaymentStatus = value
+	}
+	if value, ok := oc.mutation.OrderStatus(); ok {
+		_spec.SetField(order.FieldOrderStatus, field.TypeEnum, value)
+		_node.OrderStatus = value
+	}
+	if value, ok := oc.mutation.Notes(); ok {
+		_spec.SetField(order.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oc.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := oc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderCreateBulk is the builder for creating many Order entities in bulk.
+type OrderCreateBulk struct {
+	config
+	err      error
+	builders []*OrderCreate
+}
+
+// Save creates the Order entities in the database.
+func (ocb *OrderCreateBulk) Save(ctx context.Context) ([]*Order, error) {
+	if ocb.err != nil {
+		return nil, ocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Order, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OrderCreateBulk) SaveX(ctx context.Context) []*Order {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OrderCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/order"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderUpdate is the builder for updating Order entities.
+type OrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderMutation
+}
+
+// Where appends a list predicates to the OrderUpdate builder.
+func (ou *OrderUpdate) Where(ps ...predicate.Order) *OrderUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetOrderNumber sets the "order_number" field.
+func (ou *OrderUpdate) SetOrderNumber(s string) *OrderUpdate {
+	ou.mutation.SetOrderNumber(s)
+	return ou
+}
+
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableOrderNumber(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetOrderNumber(*s)
+	}
+	return ou
+}
+
+// SetUserID sets the "user_id" field.
+func (ou *OrderUpdate) SetUserID(i int) *OrderUpdate {
+	ou.mutation.SetUserID(i)
+	return ou
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableUserID(i *int) *OrderUpdate {
+	if i != nil {
+		ou.SetUserID(*i)
+	}
+	return ou
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ou *OrderUpdate) ClearUserID() *OrderUpdate {
+	ou.mutation.ClearUserID()
+	return ou
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (ou *OrderUpdate) SetTotalAmount(f float64) *OrderUpdate {
+	ou.mutation.ResetTotalAmount()
+	ou.mutation.SetTotalAmount(f)
+	return ou
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableTotalAmount(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetTotalAmount(*f)
+	}
+	return ou
+}
+
+// AddTotalAmount adds f to the "total_amount" field.
+func (ou *OrderUpdate) AddTotalAmount(f float64) *OrderUpdate {
+	ou.mutation.AddTotalAmount(f)
+	return ou
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ou *OrderUpdate) SetDiscountAmount(f float64) *OrderUpdate {
+	ou.mutation.ResetDiscountAmount()
+	ou.mutation.SetDiscountAmount(f)
+	return ou
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDiscountAmount(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetDiscountAmount(*f)
+	}
+	return ou
+}
+
+// AddDiscountAmount adds f to the "discount_amount" field.
+func (ou *OrderUpdate) AddDiscountAmount(f float64) *OrderUpdate {
+	ou.mutation.AddDiscountAmount(f)
+	return ou
+}
+
+// SetFinalAmount sets the "final_amount" field.
+func (ou *OrderUpdate) SetFinalAmount(f float64) *OrderUpdate {
+	ou.mutation.ResetFinalAmount()
+	ou.mutation.SetFinalAmount(f)
+	return ou
+}
+
+// SetNillableFinalAmount sets the "final_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableFinalAmount(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetFinalAmount(*f)
+	}
+	return ou
+}
+
+// AddFinalAmount adds f to the "final_amount" field.
+func (ou *OrderUpdate) AddFinalAmount(f float64) *OrderUpdate {
+	ou.mutation.AddFinalAmount(f)
+	return ou
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (ou *OrderUpdate) SetPaymentMethod(s string) *OrderUpdate {
+	ou.mutation.SetPaymentMethod(s)
+	return ou
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePaymentMethod(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetPaymentMethod(*s)
+	}
+	return ou
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (ou *OrderUpdate) ClearPaymentMethod() *OrderUpdate {
+	ou.mutation.ClearPaymentMethod()
+	return ou
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (ou *OrderUpdate) SetPaymentStatus(os order.PaymentStatus) *OrderUpdate {
+	ou.mutation.SetPaymentStatus(os)
+	return ou
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillablePaymentStatus(os *order.PaymentStatus) *OrderUpdate {
+	if os != nil {
+		ou.SetPaymentStatus(*os)
+	}
+	return ou
+}
+
+// SetOrderStatus sets the "order_status" field.
+func (ou *OrderUpdate) SetOrderStatus(os order.OrderStatus) *OrderUpdate {
+	ou.mutation.SetOrderStatus(os)
+	return ou
+}
+
+// SetNillableOrderStatus sets the "order_status" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableOrderStatus(os *order.OrderStatus) *OrderUpdate {
+	if os != nil {
+		ou.SetOrderStatus(*os)
+	}
+	return ou
+}
+
+// SetNotes sets the "notes" field.
+func (ou *OrderUpdate) SetNotes(s string) *OrderUpdate {
+	ou.mutation.SetNotes(s)
+	return ou
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableNotes(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetNotes(*s)
+	}
+	return ou
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (ou *OrderUpdate) ClearNotes() *OrderUpdate {
+	ou.mutation.ClearNotes()
+	return ou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ou *OrderUpdate) SetUpdatedAt(t time.Time) *OrderUpdate {
+	ou.mutation.SetUpdatedAt(t)
+	return ou
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ou *OrderUpdate) SetUser(u *User) *OrderUpdate {
+	return ou.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
+func (ou *OrderUpdate) AddItemIDs(ids ...int) *OrderUpdate {
+	ou.mutation.AddItemIDs(ids...)
+	return ou
+}
+
+// AddItems adds the "items" edges to the OrderItem entity.
+func (ou *OrderUpdate) AddItems(o ...*OrderItem) *OrderUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddItemIDs(ids...)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (ou *OrderUpdate) Mutation() *OrderMutation {
+	return ou.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ou *OrderUpdate) ClearUser() *OrderUpdate {
+	ou.mutation.ClearUser()
+	return ou
+}
+
+// ClearItems clears all "items" edges to the OrderItem entity.
+func (ou *OrderUpdate) ClearItems() *OrderUpdate {
+	ou.mutation.ClearItems()
+	return ou
+}
+
+// RemoveItemIDs removes the "items" edge to OrderItem entities by IDs.
+func (ou *OrderUpdate) RemoveItemIDs(ids ...int) *OrderUpdate {
+	ou.mutation.RemoveItemIDs(ids...)
+	return ou
+}
+
+// RemoveItems removes "items" edges to OrderItem entities.
+func (ou *OrderUpdate) RemoveItems(o ...*OrderItem) *OrderUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
+	ou.defaults()
+	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OrderUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OrderUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ou *OrderUpdate) defaults() {
+	if _, ok := ou.mutation.UpdatedAt(); !ok {
+		v := order.UpdateDefaultUpdatedAt()
+		ou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ou *OrderUpdate) check() error {
+	if v, ok := ou.mutation.OrderNumber(); ok {
+		if err := order.OrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "order_number", err: fmt.Errorf(`ent: validator failed for field "Order.order_number": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.TotalAmount(); ok {
+		if err := order.TotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.FinalAmount(); ok {
+		if err := order.FinalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "final_amount", err: fmt.Errorf(`ent: validator failed for field "Order.final_amount": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.PaymentMethod(); ok {
+		if err := order.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Order.payment_method": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.PaymentStatus(); ok {
+		if err := order.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.OrderStatus(); ok {
+		if err := order.OrderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "order_status", err: fmt.Errorf(`ent: validator failed for field "Order.order_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(order.Table, order.Columns, sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt))
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.OrderNumber(); ok {
+		_spec.SetField(order.FieldOrderNumber, field.TypeString, value)
+	}
+	if value, ok := ou.mutation.TotalAmount(); ok {
+		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.FinalAmount(); ok {
+		_spec.SetField(order.FieldFinalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedFinalAmount(); ok {
+		_spec.AddField(order.FieldFinalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.PaymentMethod(); ok {
+		_spec.SetField(order.FieldPaymentMethod, field.TypeString, value)
+	}
+	if ou.mutation.PaymentMethodCleared() {
+		_spec.ClearField(order.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := ou.mutation.PaymentStatus(); ok {
+		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
+	}
+	if value, ok := ou.mutation.OrderStatus(); ok {
+		_spec.SetField(order.FieldOrderStatus, field.TypeEnum, value)
+	}
+	if value, ok := ou.mutation.Notes(); ok {
+		_spec.SetField(order.FieldNotes, field.TypeString, value)
+	}
+	if ou.mutation.NotesCleared() {
+		_spec.ClearField(order.FieldNotes, field.TypeString)
+	}
+	if value, ok := ou.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ou.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedItemsIDs(); len(nodes) > 0 && !ou.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{order.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ou.mutation.done = true
+	return n, nil
+}
+
+// OrderUpdateOne is the builder for updating a single Order entity.
+type OrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderMutation
+}
+
+// SetOrderNumber sets the "order_number" field.
+func (ouo *OrderUpdateOne) SetOrderNumber(s string) *OrderUpdateOne {
+	ouo.mutation.SetOrderNumber(s)
+	return ouo
+}
+
+// SetNillableOrderNumber sets the "order_number" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableOrderNumber(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetOrderNumber(*s)
+	}
+	return ouo
+}
+
+// SetUserID sets the "user_id" field.
+func (ouo *OrderUpdateOne) SetUserID(i int) *OrderUpdateOne {
+	ouo.mutation.SetUserID(i)
+	return ouo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableUserID(i *int) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetUserID(*i)
+	}
+	return ouo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (ouo *OrderUpdateOne) ClearUserID() *OrderUpdateOne {
+	ouo.mutation.ClearUserID()
+	return ouo
+}
+
+// SetTotalAmount sets the "total_amount" field.
+func (ouo *OrderUpdateOne) SetTotalAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetTotalAmount()
+	ouo.mutation.SetTotalAmount(f)
+	return ouo
+}
+
+// SetNillableTotalAmount sets the "total_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableTotalAmount(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetTotalAmount(*f)
+	}
+	return ouo
+}
+
+// AddTotalAmount adds f to the "total_amount" field.
+func (ouo *OrderUpdateOne) AddTotalAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.AddTotalAmount(f)
+	return ouo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ouo *OrderUpdateOne) SetDiscountAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetDiscountAmount()
+	ouo.mutation.SetDiscountAmount(f)
+	return ouo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDiscountAmount(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetDiscountAmount(*f)
+	}
+	return ouo
+}
+
+// AddDiscountAmount adds f to the "discount_amount" field.
+func (ouo *OrderUpdateOne) AddDiscountAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.AddDiscountAmount(f)
+	return ouo
+}
+
+// SetFinalAmount sets the "final_amount" field.
+func (ouo *OrderUpdateOne) SetFinalAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetFinalAmount()
+	ouo.mutation.SetFinalAmount(f)
+	return ouo
+}
+
+// SetNillableFinalAmount sets the "final_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableFinalAmount(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetFinalAmount(*f)
+	}
+	return ouo
+}
+
+// AddFinalAmount adds f to the "final_amount" field.
+func (ouo *OrderUpdateOne) AddFinalAmount(f float64) *OrderUpdateOne {
+	ouo.mutation.AddFinalAmount(f)
+	return ouo
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (ouo *OrderUpdateOne) SetPaymentMethod(s string) *OrderUpdateOne {
+	ouo.mutation.SetPaymentMethod(s)
+	return ouo
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePaymentMethod(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetPaymentMethod(*s)
+	}
+	return ouo
+}
+
+// ClearPaymentMethod clears the value of the "payment_method" field.
+func (ouo *OrderUpdateOne) ClearPaymentMethod() *OrderUpdateOne {
+	ouo.mutation.ClearPaymentMethod()
+	return ouo
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (ouo *OrderUpdateOne) SetPaymentStatus(os order.PaymentStatus) *OrderUpdateOne {
+	ouo.mutation.SetPaymentStatus(os)
+	return ouo
+}
+
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillablePaymentStatus(os *order.PaymentStatus) *OrderUpdateOne {
+	if os != nil {
+		ouo.SetPaymentStatus(*os)
+	}
+	return ouo
+}
+
+// SetOrderStatus sets the "order_status" field.
+func (ouo *OrderUpdateOne) SetOrderStatus(os order.OrderStatus) *OrderUpdateOne {
+	ouo.mutation.SetOrderStatus(os)
+	return ouo
+}
+
+// SetNillableOrderStatus sets the "order_status" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableOrderStatus(os *order.OrderStatus) *OrderUpdateOne {
+	if os != nil {
+		ouo.SetOrderStatus(*os)
+	}
+	return ouo
+}
+
+// SetNotes sets the "notes" field.
+func (ouo *OrderUpdateOne) SetNotes(s string) *OrderUpdateOne {
+	ouo.mutation.SetNotes(s)
+	return ouo
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableNotes(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetNotes(*s)
+	}
+	return ouo
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (ouo *OrderUpdateOne) ClearNotes() *OrderUpdateOne {
+	ouo.mutation.ClearNotes()
+	return ouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ouo *OrderUpdateOne) SetUpdatedAt(t time.Time) *OrderUpdateOne {
+	ouo.mutation.SetUpdatedAt(t)
+	return ouo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ouo *OrderUpdateOne) SetUser(u *User) *OrderUpdateOne {
+	return ouo.SetUserID(u.ID)
+}
+
+// AddItemIDs adds the "items" edge to the OrderItem entity by IDs.
+func (ouo *OrderUpdateOne) AddItemIDs(ids ...int) *OrderUpdateOne {
+	ouo.mutation.AddItemIDs(ids...)
+	return ouo
+}
+
+// AddItems adds the "items" edges to the OrderItem entity.
+func (ouo *OrderUpdateOne) AddItems(o ...*OrderItem) *OrderUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddItemIDs(ids...)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
+	return ouo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ouo *OrderUpdateOne) ClearUser() *OrderUpdateOne {
+	ouo.mutation.ClearUser()
+	return ouo
+}
+
+// ClearItems clears all "items" edges to the OrderItem entity.
+func (ouo *OrderUpdateOne) ClearItems() *OrderUpdateOne {
+	ouo.mutation.ClearItems()
+	return ouo
+}
+
+// RemoveItemIDs removes the "items" edge to OrderItem entities by IDs.
+func (ouo *OrderUpdateOne) RemoveItemIDs(ids ...int) *OrderUpdateOne {
+	ouo.mutation.RemoveItemIDs(ids...)
+	return ouo
+}
+
+// RemoveItems removes "items" edges to OrderItem entities.
+func (ouo *OrderUpdateOne) RemoveItems(o ...*OrderItem) *OrderUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the OrderUpdate builder.
+func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
+	ouo.mutation.Where(ps...)
+	return ouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OrderUpdateOne) Select(field string, fields ...string) *OrderUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Order entity.
+func (ouo *OrderUpdateOne) Save(ctx context.Context) (*Order, error) {
+	ouo.defaults()
+	return withHooks(ctx, ouo.sqlSave, ouo.mutation, ouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OrderUpdateOne) SaveX(ctx context.Context) *Order {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OrderUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ouo *OrderUpdateOne) defaults() {
+	if _, ok := ouo.mutation.UpdatedAt(); !ok {
+		v := order.UpdateDefaultUpdatedAt()
+		ouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ouo *OrderUpdateOne) check() error {
+	if v, ok := ouo.mutation.OrderNumber(); ok {
+		if err := order.OrderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "order_number", err: fmt.Errorf(`ent: validator failed for field "Order.order_number": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.TotalAmount(); ok {
+		if err := order.TotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.FinalAmount(); ok {
+		if err := order.FinalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "final_amount", err: fmt.Errorf(`ent: validator failed for field "Order.final_amount": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.PaymentMethod(); ok {
+		if err := order.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Order.payment_method": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.PaymentStatus(); ok {
+		if err := order.PaymentStatusValidator(v); err != nil {
+			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.OrderStatus(); ok {
+		if err := order.OrderStatusValidator(v); err != nil {
+			return &ValidationError{Name: "order_status", err: fmt.Errorf(`ent: validator failed for field "Order.order_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error) {
+	if err := ouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(order.Table, order.Columns, sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt))
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Order.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, order.FieldID)
+		for _, f := range fields {
+			if !order.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != order.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.OrderNumber(); ok {
+		_spec.SetField(order.FieldOrderNumber, field.TypeString, value)
+	}
+	if value, ok := ouo.mutation.TotalAmount(); ok {
+		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedTotalAmount(); ok {
+		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.FinalAmount(); ok {
+		_spec.SetField(order.FieldFinalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedFinalAmount(); ok {
+		_spec.AddField(order.FieldFinalAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.PaymentMethod(); ok {
+		_spec.SetField(order.FieldPaymentMethod, field.TypeString, value)
+	}
+	if ouo.mutation.PaymentMethodCleared() {
+		_spec.ClearField(order.FieldPaymentMethod, field.TypeString)
+	}
+	if value, ok := ouo.mutation.PaymentStatus(); ok {
+		_spec.SetField(order.FieldPaymentStatus, field.TypeEnum, value)
+	}
+	if value, ok := ouo.mutation.OrderStatus(); ok {
+		_spec.SetField(order.FieldOrderStatus, field.TypeEnum, value)
+	}
+	if value, ok := ouo.mutation.Notes(); ok {
+		_spec.SetField(order.FieldNotes, field.TypeString, value)
+	}
+	if ouo.mutation.NotesCleared() {
+		_spec.ClearField(order.FieldNotes, field.TypeString)
+	}
+	if value, ok := ouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !ouo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ItemsTable,
+			Columns: []string{order.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Order{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{order.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ouo.mutation.done = true
+	return _node, nil
+}
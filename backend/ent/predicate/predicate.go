@@ -6,5 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BlogCategory is the predicate function for blogcategory builders.
+type BlogCategory func(*sql.Selector)
+
+// BlogPost is the predicate function for blogpost builders.
+type BlogPost func(*sql.Selector)
+
+// ContentSyncLog is the predicate function for contentsynclog builders.
+type ContentSyncLog func(*sql.Selector)
+
+// Order is the predicate function for order builders.
+type Order func(*sql.Selector)
+
+// OrderItem is the predicate function for orderitem builders.
+type OrderItem func(*sql.Selector)
+
+// Product is the predicate function for product builders.
+type Product func(*sql.Selector)
+
+// ProductCategory is the predicate function for productcategory builders.
+type ProductCategory func(*sql.Selector)
+
+// ProductFile is the predicate function for productfile builders.
+type ProductFile func(*sql.Selector)
+
+// Project is the predicate function for project builders.
+type Project func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WalletTransaction is the predicate function for wallettransaction builders.
+type WalletTransaction func(*sql.Selector)
+
+// WordPressSite is the predicate function for wordpresssite builders.
+type WordPressSite func(*sql.Selector)
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+// Product is the model entity for the Product schema.
+type Product struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ShortDescription holds the value of the "short_description" field.
+	ShortDescription *string `json:"short_description,omitempty"`
+	// FeaturedImage holds the value of the "featured_image" field.
+	FeaturedImage *string `json:"featured_image,omitempty"`
+	// GalleryImages holds the value of the "gallery_images" field.
+	GalleryImages pq.StringArray `json:"gallery_images,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// DiscountPrice holds the value of the "discount_price" field.
+	DiscountPrice *float64 `json:"discount_price,omitempty"`
+	// Version holds the value of the "version" field.
+	Version *string `json:"version,omitempty"`
+	// Requirements holds the value of the "requirements" field.
+	Requirements *string `json:"requirements,omitempty"`
+	// Features holds the value of the "features" field.
+	Features pq.StringArray `json:"features,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// DownloadURL holds the value of the "download_url" field.
+	DownloadURL *string `json:"download_url,omitempty"`
+	// FileSize holds the value of the "file_size" field.
+	FileSize *int64 `json:"file_size,omitempty"`
+	// DownloadCount holds the value of the "download_count" field.
+	DownloadCount int `json:"download_count,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// IsFeatured holds the value of the "is_featured" field.
+	IsFeatured bool `json:"is_featured,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductQuery when eager-loading is set.
+	Edges        ProductEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductEdges holds the relations/edges for other nodes in the graph.
+type ProductEdges struct {
+	// Category holds the value of the category edge.
+	Category *ProductCategory `json:"category,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*ProductFile `json:"files,omitempty"`
+	// OrderItems holds the value of the order_items edge.
+	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductEdges) CategoryOrErr() (*ProductCategory, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: productcategory.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) FilesOrErr() ([]*ProductFile, error) {
+	if e.loadedTypes[1] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// OrderItemsOrErr returns the OrderItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) OrderItemsOrErr() ([]*OrderItem, error) {
+	if e.loadedTypes[2] {
+		return e.OrderItems, nil
+	}
+	return nil, &NotLoadedError{edge: "order_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Product) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case product.FieldGalleryImages, product.FieldFeatures:
+			values[i] = new(pq.StringArray)
+		case product.FieldIsActive, product.FieldIsFeatured:
+			values[i] = new(sql.NullBool)
+		case product.FieldPrice, product.FieldDiscountPrice:
+			values[i] = new(sql.NullFloat64)
+		case product.FieldID, product.FieldCategoryID, product.FieldFileSize, product.FieldDownloadCount:
+			values[i] = new(sql.NullInt64)
+		case product.FieldName, product.FieldSlug, product.FieldDescription, product.FieldShortDescription, product.FieldFeaturedImage, product.FieldVersion, product.FieldRequirements, product.FieldDownloadURL:
+			values[i] = new(sql.NullString)
+		case product.FieldCreatedAt, product.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Product fields.
+func (pr *Product) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case product.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case product.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case product.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				pr.Slug = value.String
+			}
+		case product.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case product.FieldShortDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_description", values[i])
+			} else if value.Valid {
+				pr.ShortDescription = new(string)
+				*pr.ShortDescription = value.String
+			}
+		case product.FieldFeaturedImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field featured_image", values[i])
+			} else if value.Valid {
+				pr.FeaturedImage = new(string)
+				*pr.FeaturedImage = value.String
+			}
+		case product.FieldGalleryImages:
+			if value, ok := values[i].(*pq.StringArray); !ok {
+				return fmt.Errorf("unexpected type %T for field gallery_images", values[i])
+			} else if value != nil {
+				pr.GalleryImages = *value
+			}
+		case product.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pr.Price = value.Float64
+			}
+		case product.FieldDiscountPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_price", values[i])
+			} else if value.Valid {
+				pr.DiscountPrice = new(float64)
+				*pr.DiscountPrice = value.Float64
+			}
+		case product.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = new(string)
+				*pr.Version = value.String
+			}
+		case product.FieldRequirements:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requirements", values[i])
+			} else if value.Valid {
+				pr.Requirements = new(string)
+				*pr.Requirements = value.String
+			}
+		case product.FieldFeatures:
+			if value, ok := values[i].(*pq.StringArray); !ok {
+				return fmt.Errorf("unexpected type %T for field features", values[i])
+			} else if value != nil {
+				pr.Features = *value
+			}
+		case product.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				pr.CategoryID = new(int)
+				*pr.CategoryID = int(value.Int64)
+			}
+		case product.FieldDownloadURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field download_url", values[i])
+			} else if value.Valid {
+				pr.DownloadURL = new(string)
+				*pr.DownloadURL = value.String
+			}
+		case product.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_size", values[i])
+			} else if value.Valid {
+				pr.FileSize = new(int64)
+				*pr.FileSize = value.Int64
+			}
+		case product.FieldDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_count", values[i])
+			} else if value.Valid {
+				pr.DownloadCount = int(value.Int64)
+			}
+		case product.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				pr.IsActive = value.Bool
+			}
+		case product.FieldIsFeatured:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_featured", values[i])
+			} else if value.Valid {
+				pr.IsFeatured = value.Bool
+			}
+		case product.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case product.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Product.
+// This includes values selected through modifiers, order, etc.
+func (pr *Product) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryCategory queries the "category" edge of the Product entity.
+func (pr *Product) QueryCategory() *ProductCategoryQuery {
+	return NewProductClient(pr.config).QueryCategory(pr)
+}
+
+// QueryFiles queries the "files" edge of the Product entity.
+func (pr *Product) QueryFiles() *ProductFileQuery {
+	return NewProductClient(pr.config).QueryFiles(pr)
+}
+
+// QueryOrderItems queries the "order_items" edge of the Product entity.
+func (pr *Product) QueryOrderItems() *OrderItemQuery {
+	return NewProductClient(pr.config).QueryOrderItems(pr)
+}
+
+// Update returns a builder for updating this Product.
+// Note that you need to call Product.Unwrap() before calling this method if this Product
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Product) Update() *ProductUpdateOne {
+	return NewProductClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Product entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Product) Unwrap() *Product {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Product is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Product) String() string {
+	var builder strings.Builder
+	builder.WriteString("Product(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(pr.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	if v := pr.ShortDescription; v != nil {
+		builder.WriteString("short_description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.FeaturedImage; v != nil {
+		builder.WriteString("featured_image=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("gallery_images=")
+	builder.WriteString(fmt.Sprintf("%v", pr.GalleryImages))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Price))
+	builder.WriteString(", ")
+	if v := pr.DiscountPrice; v != nil {
+		builder.WriteString("discount_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.Version; v != nil {
+		builder.WriteString("version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.Requirements; v != nil {
+		builder.WriteString("requirements=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("features=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Features))
+	builder.WriteString(", ")
+	if v := pr.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.DownloadURL; v != nil {
+		builder.WriteString("download_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.FileSize; v != nil {
+		builder.WriteString("file_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("download_count=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DownloadCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", pr.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("is_featured=")
+	builder.WriteString(fmt.Sprintf("%v", pr.IsFeatured))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Products is a parsable slice of Product.
+type Products []*Product
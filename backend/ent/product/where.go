@@ -0,0 +1,1216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package product
+
+import (
+	"time"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lib/pq"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSlug, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDescription, v))
+}
+
+// ShortDescription applies equality check predicate on the "short_description" field. It's identical to ShortDescriptionEQ.
+func ShortDescription(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldShortDescription, v))
+}
+
+// FeaturedImage applies equality check predicate on the "featured_image" field. It's identical to FeaturedImageEQ.
+func FeaturedImage(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFeaturedImage, v))
+}
+
+// GalleryImages applies equality check predicate on the "gallery_images" field. It's identical to GalleryImagesEQ.
+func GalleryImages(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldGalleryImages, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPrice, v))
+}
+
+// DiscountPrice applies equality check predicate on the "discount_price" field. It's identical to DiscountPriceEQ.
+func DiscountPrice(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscountPrice, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVersion, v))
+}
+
+// Requirements applies equality check predicate on the "requirements" field. It's identical to RequirementsEQ.
+func Requirements(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldRequirements, v))
+}
+
+// Features applies equality check predicate on the "features" field. It's identical to FeaturesEQ.
+func Features(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFeatures, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// DownloadURL applies equality check predicate on the "download_url" field. It's identical to DownloadURLEQ.
+func DownloadURL(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDownloadURL, v))
+}
+
+// FileSize applies equality check predicate on the "file_size" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFileSize, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsFeatured applies equality check predicate on the "is_featured" field. It's identical to IsFeaturedEQ.
+func IsFeatured(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldIsFeatured, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldName, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ShortDescriptionEQ applies the EQ predicate on the "short_description" field.
+func ShortDescriptionEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldShortDescription, v))
+}
+
+// ShortDescriptionNEQ applies the NEQ predicate on the "short_description" field.
+func ShortDescriptionNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldShortDescription, v))
+}
+
+// ShortDescriptionIn applies the In predicate on the "short_description" field.
+func ShortDescriptionIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldShortDescription, vs...))
+}
+
+// ShortDescriptionNotIn applies the NotIn predicate on the "short_description" field.
+func ShortDescriptionNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldShortDescription, vs...))
+}
+
+// ShortDescriptionGT applies the GT predicate on the "short_description" field.
+func ShortDescriptionGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldShortDescription, v))
+}
+
+// ShortDescriptionGTE applies the GTE predicate on the "short_description" field.
+func ShortDescriptionGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldShortDescription, v))
+}
+
+// ShortDescriptionLT applies the LT predicate on the "short_description" field.
+func ShortDescriptionLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldShortDescription, v))
+}
+
+// ShortDescriptionLTE applies the LTE predicate on the "short_description" field.
+func ShortDescriptionLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldShortDescription, v))
+}
+
+// ShortDescriptionContains applies the Contains predicate on the "short_description" field.
+func ShortDescriptionContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldShortDescription, v))
+}
+
+// ShortDescriptionHasPrefix applies the HasPrefix predicate on the "short_description" field.
+func ShortDescriptionHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldShortDescription, v))
+}
+
+// ShortDescriptionHasSuffix applies the HasSuffix predicate on the "short_description" field.
+func ShortDescriptionHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldShortDescription, v))
+}
+
+// ShortDescriptionIsNil applies the IsNil predicate on the "short_description" field.
+func ShortDescriptionIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldShortDescription))
+}
+
+// ShortDescriptionNotNil applies the NotNil predicate on the "short_description" field.
+func ShortDescriptionNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldShortDescription))
+}
+
+// ShortDescriptionEqualFold applies the EqualFold predicate on the "short_description" field.
+func ShortDescriptionEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldShortDescription, v))
+}
+
+// ShortDescriptionContainsFold applies the ContainsFold predicate on the "short_description" field.
+func ShortDescriptionContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldShortDescription, v))
+}
+
+// FeaturedImageEQ applies the EQ predicate on the "featured_image" field.
+func FeaturedImageEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFeaturedImage, v))
+}
+
+// FeaturedImageNEQ applies the NEQ predicate on the "featured_image" field.
+func FeaturedImageNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldFeaturedImage, v))
+}
+
+// FeaturedImageIn applies the In predicate on the "featured_image" field.
+func FeaturedImageIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldFeaturedImage, vs...))
+}
+
+// FeaturedImageNotIn applies the NotIn predicate on the "featured_image" field.
+func FeaturedImageNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldFeaturedImage, vs...))
+}
+
+// FeaturedImageGT applies the GT predicate on the "featured_image" field.
+func FeaturedImageGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldFeaturedImage, v))
+}
+
+// FeaturedImageGTE applies the GTE predicate on the "featured_image" field.
+func FeaturedImageGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldFeaturedImage, v))
+}
+
+// FeaturedImageLT applies the LT predicate on the "featured_image" field.
+func FeaturedImageLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldFeaturedImage, v))
+}
+
+// FeaturedImageLTE applies the LTE predicate on the "featured_image" field.
+func FeaturedImageLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldFeaturedImage, v))
+}
+
+// FeaturedImageContains applies the Contains predicate on the "featured_image" field.
+func FeaturedImageContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldFeaturedImage, v))
+}
+
+// FeaturedImageHasPrefix applies the HasPrefix predicate on the "featured_image" field.
+func FeaturedImageHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldFeaturedImage, v))
+}
+
+// FeaturedImageHasSuffix applies the HasSuffix predicate on the "featured_image" field.
+func FeaturedImageHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldFeaturedImage, v))
+}
+
+// FeaturedImageIsNil applies the IsNil predicate on the "featured_image" field.
+func FeaturedImageIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldFeaturedImage))
+}
+
+// FeaturedImageNotNil applies the NotNil predicate on the "featured_image" field.
+func FeaturedImageNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldFeaturedImage))
+}
+
+// FeaturedImageEqualFold applies the EqualFold predicate on the "featured_image" field.
+func FeaturedImageEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldFeaturedImage, v))
+}
+
+// FeaturedImageContainsFold applies the ContainsFold predicate on the "featured_image" field.
+func FeaturedImageContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldFeaturedImage, v))
+}
+
+// GalleryImagesEQ applies the EQ predicate on the "gallery_images" field.
+func GalleryImagesEQ(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldGalleryImages, v))
+}
+
+// GalleryImagesNEQ applies the NEQ predicate on the "gallery_images" field.
+func GalleryImagesNEQ(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldGalleryImages, v))
+}
+
+// GalleryImagesIn applies the In predicate on the "gallery_images" field.
+func GalleryImagesIn(vs ...pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldGalleryImages, vs...))
+}
+
+// GalleryImagesNotIn applies the NotIn predicate on the "gallery_images" field.
+func GalleryImagesNotIn(vs ...pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldGalleryImages, vs...))
+}
+
+// GalleryImagesGT applies the GT predicate on the "gallery_images" field.
+func GalleryImagesGT(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldGalleryImages, v))
+}
+
+// GalleryImagesGTE applies the GTE predicate on the "gallery_images" field.
+func GalleryImagesGTE(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldGalleryImages, v))
+}
+
+// GalleryImagesLT applies the LT predicate on the "gallery_images" field.
+func GalleryImagesLT(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldGalleryImages, v))
+}
+
+// GalleryImagesLTE applies the LTE predicate on the "gallery_images" field.
+func GalleryImagesLTE(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldGalleryImages, v))
+}
+
+// GalleryImagesIsNil applies the IsNil predicate on the "gallery_images" field.
+func GalleryImagesIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldGalleryImages))
+}
+
+// GalleryImagesNotNil applies the NotNil predicate on the "gallery_images" field.
+func GalleryImagesNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldGalleryImages))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldPrice, v))
+}
+
+// DiscountPriceEQ applies the EQ predicate on the "discount_price" field.
+func DiscountPriceEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDiscountPrice, v))
+}
+
+// DiscountPriceNEQ applies the NEQ predicate on the "discount_price" field.
+func DiscountPriceNEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDiscountPrice, v))
+}
+
+// DiscountPriceIn applies the In predicate on the "discount_price" field.
+func DiscountPriceIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDiscountPrice, vs...))
+}
+
+// DiscountPriceNotIn applies the NotIn predicate on the "discount_price" field.
+func DiscountPriceNotIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDiscountPrice, vs...))
+}
+
+// DiscountPriceGT applies the GT predicate on the "discount_price" field.
+func DiscountPriceGT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDiscountPrice, v))
+}
+
+// DiscountPriceGTE applies the GTE predicate on the "discount_price" field.
+func DiscountPriceGTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDiscountPrice, v))
+}
+
+// DiscountPriceLT applies the LT predicate on the "discount_price" field.
+func DiscountPriceLT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDiscountPrice, v))
+}
+
+// DiscountPriceLTE applies the LTE predicate on the "discount_price" field.
+func DiscountPriceLTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDiscountPrice, v))
+}
+
+// DiscountPriceIsNil applies the IsNil predicate on the "discount_price" field.
+func DiscountPriceIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDiscountPrice))
+}
+
+// DiscountPriceNotNil applies the NotNil predicate on the "discount_price" field.
+func DiscountPriceNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDiscountPrice))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldVersion))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// RequirementsEQ applies the EQ predicate on the "requirements" field.
+func RequirementsEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldRequirements, v))
+}
+
+// RequirementsNEQ applies the NEQ predicate on the "requirements" field.
+func RequirementsNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldRequirements, v))
+}
+
+// RequirementsIn applies the In predicate on the "requirements" field.
+func RequirementsIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldRequirements, vs...))
+}
+
+// RequirementsNotIn applies the NotIn predicate on the "requirements" field.
+func RequirementsNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldRequirements, vs...))
+}
+
+// RequirementsGT applies the GT predicate on the "requirements" field.
+func RequirementsGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldRequirements, v))
+}
+
+// RequirementsGTE applies the GTE predicate on the "requirements" field.
+func RequirementsGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldRequirements, v))
+}
+
+// RequirementsLT applies the LT predicate on the "requirements" field.
+func RequirementsLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldRequirements, v))
+}
+
+// RequirementsLTE applies the LTE predicate on the "requirements" field.
+func RequirementsLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldRequirements, v))
+}
+
+// RequirementsContains applies the Contains predicate on the "requirements" field.
+func RequirementsContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldRequirements, v))
+}
+
+// RequirementsHasPrefix applies the HasPrefix predicate on the "requirements" field.
+func RequirementsHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldRequirements, v))
+}
+
+// RequirementsHasSuffix applies the HasSuffix predicate on the "requirements" field.
+func RequirementsHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldRequirements, v))
+}
+
+// RequirementsIsNil applies the IsNil predicate on the "requirements" field.
+func RequirementsIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldRequirements))
+}
+
+// RequirementsNotNil applies the NotNil predicate on the "requirements" field.
+func RequirementsNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldRequirements))
+}
+
+// RequirementsEqualFold applies the EqualFold predicate on the "requirements" field.
+func RequirementsEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldRequirements, v))
+}
+
+// RequirementsContainsFold applies the ContainsFold predicate on the "requirements" field.
+func RequirementsContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldRequirements, v))
+}
+
+// FeaturesEQ applies the EQ predicate on the "features" field.
+func FeaturesEQ(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFeatures, v))
+}
+
+// FeaturesNEQ applies the NEQ predicate on the "features" field.
+func FeaturesNEQ(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldFeatures, v))
+}
+
+// FeaturesIn applies the In predicate on the "features" field.
+func FeaturesIn(vs ...pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldFeatures, vs...))
+}
+
+// FeaturesNotIn applies the NotIn predicate on the "features" field.
+func FeaturesNotIn(vs ...pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldFeatures, vs...))
+}
+
+// FeaturesGT applies the GT predicate on the "features" field.
+func FeaturesGT(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldFeatures, v))
+}
+
+// FeaturesGTE applies the GTE predicate on the "features" field.
+func FeaturesGTE(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldFeatures, v))
+}
+
+// FeaturesLT applies the LT predicate on the "features" field.
+func FeaturesLT(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldFeatures, v))
+}
+
+// FeaturesLTE applies the LTE predicate on the "features" field.
+func FeaturesLTE(v pq.StringArray) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldFeatures, v))
+}
+
+// FeaturesIsNil applies the IsNil predicate on the "features" field.
+func FeaturesIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldFeatures))
+}
+
+// FeaturesNotNil applies the NotNil predicate on the "features" field.
+func FeaturesNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldFeatures))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldCategoryID))
+}
+
+// DownloadURLEQ applies the EQ predicate on the "download_url" field.
+func DownloadURLEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDownloadURL, v))
+}
+
+// DownloadURLNEQ applies the NEQ predicate on the "download_url" field.
+func DownloadURLNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDownloadURL, v))
+}
+
+// DownloadURLIn applies the In predicate on the "download_url" field.
+func DownloadURLIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDownloadURL, vs...))
+}
+
+// DownloadURLNotIn applies the NotIn predicate on the "download_url" field.
+func DownloadURLNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDownloadURL, vs...))
+}
+
+// DownloadURLGT applies the GT predicate on the "download_url" field.
+func DownloadURLGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDownloadURL, v))
+}
+
+// DownloadURLGTE applies the GTE predicate on the "download_url" field.
+func DownloadURLGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDownloadURL, v))
+}
+
+// DownloadURLLT applies the LT predicate on the "download_url" field.
+func DownloadURLLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDownloadURL, v))
+}
+
+// DownloadURLLTE applies the LTE predicate on the "download_url" field.
+func DownloadURLLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDownloadURL, v))
+}
+
+// DownloadURLContains applies the Contains predicate on the "download_url" field.
+func DownloadURLContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldDownloadURL, v))
+}
+
+// DownloadURLHasPrefix applies the HasPrefix predicate on the "download_url" field.
+func DownloadURLHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldDownloadURL, v))
+}
+
+// DownloadURLHasSuffix applies the HasSuffix predicate on the "download_url" field.
+func DownloadURLHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldDownloadURL, v))
+}
+
+// DownloadURLIsNil applies the IsNil predicate on the "download_url" field.
+func DownloadURLIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDownloadURL))
+}
+
+// DownloadURLNotNil applies the NotNil predicate on the "download_url" field.
+func DownloadURLNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDownloadURL))
+}
+
+// DownloadURLEqualFold applies the EqualFold predicate on the "download_url" field.
+func DownloadURLEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldDownloadURL, v))
+}
+
+// DownloadURLContainsFold applies the ContainsFold predicate on the "download_url" field.
+func DownloadURLContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldDownloadURL, v))
+}
+
+// FileSizeEQ applies the EQ predicate on the "file_size" field.
+func FileSizeEQ(v int64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "file_size" field.
+func FileSizeNEQ(v int64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "file_size" field.
+func FileSizeIn(vs ...int64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "file_size" field.
+func FileSizeNotIn(vs ...int64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "file_size" field.
+func FileSizeGT(v int64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "file_size" field.
+func FileSizeGTE(v int64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "file_size" field.
+func FileSizeLT(v int64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "file_size" field.
+func FileSizeLTE(v int64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldFileSize, v))
+}
+
+// FileSizeIsNil applies the IsNil predicate on the "file_size" field.
+func FileSizeIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldFileSize))
+}
+
+// FileSizeNotNil applies the NotNil predicate on the "file_size" field.
+func FileSizeNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldFileSize))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// IsFeaturedEQ applies the EQ predicate on the "is_featured" field.
+func IsFeaturedEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldIsFeatured, v))
+}
+
+// IsFeaturedNEQ applies the NEQ predicate on the "is_featured" field.
+func IsFeaturedNEQ(v bool) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldIsFeatured, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.ProductCategory) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.ProductFile) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderItems applies the HasEdge predicate on the "order_items" edge.
+func HasOrderItems() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderItemsWith applies the HasEdge predicate on the "order_items" edge with a given conditions (other predicates).
+func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newOrderItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Product) predicate.Product {
+	return predicate.Product(sql.NotPredicates(p))
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lib/pq"
+)
+
+// ProductCreate is the builder for creating a Product entity.
+type ProductCreate struct {
+	config
+	mutation *ProductMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ProductCreate) SetName(s string) *ProductCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetSlug sets the "slug" field.
+func (pc *ProductCreate) SetSlug(s string) *ProductCreate {
+	pc.mutation.SetSlug(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *ProductCreate) SetDescription(s string) *ProductCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetShortDescription sets the "short_description" field.
+func (pc *ProductCreate) SetShortDescription(s string) *ProductCreate {
+	pc.mutation.SetShortDescription(s)
+	return pc
+}
+
+// SetNillableShortDescription sets the "short_description" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableShortDescription(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetShortDescription(*s)
+	}
+	return pc
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (pc *ProductCreate) SetFeaturedImage(s string) *ProductCreate {
+	pc.mutation.SetFeaturedImage(s)
+	return pc
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableFeaturedImage(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetFeaturedImage(*s)
+	}
+	return pc
+}
+
+// SetGalleryImages sets the "gallery_images" field.
+func (pc *ProductCreate) SetGalleryImages(pa pq.StringArray) *ProductCreate {
+	pc.mutation.SetGalleryImages(pa)
+	return pc
+}
+
+// SetPrice sets the "price" field.
+func (pc *ProductCreate) SetPrice(f float64) *ProductCreate {
+	pc.mutation.SetPrice(f)
+	return pc
+}
+
+// SetDiscountPrice sets the "discount_price" field.
+func (pc *ProductCreate) SetDiscountPrice(f float64) *ProductCreate {
+	pc.mutation.SetDiscountPrice(f)
+	return pc
+}
+
+// SetNillableDiscountPrice sets the "discount_price" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDiscountPrice(f *float64) *ProductCreate {
+	if f != nil {
+		pc.SetDiscountPrice(*f)
+	}
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *ProductCreate) SetVersion(s string) *ProductCreate {
+	pc.mutation.SetVersion(s)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableVersion(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetVersion(*s)
+	}
+	return pc
+}
+
+// SetRequirements sets the "requirements" field.
+func (pc *ProductCreate) SetRequirements(s string) *ProductCreate {
+	pc.mutation.SetRequirements(s)
+	return pc
+}
+
+// SetNillableRequirements sets the "requirements" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableRequirements(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetRequirements(*s)
+	}
+	return pc
+}
+
+// SetFeatures sets the "features" field.
+func (pc *ProductCreate) SetFeatures(pa pq.StringArray) *ProductCreate {
+	pc.mutation.SetFeatures(pa)
+	return pc
+}
+
+// SetCategoryID sets the "category_id" field.
+func (pc *ProductCreate) SetCategoryID(i int) *ProductCreate {
+	pc.mutation.SetCategoryID(i)
+	return pc
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCategoryID(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetCategoryID(*i)
+	}
+	return pc
+}
+
+// SetDownloadURL sets the "download_url" field.
+func (pc *ProductCreate) SetDownloadURL(s string) *ProductCreate {
+	pc.mutation.SetDownloadURL(s)
+	return pc
+}
+
+// SetNillableDownloadURL sets the "download_url" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDownloadURL(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetDownloadURL(*s)
+	}
+	return pc
+}
+
+// SetFileSize sets the "file_size" field.
+func (pc *ProductCreate) SetFileSize(i int64) *ProductCreate {
+	pc.mutation.SetFileSize(i)
+	return pc
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableFileSize(i *int64) *ProductCreate {
+	if i != nil {
+		pc.SetFileSize(*i)
+	}
+	return pc
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (pc *ProductCreate) SetDownloadCount(i int) *ProductCreate {
+	pc.mutation.SetDownloadCount(i)
+	return pc
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDownloadCount(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetDownloadCount(*i)
+	}
+	return pc
+}
+
+// SetIsActive sets the "is_active" field.
+func (pc *ProductCreate) SetIsActive(b bool) *ProductCreate {
+	pc.mutation.SetIsActive(b)
+	return pc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableIsActive(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetIsActive(*b)
+	}
+	return pc
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (pc *ProductCreate) SetIsFeatured(b bool) *ProductCreate {
+	pc.mutation.SetIsFeatured(b)
+	return pc
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableIsFeatured(b *bool) *ProductCreate {
+	if b != nil {
+		pc.SetIsFeatured(*b)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProductCreate) SetCreatedAt(t time.Time) *ProductCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCreatedAt(t *time.Time) *ProductCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProductCreate) SetUpdatedAt(t time.Time) *ProductCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableUpdatedAt(t *time.Time) *ProductCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetCategory sets the "category" edge to the ProductCategory entity.
+func (pc *ProductCreate) SetCategory(p *ProductCategory) *ProductCreate {
+	return pc.SetCategoryID(p.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ProductFile entity by IDs.
+func (pc *ProductCreate) AddFileIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddFileIDs(ids...)
+	return pc
+}
+
+// AddFiles adds the "files" edges to the ProductFile entity.
+func (pc *ProductCreate) AddFiles(p ...*ProductFile) *ProductCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddFileIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (pc *ProductCreate) AddOrderItemIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddOrderItemIDs(ids...)
+	return pc
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (pc *ProductCreate) AddOrderItems(o ...*OrderItem) *ProductCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pc.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (pc *ProductCreate) Mutation() *ProductMutation {
+	return pc.mutation
+}
+
+// Save creates the Product in the database.
+func (pc *ProductCreate) Save(ctx context.Context) (*Product, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProductCreate) SaveX(ctx context.Context) *Product {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProductCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProductCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProductCreate) defaults() {
+	if _, ok := pc.mutation.DownloadCount(); !ok {
+		v := product.DefaultDownloadCount
+		pc.mutation.SetDownloadCount(v)
+	}
+	if _, ok := pc.mutation.IsActive(); !ok {
+		v := product.DefaultIsActive
+		pc.mutation.SetIsActive(v)
+	}
+	if _, ok := pc.mutation.IsFeatured(); !ok {
+		v := product.DefaultIsFeatured
+		pc.mutation.SetIsFeatured(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := product.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := product.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProductCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Product.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Product.slug"`)}
+	}
+	if v, ok := pc.mutation.Slug(); ok {
+		if err := product.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Product.slug": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Product.description"`)}
+	}
+	if v, ok := pc.mutation.Description(); ok {
+		if err := product.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Product.description": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.ShortDescription(); ok {
+		if err := product.ShortDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "short_description", err: fmt.Errorf(`ent: validator failed for field "Product.short_description": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
+	}
+	if v, ok := pc.mutation.Price(); ok {
+		if err := product.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.DiscountPrice(); ok {
+		if err := product.DiscountPriceValidator(v); err != nil {
+			return &ValidationError{Name: "discount_price", err: fmt.Errorf(`ent: validator failed for field "Product.discount_price": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.Version(); ok {
+		if err := product.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Product.version": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "Product.download_count"`)}
+	}
+	if _, ok := pc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Product.is_active"`)}
+	}
+	if _, ok := pc.mutation.IsFeatured(); !ok {
+		return &ValidationError{Name: "is_featured", err: errors.New(`ent: missing required field "Product.is_featured"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Product.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Product.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *ProductCreate) sqlSave(ctx context.Context) (*Product, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Product{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(product.Table, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Slug(); ok {
+		_spec.SetField(product.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.SetField(product.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.ShortDescription(); ok {
+		_spec.SetField(product.FieldShortDescription, field.TypeString, value)
+		_node.ShortDescription = &value
+	}
+	if value, ok := pc.mutation.FeaturedImage(); ok {
+		_spec.SetField(product.FieldFeaturedImage, field.TypeString, value)
+		_node.FeaturedImage = &value
+	}
+	if value, ok := pc.mutation.GalleryImages(); ok {
+		_spec.SetField(product.FieldGalleryImages, field.TypeOther, value)
+		_node.GalleryImages = value
+	}
+	if value, ok := pc.mutation.Price(); ok {
+		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := pc.mutation.DiscountPrice(); ok {
+		_spec.SetField(product.FieldDiscountPrice, field.TypeFloat64, value)
+		_node.DiscountPrice = &value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeString, value)
+		_node.Version = &value
+	}
+	if value, ok := pc.mutation.Requirements(); ok {
+		_spec.SetField(product.FieldRequirements, field.TypeString, value)
+		_node.Requirements = &value
+	}
+	if value, ok := pc.mutation.Features(); ok {
+		_spec.SetField(product.FieldFeatures, field.TypeOther, value)
+		_node.Features = value
+	}
+	if value, ok := pc.mutation.DownloadURL(); ok {
+		_spec.SetField(product.FieldDownloadURL, field.TypeString, value)
+		_node.DownloadURL = &value
+	}
+	if value, ok := pc.mutation.FileSize(); ok {
+		_spec.SetField(product.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = &value
+	}
+	if value, ok := pc.mutation.DownloadCount(); ok {
+		_spec.SetField(product.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := pc.mutation.IsActive(); ok {
+		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := pc.mutation.IsFeatured(); ok {
+		_spec.SetField(product.FieldIsFeatured, field.TypeBool, value)
+		_node.IsFeatured = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(product.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(product.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   product.CategoryTable,
+			Columns: []string{product.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductCreateBulk is the builder for creating many Product entities in bulk.
+type ProductCreateBulk struct {
+	config
+	err      error
+	builders []*ProductCreate
+}
+
+// Save creates the Product entities in the database.
+func (pcb *ProductCreateBulk) Save(ctx context.Context) ([]*Product, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Product, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProductCreateBulk) SaveX(ctx context.Context) []*Product {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProductCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProductCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lib/pq"
+)
+
+// ProductUpdate is the builder for updating Product entities.
+type ProductUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// Where appends a list predicates to the ProductUpdate builder.
+func (pu *ProductUpdate) Where(ps ...predicate.Product) *ProductUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *ProductUpdate) SetName(s string) *ProductUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableName(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetSlug sets the "slug" field.
+func (pu *ProductUpdate) SetSlug(s string) *ProductUpdate {
+	pu.mutation.SetSlug(s)
+	return pu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSlug(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetSlug(*s)
+	}
+	return pu
+}
+
+// SetDescription sets the "description" field.
+func (pu *ProductUpdate) SetDescription(s string) *ProductUpdate {
+	pu.mutation.SetDescription(s)
+	return pu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDescription(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetDescription(*s)
+	}
+	return pu
+}
+
+// SetShortDescription sets the "short_description" field.
+func (pu *ProductUpdate) SetShortDescription(s string) *ProductUpdate {
+	pu.mutation.SetShortDescription(s)
+	return pu
+}
+
+// SetNillableShortDescription sets the "short_description" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableShortDescription(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetShortDescription(*s)
+	}
+	return pu
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (pu *ProductUpdate) ClearShortDescription() *ProductUpdate {
+	pu.mutation.ClearShortDescription()
+	return pu
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (pu *ProductUpdate) SetFeaturedImage(s string) *ProductUpdate {
+	pu.mutation.SetFeaturedImage(s)
+	return pu
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableFeaturedImage(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetFeaturedImage(*s)
+	}
+	return pu
+}
+
+// ClearFeaturedImage clears the value of the "featured_image" field.
+func (pu *ProductUpdate) ClearFeaturedImage() *ProductUpdate {
+	pu.mutation.ClearFeaturedImage()
+	return pu
+}
+
+// SetGalleryImages sets the "gallery_images" field.
+func (pu *ProductUpdate) SetGalleryImages(pa pq.StringArray) *ProductUpdate {
+	pu.mutation.SetGalleryImages(pa)
+	return pu
+}
+
+// ClearGalleryImages clears the value of the "gallery_images" field.
+func (pu *ProductUpdate) ClearGalleryImages() *ProductUpdate {
+	pu.mutation.ClearGalleryImages()
+	return pu
+}
+
+// SetPrice sets the "price" field.
+func (pu *ProductUpdate) SetPrice(f float64) *ProductUpdate {
+	pu.mutation.ResetPrice()
+	pu.mutation.SetPrice(f)
+	return pu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillablePrice(f *float64) *ProductUpdate {
+	if f != nil {
+		pu.SetPrice(*f)
+	}
+	return pu
+}
+
+// AddPrice adds f to the "price" field.
+func (pu *ProductUpdate) AddPrice(f float64) *ProductUpdate {
+	pu.mutation.AddPrice(f)
+	return pu
+}
+
+// SetDiscountPrice sets the "discount_price" field.
+func (pu *ProductUpdate) SetDiscountPrice(f float64) *ProductUpdate {
+	pu.mutation.ResetDiscountPrice()
+	pu.mutation.SetDiscountPrice(f)
+	return pu
+}
+
+// SetNillableDiscountPrice sets the "discount_price" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDiscountPrice(f *float64) *ProductUpdate {
+	if f != nil {
+		pu.SetDiscountPrice(*f)
+	}
+	return pu
+}
+
+// AddDiscountPrice adds f to the "discount_price" field.
+func (pu *ProductUpdate) AddDiscountPrice(f float64) *ProductUpdate {
+	pu.mutation.AddDiscountPrice(f)
+	return pu
+}
+
+// ClearDiscountPrice clears the value of the "discount_price" field.
+func (pu *ProductUpdate) ClearDiscountPrice() *ProductUpdate {
+	pu.mutation.ClearDiscountPrice()
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *ProductUpdate) SetVersion(s string) *ProductUpdate {
+	pu.mutation.SetVersion(s)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableVersion(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetVersion(*s)
+	}
+	return pu
+}
+
+// ClearVersion clears the value of the "version" field.
+func (pu *ProductUpdate) ClearVersion() *ProductUpdate {
+	pu.mutation.ClearVersion()
+	return pu
+}
+
+// SetRequirements sets the "requirements" field.
+func (pu *ProductUpdate) SetRequirements(s string) *ProductUpdate {
+	pu.mutation.SetRequirements(s)
+	return pu
+}
+
+// SetNillableRequirements sets the "requirements" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableRequirements(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetRequirements(*s)
+	}
+	return pu
+}
+
+// ClearRequirements clears the value of the "requirements" field.
+func (pu *ProductUpdate) ClearRequirements() *ProductUpdate {
+	pu.mutation.ClearRequirements()
+	return pu
+}
+
+// SetFeatures sets the "features" field.
+func (pu *ProductUpdate) SetFeatures(pa pq.StringArray) *ProductUpdate {
+	pu.mutation.SetFeatures(pa)
+	return pu
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (pu *ProductUpdate) ClearFeatures() *ProductUpdate {
+	pu.mutation.ClearFeatures()
+	return pu
+}
+
+// SetCategoryID sets the "category_id" field.
+func (pu *ProductUpdate) SetCategoryID(i int) *ProductUpdate {
+	pu.mutation.SetCategoryID(i)
+	return pu
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableCategoryID(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetCategoryID(*i)
+	}
+	return pu
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (pu *ProductUpdate) ClearCategoryID() *ProductUpdate {
+	pu.mutation.ClearCategoryID()
+	return pu
+}
+
+// SetDownloadURL sets the "download_url" field.
+func (pu *ProductUpdate) SetDownloadURL(s string) *ProductUpdate {
+	pu.mutation.SetDownloadURL(s)
+	return pu
+}
+
+// SetNillableDownloadURL sets the "download_url" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDownloadURL(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetDownloadURL(*s)
+	}
+	return pu
+}
+
+// ClearDownloadURL clears the value of the "download_url" field.
+func (pu *ProductUpdate) ClearDownloadURL() *ProductUpdate {
+	pu.mutation.ClearDownloadURL()
+	return pu
+}
+
+// SetFileSize sets the "file_size" field.
+func (pu *ProductUpdate) SetFileSize(i int64) *ProductUpdate {
+	pu.mutation.ResetFileSize()
+	pu.mutation.SetFileSize(i)
+	return pu
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableFileSize(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetFileSize(*i)
+	}
+	return pu
+}
+
+// AddFileSize adds i to the "file_size" field.
+func (pu *ProductUpdate) AddFileSize(i int64) *ProductUpdate {
+	pu.mutation.AddFileSize(i)
+	return pu
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (pu *ProductUpdate) ClearFileSize() *ProductUpdate {
+	pu.mutation.ClearFileSize()
+	return pu
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (pu *ProductUpdate) SetDownloadCount(i int) *ProductUpdate {
+	pu.mutation.ResetDownloadCount()
+	pu.mutation.SetDownloadCount(i)
+	return pu
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDownloadCount(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetDownloadCount(*i)
+	}
+	return pu
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (pu *ProductUpdate) AddDownloadCount(i int) *ProductUpdate {
+	pu.mutation.AddDownloadCount(i)
+	return pu
+}
+
+// SetIsActive sets the "is_active" field.
+func (pu *ProductUpdate) SetIsActive(b bool) *ProductUpdate {
+	pu.mutation.SetIsActive(b)
+	return pu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableIsActive(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetIsActive(*b)
+	}
+	return pu
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (pu *ProductUpdate) SetIsFeatured(b bool) *ProductUpdate {
+	pu.mutation.SetIsFeatured(b)
+	return pu
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableIsFeatured(b *bool) *ProductUpdate {
+	if b != nil {
+		pu.SetIsFeatured(*b)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *ProductUpdate) SetUpdatedAt(t time.Time) *ProductUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetCategory sets the "category" edge to the ProductCategory entity.
+func (pu *ProductUpdate) SetCategory(p *ProductCategory) *ProductUpdate {
+	return pu.SetCategoryID(p.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ProductFile entity by IDs.
+func (pu *ProductUpdate) AddFileIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddFileIDs(ids...)
+	return pu
+}
+
+// AddFiles adds the "files" edges to the ProductFile entity.
+func (pu *ProductUpdate) AddFiles(p ...*ProductFile) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddFileIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (pu *ProductUpdate) AddOrderItemIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddOrderItemIDs(ids...)
+	return pu
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (pu *ProductUpdate) AddOrderItems(o ...*OrderItem) *ProductUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (pu *ProductUpdate) Mutation() *ProductMutation {
+	return pu.mutation
+}
+
+// ClearCategory clears the "category" edge to the ProductCategory entity.
+func (pu *ProductUpdate) ClearCategory() *ProductUpdate {
+	pu.mutation.ClearCategory()
+	return pu
+}
+
+// ClearFiles clears all "files" edges to the ProductFile entity.
+func (pu *ProductUpdate) ClearFiles() *ProductUpdate {
+	pu.mutation.ClearFiles()
+	return pu
+}
+
+// RemoveFileIDs removes the "files" edge to ProductFile entities by IDs.
+func (pu *ProductUpdate) RemoveFileIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveFileIDs(ids...)
+	return pu
+}
+
+// RemoveFiles removes "files" edges to ProductFile entities.
+func (pu *ProductUpdate) RemoveFiles(p ...*ProductFile) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveFileIDs(ids...)
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (pu *ProductUpdate) ClearOrderItems() *ProductUpdate {
+	pu.mutation.ClearOrderItems()
+	return pu
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (pu *ProductUpdate) RemoveOrderItemIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveOrderItemIDs(ids...)
+	return pu
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (pu *ProductUpdate) RemoveOrderItems(o ...*OrderItem) *ProductUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.RemoveOrderItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProductUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProductUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProductUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *ProductUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := product.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProductUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Slug(); ok {
+		if err := product.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Product.slug": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Description(); ok {
+		if err := product.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Product.description": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.ShortDescription(); ok {
+		if err := product.ShortDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "short_description", err: fmt.Errorf(`ent: validator failed for field "Product.short_description": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Price(); ok {
+		if err := product.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.DiscountPrice(); ok {
+		if err := product.DiscountPriceValidator(v); err != nil {
+			return &ValidationError{Name: "discount_price", err: fmt.Errorf(`ent: validator failed for field "Product.discount_price": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Version(); ok {
+		if err := product.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Product.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(product.Table, product.Columns, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Slug(); ok {
+		_spec.SetField(product.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Description(); ok {
+		_spec.SetField(product.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.ShortDescription(); ok {
+		_spec.SetField(product.FieldShortDescription, field.TypeString, value)
+	}
+	if pu.mutation.ShortDescriptionCleared() {
+		_spec.ClearField(product.FieldShortDescription, field.TypeString)
+	}
+	if value, ok := pu.mutation.FeaturedImage(); ok {
+		_spec.SetField(product.FieldFeaturedImage, field.TypeString, value)
+	}
+	if pu.mutation.FeaturedImageCleared() {
+		_spec.ClearField(product.FieldFeaturedImage, field.TypeString)
+	}
+	if value, ok := pu.mutation.GalleryImages(); ok {
+		_spec.SetField(product.FieldGalleryImages, field.TypeOther, value)
+	}
+	if pu.mutation.GalleryImagesCleared() {
+		_spec.ClearField(product.FieldGalleryImages, field.TypeOther)
+	}
+	if value, ok := pu.mutation.Price(); ok {
+		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.AddedPrice(); ok {
+		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.DiscountPrice(); ok {
+		_spec.SetField(product.FieldDiscountPrice, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.AddedDiscountPrice(); ok {
+		_spec.AddField(product.FieldDiscountPrice, field.TypeFloat64, value)
+	}
+	if pu.mutation.DiscountPriceCleared() {
+		_spec.ClearField(product.FieldDiscountPrice, field.TypeFloat64)
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeString, value)
+	}
+	if pu.mutation.VersionCleared() {
+		_spec.ClearField(product.FieldVersion, field.TypeString)
+	}
+	if value, ok := pu.mutation.Requirements(); ok {
+		_spec.SetField(product.FieldRequirements, field.TypeString, value)
+	}
+	if pu.mutation.RequirementsCleared() {
+		_spec.ClearField(product.FieldRequirements, field.TypeString)
+	}
+	if value, ok := pu.mutation.Features(); ok {
+		_spec.SetField(product.FieldFeatures, field.TypeOther, value)
+	}
+	if pu.mutation.FeaturesCleared() {
+		_spec.ClearField(product.FieldFeatures, field.TypeOther)
+	}
+	if value, ok := pu.mutation.DownloadURL(); ok {
+		_spec.SetField(product.FieldDownloadURL, field.TypeString, value)
+	}
+	if pu.mutation.DownloadURLCleared() {
+		_spec.ClearField(product.FieldDownloadURL, field.TypeString)
+	}
+	if value, ok := pu.mutation.FileSize(); ok {
+		_spec.SetField(product.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedFileSize(); ok {
+		_spec.AddField(product.FieldFileSize, field.TypeInt64, value)
+	}
+	if pu.mutation.FileSizeCleared() {
+		_spec.ClearField(product.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := pu.mutation.DownloadCount(); ok {
+		_spec.SetField(product.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(product.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.IsActive(); ok {
+		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.IsFeatured(); ok {
+		_spec.SetField(product.FieldIsFeatured, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(product.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   product.CategoryTable,
+			Columns: []string{product.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   product.CategoryTable,
+			Columns: []string{product.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedFilesIDs(); len(nodes) > 0 && !pu.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !pu.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProductUpdateOne is the builder for updating a single Product entity.
+type ProductUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// SetName sets the "name" field.
+func (puo *ProductUpdateOne) SetName(s string) *ProductUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableName(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetSlug sets the "slug" field.
+func (puo *ProductUpdateOne) SetSlug(s string) *ProductUpdateOne {
+	puo.mutation.SetSlug(s)
+	return puo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSlug(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetSlug(*s)
+	}
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *ProductUpdateOne) SetDescription(s string) *ProductUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDescription(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetDescription(*s)
+	}
+	return puo
+}
+
+// SetShortDescription sets the "short_description" field.
+func (puo *ProductUpdateOne) SetShortDescription(s string) *ProductUpdateOne {
+	puo.mutation.SetShortDescription(s)
+	return puo
+}
+
+// SetNillableShortDescription sets the "short_description" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableShortDescription(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetShortDescription(*s)
+	}
+	return puo
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (puo *ProductUpdateOne) ClearShortDescription() *ProductUpdateOne {
+	puo.mutation.ClearShortDescription()
+	return puo
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (puo *ProductUpdateOne) SetFeaturedImage(s string) *ProductUpdateOne {
+	puo.mutation.SetFeaturedImage(s)
+	return puo
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableFeaturedImage(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetFeaturedImage(*s)
+	}
+	return puo
+}
+
+// ClearFeaturedImage clears the value of the "featured_image" field.
+func (puo *ProductUpdateOne) ClearFeaturedImage() *ProductUpdateOne {
+	puo.mutation.ClearFeaturedImage()
+	return puo
+}
+
+// SetGalleryImages sets the "gallery_images" field.
+func (puo *ProductUpdateOne) SetGalleryImages(pa pq.StringArray) *ProductUpdateOne {
+	puo.mutation.SetGalleryImages(pa)
+	return puo
+}
+
+// ClearGalleryImages clears the value of the "gallery_images" field.
+func (puo *ProductUpdateOne) ClearGalleryImages() *ProductUpdateOne {
+	puo.mutation.ClearGalleryImages()
+	return puo
+}
+
+// SetPrice sets the "price" field.
+func (puo *ProductUpdateOne) SetPrice(f float64) *ProductUpdateOne {
+	puo.mutation.ResetPrice()
+	puo.mutation.SetPrice(f)
+	return puo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillablePrice(f *float64) *ProductUpdateOne {
+	if f != nil {
+		puo.SetPrice(*f)
+	}
+	return puo
+}
+
+// AddPrice adds f to the "price" field.
+func (puo *ProductUpdateOne) AddPrice(f float64) *ProductUpdateOne {
+	puo.mutation.AddPrice(f)
+	return puo
+}
+
+// SetDiscountPrice sets the "discount_price" field.
+func (puo *ProductUpdateOne) SetDiscountPrice(f float64) *ProductUpdateOne {
+	puo.mutation.ResetDiscountPrice()
+	puo.mutation.SetDiscountPrice(f)
+	return puo
+}
+
+// SetNillableDiscountPrice sets the "discount_price" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDiscountPrice(f *float64) *ProductUpdateOne {
+	if f != nil {
+		puo.SetDiscountPrice(*f)
+	}
+	return puo
+}
+
+// AddDiscountPrice adds f to the "discount_price" field.
+func (puo *ProductUpdateOne) AddDiscountPrice(f float64) *ProductUpdateOne {
+	puo.mutation.AddDiscountPrice(f)
+	return puo
+}
+
+// ClearDiscountPrice clears the value of the "discount_price" field.
+func (puo *ProductUpdateOne) ClearDiscountPrice() *ProductUpdateOne {
+	puo.mutation.ClearDiscountPrice()
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *ProductUpdateOne) SetVersion(s string) *ProductUpdateOne {
+	puo.mutation.SetVersion(s)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableVersion(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetVersion(*s)
+	}
+	return puo
+}
+
+// ClearVersion clears the value of the "version" field.
+func (puo *ProductUpdateOne) ClearVersion() *ProductUpdateOne {
+	puo.mutation.ClearVersion()
+	return puo
+}
+
+// SetRequirements sets the "requirements" field.
+func (puo *ProductUpdateOne) SetRequirements(s string) *ProductUpdateOne {
+	puo.mutation.SetRequirements(s)
+	return puo
+}
+
+// SetNillableRequirements sets the "requirements" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableRequirements(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetRequirements(*s)
+	}
+	return puo
+}
+
+// ClearRequirements clears the value of the "requirements" field.
+func (puo *ProductUpdateOne) ClearRequirements() *ProductUpdateOne {
+	puo.mutation.ClearRequirements()
+	return puo
+}
+
+// SetFeatures sets the "features" field.
+func (puo *ProductUpdateOne) SetFeatures(pa pq.StringArray) *ProductUpdateOne {
+	puo.mutation.SetFeatures(pa)
+	return puo
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (puo *ProductUpdateOne) ClearFeatures() *ProductUpdateOne {
+	puo.mutation.ClearFeatures()
+	return puo
+}
+
+// SetCategoryID sets the "category_id" field.
+func (puo *ProductUpdateOne) SetCategoryID(i int) *ProductUpdateOne {
+	puo.mutation.SetCategoryID(i)
+	return puo
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableCategoryID(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetCategoryID(*i)
+	}
+	return puo
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (puo *ProductUpdateOne) ClearCategoryID() *ProductUpdateOne {
+	puo.mutation.ClearCategoryID()
+	return puo
+}
+
+// SetDownloadURL sets the "download_url" field.
+func (puo *ProductUpdateOne) SetDownloadURL(s string) *ProductUpdateOne {
+	puo.mutation.SetDownloadURL(s)
+	return puo
+}
+
+// SetNillableDownloadURL sets the "download_url" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDownloadURL(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetDownloadURL(*s)
+	}
+	return puo
+}
+
+// ClearDownloadURL clears the value of the "download_url" field.
+func (puo *ProductUpdateOne) ClearDownloadURL() *ProductUpdateOne {
+	puo.mutation.ClearDownloadURL()
+	return puo
+}
+
+// SetFileSize sets the "file_size" field.
+func (puo *ProductUpdateOne) SetFileSize(i int64) *ProductUpdateOne {
+	puo.mutation.ResetFileSize()
+	puo.mutation.SetFileSize(i)
+	return puo
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableFileSize(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetFileSize(*i)
+	}
+	return puo
+}
+
+// AddFileSize adds i to the "file_size" field.
+func (puo *ProductUpdateOne) AddFileSize(i int64) *ProductUpdateOne {
+	puo.mutation.AddFileSize(i)
+	return puo
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (puo *ProductUpdateOne) ClearFileSize() *ProductUpdateOne {
+	puo.mutation.ClearFileSize()
+	return puo
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (puo *ProductUpdateOne) SetDownloadCount(i int) *ProductUpdateOne {
+	puo.mutation.ResetDownloadCount()
+	puo.mutation.SetDownloadCount(i)
+	return puo
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDownloadCount(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetDownloadCount(*i)
+	}
+	return puo
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (puo *ProductUpdateOne) AddDownloadCount(i int) *ProductUpdateOne {
+	puo.mutation.AddDownloadCount(i)
+	return puo
+}
+
+// SetIsActive sets the "is_active" field.
+func (puo *ProductUpdateOne) SetIsActive(b bool) *ProductUpdateOne {
+	puo.mutation.SetIsActive(b)
+	return puo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableIsActive(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetIsActive(*b)
+	}
+	return puo
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (puo *ProductUpdateOne) SetIsFeatured(b bool) *ProductUpdateOne {
+	puo.mutation.SetIsFeatured(b)
+	return puo
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableIsFeatured(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetIsFeatured(*b)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProductUpdateOne) SetUpdatedAt(t time.Time) *ProductUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetCategory sets the "category" edge to the ProductCategory entity.
+func (puo *ProductUpdateOne) SetCategory(p *ProductCategory) *ProductUpdateOne {
+	return puo.SetCategoryID(p.ID)
+}
+
+// AddFileIDs adds the "files" edge to the ProductFile entity by IDs.
+func (puo *ProductUpdateOne) AddFileIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddFileIDs(ids...)
+	return puo
+}
+
+// AddFiles adds the "files" edges to the ProductFile entity.
+func (puo *ProductUpdateOne) AddFiles(p ...*ProductFile) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddFileIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (puo *ProductUpdateOne) AddOrderItemIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddOrderItemIDs(ids...)
+	return puo
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (puo *ProductUpdateOne) AddOrderItems(o ...*OrderItem) *ProductUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (puo *ProductUpdateOne) Mutation() *ProductMutation {
+	return puo.mutation
+}
+
+// ClearCategory clears the "category" edge to the ProductCategory entity.
+func (puo *ProductUpdateOne) ClearCategory() *ProductUpdateOne {
+	puo.mutation.ClearCategory()
+	return puo
+}
+
+// ClearFiles clears all "files" edges to the ProductFile entity.
+func (puo *ProductUpdateOne) ClearFiles() *ProductUpdateOne {
+	puo.mutation.ClearFiles()
+	return puo
+}
+
+// RemoveFileIDs removes the "files" edge to ProductFile entities by IDs.
+func (puo *ProductUpdateOne) RemoveFileIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveFileIDs(ids...)
+	return puo
+}
+
+// RemoveFiles removes "files" edges to ProductFile entities.
+func (puo *ProductUpdateOne) RemoveFiles(p ...*ProductFile) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveFileIDs(ids...)
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (puo *ProductUpdateOne) ClearOrderItems() *ProductUpdateOne {
+	puo.mutation.ClearOrderItems()
+	return puo
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (puo *ProductUpdateOne) RemoveOrderItemIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveOrderItemIDs(ids...)
+	return puo
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (puo *ProductUpdateOne) RemoveOrderItems(o ...*OrderItem) *ProductUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.RemoveOrderItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ProductUpdate builder.
+func (puo *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *ProductUpdateOne) Select(field string, fields ...string) *ProductUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Product entity.
+func (puo *ProductUpdateOne) Save(ctx context.Context) (*Product, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProductUpdateOne) SaveX(ctx context.Context) *Product {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProductUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProductUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *ProductUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := product.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProductUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Slug(); ok {
+		if err := product.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Product.slug": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Description(); ok {
+		if err := product.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Product.description": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.ShortDescription(); ok {
+		if err := product.ShortDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "short_description", err: fmt.Errorf(`ent: validator failed for field "Product.short_description": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Price(); ok {
+		if err := product.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.DiscountPrice(); ok {
+		if err := product.DiscountPriceValidator(v); err != nil {
+			return &ValidationError{Name: "discount_price", err: fmt.Errorf(`ent: validator failed for field "Product.discount_price": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Version(); ok {
+		if err := product.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Product.version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(product.Table, product.Columns, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Product.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, product.FieldID)
+		for _, f := range fields {
+			if !product.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != product.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Slug(); ok {
+		_spec.SetField(product.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Description(); ok {
+		_spec.SetField(product.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.ShortDescription(); ok {
+		_spec.SetField(product.FieldShortDescription, field.TypeString, value)
+	}
+	if puo.mutation.ShortDescriptionCleared() {
+		_spec.ClearField(product.FieldShortDescription, field.TypeString)
+	}
+	if value, ok := puo.mutation.FeaturedImage(); ok {
+		_spec.SetField(product.FieldFeaturedImage, field.TypeString, value)
+	}
+	if puo.mutation.FeaturedImageCleared() {
+		_spec.ClearField(product.FieldFeaturedImage, field.TypeString)
+	}
+	if value, ok := puo.mutation.GalleryImages(); ok {
+		_spec.SetField(product.FieldGalleryImages, field.TypeOther, value)
+	}
+	if puo.mutation.GalleryImagesCleared() {
+		_spec.ClearField(product.FieldGalleryImages, field.TypeOther)
+	}
+	if value, ok := puo.mutation.Price(); ok {
+		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.AddedPrice(); ok {
+		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.DiscountPrice(); ok {
+		_spec.SetField(product.FieldDiscountPrice, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.AddedDiscountPrice(); ok {
+		_spec.AddField(product.FieldDiscountPrice, field.TypeFloat64, value)
+	}
+	if puo.mutation.DiscountPriceCleared() {
+		_spec.ClearField(product.FieldDiscountPrice, field.TypeFloat64)
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeString, value)
+	}
+	if puo.mutation.VersionCleared() {
+		_spec.ClearField(product.FieldVersion, field.TypeString)
+	}
+	if value, ok := puo.mutation.Requirements(); ok {
+		_spec.SetField(product.FieldRequirements, field.TypeString, value)
+	}
+	if puo.mutation.RequirementsCleared() {
+		_spec.ClearField(product.FieldRequirements, field.TypeString)
+	}
+	if value, ok := puo.mutation.Features(); ok {
+		_spec.SetField(product.FieldFeatures, field.TypeOther, value)
+	}
+	if puo.mutation.FeaturesCleared() {
+		_spec.ClearField(product.FieldFeatures, field.TypeOther)
+	}
+	if value, ok := puo.mutation.DownloadURL(); ok {
+		_spec.SetField(product.FieldDownloadURL, field.TypeString, value)
+	}
+	if puo.mutation.DownloadURLCleared() {
+		_spec.ClearField(product.FieldDownloadURL, field.TypeString)
+	}
+	if value, ok := puo.mutation.FileSize(); ok {
+		_spec.SetField(product.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedFileSize(); ok {
+		_spec.AddField(product.FieldFileSize, field.TypeInt64, value)
+	}
+	if puo.mutation.FileSizeCleared() {
+		_spec.ClearField(product.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := puo.mutation.DownloadCount(); ok {
+		_spec.SetField(product.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(product.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.IsActive(); ok {
+		_spec.SetField(product.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.IsFeatured(); ok {
+		_spec.SetField(product.FieldIsFeatured, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(product.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   product.CategoryTable,
+			Columns: []string{product.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   product.CategoryTable,
+			Columns: []string{product.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !puo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.FilesTable,
+			Columns: []string{product.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !puo.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrderItemsTable,
+			Columns: []string{product.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Product{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
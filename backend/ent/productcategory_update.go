@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductCategoryUpdate is the builder for updating ProductCategory entities.
+type ProductCategoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductCategoryMutation
+}
+
+// Where appends a list predicates to the ProductCategoryUpdate builder.
+func (pcu *ProductCategoryUpdate) Where(ps ...predicate.ProductCategory) *ProductCategoryUpdate {
+	pcu.mutation.Where(ps...)
+	return pcu
+}
+
+// SetName sets the "name" field.
+func (pcu *ProductCategoryUpdate) SetName(s string) *ProductCategoryUpdate {
+	pcu.mutation.SetName(s)
+	return pcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcu *ProductCategoryUpdate) SetNillableName(s *string) *ProductCategoryUpdate {
+	if s != nil {
+		pcu.SetName(*s)
+	}
+	return pcu
+}
+
+// SetSlug sets the "slug" field.
+func (pcu *ProductCategoryUpdate) SetSlug(s string) *ProductCategoryUpdate {
+	pcu.mutation.SetSlug(s)
+	return pcu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pcu *ProductCategoryUpdate) SetNillableSlug(s *string) *ProductCategoryUpdate {
+	if s != nil {
+		pcu.SetSlug(*s)
+	}
+	return pcu
+}
+
+// SetDescription sets the "description" field.
+func (pcu *ProductCategoryUpdate) SetDescription(s string) *ProductCategoryUpdate {
+	pcu.mutation.SetDescription(s)
+	return pcu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pcu *ProductCategoryUpdate) SetNillableDescription(s *string) *ProductCategoryUpdate {
+	if s != nil {
+		pcu.SetDescription(*s)
+	}
+	return pcu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pcu *ProductCategoryUpdate) ClearDescription() *ProductCategoryUpdate {
+	pcu.mutation.ClearDescription()
+	return pcu
+}
+
+// SetParentID sets the "parent_id" field.
+func (pcu *ProductCategoryUpdate) SetParentID(i int) *ProductCategoryUpdate {
+	pcu.mutation.SetParentID(i)
+	return pcu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pcu *ProductCategoryUpdate) SetNillableParentID(i *int) *ProductCategoryUpdate {
+	if i != nil {
+		pcu.SetParentID(*i)
+	}
+	return pcu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (pcu *ProductCategoryUpdate) ClearParentID() *ProductCategoryUpdate {
+	pcu.mutation.ClearParentID()
+	return pcu
+}
+
+// SetParent sets the "parent" edge to the ProductCategory entity.
+func (pcu *ProductCategoryUpdate) SetParent(p *ProductCategory) *ProductCategoryUpdate {
+	return pcu.SetParentID(p.ID)
+}
+
+// AddChildIDs adds the "children" edge to the ProductCategory entity by IDs.
+func (pcu *ProductCategoryUpdate) AddChildIDs(ids ...int) *ProductCategoryUpdate {
+	pcu.mutation.AddChildIDs(ids...)
+	return pcu
+}
+
+// AddChildren adds the "children" edges to the ProductCategory entity.
+func (pcu *ProductCategoryUpdate) AddChildren(p ...*ProductCategory) *ProductCategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.AddChildIDs(ids...)
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (pcu *ProductCategoryUpdate) AddProductIDs(ids ...int) *ProductCategoryUpdate {
+	pcu.mutation.AddProductIDs(ids...)
+	return pcu
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (pcu *ProductCategoryUpdate) AddProducts(p ...*Product) *ProductCategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.AddProductIDs(ids...)
+}
+
+// Mutation returns the ProductCategoryMutation object of the builder.
+func (pcu *ProductCategoryUpdate) Mutation() *ProductCategoryMutation {
+	return pcu.mutation
+}
+
+// ClearParent clears the "parent" edge to the ProductCategory entity.
+func (pcu *ProductCategoryUpdate) ClearParent() *ProductCategoryUpdate {
+	pcu.mutation.ClearParent()
+	return pcu
+}
+
+// ClearChildren clears all "children" edges to the ProductCategory entity.
+func (pcu *ProductCategoryUpdate) ClearChildren() *ProductCategoryUpdate {
+	pcu.mutation.ClearChildren()
+	return pcu
+}
+
+// RemoveChildIDs removes the "children" edge to ProductCategory entities by IDs.
+func (pcu *ProductCategoryUpdate) RemoveChildIDs(ids ...int) *ProductCategoryUpdate {
+	pcu.mutation.RemoveChildIDs(ids...)
+	return pcu
+}
+
+// RemoveChildren removes "children" edges to ProductCategory entities.
+func (pcu *ProductCategoryUpdate) RemoveChildren(p ...*ProductCategory) *ProductCategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.RemoveChildIDs(ids...)
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (pcu *ProductCategoryUpdate) ClearProducts() *ProductCategoryUpdate {
+	pcu.mutation.ClearProducts()
+	return pcu
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (pcu *ProductCategoryUpdate) RemoveProductIDs(ids ...int) *ProductCategoryUpdate {
+	pcu.mutation.RemoveProductIDs(ids...)
+	return pcu
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (pcu *ProductCategoryUpdate) RemoveProducts(p ...*Product) *ProductCategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcu.RemoveProductIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pcu *ProductCategoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcu *ProductCategoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := pcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pcu *ProductCategoryUpdate) Exec(ctx context.Context) error {
+	_, err := pcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcu *ProductCategoryUpdate) ExecX(ctx context.Context) {
+	if err := pcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcu *ProductCategoryUpdate) check() error {
+	if v, ok := pcu.mutation.Name(); ok {
+		if err := productcategory.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductCategory.name": %w`, err)}
+		}
+	}
+	if v, ok := pcu.mutation.Slug(); ok {
+		if err := productcategory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "ProductCategory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pcu *ProductCategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productcategory.Table, productcategory.Columns, sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt))
+	if ps := pcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcu.mutation.Name(); ok {
+		_spec.SetField(productcategory.FieldName, field.TypeString, value)
+	}
+	if value, ok := pcu.mutation.Slug(); ok {
+		_spec.SetField(productcategory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := pcu.mutation.Description(); ok {
+		_spec.SetField(productcategory.FieldDescription, field.TypeString, value)
+	}
+	if pcu.mutation.DescriptionCleared() {
+		_spec.ClearField(productcategory.FieldDescription, field.TypeString)
+	}
+	if pcu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productcategory.ParentTable,
+			Columns: []string{productcategory.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productcategory.ParentTable,
+			Columns: []string{productcategory.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !pcu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcu.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.RemovedProductsIDs(); len(nodes) > 0 && !pcu.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcu.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productcategory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pcu.mutation.done = true
+	return n, nil
+}
+
+// ProductCategoryUpdateOne is the builder for updating a single ProductCategory entity.
+type ProductCategoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductCategoryMutation
+}
+
+// SetName sets the "name" field.
+func (pcuo *ProductCategoryUpdateOne) SetName(s string) *ProductCategoryUpdateOne {
+	pcuo.mutation.SetName(s)
+	return pcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pcuo *ProductCategoryUpdateOne) SetNillableName(s *string) *ProductCategoryUpdateOne {
+	if s != nil {
+		pcuo.SetName(*s)
+	}
+	return pcuo
+}
+
+// SetSlug sets the "slug" field.
+func (pcuo *ProductCategoryUpdateOne) SetSlug(s string) *ProductCategoryUpdateOne {
+	pcuo.mutation.SetSlug(s)
+	return pcuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pcuo *ProductCategoryUpdateOne) SetNillableSlug(s *string) *ProductCategoryUpdateOne {
+	if s != nil {
+		pcuo.SetSlug(*s)
+	}
+	return pcuo
+}
+
+// SetDescription sets the "description" field.
+func (pcuo *ProductCategoryUpdateOne) SetDescription(s string) *ProductCategoryUpdateOne {
+	pcuo.mutation.SetDescription(s)
+	return pcuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (pcuo *ProductCategoryUpdateOne) SetNillableDescription(s *string) *ProductCategoryUpdateOne {
+	if s != nil {
+		pcuo.SetDescription(*s)
+	}
+	return pcuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (pcuo *ProductCategoryUpdateOne) ClearDescription() *ProductCategoryUpdateOne {
+	pcuo.mutation.ClearDescription()
+	return pcuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (pcuo *ProductCategoryUpdateOne) SetParentID(i int) *ProductCategoryUpdateOne {
+	pcuo.mutation.SetParentID(i)
+	return pcuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pcuo *ProductCategoryUpdateOne) SetNillableParentID(i *int) *ProductCategoryUpdateOne {
+	if i != nil {
+		pcuo.SetParentID(*i)
+	}
+	return pcuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (pcuo *ProductCategoryUpdateOne) ClearParentID() *ProductCategoryUpdateOne {
+	pcuo.mutation.ClearParentID()
+	return pcuo
+}
+
+// SetParent sets the "parent" edge to the ProductCategory entity.
+func (pcuo *ProductCategoryUpdateOne) SetParent(p *ProductCategory) *ProductCategoryUpdateOne {
+	return pcuo.SetParentID(p.ID)
+}
+
+// AddChildIDs adds the "children" edge to the ProductCategory entity by IDs.
+func (pcuo *ProductCategoryUpdateOne) AddChildIDs(ids ...int) *ProductCategoryUpdateOne {
+	pcuo.mutation.AddChildIDs(ids...)
+	return pcuo
+}
+
+// AddChildren adds the "children" edges to the ProductCategory entity.
+func (pcuo *ProductCategoryUpdateOne) AddChildren(p ...*ProductCategory) *ProductCategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.AddChildIDs(ids...)
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (pcuo *ProductCategoryUpdateOne) AddProductIDs(ids ...int) *ProductCategoryUpdateOne {
+	pcuo.mutation.AddProductIDs(ids...)
+	return pcuo
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (pcuo *ProductCategoryUpdateOne) AddProducts(p ...*Product) *ProductCategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.AddProductIDs(ids...)
+}
+
+// Mutation returns the ProductCategoryMutation object of the builder.
+func (pcuo *ProductCategoryUpdateOne) Mutation() *ProductCategoryMutation {
+	return pcuo.mutation
+}
+
+// ClearParent clears the "parent" edge to the ProductCategory entity.
+func (pcuo *ProductCategoryUpdateOne) ClearParent() *ProductCategoryUpdateOne {
+	pcuo.mutation.ClearParent()
+	return pcuo
+}
+
+// ClearChildren clears all "children" edges to the ProductCategory entity.
+func (pcuo *ProductCategoryUpdateOne) ClearChildren() *ProductCategoryUpdateOne {
+	pcuo.mutation.ClearChildren()
+	return pcuo
+}
+
+// RemoveChildIDs removes the "children" edge to ProductCategory entities by IDs.
+func (pcuo *ProductCategoryUpdateOne) RemoveChildIDs(ids ...int) *ProductCategoryUpdateOne {
+	pcuo.mutation.RemoveChildIDs(ids...)
+	return pcuo
+}
+
+// RemoveChildren removes "children" edges to ProductCategory entities.
+func (pcuo *ProductCategoryUpdateOne) RemoveChildren(p ...*ProductCategory) *ProductCategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.RemoveChildIDs(ids...)
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (pcuo *ProductCategoryUpdateOne) ClearProducts() *ProductCategoryUpdateOne {
+	pcuo.mutation.ClearProducts()
+	return pcuo
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (pcuo *ProductCategoryUpdateOne) RemoveProductIDs(ids ...int) *ProductCategoryUpdateOne {
+	pcuo.mutation.RemoveProductIDs(ids...)
+	return pcuo
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (pcuo *ProductCategoryUpdateOne) RemoveProducts(p ...*Product) *ProductCategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pcuo.RemoveProductIDs(ids...)
+}
+
+// Where appends a list predicates to the ProductCategoryUpdate builder.
+func (pcuo *ProductCategoryUpdateOne) Where(ps ...predicate.ProductCategory) *ProductCategoryUpdateOne {
+	pcuo.mutation.Where(ps...)
+	return pcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pcuo *ProductCategoryUpdateOne) Select(field string, fields ...string) *ProductCategoryUpdateOne {
+	pcuo.fields = append([]string{field}, fields...)
+	return pcuo
+}
+
+// Save executes the query and returns the updated ProductCategory entity.
+func (pcuo *ProductCategoryUpdateOne) Save(ctx context.Context) (*ProductCategory, error) {
+	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcuo *ProductCategoryUpdateOne) SaveX(ctx context.Context) *ProductCategory {
+	node, err := pcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pcuo *ProductCategoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := pcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcuo *ProductCategoryUpdateOne) ExecX(ctx context.Context) {
+	if err := pcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pcuo *ProductCategoryUpdateOne) check() error {
+	if v, ok := pcuo.mutation.Name(); ok {
+		if err := productcategory.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductCategory.name": %w`, err)}
+		}
+	}
+	if v, ok := pcuo.mutation.Slug(); ok {
+		if err := productcategory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "ProductCategory.slug": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pcuo *ProductCategoryUpdateOne) sqlSave(ctx context.Context) (_node *ProductCategory, err error) {
+	if err := pcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productcategory.Table, productcategory.Columns, sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt))
+	id, ok := pcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductCategory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productcategory.FieldID)
+		for _, f := range fields {
+			if !productcategory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productcategory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pcuo.mutation.Name(); ok {
+		_spec.SetField(productcategory.FieldName, field.TypeString, value)
+	}
+	if value, ok := pcuo.mutation.Slug(); ok {
+		_spec.SetField(productcategory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := pcuo.mutation.Description(); ok {
+		_spec.SetField(productcategory.FieldDescription, field.TypeString, value)
+	}
+	if pcuo.mutation.DescriptionCleared() {
+		_spec.ClearField(productcategory.FieldDescription, field.TypeString)
+	}
+	if pcuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productcategory.ParentTable,
+			Columns: []string{productcategory.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productcategory.ParentTable,
+			Columns: []string{productcategory.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !pcuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ChildrenTable,
+			Columns: []string{productcategory.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productcategory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pcuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !pcuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pcuo.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productcategory.ProductsTable,
+			Columns: []string{productcategory.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductCategory{config: pcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productcategory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProductFile is the model entity for the ProductFile schema.
+type ProductFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID int `json:"product_id,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// OriginalFilename holds the value of the "original_filename" field.
+	OriginalFilename string `json:"original_filename,omitempty"`
+	// FilePath holds the value of the "file_path" field.
+	FilePath string `json:"file_path,omitempty"`
+	// FileSize holds the value of the "file_size" field.
+	FileSize int64 `json:"file_size,omitempty"`
+	// FileType holds the value of the "file_type" field.
+	FileType *string `json:"file_type,omitempty"`
+	// IsMain holds the value of the "is_main" field.
+	IsMain bool `json:"is_main,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductFileQuery when eager-loading is set.
+	Edges        ProductFileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductFileEdges holds the relations/edges for other nodes in the graph.
+type ProductFileEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductFileEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductFile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productfile.FieldIsMain:
+			values[i] = new(sql.NullBool)
+		case productfile.FieldID, productfile.FieldProductID, productfile.FieldFileSize:
+			values[i] = new(sql.NullInt64)
+		case productfile.FieldFilename, productfile.FieldOriginalFilename, productfile.FieldFilePath, productfile.FieldFileType:
+			values[i] = new(sql.NullString)
+		case productfile.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductFile fields.
+func (pf *ProductFile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productfile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pf.ID = int(value.Int64)
+		case productfile.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pf.ProductID = int(value.Int64)
+			}
+		case productfile.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				pf.Filename = value.String
+			}
+		case productfile.FieldOriginalFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_filename", values[i])
+			} else if value.Valid {
+				pf.OriginalFilename = value.String
+			}
+		case productfile.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				pf.FilePath = value.String
+			}
+		case productfile.FieldFileSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_size", values[i])
+			} else if value.Valid {
+				pf.FileSize = value.Int64
+			}
+		case productfile.FieldFileType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_type", values[i])
+			} else if value.Valid {
+				pf.FileType = new(string)
+				*pf.FileType = value.String
+			}
+		case productfile.FieldIsMain:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_main", values[i])
+			} else if value.Valid {
+				pf.IsMain = value.Bool
+			}
+		case productfile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pf.CreatedAt = value.Time
+			}
+		default:
+			pf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProductFile.
+// This includes values selected through modifiers, order, etc.
+func (pf *ProductFile) Value(name string) (ent.Value, error) {
+	return pf.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the ProductFile entity.
+func (pf *ProductFile) QueryProduct() *ProductQuery {
+	return NewProductFileClient(pf.config).QueryProduct(pf)
+}
+
+// Update returns a builder for updating this ProductFile.
+// Note that you need to call ProductFile.Unwrap() before calling this method if this ProductFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pf *ProductFile) Update() *ProductFileUpdateOne {
+	return NewProductFileClient(pf.config).UpdateOne(pf)
+}
+
+// Unwrap unwraps the ProductFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pf *ProductFile) Unwrap() *ProductFile {
+	_tx, ok := pf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductFile is not a transactional entity")
+	}
+	pf.config.driver = _tx.drv
+	return pf
+}
+
+// String implements the fmt.Stringer.
+func (pf *ProductFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pf.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pf.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(pf.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("original_filename=")
+	builder.WriteString(pf.OriginalFilename)
+	builder.WriteString(", ")
+	builder.WriteString("file_path=")
+	builder.WriteString(pf.FilePath)
+	builder.WriteString(", ")
+	builder.WriteString("file_size=")
+	builder.WriteString(fmt.Sprintf("%v", pf.FileSize))
+	builder.WriteString(", ")
+	if v := pf.FileType; v != nil {
+		builder.WriteString("file_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_main=")
+	builder.WriteString(fmt.Sprintf("%v", pf.IsMain))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pf.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductFiles is a parsable slice of ProductFile.
+type ProductFiles []*ProductFile
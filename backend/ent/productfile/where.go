@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productfile
+
+import (
+	"time"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldID, id))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldProductID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFilename, v))
+}
+
+// OriginalFilename applies equality check predicate on the "original_filename" field. It's identical to OriginalFilenameEQ.
+func OriginalFilename(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldOriginalFilename, v))
+}
+
+// FilePath applies equality check predicate on the "file_path" field. It's identical to FilePathEQ.
+func FilePath(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFilePath, v))
+}
+
+// FileSize applies equality check predicate on the "file_size" field. It's identical to FileSizeEQ.
+func FileSize(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileType applies equality check predicate on the "file_type" field. It's identical to FileTypeEQ.
+func FileType(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFileType, v))
+}
+
+// IsMain applies equality check predicate on the "is_main" field. It's identical to IsMainEQ.
+func IsMain(v bool) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldIsMain, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// OriginalFilenameEQ applies the EQ predicate on the "original_filename" field.
+func OriginalFilenameEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameNEQ applies the NEQ predicate on the "original_filename" field.
+func OriginalFilenameNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameIn applies the In predicate on the "original_filename" field.
+func OriginalFilenameIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldOriginalFilename, vs...))
+}
+
+// OriginalFilenameNotIn applies the NotIn predicate on the "original_filename" field.
+func OriginalFilenameNotIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldOriginalFilename, vs...))
+}
+
+// OriginalFilenameGT applies the GT predicate on the "original_filename" field.
+func OriginalFilenameGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameGTE applies the GTE predicate on the "original_filename" field.
+func OriginalFilenameGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameLT applies the LT predicate on the "original_filename" field.
+func OriginalFilenameLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameLTE applies the LTE predicate on the "original_filename" field.
+func OriginalFilenameLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameContains applies the Contains predicate on the "original_filename" field.
+func OriginalFilenameContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContains(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameHasPrefix applies the HasPrefix predicate on the "original_filename" field.
+func OriginalFilenameHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasPrefix(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameHasSuffix applies the HasSuffix predicate on the "original_filename" field.
+func OriginalFilenameHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasSuffix(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameEqualFold applies the EqualFold predicate on the "original_filename" field.
+func OriginalFilenameEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEqualFold(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameContainsFold applies the ContainsFold predicate on the "original_filename" field.
+func OriginalFilenameContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContainsFold(FieldOriginalFilename, v))
+}
+
+// FilePathEQ applies the EQ predicate on the "file_path" field.
+func FilePathEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFilePath, v))
+}
+
+// FilePathNEQ applies the NEQ predicate on the "file_path" field.
+func FilePathNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldFilePath, v))
+}
+
+// FilePathIn applies the In predicate on the "file_path" field.
+func FilePathIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldFilePath, vs...))
+}
+
+// FilePathNotIn applies the NotIn predicate on the "file_path" field.
+func FilePathNotIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldFilePath, vs...))
+}
+
+// FilePathGT applies the GT predicate on the "file_path" field.
+func FilePathGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldFilePath, v))
+}
+
+// FilePathGTE applies the GTE predicate on the "file_path" field.
+func FilePathGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldFilePath, v))
+}
+
+// FilePathLT applies the LT predicate on the "file_path" field.
+func FilePathLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldFilePath, v))
+}
+
+// FilePathLTE applies the LTE predicate on the "file_path" field.
+func FilePathLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldFilePath, v))
+}
+
+// FilePathContains applies the Contains predicate on the "file_path" field.
+func FilePathContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContains(FieldFilePath, v))
+}
+
+// FilePathHasPrefix applies the HasPrefix predicate on the "file_path" field.
+func FilePathHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasPrefix(FieldFilePath, v))
+}
+
+// FilePathHasSuffix applies the HasSuffix predicate on the "file_path" field.
+func FilePathHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasSuffix(FieldFilePath, v))
+}
+
+// FilePathEqualFold applies the EqualFold predicate on the "file_path" field.
+func FilePathEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEqualFold(FieldFilePath, v))
+}
+
+// FilePathContainsFold applies the ContainsFold predicate on the "file_path" field.
+func FilePathContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContainsFold(FieldFilePath, v))
+}
+
+// FileSizeEQ applies the EQ predicate on the "file_size" field.
+func FileSizeEQ(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFileSize, v))
+}
+
+// FileSizeNEQ applies the NEQ predicate on the "file_size" field.
+func FileSizeNEQ(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldFileSize, v))
+}
+
+// FileSizeIn applies the In predicate on the "file_size" field.
+func FileSizeIn(vs ...int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldFileSize, vs...))
+}
+
+// FileSizeNotIn applies the NotIn predicate on the "file_size" field.
+func FileSizeNotIn(vs ...int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldFileSize, vs...))
+}
+
+// FileSizeGT applies the GT predicate on the "file_size" field.
+func FileSizeGT(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldFileSize, v))
+}
+
+// FileSizeGTE applies the GTE predicate on the "file_size" field.
+func FileSizeGTE(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldFileSize, v))
+}
+
+// FileSizeLT applies the LT predicate on the "file_size" field.
+func FileSizeLT(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldFileSize, v))
+}
+
+// FileSizeLTE applies the LTE predicate on the "file_size" field.
+func FileSizeLTE(v int64) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldFileSize, v))
+}
+
+// FileTypeEQ applies the EQ predicate on the "file_type" field.
+func FileTypeEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldFileType, v))
+}
+
+// FileTypeNEQ applies the NEQ predicate on the "file_type" field.
+func FileTypeNEQ(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldFileType, v))
+}
+
+// FileTypeIn applies the In predicate on the "file_type" field.
+func FileTypeIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldFileType, vs...))
+}
+
+// FileTypeNotIn applies the NotIn predicate on the "file_type" field.
+func FileTypeNotIn(vs ...string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldFileType, vs...))
+}
+
+// FileTypeGT applies the GT predicate on the "file_type" field.
+func FileTypeGT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldFileType, v))
+}
+
+// FileTypeGTE applies the GTE predicate on the "file_type" field.
+func FileTypeGTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldFileType, v))
+}
+
+// FileTypeLT applies the LT predicate on the "file_type" field.
+func FileTypeLT(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldFileType, v))
+}
+
+// FileTypeLTE applies the LTE predicate on the "file_type" field.
+func FileTypeLTE(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldFileType, v))
+}
+
+// FileTypeContains applies the Contains predicate on the "file_type" field.
+func FileTypeContains(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContains(FieldFileType, v))
+}
+
+// FileTypeHasPrefix applies the HasPrefix predicate on the "file_type" field.
+func FileTypeHasPrefix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasPrefix(FieldFileType, v))
+}
+
+// FileTypeHasSuffix applies the HasSuffix predicate on the "file_type" field.
+func FileTypeHasSuffix(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldHasSuffix(FieldFileType, v))
+}
+
+// FileTypeIsNil applies the IsNil predicate on the "file_type" field.
+func FileTypeIsNil() predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIsNull(FieldFileType))
+}
+
+// FileTypeNotNil applies the NotNil predicate on the "file_type" field.
+func FileTypeNotNil() predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotNull(FieldFileType))
+}
+
+// FileTypeEqualFold applies the EqualFold predicate on the "file_type" field.
+func FileTypeEqualFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEqualFold(FieldFileType, v))
+}
+
+// FileTypeContainsFold applies the ContainsFold predicate on the "file_type" field.
+func FileTypeContainsFold(v string) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldContainsFold(FieldFileType, v))
+}
+
+// IsMainEQ applies the EQ predicate on the "is_main" field.
+func IsMainEQ(v bool) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldIsMain, v))
+}
+
+// IsMainNEQ applies the NEQ predicate on the "is_main" field.
+func IsMainNEQ(v bool) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldIsMain, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductFile {
+	return predicate.ProductFile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.ProductFile {
+	return predicate.ProductFile(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductFile) predicate.ProductFile {
+	return predicate.ProductFile(sql.NotPredicates(p))
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileCreate is the builder for creating a ProductFile entity.
+type ProductFileCreate struct {
+	config
+	mutation *ProductFileMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (pfc *ProductFileCreate) SetProductID(i int) *ProductFileCreate {
+	pfc.mutation.SetProductID(i)
+	return pfc
+}
+
+// SetFilename sets the "filename" field.
+func (pfc *ProductFileCreate) SetFilename(s string) *ProductFileCreate {
+	pfc.mutation.SetFilename(s)
+	return pfc
+}
+
+// SetOriginalFilename sets the "original_filename" field.
+func (pfc *ProductFileCreate) SetOriginalFilename(s string) *ProductFileCreate {
+	pfc.mutation.SetOriginalFilename(s)
+	return pfc
+}
+
+// SetFilePath sets the "file_path" field.
+func (pfc *ProductFileCreate) SetFilePath(s string) *ProductFileCreate {
+	pfc.mutation.SetFilePath(s)
+	return pfc
+}
+
+// SetFileSize sets the "file_size" field.
+func (pfc *ProductFileCreate) SetFileSize(i int64) *ProductFileCreate {
+	pfc.mutation.SetFileSize(i)
+	return pfc
+}
+
+// SetFileType sets the "file_type" field.
+func (pfc *ProductFileCreate) SetFileType(s string) *ProductFileCreate {
+	pfc.mutation.SetFileType(s)
+	return pfc
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableFileType(s *string) *ProductFileCreate {
+	if s != nil {
+		pfc.SetFileType(*s)
+	}
+	return pfc
+}
+
+// SetIsMain sets the "is_main" field.
+func (pfc *ProductFileCreate) SetIsMain(b bool) *ProductFileCreate {
+	pfc.mutation.SetIsMain(b)
+	return pfc
+}
+
+// SetNillableIsMain sets the "is_main" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableIsMain(b *bool) *ProductFileCreate {
+	if b != nil {
+		pfc.SetIsMain(*b)
+	}
+	return pfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pfc *ProductFileCreate) SetCreatedAt(t time.Time) *ProductFileCreate {
+	pfc.mutation.SetCreatedAt(t)
+	return pfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pfc *ProductFileCreate) SetNillableCreatedAt(t *time.Time) *ProductFileCreate {
+	if t != nil {
+		pfc.SetCreatedAt(*t)
+	}
+	return pfc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pfc *ProductFileCreate) SetProduct(p *Product) *ProductFileCreate {
+	return pfc.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfc *ProductFileCreate) Mutation() *ProductFileMutation {
+	return pfc.mutation
+}
+
+// Save creates the ProductFile in the database.
+func (pfc *ProductFileCreate) Save(ctx context.Context) (*ProductFile, error) {
+	pfc.defaults()
+	return withHooks(ctx, pfc.sqlSave, pfc.mutation, pfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pfc *ProductFileCreate) SaveX(ctx context.Context) *ProductFile {
+	v, err := pfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfc *ProductFileCreate) Exec(ctx context.Context) error {
+	_, err := pfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfc *ProductFileCreate) ExecX(ctx context.Context) {
+	if err := pfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pfc *ProductFileCreate) defaults() {
+	if _, ok := pfc.mutation.IsMain(); !ok {
+		v := productfile.DefaultIsMain
+		pfc.mutation.SetIsMain(v)
+	}
+	if _, ok := pfc.mutation.CreatedAt(); !ok {
+		v := productfile.DefaultCreatedAt()
+		pfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfc *ProductFileCreate) check() error {
+	if _, ok := pfc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductFile.product_id"`)}
+	}
+	if _, ok := pfc.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "ProductFile.filename"`)}
+	}
+	if v, ok := pfc.mutation.Filename(); ok {
+		if err := productfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.filename": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.OriginalFilename(); !ok {
+		return &ValidationError{Name: "original_filename", err: errors.New(`ent: missing required field "ProductFile.original_filename"`)}
+	}
+	if v, ok := pfc.mutation.OriginalFilename(); ok {
+		if err := productfile.OriginalFilenameValidator(v); err != nil {
+			return &ValidationError{Name: "original_filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.original_filename": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.FilePath(); !ok {
+		return &ValidationError{Name: "file_path", err: errors.New(`ent: missing required field "ProductFile.file_path"`)}
+	}
+	if v, ok := pfc.mutation.FilePath(); ok {
+		if err := productfile.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_path": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.FileSize(); !ok {
+		return &ValidationError{Name: "file_size", err: errors.New(`ent: missing required field "ProductFile.file_size"`)}
+	}
+	if v, ok := pfc.mutation.FileType(); ok {
+		if err := productfile.FileTypeValidator(v); err != nil {
+			return &ValidationError{Name: "file_type", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_type": %w`, err)}
+		}
+	}
+	if _, ok := pfc.mutation.IsMain(); !ok {
+		return &ValidationError{Name: "is_main", err: errors.New(`ent: missing required field "ProductFile.is_main"`)}
+	}
+	if _, ok := pfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductFile.created_at"`)}
+	}
+	if len(pfc.mutation.ProductIDs()) == 0 {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductFile.product"`)}
+	}
+	return nil
+}
+
+func (pfc *ProductFileCreate) sqlSave(ctx context.Context) (*ProductFile, error) {
+	if err := pfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pfc.mutation.id = &_node.ID
+	pfc.mutation.done = true
+	return _node, nil
+}
+
+func (pfc *ProductFileCreate) createSpec() (*ProductFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductFile{config: pfc.config}
+		_spec = sqlgraph.NewCreateSpec(productfile.Table, sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt))
+	)
+	if value, ok := pfc.mutation.Filename(); ok {
+		_spec.SetField(productfile.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := pfc.mutation.OriginalFilename(); ok {
+		_spec.SetField(productfile.FieldOriginalFilename, field.TypeString, value)
+		_node.OriginalFilename = value
+	}
+	if value, ok := pfc.mutation.FilePath(); ok {
+		_spec.SetField(productfile.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := pfc.mutation.FileSize(); ok {
+		_spec.SetField(productfile.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = value
+	}
+	if value, ok := pfc.mutation.FileType(); ok {
+		_spec.SetField(productfile.FieldFileType, field.TypeString, value)
+		_node.FileType = &value
+	}
+	if value, ok := pfc.mutation.IsMain(); ok {
+		_spec.SetField(productfile.FieldIsMain, field.TypeBool, value)
+		_node.IsMain = value
+	}
+	if value, ok := pfc.mutation.CreatedAt(); ok {
+		_spec.SetField(productfile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pfc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productfile.ProductTable,
+			Columns: []string{productfile.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductFileCreateBulk is the builder for creating many ProductFile entities in bulk.
+type ProductFileCreateBulk struct {
+	config
+	err      error
+	builders []*ProductFileCreate
+}
+
+// Save creates the ProductFile entities in the database.
+func (pfcb *ProductFileCreateBulk) Save(ctx context.Context) ([]*ProductFile, error) {
+	if pfcb.err != nil {
+		return nil, pfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pfcb.builders))
+	nodes := make([]*ProductFile, len(pfcb.builders))
+	mutators := make([]Mutator, len(pfcb.builders))
+	for i := range pfcb.builders {
+		func(i int, root context.Context) {
+			builder := pfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfcb *ProductFileCreateBulk) SaveX(ctx context.Context) []*ProductFile {
+	v, err := pfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pfcb *ProductFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := pfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfcb *ProductFileCreateBulk) ExecX(ctx context.Context) {
+	if err := pfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
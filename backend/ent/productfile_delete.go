@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileDelete is the builder for deleting a ProductFile entity.
+type ProductFileDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// Where appends a list predicates to the ProductFileDelete builder.
+func (pfd *ProductFileDelete) Where(ps ...predicate.ProductFile) *ProductFileDelete {
+	pfd.mutation.Where(ps...)
+	return pfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pfd *ProductFileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pfd.sqlExec, pfd.mutation, pfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfd *ProductFileDelete) ExecX(ctx context.Context) int {
+	n, err := pfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pfd *ProductFileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(productfile.Table, sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt))
+	if ps := pfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pfd.mutation.done = true
+	return affected, err
+}
+
+// ProductFileDeleteOne is the builder for deleting a single ProductFile entity.
+type ProductFileDeleteOne struct {
+	pfd *ProductFileDelete
+}
+
+// Where appends a list predicates to the ProductFileDelete builder.
+func (pfdo *ProductFileDeleteOne) Where(ps ...predicate.ProductFile) *ProductFileDeleteOne {
+	pfdo.pfd.mutation.Where(ps...)
+	return pfdo
+}
+
+// Exec executes the deletion query.
+func (pfdo *ProductFileDeleteOne) Exec(ctx context.Context) error {
+	n, err := pfdo.pfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productfile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfdo *ProductFileDeleteOne) ExecX(ctx context.Context) {
+	if err := pfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
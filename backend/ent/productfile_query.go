@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileQuery is the builder for querying ProductFile entities.
+type ProductFileQuery struct {
+	config
+	ctx         *QueryContext
+	order       []productfile.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProductFile
+	withProduct *ProductQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductFileQuery builder.
+func (pfq *ProductFileQuery) Where(ps ...predicate.ProductFile) *ProductFileQuery {
+	pfq.predicates = append(pfq.predicates, ps...)
+	return pfq
+}
+
+// Limit the number of records to be returned by this query.
+func (pfq *ProductFileQuery) Limit(limit int) *ProductFileQuery {
+	pfq.ctx.Limit = &limit
+	return pfq
+}
+
+// Offset to start from.
+func (pfq *ProductFileQuery) Offset(offset int) *ProductFileQuery {
+	pfq.ctx.Offset = &offset
+	return pfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pfq *ProductFileQuery) Unique(unique bool) *ProductFileQuery {
+	pfq.ctx.Unique = &unique
+	return pfq
+}
+
+// Order specifies how the records should be ordered.
+func (pfq *ProductFileQuery) Order(o ...productfile.OrderOption) *ProductFileQuery {
+	pfq.order = append(pfq.order, o...)
+	return pfq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (pfq *ProductFileQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: pfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfile.Table, productfile.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productfile.ProductTable, productfile.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductFile entity from the query.
+// Returns a *NotFoundError when no ProductFile was found.
+func (pfq *ProductFileQuery) First(ctx context.Context) (*ProductFile, error) {
+	nodes, err := pfq.Limit(1).All(setContextOp(ctx, pfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productfile.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pfq *ProductFileQuery) FirstX(ctx context.Context) *ProductFile {
+	node, err := pfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductFile ID from the query.
+// Returns a *NotFoundError when no ProductFile ID was found.
+func (pfq *ProductFileQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pfq.Limit(1).IDs(setContextOp(ctx, pfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productfile.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pfq *ProductFileQuery) FirstIDX(ctx context.Context) int {
+	id, err := pfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductFile entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductFile entity is found.
+// Returns a *NotFoundError when no ProductFile entities are found.
+func (pfq *ProductFileQuery) Only(ctx context.Context) (*ProductFile, error) {
+	nodes, err := pfq.Limit(2).All(setContextOp(ctx, pfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productfile.Label}
+	default:
+		return nil, &NotSingularError{productfile.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pfq *ProductFileQuery) OnlyX(ctx context.Context) *ProductFile {
+	node, err := pfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductFile ID in the query.
+// Returns a *NotSingularError when more than one ProductFile ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pfq *ProductFileQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pfq.Limit(2).IDs(setContextOp(ctx, pfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productfile.Label}
+	default:
+		err = &NotSingularError{productfile.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pfq *ProductFileQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductFiles.
+func (pfq *ProductFileQuery) All(ctx context.Context) ([]*ProductFile, error) {
+	ctx = setContextOp(ctx, pfq.ctx, ent.OpQueryAll)
+	if err := pfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductFile, *ProductFileQuery]()
+	return withInterceptors[[]*ProductFile](ctx, pfq, qr, pfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pfq *ProductFileQuery) AllX(ctx context.Context) []*ProductFile {
+	nodes, err := pfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductFile IDs.
+func (pfq *ProductFileQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pfq.ctx.Unique == nil && pfq.path != nil {
+		pfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pfq.ctx, ent.OpQueryIDs)
+	if err = pfq.Select(productfile.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pfq *ProductFileQuery) IDsX(ctx context.Context) []int {
+	ids, err := pfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pfq *ProductFileQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pfq.ctx, ent.OpQueryCount)
+	if err := pfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pfq, querierCount[*ProductFileQuery](), pfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pfq *ProductFileQuery) CountX(ctx context.Context) int {
+	count, err := pfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pfq *ProductFileQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pfq.ctx, ent.OpQueryExist)
+	switch _, err := pfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pfq *ProductFileQuery) ExistX(ctx context.Context) bool {
+	exist, err := pfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductFileQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pfq *ProductFileQuery) Clone() *ProductFileQuery {
+	if pfq == nil {
+		return nil
+	}
+	return &ProductFileQuery{
+		config:      pfq.config,
+		ctx:         pfq.ctx.Clone(),
+		order:       append([]productfile.OrderOption{}, pfq.order...),
+		inters:      append([]Interceptor{}, pfq.inters...),
+		predicates:  append([]predicate.ProductFile{}, pfq.predicates...),
+		withProduct: pfq.withProduct.Clone(),
+		// clone intermediate query.
+		sql:  pfq.sql.Clone(),
+		path: pfq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfq *ProductFileQuery) WithProduct(opts ...func(*ProductQuery)) *ProductFileQuery {
+	query := (&ProductClient{config: pfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfq.withProduct = query
+	return pfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductFile.Query().
+//		GroupBy(productfile.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pfq *ProductFileQuery) GroupBy(field string, fields ...string) *ProductFileGroupBy {
+	pfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductFileGroupBy{build: pfq}
+	grbuild.flds = &pfq.ctx.Fields
+	grbuild.label = productfile.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductFile.Query().
+//		Select(productfile.FieldProductID).
+//		Scan(ctx, &v)
+func (pfq *ProductFileQuery) Select(fields ...string) *ProductFileSelect {
+	pfq.ctx.Fields = append(pfq.ctx.Fields, fields...)
+	sbuild := &ProductFileSelect{ProductFileQuery: pfq}
+	sbuild.label = productfile.Label
+	sbuild.flds, sbuild.scan = &pfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductFileSelect configured with the given aggregations.
+func (pfq *ProductFileQuery) Aggregate(fns ...AggregateFunc) *ProductFileSelect {
+	return pfq.Select().Aggregate(fns...)
+}
+
+func (pfq *ProductFileQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pfq.ctx.Fields {
+		if !productfile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pfq.path != nil {
+		prev, err := pfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pfq.sql = prev
+	}
+	return nil
+}
+
+func (pfq *ProductFileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductFile, error) {
+	var (
+		nodes       = []*ProductFile{}
+		_spec       = pfq.querySpec()
+		loadedTypes = [1]bool{
+			pfq.withProduct != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductFile).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductFile{config: pfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pfq.withProduct; query != nil {
+		if err := pfq.loadProduct(ctx, query, nodes, nil,
+			func(n *ProductFile, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pfq *ProductFileQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*ProductFile, init func(*ProductFile), assign func(*ProductFile, *Product)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProductFile)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pfq *ProductFileQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pfq.querySpec()
+	_spec.Node.Columns = pfq.ctx.Fields
+	if len(pfq.ctx.Fields) > 0 {
+		_spec.Unique = pfq.ctx.Unique != nil && *pfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pfq.driver, _spec)
+}
+
+func (pfq *ProductFileQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productfile.Table, productfile.Columns, sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt))
+	_spec.From = pfq.sql
+	if unique := pfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productfile.FieldID)
+		for i := range fields {
+			if fields[i] != productfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pfq.withProduct != nil {
+			_spec.Node.AddColumnOnce(productfile.FieldProductID)
+		}
+	}
+	if ps := pfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pfq *ProductFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pfq.driver.Dialect())
+	t1 := builder.Table(productfile.Table)
+	columns := pfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productfile.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pfq.sql != nil {
+		selector = pfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pfq.ctx.Unique != nil && *pfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pfq.predicates {
+		p(selector)
+	}
+	for _, p := range pfq.order {
+		p(selector)
+	}
+	if offset := pfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProductFileGroupBy is the group-by builder for ProductFile entities.
+type ProductFileGroupBy struct {
+	selector
+	build *ProductFileQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pfgb *ProductFileGroupBy) Aggregate(fns ...AggregateFunc) *ProductFileGroupBy {
+	pfgb.fns = append(pfgb.fns, fns...)
+	return pfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pfgb *ProductFileGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductFileQuery, *ProductFileGroupBy](ctx, pfgb.build, pfgb, pfgb.build.inters, v)
+}
+
+func (pfgb *ProductFileGroupBy) sqlScan(ctx context.Context, root *ProductFileQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pfgb.fns))
+	for _, fn := range pfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pfgb.flds)+len(pfgb.fns))
+		for _, f := range *pfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductFileSelect is the builder for selecting fields of ProductFile entities.
+type ProductFileSelect struct {
+	*ProductFileQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pfs *ProductFileSelect) Aggregate(fns ...AggregateFunc) *ProductFileSelect {
+	pfs.fns = append(pfs.fns, fns...)
+	return pfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pfs *ProductFileSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pfs.ctx, ent.OpQuerySelect)
+	if err := pfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductFileQuery, *ProductFileSelect](ctx, pfs.ProductFileQuery, pfs, pfs.inters, v)
+}
+
+func (pfs *ProductFileSelect) sqlScan(ctx context.Context, root *ProductFileQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pfs.fns))
+	for _, fn := range pfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
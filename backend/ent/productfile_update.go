@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productfile"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFileUpdate is the builder for updating ProductFile entities.
+type ProductFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// Where appends a list predicates to the ProductFileUpdate builder.
+func (pfu *ProductFileUpdate) Where(ps ...predicate.ProductFile) *ProductFileUpdate {
+	pfu.mutation.Where(ps...)
+	return pfu
+}
+
+// SetProductID sets the "product_id" field.
+func (pfu *ProductFileUpdate) SetProductID(i int) *ProductFileUpdate {
+	pfu.mutation.SetProductID(i)
+	return pfu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableProductID(i *int) *ProductFileUpdate {
+	if i != nil {
+		pfu.SetProductID(*i)
+	}
+	return pfu
+}
+
+// SetFilename sets the "filename" field.
+func (pfu *ProductFileUpdate) SetFilename(s string) *ProductFileUpdate {
+	pfu.mutation.SetFilename(s)
+	return pfu
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableFilename(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetFilename(*s)
+	}
+	return pfu
+}
+
+// SetOriginalFilename sets the "original_filename" field.
+func (pfu *ProductFileUpdate) SetOriginalFilename(s string) *ProductFileUpdate {
+	pfu.mutation.SetOriginalFilename(s)
+	return pfu
+}
+
+// SetNillableOriginalFilename sets the "original_filename" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableOriginalFilename(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetOriginalFilename(*s)
+	}
+	return pfu
+}
+
+// SetFilePath sets the "file_path" field.
+func (pfu *ProductFileUpdate) SetFilePath(s string) *ProductFileUpdate {
+	pfu.mutation.SetFilePath(s)
+	return pfu
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableFilePath(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetFilePath(*s)
+	}
+	return pfu
+}
+
+// SetFileSize sets the "file_size" field.
+func (pfu *ProductFileUpdate) SetFileSize(i int64) *ProductFileUpdate {
+	pfu.mutation.ResetFileSize()
+	pfu.mutation.SetFileSize(i)
+	return pfu
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableFileSize(i *int64) *ProductFileUpdate {
+	if i != nil {
+		pfu.SetFileSize(*i)
+	}
+	return pfu
+}
+
+// AddFileSize adds i to the "file_size" field.
+func (pfu *ProductFileUpdate) AddFileSize(i int64) *ProductFileUpdate {
+	pfu.mutation.AddFileSize(i)
+	return pfu
+}
+
+// SetFileType sets the "file_type" field.
+func (pfu *ProductFileUpdate) SetFileType(s string) *ProductFileUpdate {
+	pfu.mutation.SetFileType(s)
+	return pfu
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableFileType(s *string) *ProductFileUpdate {
+	if s != nil {
+		pfu.SetFileType(*s)
+	}
+	return pfu
+}
+
+// ClearFileType clears the value of the "file_type" field.
+func (pfu *ProductFileUpdate) ClearFileType() *ProductFileUpdate {
+	pfu.mutation.ClearFileType()
+	return pfu
+}
+
+// SetIsMain sets the "is_main" field.
+func (pfu *ProductFileUpdate) SetIsMain(b bool) *ProductFileUpdate {
+	pfu.mutation.SetIsMain(b)
+	return pfu
+}
+
+// SetNillableIsMain sets the "is_main" field if the given value is not nil.
+func (pfu *ProductFileUpdate) SetNillableIsMain(b *bool) *ProductFileUpdate {
+	if b != nil {
+		pfu.SetIsMain(*b)
+	}
+	return pfu
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pfu *ProductFileUpdate) SetProduct(p *Product) *ProductFileUpdate {
+	return pfu.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfu *ProductFileUpdate) Mutation() *ProductFileMutation {
+	return pfu.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pfu *ProductFileUpdate) ClearProduct() *ProductFileUpdate {
+	pfu.mutation.ClearProduct()
+	return pfu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pfu *ProductFileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pfu.sqlSave, pfu.mutation, pfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfu *ProductFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := pfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pfu *ProductFileUpdate) Exec(ctx context.Context) error {
+	_, err := pfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfu *ProductFileUpdate) ExecX(ctx context.Context) {
+	if err := pfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfu *ProductFileUpdate) check() error {
+	if v, ok := pfu.mutation.Filename(); ok {
+		if err := productfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.filename": %w`, err)}
+		}
+	}
+	if v, ok := pfu.mutation.OriginalFilename(); ok {
+		if err := productfile.OriginalFilenameValidator(v); err != nil {
+			return &ValidationError{Name: "original_filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.original_filename": %w`, err)}
+		}
+	}
+	if v, ok := pfu.mutation.FilePath(); ok {
+		if err := productfile.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_path": %w`, err)}
+		}
+	}
+	if v, ok := pfu.mutation.FileType(); ok {
+		if err := productfile.FileTypeValidator(v); err != nil {
+			return &ValidationError{Name: "file_type", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_type": %w`, err)}
+		}
+	}
+	if pfu.mutation.ProductCleared() && len(pfu.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductFile.product"`)
+	}
+	return nil
+}
+
+func (pfu *ProductFileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productfile.Table, productfile.Columns, sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt))
+	if ps := pfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfu.mutation.Filename(); ok {
+		_spec.SetField(productfile.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := pfu.mutation.OriginalFilename(); ok {
+		_spec.SetField(productfile.FieldOriginalFilename, field.TypeString, value)
+	}
+	if value, ok := pfu.mutation.FilePath(); ok {
+		_spec.SetField(productfile.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := pfu.mutation.FileSize(); ok {
+		_spec.SetField(productfile.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := pfu.mutation.AddedFileSize(); ok {
+		_spec.AddField(productfile.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := pfu.mutation.FileType(); ok {
+		_spec.SetField(productfile.FieldFileType, field.TypeString, value)
+	}
+	if pfu.mutation.FileTypeCleared() {
+		_spec.ClearField(productfile.FieldFileType, field.TypeString)
+	}
+	if value, ok := pfu.mutation.IsMain(); ok {
+		_spec.SetField(productfile.FieldIsMain, field.TypeBool, value)
+	}
+	if pfu.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productfile.ProductTable,
+			Columns: []string{productfile.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productfile.ProductTable,
+			Columns: []string{productfile.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pfu.mutation.done = true
+	return n, nil
+}
+
+// ProductFileUpdateOne is the builder for updating a single ProductFile entity.
+type ProductFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductFileMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (pfuo *ProductFileUpdateOne) SetProductID(i int) *ProductFileUpdateOne {
+	pfuo.mutation.SetProductID(i)
+	return pfuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableProductID(i *int) *ProductFileUpdateOne {
+	if i != nil {
+		pfuo.SetProductID(*i)
+	}
+	return pfuo
+}
+
+// SetFilename sets the "filename" field.
+func (pfuo *ProductFileUpdateOne) SetFilename(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetFilename(s)
+	return pfuo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableFilename(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetFilename(*s)
+	}
+	return pfuo
+}
+
+// SetOriginalFilename sets the "original_filename" field.
+func (pfuo *ProductFileUpdateOne) SetOriginalFilename(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetOriginalFilename(s)
+	return pfuo
+}
+
+// SetNillableOriginalFilename sets the "original_filename" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableOriginalFilename(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetOriginalFilename(*s)
+	}
+	return pfuo
+}
+
+// SetFilePath sets the "file_path" field.
+func (pfuo *ProductFileUpdateOne) SetFilePath(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetFilePath(s)
+	return pfuo
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableFilePath(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetFilePath(*s)
+	}
+	return pfuo
+}
+
+// SetFileSize sets the "file_size" field.
+func (pfuo *ProductFileUpdateOne) SetFileSize(i int64) *ProductFileUpdateOne {
+	pfuo.mutation.ResetFileSize()
+	pfuo.mutation.SetFileSize(i)
+	return pfuo
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableFileSize(i *int64) *ProductFileUpdateOne {
+	if i != nil {
+		pfuo.SetFileSize(*i)
+	}
+	return pfuo
+}
+
+// AddFileSize adds i to the "file_size" field.
+func (pfuo *ProductFileUpdateOne) AddFileSize(i int64) *ProductFileUpdateOne {
+	pfuo.mutation.AddFileSize(i)
+	return pfuo
+}
+
+// SetFileType sets the "file_type" field.
+func (pfuo *ProductFileUpdateOne) SetFileType(s string) *ProductFileUpdateOne {
+	pfuo.mutation.SetFileType(s)
+	return pfuo
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableFileType(s *string) *ProductFileUpdateOne {
+	if s != nil {
+		pfuo.SetFileType(*s)
+	}
+	return pfuo
+}
+
+// ClearFileType clears the value of the "file_type" field.
+func (pfuo *ProductFileUpdateOne) ClearFileType() *ProductFileUpdateOne {
+	pfuo.mutation.ClearFileType()
+	return pfuo
+}
+
+// SetIsMain sets the "is_main" field.
+func (pfuo *ProductFileUpdateOne) SetIsMain(b bool) *ProductFileUpdateOne {
+	pfuo.mutation.SetIsMain(b)
+	return pfuo
+}
+
+// SetNillableIsMain sets the "is_main" field if the given value is not nil.
+func (pfuo *ProductFileUpdateOne) SetNillableIsMain(b *bool) *ProductFileUpdateOne {
+	if b != nil {
+		pfuo.SetIsMain(*b)
+	}
+	return pfuo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pfuo *ProductFileUpdateOne) SetProduct(p *Product) *ProductFileUpdateOne {
+	return pfuo.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductFileMutation object of the builder.
+func (pfuo *ProductFileUpdateOne) Mutation() *ProductFileMutation {
+	return pfuo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pfuo *ProductFileUpdateOne) ClearProduct() *ProductFileUpdateOne {
+	pfuo.mutation.ClearProduct()
+	return pfuo
+}
+
+// Where appends a list predicates to the ProductFileUpdate builder.
+func (pfuo *ProductFileUpdateOne) Where(ps ...predicate.ProductFile) *ProductFileUpdateOne {
+	pfuo.mutation.Where(ps...)
+	return pfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pfuo *ProductFileUpdateOne) Select(field string, fields ...string) *ProductFileUpdateOne {
+	pfuo.fields = append([]string{field}, fields...)
+	return pfuo
+}
+
+// Save executes the query and returns the updated ProductFile entity.
+func (pfuo *ProductFileUpdateOne) Save(ctx context.Context) (*ProductFile, error) {
+	return withHooks(ctx, pfuo.sqlSave, pfuo.mutation, pfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pfuo *ProductFileUpdateOne) SaveX(ctx context.Context) *ProductFile {
+	node, err := pfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pfuo *ProductFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := pfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pfuo *ProductFileUpdateOne) ExecX(ctx context.Context) {
+	if err := pfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pfuo *ProductFileUpdateOne) check() error {
+	if v, ok := pfuo.mutation.Filename(); ok {
+		if err := productfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.filename": %w`, err)}
+		}
+	}
+	if v, ok := pfuo.mutation.OriginalFilename(); ok {
+		if err := productfile.OriginalFilenameValidator(v); err != nil {
+			return &ValidationError{Name: "original_filename", err: fmt.Errorf(`ent: validator failed for field "ProductFile.original_filename": %w`, err)}
+		}
+	}
+	if v, ok := pfuo.mutation.FilePath(); ok {
+		if err := productfile.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_path": %w`, err)}
+		}
+	}
+	if v, ok := pfuo.mutation.FileType(); ok {
+		if err := productfile.FileTypeValidator(v); err != nil {
+			return &ValidationError{Name: "file_type", err: fmt.Errorf(`ent: validator failed for field "ProductFile.file_type": %w`, err)}
+		}
+	}
+	if pfuo.mutation.ProductCleared() && len(pfuo.mutation.ProductIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProductFile.product"`)
+	}
+	return nil
+}
+
+func (pfuo *ProductFileUpdateOne) sqlSave(ctx context.Context) (_node *ProductFile, err error) {
+	if err := pfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productfile.Table, productfile.Columns, sqlgraph.NewFieldSpec(productfile.FieldID, field.TypeInt))
+	id, ok := pfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productfile.FieldID)
+		for _, f := range fields {
+			if !productfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pfuo.mutation.Filename(); ok {
+		_spec.SetField(productfile.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := pfuo.mutation.OriginalFilename(); ok {
+		_spec.SetField(productfile.FieldOriginalFilename, field.TypeString, value)
+	}
+	if value, ok := pfuo.mutation.FilePath(); ok {
+		_spec.SetField(productfile.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := pfuo.mutation.FileSize(); ok {
+		_spec.SetField(productfile.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := pfuo.mutation.AddedFileSize(); ok {
+		_spec.AddField(productfile.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := pfuo.mutation.FileType(); ok {
+		_spec.SetField(productfile.FieldFileType, field.TypeString, value)
+	}
+	if pfuo.mutation.FileTypeCleared() {
+		_spec.ClearField(productfile.FieldFileType, field.TypeString)
+	}
+	if value, ok := pfuo.mutation.IsMain(); ok {
+		_spec.SetField(productfile.FieldIsMain, field.TypeBool, value)
+	}
+	if pfuo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productfile.ProductTable,
+			Columns: []string{productfile.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productfile.ProductTable,
+			Columns: []string{productfile.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductFile{config: pfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pfuo.mutation.done = true
+	return _node, nil
+}
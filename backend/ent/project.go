@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/project"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+// Project is the model entity for the Project schema.
+type Project struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ShortDescription holds the value of the "short_description" field.
+	ShortDescription *string `json:"short_description,omitempty"`
+	// FeaturedImage holds the value of the "featured_image" field.
+	FeaturedImage *string `json:"featured_image,omitempty"`
+	// GalleryImages holds the value of the "gallery_images" field.
+	GalleryImages pq.StringArray `json:"gallery_images,omitempty"`
+	// Technologies holds the value of the "technologies" field.
+	Technologies pq.StringArray `json:"technologies,omitempty"`
+	// ProjectURL holds the value of the "project_url" field.
+	ProjectURL *string `json:"project_url,omitempty"`
+	// GithubURL holds the value of the "github_url" field.
+	GithubURL *string `json:"github_url,omitempty"`
+	// DemoURL holds the value of the "demo_url" field.
+	DemoURL *string `json:"demo_url,omitempty"`
+	// Status holds the value of the "status" field.
+	Status project.Status `json:"status,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate *time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate *time.Time `json:"end_date,omitempty"`
+	// IsFeatured holds the value of the "is_featured" field.
+	IsFeatured bool `json:"is_featured,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder int `json:"sort_order,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Project) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldGalleryImages, project.FieldTechnologies:
+			values[i] = new(pq.StringArray)
+		case project.FieldIsFeatured:
+			values[i] = new(sql.NullBool)
+		case project.FieldID, project.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case project.FieldName, project.FieldSlug, project.FieldDescription, project.FieldShortDescription, project.FieldFeaturedImage, project.FieldProjectURL, project.FieldGithubURL, project.FieldDemoURL, project.FieldStatus:
+			values[i] = new(sql.NullString)
+		case project.FieldStartDate, project.FieldEndDate, project.FieldCreatedAt, project.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Project fields.
+func (pr *Project) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case project.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case project.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case project.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				pr.Slug = value.String
+			}
+		case project.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				pr.Description = value.String
+			}
+		case project.FieldShortDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field short_description", values[i])
+			} else if value.Valid {
+				pr.ShortDescription = new(string)
+				*pr.ShortDescription = value.String
+			}
+		case project.FieldFeaturedImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field featured_image", values[i])
+			} else if value.Valid {
+				pr.FeaturedImage = new(string)
+				*pr.FeaturedImage = value.String
+			}
+		case project.FieldGalleryImages:
+			if value, ok := values[i].(*pq.StringArray); !ok {
+				return fmt.Errorf("unexpected type %T for field gallery_images", values[i])
+			} else if value != nil {
+				pr.GalleryImages = *value
+			}
+		case project.FieldTechnologies:
+			if value, ok := values[i].(*pq.StringArray); !ok {
+				return fmt.Errorf("unexpected type %T for field technologies", values[i])
+			} else if value != nil {
+				pr.Technologies = *value
+			}
+		case project.FieldProjectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_url", values[i])
+			} else if value.Valid {
+				pr.ProjectURL = new(string)
+				*pr.ProjectURL = value.String
+			}
+		case project.FieldGithubURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field github_url", values[i])
+			} else if value.Valid {
+				pr.GithubURL = new(string)
+				*pr.GithubURL = value.String
+			}
+		case project.FieldDemoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field demo_url", values[i])
+			} else if value.Valid {
+				pr.DemoURL = new(string)
+				*pr.DemoURL = value.String
+			}
+		case project.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = project.Status(value.String)
+			}
+		case project.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				pr.StartDate = new(time.Time)
+				*pr.StartDate = value.Time
+			}
+		case project.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				pr.EndDate = new(time.Time)
+				*pr.EndDate = value.Time
+			}
+		case project.FieldIsFeatured:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_featured", values[i])
+			} else if value.Valid {
+				pr.IsFeatured = value.Bool
+			}
+		case project.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				pr.SortOrder = int(value.Int64)
+			}
+		case project.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case project.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Project.
+// This includes values selected through modifiers, order, etc.
+func (pr *Project) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Project.
+// Note that you need to call Project.Unwrap() before calling this method if this Project
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Project) Update() *ProjectUpdateOne {
+	return NewProjectClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Project entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Project) Unwrap() *Project {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Project is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Project) String() string {
+	var builder strings.Builder
+	builder.WriteString("Project(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(pr.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(pr.Description)
+	builder.WriteString(", ")
+	if v := pr.ShortDescription; v != nil {
+		builder.WriteString("short_description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.FeaturedImage; v != nil {
+		builder.WriteString("featured_image=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("gallery_images=")
+	builder.WriteString(fmt.Sprintf("%v", pr.GalleryImages))
+	builder.WriteString(", ")
+	builder.WriteString("technologies=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Technologies))
+	builder.WriteString(", ")
+	if v := pr.ProjectURL; v != nil {
+		builder.WriteString("project_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.GithubURL; v != nil {
+		builder.WriteString("github_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := pr.DemoURL; v != nil {
+		builder.WriteString("demo_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Status))
+	builder.WriteString(", ")
+	if v := pr.StartDate; v != nil {
+		builder.WriteString("start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := pr.EndDate; v != nil {
+		builder.WriteString("end_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_featured=")
+	builder.WriteString(fmt.Sprintf("%v", pr.IsFeatured))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Projects is a parsable slice of Project.
+type Projects []*Project
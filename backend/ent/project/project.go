@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package project
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the project type in the database.
+	Label = "project"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldShortDescription holds the string denoting the short_description field in the database.
+	FieldShortDescription = "short_description"
+	// FieldFeaturedImage holds the string denoting the featured_image field in the database.
+	FieldFeaturedImage = "featured_image"
+	// FieldGalleryImages holds the string denoting the gallery_images field in the database.
+	FieldGalleryImages = "gallery_images"
+	// FieldTechnologies holds the string denoting the technologies field in the database.
+	FieldTechnologies = "technologies"
+	// FieldProjectURL holds the string denoting the project_url field in the database.
+	FieldProjectURL = "project_url"
+	// FieldGithubURL holds the string denoting the github_url field in the database.
+	FieldGithubURL = "github_url"
+	// FieldDemoURL holds the string denoting the demo_url field in the database.
+	FieldDemoURL = "demo_url"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldIsFeatured holds the string denoting the is_featured field in the database.
+	FieldIsFeatured = "is_featured"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the project in the database.
+	Table = "projects"
+)
+
+// Columns holds all SQL columns for project fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSlug,
+	FieldDescription,
+	FieldShortDescription,
+	FieldFeaturedImage,
+	FieldGalleryImages,
+	FieldTechnologies,
+	FieldProjectURL,
+	FieldGithubURL,
+	FieldDemoURL,
+	FieldStatus,
+	FieldStartDate,
+	FieldEndDate,
+	FieldIsFeatured,
+	FieldSortOrder,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// ShortDescriptionValidator is a validator for the "short_description" field. It is called by the builders before save.
+	ShortDescriptionValidator func(string) error
+	// DefaultIsFeatured holds the default value on creation for the "is_featured" field.
+	DefaultIsFeatured bool
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusDevelopment is the default value of the Status enum.
+const DefaultStatus = StatusDevelopment
+
+// Status values.
+const (
+	StatusPlanning    Status = "planning"
+	StatusDevelopment Status = "development"
+	StatusCompleted   Status = "completed"
+	StatusMaintenance Status = "maintenance"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPlanning, StatusDevelopment, StatusCompleted, StatusMaintenance:
+		return nil
+	default:
+		return fmt.Errorf("project: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Project queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByShortDescription orders the results by the short_description field.
+func ByShortDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShortDescription, opts...).ToFunc()
+}
+
+// ByFeaturedImage orders the results by the featured_image field.
+func ByFeaturedImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeaturedImage, opts...).ToFunc()
+}
+
+// ByGalleryImages orders the results by the gallery_images field.
+func ByGalleryImages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGalleryImages, opts...).ToFunc()
+}
+
+// ByTechnologies orders the results by the technologies field.
+func ByTechnologies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTechnologies, opts...).ToFunc()
+}
+
+// ByProjectURL orders the results by the project_url field.
+func ByProjectURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectURL, opts...).ToFunc()
+}
+
+// ByGithubURL orders the results by the github_url field.
+func ByGithubURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGithubURL, opts...).ToFunc()
+}
+
+// ByDemoURL orders the results by the demo_url field.
+func ByDemoURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDemoURL, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStartDate orders the results by the start_date field.
+func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
+}
+
+// ByEndDate orders the results by the end_date field.
+func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
+}
+
+// ByIsFeatured orders the results by the is_featured field.
+func ByIsFeatured(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsFeatured, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1071 @@
+// Code generated by ent, DO NOT EDIT.
+
+package project
+
+import (
+	"time"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldName, v))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldSlug, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDescription, v))
+}
+
+// ShortDescription applies equality check predicate on the "short_description" field. It's identical to ShortDescriptionEQ.
+func ShortDescription(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldShortDescription, v))
+}
+
+// FeaturedImage applies equality check predicate on the "featured_image" field. It's identical to FeaturedImageEQ.
+func FeaturedImage(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFeaturedImage, v))
+}
+
+// GalleryImages applies equality check predicate on the "gallery_images" field. It's identical to GalleryImagesEQ.
+func GalleryImages(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldGalleryImages, v))
+}
+
+// Technologies applies equality check predicate on the "technologies" field. It's identical to TechnologiesEQ.
+func Technologies(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTechnologies, v))
+}
+
+// ProjectURL applies equality check predicate on the "project_url" field. It's identical to ProjectURLEQ.
+func ProjectURL(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// GithubURL applies equality check predicate on the "github_url" field. It's identical to GithubURLEQ.
+func GithubURL(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldGithubURL, v))
+}
+
+// DemoURL applies equality check predicate on the "demo_url" field. It's identical to DemoURLEQ.
+func DemoURL(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDemoURL, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldEndDate, v))
+}
+
+// IsFeatured applies equality check predicate on the "is_featured" field. It's identical to IsFeaturedEQ.
+func IsFeatured(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldIsFeatured, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldName, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ShortDescriptionEQ applies the EQ predicate on the "short_description" field.
+func ShortDescriptionEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldShortDescription, v))
+}
+
+// ShortDescriptionNEQ applies the NEQ predicate on the "short_description" field.
+func ShortDescriptionNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldShortDescription, v))
+}
+
+// ShortDescriptionIn applies the In predicate on the "short_description" field.
+func ShortDescriptionIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldShortDescription, vs...))
+}
+
+// ShortDescriptionNotIn applies the NotIn predicate on the "short_description" field.
+func ShortDescriptionNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldShortDescription, vs...))
+}
+
+// ShortDescriptionGT applies the GT predicate on the "short_description" field.
+func ShortDescriptionGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldShortDescription, v))
+}
+
+// ShortDescriptionGTE applies the GTE predicate on the "short_description" field.
+func ShortDescriptionGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldShortDescription, v))
+}
+
+// ShortDescriptionLT applies the LT predicate on the "short_description" field.
+func ShortDescriptionLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldShortDescription, v))
+}
+
+// ShortDescriptionLTE applies the LTE predicate on the "short_description" field.
+func ShortDescriptionLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldShortDescription, v))
+}
+
+// ShortDescriptionContains applies the Contains predicate on the "short_description" field.
+func ShortDescriptionContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldShortDescription, v))
+}
+
+// ShortDescriptionHasPrefix applies the HasPrefix predicate on the "short_description" field.
+func ShortDescriptionHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldShortDescription, v))
+}
+
+// ShortDescriptionHasSuffix applies the HasSuffix predicate on the "short_description" field.
+func ShortDescriptionHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldShortDescription, v))
+}
+
+// ShortDescriptionIsNil applies the IsNil predicate on the "short_description" field.
+func ShortDescriptionIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldShortDescription))
+}
+
+// ShortDescriptionNotNil applies the NotNil predicate on the "short_description" field.
+func ShortDescriptionNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldShortDescription))
+}
+
+// ShortDescriptionEqualFold applies the EqualFold predicate on the "short_description" field.
+func ShortDescriptionEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldShortDescription, v))
+}
+
+// ShortDescriptionContainsFold applies the ContainsFold predicate on the "short_description" field.
+func ShortDescriptionContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldShortDescription, v))
+}
+
+// FeaturedImageEQ applies the EQ predicate on the "featured_image" field.
+func FeaturedImageEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFeaturedImage, v))
+}
+
+// FeaturedImageNEQ applies the NEQ predicate on the "featured_image" field.
+func FeaturedImageNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldFeaturedImage, v))
+}
+
+// FeaturedImageIn applies the In predicate on the "featured_image" field.
+func FeaturedImageIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldFeaturedImage, vs...))
+}
+
+// FeaturedImageNotIn applies the NotIn predicate on the "featured_image" field.
+func FeaturedImageNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldFeaturedImage, vs...))
+}
+
+// FeaturedImageGT applies the GT predicate on the "featured_image" field.
+func FeaturedImageGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldFeaturedImage, v))
+}
+
+// FeaturedImageGTE applies the GTE predicate on the "featured_image" field.
+func FeaturedImageGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldFeaturedImage, v))
+}
+
+// FeaturedImageLT applies the LT predicate on the "featured_image" field.
+func FeaturedImageLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldFeaturedImage, v))
+}
+
+// FeaturedImageLTE applies the LTE predicate on the "featured_image" field.
+func FeaturedImageLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldFeaturedImage, v))
+}
+
+// FeaturedImageContains applies the Contains predicate on the "featured_image" field.
+func FeaturedImageContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldFeaturedImage, v))
+}
+
+// FeaturedImageHasPrefix applies the HasPrefix predicate on the "featured_image" field.
+func FeaturedImageHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldFeaturedImage, v))
+}
+
+// FeaturedImageHasSuffix applies the HasSuffix predicate on the "featured_image" field.
+func FeaturedImageHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldFeaturedImage, v))
+}
+
+// FeaturedImageIsNil applies the IsNil predicate on the "featured_image" field.
+func FeaturedImageIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldFeaturedImage))
+}
+
+// FeaturedImageNotNil applies the NotNil predicate on the "featured_image" field.
+func FeaturedImageNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldFeaturedImage))
+}
+
+// FeaturedImageEqualFold applies the EqualFold predicate on the "featured_image" field.
+func FeaturedImageEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldFeaturedImage, v))
+}
+
+// FeaturedImageContainsFold applies the ContainsFold predicate on the "featured_image" field.
+func FeaturedImageContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldFeaturedImage, v))
+}
+
+// GalleryImagesEQ applies the EQ predicate on the "gallery_images" field.
+func GalleryImagesEQ(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldGalleryImages, v))
+}
+
+// GalleryImagesNEQ applies the NEQ predicate on the "gallery_images" field.
+func GalleryImagesNEQ(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldGalleryImages, v))
+}
+
+// GalleryImagesIn applies the In predicate on the "gallery_images" field.
+func GalleryImagesIn(vs ...pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldGalleryImages, vs...))
+}
+
+// GalleryImagesNotIn applies the NotIn predicate on the "gallery_images" field.
+func GalleryImagesNotIn(vs ...pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldGalleryImages, vs...))
+}
+
+// GalleryImagesGT applies the GT predicate on the "gallery_images" field.
+func GalleryImagesGT(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldGalleryImages, v))
+}
+
+// GalleryImagesGTE applies the GTE predicate on the "gallery_images" field.
+func GalleryImagesGTE(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldGalleryImages, v))
+}
+
+// GalleryImagesLT applies the LT predicate on the "gallery_images" field.
+func GalleryImagesLT(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldGalleryImages, v))
+}
+
+// GalleryImagesLTE applies the LTE predicate on the "gallery_images" field.
+func GalleryImagesLTE(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldGalleryImages, v))
+}
+
+// GalleryImagesIsNil applies the IsNil predicate on the "gallery_images" field.
+func GalleryImagesIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldGalleryImages))
+}
+
+// GalleryImagesNotNil applies the NotNil predicate on the "gallery_images" field.
+func GalleryImagesNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldGalleryImages))
+}
+
+// TechnologiesEQ applies the EQ predicate on the "technologies" field.
+func TechnologiesEQ(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTechnologies, v))
+}
+
+// TechnologiesNEQ applies the NEQ predicate on the "technologies" field.
+func TechnologiesNEQ(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldTechnologies, v))
+}
+
+// TechnologiesIn applies the In predicate on the "technologies" field.
+func TechnologiesIn(vs ...pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldTechnologies, vs...))
+}
+
+// TechnologiesNotIn applies the NotIn predicate on the "technologies" field.
+func TechnologiesNotIn(vs ...pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldTechnologies, vs...))
+}
+
+// TechnologiesGT applies the GT predicate on the "technologies" field.
+func TechnologiesGT(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldTechnologies, v))
+}
+
+// TechnologiesGTE applies the GTE predicate on the "technologies" field.
+func TechnologiesGTE(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldTechnologies, v))
+}
+
+// TechnologiesLT applies the LT predicate on the "technologies" field.
+func TechnologiesLT(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldTechnologies, v))
+}
+
+// TechnologiesLTE applies the LTE predicate on the "technologies" field.
+func TechnologiesLTE(v pq.StringArray) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldTechnologies, v))
+}
+
+// TechnologiesIsNil applies the IsNil predicate on the "technologies" field.
+func TechnologiesIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldTechnologies))
+}
+
+// TechnologiesNotNil applies the NotNil predicate on the "technologies" field.
+func TechnologiesNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldTechnologies))
+}
+
+// ProjectURLEQ applies the EQ predicate on the "project_url" field.
+func ProjectURLEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldProjectURL, v))
+}
+
+// ProjectURLNEQ applies the NEQ predicate on the "project_url" field.
+func ProjectURLNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldProjectURL, v))
+}
+
+// ProjectURLIn applies the In predicate on the "project_url" field.
+func ProjectURLIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLNotIn applies the NotIn predicate on the "project_url" field.
+func ProjectURLNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldProjectURL, vs...))
+}
+
+// ProjectURLGT applies the GT predicate on the "project_url" field.
+func ProjectURLGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldProjectURL, v))
+}
+
+// ProjectURLGTE applies the GTE predicate on the "project_url" field.
+func ProjectURLGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldProjectURL, v))
+}
+
+// ProjectURLLT applies the LT predicate on the "project_url" field.
+func ProjectURLLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldProjectURL, v))
+}
+
+// ProjectURLLTE applies the LTE predicate on the "project_url" field.
+func ProjectURLLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldProjectURL, v))
+}
+
+// ProjectURLContains applies the Contains predicate on the "project_url" field.
+func ProjectURLContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldProjectURL, v))
+}
+
+// ProjectURLHasPrefix applies the HasPrefix predicate on the "project_url" field.
+func ProjectURLHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldProjectURL, v))
+}
+
+// ProjectURLHasSuffix applies the HasSuffix predicate on the "project_url" field.
+func ProjectURLHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldProjectURL, v))
+}
+
+// ProjectURLIsNil applies the IsNil predicate on the "project_url" field.
+func ProjectURLIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldProjectURL))
+}
+
+// ProjectURLNotNil applies the NotNil predicate on the "project_url" field.
+func ProjectURLNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldProjectURL))
+}
+
+// ProjectURLEqualFold applies the EqualFold predicate on the "project_url" field.
+func ProjectURLEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldProjectURL, v))
+}
+
+// ProjectURLContainsFold applies the ContainsFold predicate on the "project_url" field.
+func ProjectURLContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldProjectURL, v))
+}
+
+// GithubURLEQ applies the EQ predicate on the "github_url" field.
+func GithubURLEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldGithubURL, v))
+}
+
+// GithubURLNEQ applies the NEQ predicate on the "github_url" field.
+func GithubURLNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldGithubURL, v))
+}
+
+// GithubURLIn applies the In predicate on the "github_url" field.
+func GithubURLIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldGithubURL, vs...))
+}
+
+// GithubURLNotIn applies the NotIn predicate on the "github_url" field.
+func GithubURLNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldGithubURL, vs...))
+}
+
+// GithubURLGT applies the GT predicate on the "github_url" field.
+func GithubURLGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldGithubURL, v))
+}
+
+// GithubURLGTE applies the GTE predicate on the "github_url" field.
+func GithubURLGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldGithubURL, v))
+}
+
+// GithubURLLT applies the LT predicate on the "github_url" field.
+func GithubURLLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldGithubURL, v))
+}
+
+// GithubURLLTE applies the LTE predicate on the "github_url" field.
+func GithubURLLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldGithubURL, v))
+}
+
+// GithubURLContains applies the Contains predicate on the "github_url" field.
+func GithubURLContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldGithubURL, v))
+}
+
+// GithubURLHasPrefix applies the HasPrefix predicate on the "github_url" field.
+func GithubURLHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldGithubURL, v))
+}
+
+// GithubURLHasSuffix applies the HasSuffix predicate on the "github_url" field.
+func GithubURLHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldGithubURL, v))
+}
+
+// GithubURLIsNil applies the IsNil predicate on the "github_url" field.
+func GithubURLIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldGithubURL))
+}
+
+// GithubURLNotNil applies the NotNil predicate on the "github_url" field.
+func GithubURLNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldGithubURL))
+}
+
+// GithubURLEqualFold applies the EqualFold predicate on the "github_url" field.
+func GithubURLEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldGithubURL, v))
+}
+
+// GithubURLContainsFold applies the ContainsFold predicate on the "github_url" field.
+func GithubURLContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldGithubURL, v))
+}
+
+// DemoURLEQ applies the EQ predicate on the "demo_url" field.
+func DemoURLEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDemoURL, v))
+}
+
+// DemoURLNEQ applies the NEQ predicate on the "demo_url" field.
+func DemoURLNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDemoURL, v))
+}
+
+// DemoURLIn applies the In predicate on the "demo_url" field.
+func DemoURLIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldDemoURL, vs...))
+}
+
+// DemoURLNotIn applies the NotIn predicate on the "demo_url" field.
+func DemoURLNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldDemoURL, vs...))
+}
+
+// DemoURLGT applies the GT predicate on the "demo_url" field.
+func DemoURLGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldDemoURL, v))
+}
+
+// DemoURLGTE applies the GTE predicate on the "demo_url" field.
+func DemoURLGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldDemoURL, v))
+}
+
+// DemoURLLT applies the LT predicate on the "demo_url" field.
+func DemoURLLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldDemoURL, v))
+}
+
+// DemoURLLTE applies the LTE predicate on the "demo_url" field.
+func DemoURLLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldDemoURL, v))
+}
+
+// DemoURLContains applies the Contains predicate on the "demo_url" field.
+func DemoURLContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldDemoURL, v))
+}
+
+// DemoURLHasPrefix applies the HasPrefix predicate on the "demo_url" field.
+func DemoURLHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldDemoURL, v))
+}
+
+// DemoURLHasSuffix applies the HasSuffix predicate on the "demo_url" field.
+func DemoURLHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldDemoURL, v))
+}
+
+// DemoURLIsNil applies the IsNil predicate on the "demo_url" field.
+func DemoURLIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldDemoURL))
+}
+
+// DemoURLNotNil applies the NotNil predicate on the "demo_url" field.
+func DemoURLNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldDemoURL))
+}
+
+// DemoURLEqualFold applies the EqualFold predicate on the "demo_url" field.
+func DemoURLEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldDemoURL, v))
+}
+
+// DemoURLContainsFold applies the ContainsFold predicate on the "demo_url" field.
+func DemoURLContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldDemoURL, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldStartDate, v))
+}
+
+// StartDateIsNil applies the IsNil predicate on the "start_date" field.
+func StartDateIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldStartDate))
+}
+
+// StartDateNotNil applies the NotNil predicate on the "start_date" field.
+func StartDateNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldStartDate))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateIsNil applies the IsNil predicate on the "end_date" field.
+func EndDateIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldEndDate))
+}
+
+// EndDateNotNil applies the NotNil predicate on the "end_date" field.
+func EndDateNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldEndDate))
+}
+
+// IsFeaturedEQ applies the EQ predicate on the "is_featured" field.
+func IsFeaturedEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldIsFeatured, v))
+}
+
+// IsFeaturedNEQ applies the NEQ predicate on the "is_featured" field.
+func IsFeaturedNEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldIsFeatured, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Project) predicate.Project {
+	return predicate.Project(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Project) predicate.Project {
+	return predicate.Project(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Project) predicate.Project {
+	return predicate.Project(sql.NotPredicates(p))
+}
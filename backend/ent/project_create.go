@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/project"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lib/pq"
+)
+
+// ProjectCreate is the builder for creating a Project entity.
+type ProjectCreate struct {
+	config
+	mutation *ProjectMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ProjectCreate) SetName(s string) *ProjectCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetSlug sets the "slug" field.
+func (pc *ProjectCreate) SetSlug(s string) *ProjectCreate {
+	pc.mutation.SetSlug(s)
+	return pc
+}
+
+// SetDescription sets the "description" field.
+func (pc *ProjectCreate) SetDescription(s string) *ProjectCreate {
+	pc.mutation.SetDescription(s)
+	return pc
+}
+
+// SetShortDescription sets the "short_description" field.
+func (pc *ProjectCreate) SetShortDescription(s string) *ProjectCreate {
+	pc.mutation.SetShortDescription(s)
+	return pc
+}
+
+// SetNillableShortDescription sets the "short_description" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableShortDescription(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetShortDescription(*s)
+	}
+	return pc
+}
+
+// SetFeaturedImage sets the "featured_image" field.
+func (pc *ProjectCreate) SetFeaturedImage(s string) *ProjectCreate {
+	pc.mutation.SetFeaturedImage(s)
+	return pc
+}
+
+// SetNillableFeaturedImage sets the "featured_image" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableFeaturedImage(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetFeaturedImage(*s)
+	}
+	return pc
+}
+
+// SetGalleryImages sets the "gallery_images" field.
+func (pc *ProjectCreate) SetGalleryImages(pa pq.StringArray) *ProjectCreate {
+	pc.mutation.SetGalleryImages(pa)
+	return pc
+}
+
+// SetTechnologies sets the "technologies" field.
+func (pc *ProjectCreate) SetTechnologies(pa pq.StringArray) *ProjectCreate {
+	pc.mutation.SetTechnologies(pa)
+	return pc
+}
+
+// SetProjectURL sets the "project_url" field.
+func (pc *ProjectCreate) SetProjectURL(s string) *ProjectCreate {
+	pc.mutation.SetProjectURL(s)
+	return pc
+}
+
+// SetNillableProjectURL sets the "project_url" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableProjectURL(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetProjectURL(*s)
+	}
+	return pc
+}
+
+// SetGithubURL sets the "github_url" field.
+func (pc *ProjectCreate) SetGithubURL(s string) *ProjectCreate {
+	pc.mutation.SetGithubURL(s)
+	return pc
+}
+
+// SetNillableGithubURL sets the "github_url" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableGithubURL(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetGithubURL(*s)
+	}
+	return pc
+}
+
+// SetDemoURL sets the "demo_url" field.
+func (pc *ProjectCreate) SetDemoURL(s string) *ProjectCreate {
+	pc.mutation.SetDemoURL(s)
+	return pc
+}
+
+// SetNillableDemoURL sets the "demo_url" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableDemoURL(s *string) *ProjectCreate {
+	if s != nil {
+		pc.SetDemoURL(*s)
+	}
+	return pc
+}
+
+// SetStatus sets the "status" field.
+func (pc *ProjectCreate) SetStatus(pr project.Status) *ProjectCreate {
+	pc.mutation.SetStatus(pr)
+	return pc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableStatus(pr *project.Status) *ProjectCreate {
+	if pr != nil {
+		pc.SetStatus(*pr)
+	}
+	return pc
+}
+
+// SetStartDate sets the "start_date" field.
+func (pc *ProjectCreate) SetStartDate(t time.Time) *ProjectCreate {
+	pc.mutation.SetStartDate(t)
+	return pc
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableStartDate(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetStartDate(*t)
+	}
+	return pc
+}
+
+// SetEndDate sets the "end_date" field.
+func (pc *ProjectCreate) SetEndDate(t time.Time) *ProjectCreate {
+	pc.mutation.SetEndDate(t)
+	return pc
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableEndDate(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetEndDate(*t)
+	}
+	return pc
+}
+
+// SetIsFeatured sets the "is_featured" field.
+func (pc *ProjectCreate) SetIsFeatured(b bool) *ProjectCreate {
+	pc.mutation.SetIsFeatured(b)
+	return pc
+}
+
+// SetNillableIsFeatured sets the "is_featured" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableIsFeatured(b *bool) *ProjectCreate {
+	if b != nil {
+		pc.SetIsFeatured(*b)
+	}
+	return pc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (pc *ProjectCreate) SetSortOrder(i int) *ProjectCreate {
+	pc.mutation.SetSortOrder(i)
+	return pc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableSortOrder(i *int) *ProjectCreate {
+	if i != nil {
+		pc.SetSortOrder(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCreatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProjectCreate) SetUpdatedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableUpdatedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (pc *ProjectCreate) Mutation() *ProjectMutation {
+	return pc.mutation
+}
+
+// Save creates the Project in the database.
+func (pc *ProjectCreate) Save(ctx context.Context) (*Project, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProjectCreate) SaveX(ctx context.Context) *Project {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProjectCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProjectCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProjectCreate) defaults() {
+	if _, ok := pc.mutation.Status(); !ok {
+		v := project.DefaultStatus
+		pc.mutation.SetStatus(v)
+	}
+	if _, ok := pc.mutation.IsFeatured(); !ok {
+		v := project.DefaultIsFeatured
+		pc.mutation.SetIsFeatured(v)
+	}
+	if _, ok := pc.mutation.SortOrder(); !ok {
+		v := project.DefaultSortOrder
+		pc.mutation.SetSortOrder(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := project.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := project.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProjectCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Project.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Project.slug"`)}
+	}
+	if v, ok := pc.mutation.Slug(); ok {
+		if err := project.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Project.slug": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Project.description"`)}
+	}
+	if v, ok := pc.mutation.Description(); ok {
+		if err := project.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Project.description": %w`, err)}
+		}
+	}
+	if v, ok := pc.mutation.ShortDescription(); ok {
+		if err := project.ShortDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "short_description", err: fmt.Errorf(`ent: validator failed for field "Project.short_description": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Project.status"`)}
+	}
+	if v, ok := pc.mutation.Status(); ok {
+		if err := project.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Project.status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.IsFeatured(); !ok {
+		return &ValidationError{Name: "is_featured", err: errors.New(`ent: missing required field "Project.is_featured"`)}
+	}
+	if _, ok := pc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Project.sort_order"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Project.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *ProjectCreate) sqlSave(ctx context.Context) (*Project, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Project{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(project.Table, sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Slug(); ok {
+		_spec.SetField(project.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := pc.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := pc.mutation.ShortDescription(); ok {
+		_spec.SetField(project.FieldShortDescription, field.TypeString, value)
+		_node.ShortDescription = &value
+	}
+	if value, ok := pc.mutation.FeaturedImage(); ok {
+		_spec.SetField(project.FieldFeaturedImage, field.TypeString, value)
+		_node.FeaturedImage = &value
+	}
+	if value, ok := pc.mutation.GalleryImages(); ok {
+		_spec.SetField(project.FieldGalleryImages, field.TypeOther, value)
+		_node.GalleryImages = value
+	}
+	if value, ok := pc.mutation.Technologies(); ok {
+		_spec.SetField(project.FieldTechnologies, field.TypeOther, value)
+		_node.Technologies = value
+	}
+	if value, ok := pc.mutation.ProjectURL(); ok {
+		_spec.SetField(project.FieldProjectURL, field.TypeString, value)
+		_node.ProjectURL = &value
+	}
+	if value, ok := pc.mutation.GithubURL(); ok {
+		_spec.SetField(project.FieldGithubURL, field.TypeString, value)
+		_node.GithubURL = &value
+	}
+	if value, ok := pc.mutation.DemoURL(); ok {
+		_spec.SetField(project.FieldDemoURL, field.TypeString, value)
+		_node.DemoURL = &value
+	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pc.mutation.StartDate(); ok {
+		_spec.SetField(project.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = &value
+	}
+	if value, ok := pc.mutation.EndDate(); ok {
+		_spec.SetField(project.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if value, ok := pc.mutation.IsFeatured(); ok {
+		_spec.SetField(project.FieldIsFeatured, field.TypeBool, value)
+		_node.IsFeatured = value
+	}
+	if value, ok := pc.mutation.SortOrder(); ok {
+		_spec.SetField(project.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProjectCreateBulk is the builder for creating many Project entities in bulk.
+type ProjectCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectCreate
+}
+
+// Save creates the Project entities in the database.
+func (pcb *ProjectCreateBulk) Save(ctx context.Context) ([]*Project, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Project, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) SaveX(ctx context.Context) []*Project {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProjectCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
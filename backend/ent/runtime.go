@@ -4,54 +4,639 @@ package ent
 
 import (
 	"time"
+	"zplus_web/backend/ent/blogcategory"
+	"zplus_web/backend/ent/blogpost"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/order"
+	"zplus_web/backend/ent/orderitem"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+	"zplus_web/backend/ent/productfile"
+	"zplus_web/backend/ent/project"
 	"zplus_web/backend/ent/schema"
 	"zplus_web/backend/ent/user"
-
-	"github.com/google/uuid"
+	"zplus_web/backend/ent/wallettransaction"
+	"zplus_web/backend/ent/wordpresssite"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	blogcategoryFields := schema.BlogCategory{}.Fields()
+	_ = blogcategoryFields
+	// blogcategoryDescName is the schema descriptor for name field.
+	blogcategoryDescName := blogcategoryFields[0].Descriptor()
+	// blogcategory.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	blogcategory.NameValidator = func() func(string) error {
+		validators := blogcategoryDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogcategoryDescSlug is the schema descriptor for slug field.
+	blogcategoryDescSlug := blogcategoryFields[1].Descriptor()
+	// blogcategory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	blogcategory.SlugValidator = func() func(string) error {
+		validators := blogcategoryDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogcategoryDescCreatedAt is the schema descriptor for created_at field.
+	blogcategoryDescCreatedAt := blogcategoryFields[3].Descriptor()
+	// blogcategory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blogcategory.DefaultCreatedAt = blogcategoryDescCreatedAt.Default.(func() time.Time)
+	blogpostFields := schema.BlogPost{}.Fields()
+	_ = blogpostFields
+	// blogpostDescTitle is the schema descriptor for title field.
+	blogpostDescTitle := blogpostFields[0].Descriptor()
+	// blogpost.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	blogpost.TitleValidator = func() func(string) error {
+		validators := blogpostDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogpostDescSlug is the schema descriptor for slug field.
+	blogpostDescSlug := blogpostFields[1].Descriptor()
+	// blogpost.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	blogpost.SlugValidator = func() func(string) error {
+		validators := blogpostDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blogpostDescContent is the schema descriptor for content field.
+	blogpostDescContent := blogpostFields[2].Descriptor()
+	// blogpost.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	blogpost.ContentValidator = blogpostDescContent.Validators[0].(func(string) error)
+	// blogpostDescIsFeatured is the schema descriptor for is_featured field.
+	blogpostDescIsFeatured := blogpostFields[7].Descriptor()
+	// blogpost.DefaultIsFeatured holds the default value on creation for the is_featured field.
+	blogpost.DefaultIsFeatured = blogpostDescIsFeatured.Default.(bool)
+	// blogpostDescViewCount is the schema descriptor for view_count field.
+	blogpostDescViewCount := blogpostFields[8].Descriptor()
+	// blogpost.DefaultViewCount holds the default value on creation for the view_count field.
+	blogpost.DefaultViewCount = blogpostDescViewCount.Default.(int)
+	// blogpostDescCreatedAt is the schema descriptor for created_at field.
+	blogpostDescCreatedAt := blogpostFields[10].Descriptor()
+	// blogpost.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blogpost.DefaultCreatedAt = blogpostDescCreatedAt.Default.(func() time.Time)
+	// blogpostDescUpdatedAt is the schema descriptor for updated_at field.
+	blogpostDescUpdatedAt := blogpostFields[11].Descriptor()
+	// blogpost.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blogpost.DefaultUpdatedAt = blogpostDescUpdatedAt.Default.(func() time.Time)
+	// blogpost.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blogpost.UpdateDefaultUpdatedAt = blogpostDescUpdatedAt.UpdateDefault.(func() time.Time)
+	contentsynclogFields := schema.ContentSyncLog{}.Fields()
+	_ = contentsynclogFields
+	// contentsynclogDescSyncType is the schema descriptor for sync_type field.
+	contentsynclogDescSyncType := contentsynclogFields[1].Descriptor()
+	// contentsynclog.SyncTypeValidator is a validator for the "sync_type" field. It is called by the builders before save.
+	contentsynclog.SyncTypeValidator = func() func(string) error {
+		validators := contentsynclogDescSyncType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(sync_type string) error {
+			for _, fn := range fns {
+				if err := fn(sync_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// contentsynclogDescCreatedAt is the schema descriptor for created_at field.
+	contentsynclogDescCreatedAt := contentsynclogFields[6].Descriptor()
+	// contentsynclog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentsynclog.DefaultCreatedAt = contentsynclogDescCreatedAt.Default.(func() time.Time)
+	orderFields := schema.Order{}.Fields()
+	_ = orderFields
+	// orderDescOrderNumber is the schema descriptor for order_number field.
+	orderDescOrderNumber := orderFields[0].Descriptor()
+	// order.OrderNumberValidator is a validator for the "order_number" field. It is called by the builders before save.
+	order.OrderNumberValidator = func() func(string) error {
+		validators := orderDescOrderNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(order_number string) error {
+			for _, fn := range fns {
+				if err := fn(order_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderDescTotalAmount is the schema descriptor for total_amount field.
+	orderDescTotalAmount := orderFields[2].Descriptor()
+	// order.TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
+	order.TotalAmountValidator = orderDescTotalAmount.Validators[0].(func(float64) error)
+	// orderDescDiscountAmount is the schema descriptor for discount_amount field.
+	orderDescDiscountAmount := orderFields[3].Descriptor()
+	// order.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	order.DefaultDiscountAmount = orderDescDiscountAmount.Default.(float64)
+	// order.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	order.DiscountAmountValidator = orderDescDiscountAmount.Validators[0].(func(float64) error)
+	// orderDescFinalAmount is the schema descriptor for final_amount field.
+	orderDescFinalAmount := orderFields[4].Descriptor()
+	// order.FinalAmountValidator is a validator for the "final_amount" field. It is called by the builders before save.
+	order.FinalAmountValidator = orderDescFinalAmount.Validators[0].(func(float64) error)
+	// orderDescPaymentMethod is the schema descriptor for payment_method field.
+	orderDescPaymentMethod := orderFields[5].Descriptor()
+	// order.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
+	order.PaymentMethodValidator = orderDescPaymentMethod.Validators[0].(func(string) error)
+	// orderDescCreatedAt is the schema descriptor for created_at field.
+	orderDescCreatedAt := orderFields[9].Descriptor()
+	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
+	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
+	// orderDescUpdatedAt is the schema descriptor for updated_at field.
+	orderDescUpdatedAt := orderFields[10].Descriptor()
+	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
+	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	orderitemFields := schema.OrderItem{}.Fields()
+	_ = orderitemFields
+	// orderitemDescProductName is the schema descriptor for product_name field.
+	orderitemDescProductName := orderitemFields[2].Descriptor()
+	// orderitem.ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
+	orderitem.ProductNameValidator = func() func(string) error {
+		validators := orderitemDescProductName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(product_name string) error {
+			for _, fn := range fns {
+				if err := fn(product_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderitemDescPrice is the schema descriptor for price field.
+	orderitemDescPrice := orderitemFields[3].Descriptor()
+	// orderitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	orderitem.PriceValidator = orderitemDescPrice.Validators[0].(func(float64) error)
+	// orderitemDescQuantity is the schema descriptor for quantity field.
+	orderitemDescQuantity := orderitemFields[4].Descriptor()
+	// orderitem.DefaultQuantity holds the default value on creation for the quantity field.
+	orderitem.DefaultQuantity = orderitemDescQuantity.Default.(int)
+	// orderitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	orderitem.QuantityValidator = orderitemDescQuantity.Validators[0].(func(int) error)
+	// orderitemDescCreatedAt is the schema descriptor for created_at field.
+	orderitemDescCreatedAt := orderitemFields[5].Descriptor()
+	// orderitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderitem.DefaultCreatedAt = orderitemDescCreatedAt.Default.(func() time.Time)
+	productFields := schema.Product{}.Fields()
+	_ = productFields
+	// productDescName is the schema descriptor for name field.
+	productDescName := productFields[0].Descriptor()
+	// product.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	product.NameValidator = func() func(string) error {
+		validators := productDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productDescSlug is the schema descriptor for slug field.
+	productDescSlug := productFields[1].Descriptor()
+	// product.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	product.SlugValidator = func() func(string) error {
+		validators := productDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productDescDescription is the schema descriptor for description field.
+	productDescDescription := productFields[2].Descriptor()
+	// product.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	product.DescriptionValidator = productDescDescription.Validators[0].(func(string) error)
+	// productDescShortDescription is the schema descriptor for short_description field.
+	productDescShortDescription := productFields[3].Descriptor()
+	// product.ShortDescriptionValidator is a validator for the "short_description" field. It is called by the builders before save.
+	product.ShortDescriptionValidator = productDescShortDescription.Validators[0].(func(string) error)
+	// productDescPrice is the schema descriptor for price field.
+	productDescPrice := productFields[6].Descriptor()
+	// product.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	product.PriceValidator = productDescPrice.Validators[0].(func(float64) error)
+	// productDescDiscountPrice is the schema descriptor for discount_price field.
+	productDescDiscountPrice := productFields[7].Descriptor()
+	// product.DiscountPriceValidator is a validator for the "discount_price" field. It is called by the builders before save.
+	product.DiscountPriceValidator = productDescDiscountPrice.Validators[0].(func(float64) error)
+	// productDescVersion is the schema descriptor for version field.
+	productDescVersion := productFields[8].Descriptor()
+	// product.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	product.VersionValidator = productDescVersion.Validators[0].(func(string) error)
+	// productDescDownloadCount is the schema descriptor for download_count field.
+	productDescDownloadCount := productFields[14].Descriptor()
+	// product.DefaultDownloadCount holds the default value on creation for the download_count field.
+	product.DefaultDownloadCount = productDescDownloadCount.Default.(int)
+	// productDescIsActive is the schema descriptor for is_active field.
+	productDescIsActive := productFields[15].Descriptor()
+	// product.DefaultIsActive holds the default value on creation for the is_active field.
+	product.DefaultIsActive = productDescIsActive.Default.(bool)
+	// productDescIsFeatured is the schema descriptor for is_featured field.
+	productDescIsFeatured := productFields[16].Descriptor()
+	// product.DefaultIsFeatured holds the default value on creation for the is_featured field.
+	product.DefaultIsFeatured = productDescIsFeatured.Default.(bool)
+	// productDescCreatedAt is the schema descriptor for created_at field.
+	productDescCreatedAt := productFields[17].Descriptor()
+	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
+	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
+	// productDescUpdatedAt is the schema descriptor for updated_at field.
+	productDescUpdatedAt := productFields[18].Descriptor()
+	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
+	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productcategoryFields := schema.ProductCategory{}.Fields()
+	_ = productcategoryFields
+	// productcategoryDescName is the schema descriptor for name field.
+	productcategoryDescName := productcategoryFields[0].Descriptor()
+	// productcategory.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	productcategory.NameValidator = func() func(string) error {
+		validators := productcategoryDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productcategoryDescSlug is the schema descriptor for slug field.
+	productcategoryDescSlug := productcategoryFields[1].Descriptor()
+	// productcategory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	productcategory.SlugValidator = func() func(string) error {
+		validators := productcategoryDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productcategoryDescCreatedAt is the schema descriptor for created_at field.
+	productcategoryDescCreatedAt := productcategoryFields[4].Descriptor()
+	// productcategory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productcategory.DefaultCreatedAt = productcategoryDescCreatedAt.Default.(func() time.Time)
+	productfileFields := schema.ProductFile{}.Fields()
+	_ = productfileFields
+	// productfileDescFilename is the schema descriptor for filename field.
+	productfileDescFilename := productfileFields[1].Descriptor()
+	// productfile.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	productfile.FilenameValidator = func() func(string) error {
+		validators := productfileDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productfileDescOriginalFilename is the schema descriptor for original_filename field.
+	productfileDescOriginalFilename := productfileFields[2].Descriptor()
+	// productfile.OriginalFilenameValidator is a validator for the "original_filename" field. It is called by the builders before save.
+	productfile.OriginalFilenameValidator = func() func(string) error {
+		validators := productfileDescOriginalFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(original_filename string) error {
+			for _, fn := range fns {
+				if err := fn(original_filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productfileDescFilePath is the schema descriptor for file_path field.
+	productfileDescFilePath := productfileFields[3].Descriptor()
+	// productfile.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	productfile.FilePathValidator = func() func(string) error {
+		validators := productfileDescFilePath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(file_path string) error {
+			for _, fn := range fns {
+				if err := fn(file_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productfileDescFileType is the schema descriptor for file_type field.
+	productfileDescFileType := productfileFields[5].Descriptor()
+	// productfile.FileTypeValidator is a validator for the "file_type" field. It is called by the builders before save.
+	productfile.FileTypeValidator = productfileDescFileType.Validators[0].(func(string) error)
+	// productfileDescIsMain is the schema descriptor for is_main field.
+	productfileDescIsMain := productfileFields[6].Descriptor()
+	// productfile.DefaultIsMain holds the default value on creation for the is_main field.
+	productfile.DefaultIsMain = productfileDescIsMain.Default.(bool)
+	// productfileDescCreatedAt is the schema descriptor for created_at field.
+	productfileDescCreatedAt := productfileFields[7].Descriptor()
+	// productfile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productfile.DefaultCreatedAt = productfileDescCreatedAt.Default.(func() time.Time)
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[0].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = func() func(string) error {
+		validators := projectDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// projectDescSlug is the schema descriptor for slug field.
+	projectDescSlug := projectFields[1].Descriptor()
+	// project.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	project.SlugValidator = func() func(string) error {
+		validators := projectDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// projectDescDescription is the schema descriptor for description field.
+	projectDescDescription := projectFields[2].Descriptor()
+	// project.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	project.DescriptionValidator = projectDescDescription.Validators[0].(func(string) error)
+	// projectDescShortDescription is the schema descriptor for short_description field.
+	projectDescShortDescription := projectFields[3].Descriptor()
+	// project.ShortDescriptionValidator is a validator for the "short_description" field. It is called by the builders before save.
+	project.ShortDescriptionValidator = projectDescShortDescription.Validators[0].(func(string) error)
+	// projectDescIsFeatured is the schema descriptor for is_featured field.
+	projectDescIsFeatured := projectFields[13].Descriptor()
+	// project.DefaultIsFeatured holds the default value on creation for the is_featured field.
+	project.DefaultIsFeatured = projectDescIsFeatured.Default.(bool)
+	// projectDescSortOrder is the schema descriptor for sort_order field.
+	projectDescSortOrder := projectFields[14].Descriptor()
+	// project.DefaultSortOrder holds the default value on creation for the sort_order field.
+	project.DefaultSortOrder = projectDescSortOrder.Default.(int)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[15].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[16].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = func() func(string) error {
+		validators := userDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// userDescEmail is the schema descriptor for email field.
 	userDescEmail := userFields[1].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
-	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[2].Descriptor()
-	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
-	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	user.EmailValidator = func() func(string) error {
+		validators := userDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[3].Descriptor()
+	userDescPasswordHash := userFields[2].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescRole is the schema descriptor for role field.
+	userDescRole := userFields[3].Descriptor()
+	// user.DefaultRole holds the default value on creation for the role field.
+	user.DefaultRole = userDescRole.Default.(string)
+	// user.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	user.RoleValidator = userDescRole.Validators[0].(func(string) error)
+	// userDescIsActive is the schema descriptor for is_active field.
+	userDescIsActive := userFields[7].Descriptor()
+	// user.DefaultIsActive holds the default value on creation for the is_active field.
+	user.DefaultIsActive = userDescIsActive.Default.(bool)
 	// userDescEmailVerified is the schema descriptor for email_verified field.
-	userDescEmailVerified := userFields[7].Descriptor()
+	userDescEmailVerified := userFields[8].Descriptor()
 	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
-	// userDescPoints is the schema descriptor for points field.
-	userDescPoints := userFields[8].Descriptor()
-	// user.DefaultPoints holds the default value on creation for the points field.
-	user.DefaultPoints = userDescPoints.Default.(int)
-	// userDescWalletBalance is the schema descriptor for wallet_balance field.
-	userDescWalletBalance := userFields[9].Descriptor()
-	// user.DefaultWalletBalance holds the default value on creation for the wallet_balance field.
-	user.DefaultWalletBalance = userDescWalletBalance.Default.(float64)
+	// userDescIsServiceAccount is the schema descriptor for is_service_account field.
+	userDescIsServiceAccount := userFields[9].Descriptor()
+	// user.DefaultIsServiceAccount holds the default value on creation for the is_service_account field.
+	user.DefaultIsServiceAccount = userDescIsServiceAccount.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[10].Descriptor()
+	userDescCreatedAt := userFields[11].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[11].Descriptor()
+	userDescUpdatedAt := userFields[12].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	wallettransactionFields := schema.WalletTransaction{}.Fields()
+	_ = wallettransactionFields
+	// wallettransactionDescReferenceID is the schema descriptor for reference_id field.
+	wallettransactionDescReferenceID := wallettransactionFields[5].Descriptor()
+	// wallettransaction.ReferenceIDValidator is a validator for the "reference_id" field. It is called by the builders before save.
+	wallettransaction.ReferenceIDValidator = wallettransactionDescReferenceID.Validators[0].(func(string) error)
+	// wallettransactionDescCreatedAt is the schema descriptor for created_at field.
+	wallettransactionDescCreatedAt := wallettransactionFields[7].Descriptor()
+	// wallettransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallettransaction.DefaultCreatedAt = wallettransactionDescCreatedAt.Default.(func() time.Time)
+	wordpresssiteFields := schema.WordPressSite{}.Fields()
+	_ = wordpresssiteFields
+	// wordpresssiteDescName is the schema descriptor for name field.
+	wordpresssiteDescName := wordpresssiteFields[0].Descriptor()
+	// wordpresssite.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	wordpresssite.NameValidator = func() func(string) error {
+		validators := wordpresssiteDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// wordpresssiteDescURL is the schema descriptor for url field.
+	wordpresssiteDescURL := wordpresssiteFields[1].Descriptor()
+	// wordpresssite.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	wordpresssite.URLValidator = func() func(string) error {
+		validators := wordpresssiteDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// wordpresssiteDescAPIEndpoint is the schema descriptor for api_endpoint field.
+	wordpresssiteDescAPIEndpoint := wordpresssiteFields[2].Descriptor()
+	// wordpresssite.APIEndpointValidator is a validator for the "api_endpoint" field. It is called by the builders before save.
+	wordpresssite.APIEndpointValidator = func() func(string) error {
+		validators := wordpresssiteDescAPIEndpoint.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(api_endpoint string) error {
+			for _, fn := range fns {
+				if err := fn(api_endpoint); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// wordpresssiteDescUsername is the schema descriptor for username field.
+	wordpresssiteDescUsername := wordpresssiteFields[3].Descriptor()
+	// wordpresssite.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	wordpresssite.UsernameValidator = wordpresssiteDescUsername.Validators[0].(func(string) error)
+	// wordpresssiteDescIsActive is the schema descriptor for is_active field.
+	wordpresssiteDescIsActive := wordpresssiteFields[5].Descriptor()
+	// wordpresssite.DefaultIsActive holds the default value on creation for the is_active field.
+	wordpresssite.DefaultIsActive = wordpresssiteDescIsActive.Default.(bool)
+	// wordpresssiteDescCreatedAt is the schema descriptor for created_at field.
+	wordpresssiteDescCreatedAt := wordpresssiteFields[7].Descriptor()
+	// wordpresssite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wordpresssite.DefaultCreatedAt = wordpresssiteDescCreatedAt.Default.(func() time.Time)
+	// wordpresssiteDescUpdatedAt is the schema descriptor for updated_at field.
+	wordpresssiteDescUpdatedAt := wordpresssiteFields[8].Descriptor()
+	// wordpresssite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wordpresssite.DefaultUpdatedAt = wordpresssiteDescUpdatedAt.Default.(func() time.Time)
+	// wordpresssite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wordpresssite.UpdateDefaultUpdatedAt = wordpresssiteDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -4,10 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
-	"github.com/google/uuid"
 )
 
 // BlogPost holds the schema definition for the BlogPost entity.
@@ -15,38 +16,45 @@ type BlogPost struct {
 	ent.Schema
 }
 
+// Annotations of the BlogPost.
+func (BlogPost) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "blog_posts"},
+	}
+}
+
 // Fields of the BlogPost.
 func (BlogPost) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
 		field.String("title").
+			MaxLen(255).
 			NotEmpty(),
 		field.String("slug").
+			MaxLen(255).
 			Unique().
 			NotEmpty(),
 		field.Text("content").
 			NotEmpty(),
 		field.Text("excerpt").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.String("featured_image").
-			Optional(),
-		field.JSON("meta_tags", []string{}).
-			Optional(),
-		field.String("meta_description").
-			Optional(),
+			Optional().
+			Nillable(),
+		// Set to NULL when the author is deleted
+		field.Int("author_id").
+			Optional().
+			Nillable(),
 		field.Enum("status").
-			Values("draft", "published", "archived").
+			Values("draft", "published", "private").
 			Default("draft"),
-		field.Bool("featured").
+		field.Bool("is_featured").
 			Default(false),
 		field.Int("view_count").
 			Default(0),
-		field.Int("like_count").
-			Default(0),
 		field.Time("published_at").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -61,20 +69,24 @@ func (BlogPost) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("author", User.Type).
 			Ref("blog_posts").
+			Field("author_id").
 			Unique(),
-		edge.From("category", BlogCategory.Type).
-			Ref("blog_posts").
-			Unique(),
+		// A post can be in several categories, through the blog_post_categories join table
+		edge.To("categories", BlogCategory.Type).
+			StorageKey(
+				edge.Table("blog_post_categories"),
+				edge.Columns("post_id", "category_id"),
+				edge.Symbols("blog_post_categories_post_id_fkey", "blog_post_categories_category_id_fkey"),
+			),
 	}
 }
 
 // Indexes of the BlogPost.
 func (BlogPost) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("slug"),
-		index.Fields("status"),
-		index.Fields("published_at"),
-		index.Fields("created_at"),
-		index.Fields("featured"),
+		index.Fields("status").
+			StorageKey("idx_blog_posts_status"),
+		index.Fields("published_at").
+			StorageKey("idx_blog_posts_published_at"),
 	}
 }
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ContentSyncLog holds the schema definition for the ContentSyncLog entity,
+// one content transfer between the site and a WordPress site.
+type ContentSyncLog struct {
+	ent.Schema
+}
+
+// Annotations of the ContentSyncLog.
+func (ContentSyncLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "content_sync_logs"},
+	}
+}
+
+// Fields of the ContentSyncLog.
+func (ContentSyncLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("site_id"),
+		// e.g. post_create, post_update, post_sync
+		field.String("sync_type").
+			MaxLen(50).
+			NotEmpty(),
+		field.Int("local_content_id").
+			Optional().
+			Nillable(),
+		field.Int("remote_content_id").
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("pending", "success", "failed").
+			Default("pending"),
+		field.Text("error_message").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ContentSyncLog.
+func (ContentSyncLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("site", WordPressSite.Type).
+			Ref("sync_logs").
+			Field("site_id").
+			Unique().
+			Required(),
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
-	"github.com/google/uuid"
 )
 
 // Order holds the schema definition for the Order entity.
@@ -15,52 +16,47 @@ type Order struct {
 	ent.Schema
 }
 
+// Annotations of the Order.
+func (Order) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "orders"},
+	}
+}
+
 // Fields of the Order.
 func (Order) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
 		field.String("order_number").
+			MaxLen(50).
 			Unique().
 			NotEmpty(),
-		field.Enum("status").
-			Values("pending", "processing", "shipped", "delivered", "cancelled", "refunded").
-			Default("pending"),
-		field.Float("subtotal").
-			Min(0),
-		field.Float("tax_amount").
-			Default(0).
-			Min(0),
-		field.Float("shipping_amount").
-			Default(0).
+		// Set to NULL when the customer is deleted
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.Float("total_amount").
+			SchemaType(money).
 			Min(0),
 		field.Float("discount_amount").
+			SchemaType(money).
 			Default(0).
 			Min(0),
-		field.Float("total_amount").
+		field.Float("final_amount").
+			SchemaType(money).
 			Min(0),
-		field.String("currency").
-			Default("USD"),
-		field.JSON("billing_address", map[string]interface{}{}).
-			Optional(),
-		field.JSON("shipping_address", map[string]interface{}{}).
-			Optional(),
 		field.String("payment_method").
-			Optional(),
+			MaxLen(50).
+			Optional().
+			Nillable(),
 		field.Enum("payment_status").
 			Values("pending", "paid", "failed", "refunded").
 			Default("pending"),
-		field.String("shipping_method").
-			Optional(),
-		field.String("tracking_number").
-			Optional(),
+		field.Enum("order_status").
+			Values("processing", "completed", "cancelled").
+			Default("processing"),
 		field.Text("notes").
-			Optional(),
-		field.Time("shipped_at").
-			Optional(),
-		field.Time("delivered_at").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -73,21 +69,22 @@ func (Order) Fields() []ent.Field {
 // Edges of the Order.
 func (Order) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("customer", User.Type).
+		edge.From("user", User.Type).
 			Ref("orders").
-			Unique().
-			Required(),
-		edge.To("order_items", OrderItem.Type),
-		edge.To("payments", Payment.Type),
+			Field("user_id").
+			Unique(),
+		edge.To("items", OrderItem.Type).
+			StorageKey(edge.Symbol("order_items_order_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 // Indexes of the Order.
 func (Order) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("order_number"),
-		index.Fields("status"),
-		index.Fields("payment_status"),
-		index.Fields("created_at"),
+		index.Fields("user_id").
+			StorageKey("idx_orders_user_id"),
+		index.Fields("order_status").
+			StorageKey("idx_orders_status"),
 	}
 }
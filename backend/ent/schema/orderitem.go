@@ -4,9 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"github.com/google/uuid"
 )
 
 // OrderItem holds the schema definition for the OrderItem entity.
@@ -14,20 +15,29 @@ type OrderItem struct {
 	ent.Schema
 }
 
+// Annotations of the OrderItem.
+func (OrderItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "order_items"},
+	}
+}
+
 // Fields of the OrderItem.
 func (OrderItem) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
+		field.Int("order_id"),
+		field.Int("product_id").
+			Optional().
+			Nillable(),
+		field.String("product_name").
+			MaxLen(255).
+			NotEmpty(),
+		field.Float("price").
+			SchemaType(money).
+			Min(0),
 		field.Int("quantity").
+			Default(1).
 			Min(1),
-		field.Float("unit_price").
-			Min(0),
-		field.Float("total_price").
-			Min(0),
-		field.JSON("product_snapshot", map[string]interface{}{}).
-			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -38,12 +48,13 @@ func (OrderItem) Fields() []ent.Field {
 func (OrderItem) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("order", Order.Type).
-			Ref("order_items").
+			Ref("items").
+			Field("order_id").
 			Unique().
 			Required(),
 		edge.From("product", Product.Type).
 			Ref("order_items").
-			Unique().
-			Required(),
+			Field("product_id").
+			Unique(),
 	}
 }
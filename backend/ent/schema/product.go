@@ -4,70 +4,83 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
-	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-// Product holds the schema definition for the Product entity.
+// Product holds the schema definition for the Product entity, a software
+// product for sale.
 type Product struct {
 	ent.Schema
 }
 
+// Annotations of the Product.
+func (Product) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "software_products"},
+	}
+}
+
 // Fields of the Product.
 func (Product) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
 		field.String("name").
+			MaxLen(255).
 			NotEmpty(),
 		field.String("slug").
+			MaxLen(255).
 			Unique().
 			NotEmpty(),
 		field.Text("description").
 			NotEmpty(),
-		field.Text("short_description").
-			Optional(),
+		field.String("short_description").
+			MaxLen(500).
+			Optional().
+			Nillable(),
 		field.String("featured_image").
-			Optional(),
-		field.JSON("gallery", []string{}).
+			Optional().
+			Nillable(),
+		field.Other("gallery_images", pq.StringArray{}).
+			SchemaType(textArray).
 			Optional(),
 		field.Float("price").
+			SchemaType(money).
 			Min(0),
-		field.Float("sale_price").
+		field.Float("discount_price").
+			SchemaType(money).
 			Optional().
+			Nillable().
 			Min(0),
-		field.String("sku").
-			Unique().
-			Optional(),
-		field.Int("stock_quantity").
-			Default(0).
-			Min(0),
-		field.Bool("manage_stock").
-			Default(true),
-		field.Enum("stock_status").
-			Values("in_stock", "out_of_stock", "on_backorder").
-			Default("in_stock"),
-		field.Enum("status").
-			Values("draft", "published", "archived").
-			Default("draft"),
-		field.JSON("attributes", map[string]interface{}{}).
-			Optional(),
-		field.Float("weight").
+		field.String("version").
+			MaxLen(50).
 			Optional().
-			Min(0),
-		field.JSON("dimensions", map[string]float64{}).
+			Nillable(),
+		field.Text("requirements").
+			Optional().
+			Nillable(),
+		field.Other("features", pq.StringArray{}).
+			SchemaType(textArray).
 			Optional(),
+		field.Int("category_id").
+			Optional().
+			Nillable(),
 		field.String("download_url").
-			Optional(),
-		field.Bool("is_digital").
-			Default(false),
-		field.Bool("featured").
-			Default(false),
-		field.Int("sales_count").
+			Optional().
+			Nillable(),
+		// In bytes
+		field.Int64("file_size").
+			Optional().
+			Nillable(),
+		field.Int("download_count").
 			Default(0),
+		field.Bool("is_active").
+			Default(true),
+		field.Bool("is_featured").
+			Default(false),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -80,18 +93,24 @@ func (Product) Fields() []ent.Field {
 // Edges of the Product.
 func (Product) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("order_items", OrderItem.Type),
+		edge.From("category", ProductCategory.Type).
+			Ref("products").
+			Field("category_id").
+			Unique(),
+		edge.To("files", ProductFile.Type).
+			StorageKey(edge.Symbol("product_files_product_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Items keep the product name and price after the product is deleted
+		edge.To("order_items", OrderItem.Type).
+			StorageKey(edge.Symbol("order_items_product_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
 // Indexes of the Product.
 func (Product) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("slug"),
-		index.Fields("sku"),
-		index.Fields("status"),
-		index.Fields("featured"),
-		index.Fields("price"),
-		index.Fields("created_at"),
+		index.Fields("is_active").
+			StorageKey("idx_software_products_active"),
 	}
 }
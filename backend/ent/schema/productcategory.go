@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductCategory holds the schema definition for the ProductCategory entity.
+type ProductCategory struct {
+	ent.Schema
+}
+
+// Annotations of the ProductCategory.
+func (ProductCategory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "product_categories"},
+	}
+}
+
+// Fields of the ProductCategory.
+func (ProductCategory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("slug").
+			MaxLen(100).
+			Unique().
+			NotEmpty(),
+		field.Text("description").
+			Optional().
+			Nillable(),
+		field.Int("parent_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductCategory.
+func (ProductCategory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", ProductCategory.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("products", Product.Type).
+			StorageKey(edge.Symbol("software_products_category_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductFile holds the schema definition for the ProductFile entity, a
+// downloadable file of a product.
+type ProductFile struct {
+	ent.Schema
+}
+
+// Annotations of the ProductFile.
+func (ProductFile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "product_files"},
+	}
+}
+
+// Fields of the ProductFile.
+func (ProductFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("product_id"),
+		field.String("filename").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("original_filename").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("file_path").
+			MaxLen(500).
+			NotEmpty(),
+		field.Int64("file_size"),
+		field.String("file_type").
+			MaxLen(50).
+			Optional().
+			Nillable(),
+		field.Bool("is_main").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ProductFile.
+func (ProductFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("files").
+			Field("product_id").
+			Unique().
+			Required(),
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
-	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
-	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // Project holds the schema definition for the Project entity.
@@ -15,48 +16,61 @@ type Project struct {
 	ent.Schema
 }
 
+// Annotations of the Project.
+func (Project) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "projects"},
+	}
+}
+
 // Fields of the Project.
 func (Project) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
-		field.String("title").
+		field.String("name").
+			MaxLen(255).
 			NotEmpty(),
 		field.String("slug").
+			MaxLen(255).
 			Unique().
 			NotEmpty(),
 		field.Text("description").
 			NotEmpty(),
-		field.Text("content").
-			Optional(),
+		field.String("short_description").
+			MaxLen(500).
+			Optional().
+			Nillable(),
 		field.String("featured_image").
+			Optional().
+			Nillable(),
+		field.Other("gallery_images", pq.StringArray{}).
+			SchemaType(textArray).
 			Optional(),
-		field.JSON("gallery", []string{}).
-			Optional(),
-		field.JSON("technologies", []string{}).
+		field.Other("technologies", pq.StringArray{}).
+			SchemaType(textArray).
 			Optional(),
+		field.String("project_url").
+			Optional().
+			Nillable(),
 		field.String("github_url").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.String("demo_url").
-			Optional(),
-		field.String("client_name").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.Enum("status").
-			Values("planning", "in_progress", "completed", "on_hold").
-			Default("planning"),
-		field.Enum("project_type").
-			Values("web", "mobile", "desktop", "api", "devops", "design").
-			Default("web"),
-		field.Float("price").
-			Optional(),
+			Values("planning", "development", "completed", "maintenance").
+			Default("development"),
 		field.Time("start_date").
-			Optional(),
+			SchemaType(date).
+			Optional().
+			Nillable(),
 		field.Time("end_date").
-			Optional(),
-		field.Bool("featured").
+			SchemaType(date).
+			Optional().
+			Nillable(),
+		field.Bool("is_featured").
 			Default(false),
-		field.Int("view_count").
+		field.Int("sort_order").
 			Default(0),
 		field.Time("created_at").
 			Default(time.Now).
@@ -67,22 +81,10 @@ func (Project) Fields() []ent.Field {
 	}
 }
 
-// Edges of the Project.
-func (Project) Edges() []ent.Edge {
-	return []ent.Edge{
-		edge.From("owner", User.Type).
-			Ref("projects").
-			Unique(),
-	}
-}
-
 // Indexes of the Project.
 func (Project) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("slug"),
-		index.Fields("status"),
-		index.Fields("project_type"),
-		index.Fields("featured"),
-		index.Fields("created_at"),
+		index.Fields("status").
+			StorageKey("idx_projects_status"),
 	}
 }
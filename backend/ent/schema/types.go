@@ -0,0 +1,12 @@
+package schema
+
+import "entgo.io/ent/dialect"
+
+// Column types of backend/migrations that differ from the ent defaults.
+// The schema is owned by the SQL migrations; these only keep the generated
+// ent/migrate tables faithful to it.
+var (
+	textArray = map[string]string{dialect.Postgres: "text[]"}
+	money     = map[string]string{dialect.Postgres: "decimal(10,2)"}
+	date      = map[string]string{dialect.Postgres: "date"}
+)
@@ -4,6 +4,9 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -13,33 +16,49 @@ type User struct {
 	ent.Schema
 }
 
+// Annotations of the User.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "users"},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.Int("id").
-			Positive(),
-		field.String("email").
+		field.String("username").
+			MaxLen(50).
 			Unique().
 			NotEmpty(),
-		field.String("username").
+		field.String("email").
+			MaxLen(100).
 			Unique().
 			NotEmpty(),
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(),
+		// Name of a row in roles; roles are managed at runtime, so this is not an enum
+		field.String("role").
+			MaxLen(50).
+			Default("user"),
 		field.String("full_name").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.String("phone").
-			Optional(),
+			Optional().
+			Nillable(),
 		field.String("avatar_url").
-			Optional(),
-		field.Enum("role").
-			Values("admin", "user").
-			Default("user"),
+			Optional().
+			Nillable(),
 		field.Bool("is_active").
 			Default(true),
 		field.Bool("email_verified").
 			Default(false),
+		field.Bool("is_service_account").
+			Default(false),
+		field.Time("erased_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -51,13 +70,26 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("blog_posts", BlogPost.Type).
+			StorageKey(edge.Symbol("blog_posts_author_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("orders", Order.Type).
+			StorageKey(edge.Symbol("orders_user_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		// Financial records outlive the account, see user erasure
+		edge.To("wallet_transactions", WalletTransaction.Type).
+			StorageKey(edge.Symbol("wallet_transactions_user_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.Restrict)),
+	}
 }
 
 // Indexes of the User.
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("email"),
-		index.Fields("username"),
+		index.Fields("email").
+			StorageKey("idx_users_email"),
+		index.Fields("role").
+			StorageKey("idx_users_role"),
 	}
 }
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WalletTransaction holds the schema definition for the WalletTransaction
+// entity. Payments are wallet transactions: deposits through a payment
+// gateway and purchases paid from the wallet.
+type WalletTransaction struct {
+	ent.Schema
+}
+
+// Annotations of the WalletTransaction.
+func (WalletTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "wallet_transactions"},
+	}
+}
+
+// Fields of the WalletTransaction.
+func (WalletTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("transaction_type").
+			Values("deposit", "purchase", "refund"),
+		field.Float("amount").
+			SchemaType(money),
+		field.Float("balance_after").
+			SchemaType(money),
+		field.Text("description").
+			Optional().
+			Nillable(),
+		// Order id, payment gateway reference, etc.
+		field.String("reference_id").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("pending", "completed", "failed").
+			Default("completed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WalletTransaction.
+func (WalletTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("wallet_transactions").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WalletTransaction.
+func (WalletTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").
+			StorageKey("idx_wallet_transactions_user_id"),
+	}
+}
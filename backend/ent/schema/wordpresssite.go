@@ -4,9 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"entgo.io/ent/schema/index"
-	"github.com/google/uuid"
 )
 
 // WordPressSite holds the schema definition for the WordPressSite entity.
@@ -14,35 +15,38 @@ type WordPressSite struct {
 	ent.Schema
 }
 
+// Annotations of the WordPressSite.
+func (WordPressSite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "wordpress_sites"},
+	}
+}
+
 // Fields of the WordPressSite.
 func (WordPressSite) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			StorageKey("id"),
 		field.String("name").
+			MaxLen(255).
 			NotEmpty(),
 		field.String("url").
+			MaxLen(255).
 			NotEmpty(),
-		field.String("username").
+		field.String("api_endpoint").
+			MaxLen(255).
 			NotEmpty(),
-		field.String("password").
-			NotEmpty().
-			Sensitive(),
-		field.String("api_key").
+		field.String("username").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+		field.String("application_password").
 			Optional().
+			Nillable().
 			Sensitive(),
 		field.Bool("is_active").
 			Default(true),
-		field.Time("last_sync").
-			Optional(),
-		field.Enum("sync_status").
-			Values("idle", "syncing", "error").
-			Default("idle"),
-		field.Text("sync_logs").
-			Optional(),
-		field.JSON("settings", map[string]interface{}{}).
-			Optional(),
+		field.Time("last_sync_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -54,14 +58,9 @@ func (WordPressSite) Fields() []ent.Field {
 
 // Edges of the WordPressSite.
 func (WordPressSite) Edges() []ent.Edge {
-	return nil
-}
-
-// Indexes of the WordPressSite.
-func (WordPressSite) Indexes() []ent.Index {
-	return []ent.Index{
-		index.Fields("url"),
-		index.Fields("is_active"),
-		index.Fields("sync_status"),
+	return []ent.Edge{
+		edge.To("sync_logs", ContentSyncLog.Type).
+			StorageKey(edge.Symbol("content_sync_logs_site_id_fkey")).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
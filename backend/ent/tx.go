@@ -12,8 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BlogCategory is the client for interacting with the BlogCategory builders.
+	BlogCategory *BlogCategoryClient
+	// BlogPost is the client for interacting with the BlogPost builders.
+	BlogPost *BlogPostClient
+	// ContentSyncLog is the client for interacting with the ContentSyncLog builders.
+	ContentSyncLog *ContentSyncLogClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
+	// OrderItem is the client for interacting with the OrderItem builders.
+	OrderItem *OrderItemClient
+	// Product is the client for interacting with the Product builders.
+	Product *ProductClient
+	// ProductCategory is the client for interacting with the ProductCategory builders.
+	ProductCategory *ProductCategoryClient
+	// ProductFile is the client for interacting with the ProductFile builders.
+	ProductFile *ProductFileClient
+	// Project is the client for interacting with the Project builders.
+	Project *ProjectClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WalletTransaction is the client for interacting with the WalletTransaction builders.
+	WalletTransaction *WalletTransactionClient
+	// WordPressSite is the client for interacting with the WordPressSite builders.
+	WordPressSite *WordPressSiteClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +167,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BlogCategory = NewBlogCategoryClient(tx.config)
+	tx.BlogPost = NewBlogPostClient(tx.config)
+	tx.ContentSyncLog = NewContentSyncLogClient(tx.config)
+	tx.Order = NewOrderClient(tx.config)
+	tx.OrderItem = NewOrderItemClient(tx.config)
+	tx.Product = NewProductClient(tx.config)
+	tx.ProductCategory = NewProductCategoryClient(tx.config)
+	tx.ProductFile = NewProductFileClient(tx.config)
+	tx.Project = NewProjectClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WalletTransaction = NewWalletTransactionClient(tx.config)
+	tx.WordPressSite = NewWordPressSiteClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: BlogCategory.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/user"
+	"zplus_web/backend/ent/wallettransaction"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WalletTransaction is the model entity for the WalletTransaction schema.
+type WalletTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// TransactionType holds the value of the "transaction_type" field.
+	TransactionType wallettransaction.TransactionType `json:"transaction_type,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// BalanceAfter holds the value of the "balance_after" field.
+	BalanceAfter float64 `json:"balance_after,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// ReferenceID holds the value of the "reference_id" field.
+	ReferenceID *string `json:"reference_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status wallettransaction.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WalletTransactionQuery when eager-loading is set.
+	Edges        WalletTransactionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WalletTransactionEdges holds the relations/edges for other nodes in the graph.
+type WalletTransactionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletTransactionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldAmount, wallettransaction.FieldBalanceAfter:
+			values[i] = new(sql.NullFloat64)
+		case wallettransaction.FieldID, wallettransaction.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case wallettransaction.FieldTransactionType, wallettransaction.FieldDescription, wallettransaction.FieldReferenceID, wallettransaction.FieldStatus:
+			values[i] = new(sql.NullString)
+		case wallettransaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletTransaction fields.
+func (wt *WalletTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wallettransaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wt.ID = int(value.Int64)
+		case wallettransaction.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				wt.UserID = int(value.Int64)
+			}
+		case wallettransaction.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				wt.TransactionType = wallettransaction.TransactionType(value.String)
+			}
+		case wallettransaction.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				wt.Amount = value.Float64
+			}
+		case wallettransaction.FieldBalanceAfter:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value.Valid {
+				wt.BalanceAfter = value.Float64
+			}
+		case wallettransaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				wt.Description = new(string)
+				*wt.Description = value.String
+			}
+		case wallettransaction.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				wt.ReferenceID = new(string)
+				*wt.ReferenceID = value.String
+			}
+		case wallettransaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wt.Status = wallettransaction.Status(value.String)
+			}
+		case wallettransaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wt.CreatedAt = value.Time
+			}
+		default:
+			wt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WalletTransaction.
+// This includes values selected through modifiers, order, etc.
+func (wt *WalletTransaction) Value(name string) (ent.Value, error) {
+	return wt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WalletTransaction entity.
+func (wt *WalletTransaction) QueryUser() *UserQuery {
+	return NewWalletTransactionClient(wt.config).QueryUser(wt)
+}
+
+// Update returns a builder for updating this WalletTransaction.
+// Note that you need to call WalletTransaction.Unwrap() before calling this method if this WalletTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wt *WalletTransaction) Update() *WalletTransactionUpdateOne {
+	return NewWalletTransactionClient(wt.config).UpdateOne(wt)
+}
+
+// Unwrap unwraps the WalletTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wt *WalletTransaction) Unwrap() *WalletTransaction {
+	_tx, ok := wt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WalletTransaction is not a transactional entity")
+	}
+	wt.config.driver = _tx.drv
+	return wt
+}
+
+// String implements the fmt.Stringer.
+func (wt *WalletTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wt.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", wt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(fmt.Sprintf("%v", wt.TransactionType))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", wt.BalanceAfter))
+	builder.WriteString(", ")
+	if v := wt.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := wt.ReferenceID; v != nil {
+		builder.WriteString("reference_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wt.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletTransactions is a parsable slice of WalletTransaction.
+type WalletTransactions []*WalletTransaction
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/user"
+	"zplus_web/backend/ent/wallettransaction"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletTransactionCreate is the builder for creating a WalletTransaction entity.
+type WalletTransactionCreate struct {
+	config
+	mutation *WalletTransactionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (wtc *WalletTransactionCreate) SetUserID(i int) *WalletTransactionCreate {
+	wtc.mutation.SetUserID(i)
+	return wtc
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (wtc *WalletTransactionCreate) SetTransactionType(wt wallettransaction.TransactionType) *WalletTransactionCreate {
+	wtc.mutation.SetTransactionType(wt)
+	return wtc
+}
+
+// SetAmount sets the "amount" field.
+func (wtc *WalletTransactionCreate) SetAmount(f float64) *WalletTransactionCreate {
+	wtc.mutation.SetAmount(f)
+	return wtc
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (wtc *WalletTransactionCreate) SetBalanceAfter(f float64) *WalletTransactionCreate {
+	wtc.mutation.SetBalanceAfter(f)
+	return wtc
+}
+
+// SetDescription sets the "description" field.
+func (wtc *WalletTransactionCreate) SetDescription(s string) *WalletTransactionCreate {
+	wtc.mutation.SetDescription(s)
+	return wtc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableDescription(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetDescription(*s)
+	}
+	return wtc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (wtc *WalletTransactionCreate) SetReferenceID(s string) *WalletTransactionCreate {
+	wtc.mutation.SetReferenceID(s)
+	return wtc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableReferenceID(s *string) *WalletTransactionCreate {
+	if s != nil {
+		wtc.SetReferenceID(*s)
+	}
+	return wtc
+}
+
+// SetStatus sets the "status" field.
+func (wtc *WalletTransactionCreate) SetStatus(w wallettransaction.Status) *WalletTransactionCreate {
+	wtc.mutation.SetStatus(w)
+	return wtc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableStatus(w *wallettransaction.Status) *WalletTransactionCreate {
+	if w != nil {
+		wtc.SetStatus(*w)
+	}
+	return wtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wtc *WalletTransactionCreate) SetCreatedAt(t time.Time) *WalletTransactionCreate {
+	wtc.mutation.SetCreatedAt(t)
+	return wtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wtc *WalletTransactionCreate) SetNillableCreatedAt(t *time.Time) *WalletTransactionCreate {
+	if t != nil {
+		wtc.SetCreatedAt(*t)
+	}
+	return wtc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wtc *WalletTransactionCreate) SetUser(u *User) *WalletTransactionCreate {
+	return wtc.SetUserID(u.ID)
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtc *WalletTransactionCreate) Mutation() *WalletTransactionMutation {
+	return wtc.mutation
+}
+
+// Save creates the WalletTransaction in the database.
+func (wtc *WalletTransactionCreate) Save(ctx context.Context) (*WalletTransaction, error) {
+	wtc.defaults()
+	return withHooks(ctx, wtc.sqlSave, wtc.mutation, wtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wtc *WalletTransactionCreate) SaveX(ctx context.Context) *WalletTransaction {
+	v, err := wtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtc *WalletTransactionCreate) Exec(ctx context.Context) error {
+	_, err := wtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtc *WalletTransactionCreate) ExecX(ctx context.Context) {
+	if err := wtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wtc *WalletTransactionCreate) defaults() {
+	if _, ok := wtc.mutation.Status(); !ok {
+		v := wallettransaction.DefaultStatus
+		wtc.mutation.SetStatus(v)
+	}
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		v := wallettransaction.DefaultCreatedAt()
+		wtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtc *WalletTransactionCreate) check() error {
+	if _, ok := wtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WalletTransaction.user_id"`)}
+	}
+	if _, ok := wtc.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "WalletTransaction.transaction_type"`)}
+	}
+	if v, ok := wtc.mutation.TransactionType(); ok {
+		if err := wallettransaction.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.transaction_type": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletTransaction.amount"`)}
+	}
+	if _, ok := wtc.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "WalletTransaction.balance_after"`)}
+	}
+	if v, ok := wtc.mutation.ReferenceID(); ok {
+		if err := wallettransaction.ReferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.reference_id": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WalletTransaction.status"`)}
+	}
+	if v, ok := wtc.mutation.Status(); ok {
+		if err := wallettransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.status": %w`, err)}
+		}
+	}
+	if _, ok := wtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletTransaction.created_at"`)}
+	}
+	if len(wtc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WalletTransaction.user"`)}
+	}
+	return nil
+}
+
+func (wtc *WalletTransactionCreate) sqlSave(ctx context.Context) (*WalletTransaction, error) {
+	if err := wtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wtc.mutation.id = &_node.ID
+	wtc.mutation.done = true
+	return _node, nil
+}
+
+func (wtc *WalletTransactionCreate) createSpec() (*WalletTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletTransaction{config: wtc.config}
+		_spec = sqlgraph.NewCreateSpec(wallettransaction.Table, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	)
+	if value, ok := wtc.mutation.TransactionType(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionType, field.TypeEnum, value)
+		_node.TransactionType = value
+	}
+	if value, ok := wtc.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := wtc.mutation.BalanceAfter(); ok {
+		_spec.SetField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := wtc.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := wtc.mutation.ReferenceID(); ok {
+		_spec.SetField(wallettransaction.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = &value
+	}
+	if value, ok := wtc.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wtc.mutation.CreatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wtc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.UserTable,
+			Columns: []string{wallettransaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WalletTransactionCreateBulk is the builder for creating many WalletTransaction entities in bulk.
+type WalletTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*WalletTransactionCreate
+}
+
+// Save creates the WalletTransaction entities in the database.
+func (wtcb *WalletTransactionCreateBulk) Save(ctx context.Context) ([]*WalletTransaction, error) {
+	if wtcb.err != nil {
+		return nil, wtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wtcb.builders))
+	nodes := make([]*WalletTransaction, len(wtcb.builders))
+	mutators := make([]Mutator, len(wtcb.builders))
+	for i := range wtcb.builders {
+		func(i int, root context.Context) {
+			builder := wtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtcb *WalletTransactionCreateBulk) SaveX(ctx context.Context) []*WalletTransaction {
+	v, err := wtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wtcb *WalletTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtcb *WalletTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := wtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/user"
+	"zplus_web/backend/ent/wallettransaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WalletTransactionUpdate is the builder for updating WalletTransaction entities.
+type WalletTransactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (wtu *WalletTransactionUpdate) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdate {
+	wtu.mutation.Where(ps...)
+	return wtu
+}
+
+// SetUserID sets the "user_id" field.
+func (wtu *WalletTransactionUpdate) SetUserID(i int) *WalletTransactionUpdate {
+	wtu.mutation.SetUserID(i)
+	return wtu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableUserID(i *int) *WalletTransactionUpdate {
+	if i != nil {
+		wtu.SetUserID(*i)
+	}
+	return wtu
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (wtu *WalletTransactionUpdate) SetTransactionType(wt wallettransaction.TransactionType) *WalletTransactionUpdate {
+	wtu.mutation.SetTransactionType(wt)
+	return wtu
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableTransactionType(wt *wallettransaction.TransactionType) *WalletTransactionUpdate {
+	if wt != nil {
+		wtu.SetTransactionType(*wt)
+	}
+	return wtu
+}
+
+// SetAmount sets the "amount" field.
+func (wtu *WalletTransactionUpdate) SetAmount(f float64) *WalletTransactionUpdate {
+	wtu.mutation.ResetAmount()
+	wtu.mutation.SetAmount(f)
+	return wtu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableAmount(f *float64) *WalletTransactionUpdate {
+	if f != nil {
+		wtu.SetAmount(*f)
+	}
+	return wtu
+}
+
+// AddAmount adds f to the "amount" field.
+func (wtu *WalletTransactionUpdate) AddAmount(f float64) *WalletTransactionUpdate {
+	wtu.mutation.AddAmount(f)
+	return wtu
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (wtu *WalletTransactionUpdate) SetBalanceAfter(f float64) *WalletTransactionUpdate {
+	wtu.mutation.ResetBalanceAfter()
+	wtu.mutation.SetBalanceAfter(f)
+	return wtu
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableBalanceAfter(f *float64) *WalletTransactionUpdate {
+	if f != nil {
+		wtu.SetBalanceAfter(*f)
+	}
+	return wtu
+}
+
+// AddBalanceAfter adds f to the "balance_after" field.
+func (wtu *WalletTransactionUpdate) AddBalanceAfter(f float64) *WalletTransactionUpdate {
+	wtu.mutation.AddBalanceAfter(f)
+	return wtu
+}
+
+// SetDescription sets the "description" field.
+func (wtu *WalletTransactionUpdate) SetDescription(s string) *WalletTransactionUpdate {
+	wtu.mutation.SetDescription(s)
+	return wtu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableDescription(s *string) *WalletTransactionUpdate {
+	if s != nil {
+		wtu.SetDescription(*s)
+	}
+	return wtu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wtu *WalletTransactionUpdate) ClearDescription() *WalletTransactionUpdate {
+	wtu.mutation.ClearDescription()
+	return wtu
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (wtu *WalletTransactionUpdate) SetReferenceID(s string) *WalletTransactionUpdate {
+	wtu.mutation.SetReferenceID(s)
+	return wtu
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableReferenceID(s *string) *WalletTransactionUpdate {
+	if s != nil {
+		wtu.SetReferenceID(*s)
+	}
+	return wtu
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (wtu *WalletTransactionUpdate) ClearReferenceID() *WalletTransactionUpdate {
+	wtu.mutation.ClearReferenceID()
+	return wtu
+}
+
+// SetStatus sets the "status" field.
+func (wtu *WalletTransactionUpdate) SetStatus(w wallettransaction.Status) *WalletTransactionUpdate {
+	wtu.mutation.SetStatus(w)
+	return wtu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtu *WalletTransactionUpdate) SetNillableStatus(w *wallettransaction.Status) *WalletTransactionUpdate {
+	if w != nil {
+		wtu.SetStatus(*w)
+	}
+	return wtu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wtu *WalletTransactionUpdate) SetUser(u *User) *WalletTransactionUpdate {
+	return wtu.SetUserID(u.ID)
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtu *WalletTransactionUpdate) Mutation() *WalletTransactionMutation {
+	return wtu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wtu *WalletTransactionUpdate) ClearUser() *WalletTransactionUpdate {
+	wtu.mutation.ClearUser()
+	return wtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wtu *WalletTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wtu.sqlSave, wtu.mutation, wtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtu *WalletTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wtu *WalletTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := wtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtu *WalletTransactionUpdate) ExecX(ctx context.Context) {
+	if err := wtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtu *WalletTransactionUpdate) check() error {
+	if v, ok := wtu.mutation.TransactionType(); ok {
+		if err := wallettransaction.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := wtu.mutation.ReferenceID(); ok {
+		if err := wallettransaction.ReferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.reference_id": %w`, err)}
+		}
+	}
+	if v, ok := wtu.mutation.Status(); ok {
+		if err := wallettransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.status": %w`, err)}
+		}
+	}
+	if wtu.mutation.UserCleared() && len(wtu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WalletTransaction.user"`)
+	}
+	return nil
+}
+
+func (wtu *WalletTransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	if ps := wtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtu.mutation.TransactionType(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := wtu.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := wtu.mutation.AddedAmount(); ok {
+		_spec.AddField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := wtu.mutation.BalanceAfter(); ok {
+		_spec.SetField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := wtu.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := wtu.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+	}
+	if wtu.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := wtu.mutation.ReferenceID(); ok {
+		_spec.SetField(wallettransaction.FieldReferenceID, field.TypeString, value)
+	}
+	if wtu.mutation.ReferenceIDCleared() {
+		_spec.ClearField(wallettransaction.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := wtu.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeEnum, value)
+	}
+	if wtu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.UserTable,
+			Columns: []string{wallettransaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.UserTable,
+			Columns: []string{wallettransaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wtu.mutation.done = true
+	return n, nil
+}
+
+// WalletTransactionUpdateOne is the builder for updating a single WalletTransaction entity.
+type WalletTransactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletTransactionMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (wtuo *WalletTransactionUpdateOne) SetUserID(i int) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetUserID(i)
+	return wtuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableUserID(i *int) *WalletTransactionUpdateOne {
+	if i != nil {
+		wtuo.SetUserID(*i)
+	}
+	return wtuo
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (wtuo *WalletTransactionUpdateOne) SetTransactionType(wt wallettransaction.TransactionType) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetTransactionType(wt)
+	return wtuo
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableTransactionType(wt *wallettransaction.TransactionType) *WalletTransactionUpdateOne {
+	if wt != nil {
+		wtuo.SetTransactionType(*wt)
+	}
+	return wtuo
+}
+
+// SetAmount sets the "amount" field.
+func (wtuo *WalletTransactionUpdateOne) SetAmount(f float64) *WalletTransactionUpdateOne {
+	wtuo.mutation.ResetAmount()
+	wtuo.mutation.SetAmount(f)
+	return wtuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableAmount(f *float64) *WalletTransactionUpdateOne {
+	if f != nil {
+		wtuo.SetAmount(*f)
+	}
+	return wtuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (wtuo *WalletTransactionUpdateOne) AddAmount(f float64) *WalletTransactionUpdateOne {
+	wtuo.mutation.AddAmount(f)
+	return wtuo
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (wtuo *WalletTransactionUpdateOne) SetBalanceAfter(f float64) *WalletTransactionUpdateOne {
+	wtuo.mutation.ResetBalanceAfter()
+	wtuo.mutation.SetBalanceAfter(f)
+	return wtuo
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableBalanceAfter(f *float64) *WalletTransactionUpdateOne {
+	if f != nil {
+		wtuo.SetBalanceAfter(*f)
+	}
+	return wtuo
+}
+
+// AddBalanceAfter adds f to the "balance_after" field.
+func (wtuo *WalletTransactionUpdateOne) AddBalanceAfter(f float64) *WalletTransactionUpdateOne {
+	wtuo.mutation.AddBalanceAfter(f)
+	return wtuo
+}
+
+// SetDescription sets the "description" field.
+func (wtuo *WalletTransactionUpdateOne) SetDescription(s string) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetDescription(s)
+	return wtuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableDescription(s *string) *WalletTransactionUpdateOne {
+	if s != nil {
+		wtuo.SetDescription(*s)
+	}
+	return wtuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wtuo *WalletTransactionUpdateOne) ClearDescription() *WalletTransactionUpdateOne {
+	wtuo.mutation.ClearDescription()
+	return wtuo
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (wtuo *WalletTransactionUpdateOne) SetReferenceID(s string) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetReferenceID(s)
+	return wtuo
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableReferenceID(s *string) *WalletTransactionUpdateOne {
+	if s != nil {
+		wtuo.SetReferenceID(*s)
+	}
+	return wtuo
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (wtuo *WalletTransactionUpdateOne) ClearReferenceID() *WalletTransactionUpdateOne {
+	wtuo.mutation.ClearReferenceID()
+	return wtuo
+}
+
+// SetStatus sets the "status" field.
+func (wtuo *WalletTransactionUpdateOne) SetStatus(w wallettransaction.Status) *WalletTransactionUpdateOne {
+	wtuo.mutation.SetStatus(w)
+	return wtuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wtuo *WalletTransactionUpdateOne) SetNillableStatus(w *wallettransaction.Status) *WalletTransactionUpdateOne {
+	if w != nil {
+		wtuo.SetStatus(*w)
+	}
+	return wtuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wtuo *WalletTransactionUpdateOne) SetUser(u *User) *WalletTransactionUpdateOne {
+	return wtuo.SetUserID(u.ID)
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (wtuo *WalletTransactionUpdateOne) Mutation() *WalletTransactionMutation {
+	return wtuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wtuo *WalletTransactionUpdateOne) ClearUser() *WalletTransactionUpdateOne {
+	wtuo.mutation.ClearUser()
+	return wtuo
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (wtuo *WalletTransactionUpdateOne) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdateOne {
+	wtuo.mutation.Where(ps...)
+	return wtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wtuo *WalletTransactionUpdateOne) Select(field string, fields ...string) *WalletTransactionUpdateOne {
+	wtuo.fields = append([]string{field}, fields...)
+	return wtuo
+}
+
+// Save executes the query and returns the updated WalletTransaction entity.
+func (wtuo *WalletTransactionUpdateOne) Save(ctx context.Context) (*WalletTransaction, error) {
+	return withHooks(ctx, wtuo.sqlSave, wtuo.mutation, wtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wtuo *WalletTransactionUpdateOne) SaveX(ctx context.Context) *WalletTransaction {
+	node, err := wtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wtuo *WalletTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wtuo *WalletTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := wtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wtuo *WalletTransactionUpdateOne) check() error {
+	if v, ok := wtuo.mutation.TransactionType(); ok {
+		if err := wallettransaction.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := wtuo.mutation.ReferenceID(); ok {
+		if err := wallettransaction.ReferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.reference_id": %w`, err)}
+		}
+	}
+	if v, ok := wtuo.mutation.Status(); ok {
+		if err := wallettransaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.status": %w`, err)}
+		}
+	}
+	if wtuo.mutation.UserCleared() && len(wtuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WalletTransaction.user"`)
+	}
+	return nil
+}
+
+func (wtuo *WalletTransactionUpdateOne) sqlSave(ctx context.Context) (_node *WalletTransaction, err error) {
+	if err := wtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	id, ok := wtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallettransaction.FieldID)
+		for _, f := range fields {
+			if !wallettransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallettransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wtuo.mutation.TransactionType(); ok {
+		_spec.SetField(wallettransaction.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := wtuo.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := wtuo.mutation.AddedAmount(); ok {
+		_spec.AddField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := wtuo.mutation.BalanceAfter(); ok {
+		_spec.SetField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := wtuo.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := wtuo.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+	}
+	if wtuo.mutation.DescriptionCleared() {
+		_spec.ClearField(wallettransaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := wtuo.mutation.ReferenceID(); ok {
+		_spec.SetField(wallettransaction.FieldReferenceID, field.TypeString, value)
+	}
+	if wtuo.mutation.ReferenceIDCleared() {
+		_spec.ClearField(wallettransaction.FieldReferenceID, field.TypeString)
+	}
+	if value, ok := wtuo.mutation.Status(); ok {
+		_spec.SetField(wallettransaction.FieldStatus, field.TypeEnum, value)
+	}
+	if wtuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.UserTable,
+			Columns: []string{wallettransaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wtuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.UserTable,
+			Columns: []string{wallettransaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WalletTransaction{config: wtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wtuo.mutation.done = true
+	return _node, nil
+}
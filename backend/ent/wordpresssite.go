@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WordPressSite is the model entity for the WordPressSite schema.
+type WordPressSite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// APIEndpoint holds the value of the "api_endpoint" field.
+	APIEndpoint string `json:"api_endpoint,omitempty"`
+	// Username holds the value of the "username" field.
+	Username *string `json:"username,omitempty"`
+	// ApplicationPassword holds the value of the "application_password" field.
+	ApplicationPassword *string `json:"-"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// LastSyncAt holds the value of the "last_sync_at" field.
+	LastSyncAt *time.Time `json:"last_sync_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WordPressSiteQuery when eager-loading is set.
+	Edges        WordPressSiteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WordPressSiteEdges holds the relations/edges for other nodes in the graph.
+type WordPressSiteEdges struct {
+	// SyncLogs holds the value of the sync_logs edge.
+	SyncLogs []*ContentSyncLog `json:"sync_logs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SyncLogsOrErr returns the SyncLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e WordPressSiteEdges) SyncLogsOrErr() ([]*ContentSyncLog, error) {
+	if e.loadedTypes[0] {
+		return e.SyncLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "sync_logs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WordPressSite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wordpresssite.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case wordpresssite.FieldID:
+			values[i] = new(sql.NullInt64)
+		case wordpresssite.FieldName, wordpresssite.FieldURL, wordpresssite.FieldAPIEndpoint, wordpresssite.FieldUsername, wordpresssite.FieldApplicationPassword:
+			values[i] = new(sql.NullString)
+		case wordpresssite.FieldLastSyncAt, wordpresssite.FieldCreatedAt, wordpresssite.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WordPressSite fields.
+func (wps *WordPressSite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wordpresssite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wps.ID = int(value.Int64)
+		case wordpresssite.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				wps.Name = value.String
+			}
+		case wordpresssite.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				wps.URL = value.String
+			}
+		case wordpresssite.FieldAPIEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_endpoint", values[i])
+			} else if value.Valid {
+				wps.APIEndpoint = value.String
+			}
+		case wordpresssite.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				wps.Username = new(string)
+				*wps.Username = value.String
+			}
+		case wordpresssite.FieldApplicationPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field application_password", values[i])
+			} else if value.Valid {
+				wps.ApplicationPassword = new(string)
+				*wps.ApplicationPassword = value.String
+			}
+		case wordpresssite.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				wps.IsActive = value.Bool
+			}
+		case wordpresssite.FieldLastSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sync_at", values[i])
+			} else if value.Valid {
+				wps.LastSyncAt = new(time.Time)
+				*wps.LastSyncAt = value.Time
+			}
+		case wordpresssite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wps.CreatedAt = value.Time
+			}
+		case wordpresssite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wps.UpdatedAt = value.Time
+			}
+		default:
+			wps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WordPressSite.
+// This includes values selected through modifiers, order, etc.
+func (wps *WordPressSite) Value(name string) (ent.Value, error) {
+	return wps.selectValues.Get(name)
+}
+
+// QuerySyncLogs queries the "sync_logs" edge of the WordPressSite entity.
+func (wps *WordPressSite) QuerySyncLogs() *ContentSyncLogQuery {
+	return NewWordPressSiteClient(wps.config).QuerySyncLogs(wps)
+}
+
+// Update returns a builder for updating this WordPressSite.
+// Note that you need to call WordPressSite.Unwrap() before calling this method if this WordPressSite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wps *WordPressSite) Update() *WordPressSiteUpdateOne {
+	return NewWordPressSiteClient(wps.config).UpdateOne(wps)
+}
+
+// Unwrap unwraps the WordPressSite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wps *WordPressSite) Unwrap() *WordPressSite {
+	_tx, ok := wps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WordPressSite is not a transactional entity")
+	}
+	wps.config.driver = _tx.drv
+	return wps
+}
+
+// String implements the fmt.Stringer.
+func (wps *WordPressSite) String() string {
+	var builder strings.Builder
+	builder.WriteString("WordPressSite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wps.ID))
+	builder.WriteString("name=")
+	builder.WriteString(wps.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(wps.URL)
+	builder.WriteString(", ")
+	builder.WriteString("api_endpoint=")
+	builder.WriteString(wps.APIEndpoint)
+	builder.WriteString(", ")
+	if v := wps.Username; v != nil {
+		builder.WriteString("username=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("application_password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", wps.IsActive))
+	builder.WriteString(", ")
+	if v := wps.LastSyncAt; v != nil {
+		builder.WriteString("last_sync_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wps.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WordPressSites is a parsable slice of WordPressSite.
+type WordPressSites []*WordPressSite
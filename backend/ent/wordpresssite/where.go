@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordpresssite
+
+import (
+	"time"
+	"zplus_web/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldURL, v))
+}
+
+// APIEndpoint applies equality check predicate on the "api_endpoint" field. It's identical to APIEndpointEQ.
+func APIEndpoint(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldAPIEndpoint, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldUsername, v))
+}
+
+// ApplicationPassword applies equality check predicate on the "application_password" field. It's identical to ApplicationPasswordEQ.
+func ApplicationPassword(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldApplicationPassword, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldIsActive, v))
+}
+
+// LastSyncAt applies equality check predicate on the "last_sync_at" field. It's identical to LastSyncAtEQ.
+func LastSyncAt(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContainsFold(FieldURL, v))
+}
+
+// APIEndpointEQ applies the EQ predicate on the "api_endpoint" field.
+func APIEndpointEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldAPIEndpoint, v))
+}
+
+// APIEndpointNEQ applies the NEQ predicate on the "api_endpoint" field.
+func APIEndpointNEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldAPIEndpoint, v))
+}
+
+// APIEndpointIn applies the In predicate on the "api_endpoint" field.
+func APIEndpointIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldAPIEndpoint, vs...))
+}
+
+// APIEndpointNotIn applies the NotIn predicate on the "api_endpoint" field.
+func APIEndpointNotIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldAPIEndpoint, vs...))
+}
+
+// APIEndpointGT applies the GT predicate on the "api_endpoint" field.
+func APIEndpointGT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldAPIEndpoint, v))
+}
+
+// APIEndpointGTE applies the GTE predicate on the "api_endpoint" field.
+func APIEndpointGTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldAPIEndpoint, v))
+}
+
+// APIEndpointLT applies the LT predicate on the "api_endpoint" field.
+func APIEndpointLT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldAPIEndpoint, v))
+}
+
+// APIEndpointLTE applies the LTE predicate on the "api_endpoint" field.
+func APIEndpointLTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldAPIEndpoint, v))
+}
+
+// APIEndpointContains applies the Contains predicate on the "api_endpoint" field.
+func APIEndpointContains(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContains(FieldAPIEndpoint, v))
+}
+
+// APIEndpointHasPrefix applies the HasPrefix predicate on the "api_endpoint" field.
+func APIEndpointHasPrefix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasPrefix(FieldAPIEndpoint, v))
+}
+
+// APIEndpointHasSuffix applies the HasSuffix predicate on the "api_endpoint" field.
+func APIEndpointHasSuffix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasSuffix(FieldAPIEndpoint, v))
+}
+
+// APIEndpointEqualFold applies the EqualFold predicate on the "api_endpoint" field.
+func APIEndpointEqualFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEqualFold(FieldAPIEndpoint, v))
+}
+
+// APIEndpointContainsFold applies the ContainsFold predicate on the "api_endpoint" field.
+func APIEndpointContainsFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContainsFold(FieldAPIEndpoint, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameIsNil applies the IsNil predicate on the "username" field.
+func UsernameIsNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIsNull(FieldUsername))
+}
+
+// UsernameNotNil applies the NotNil predicate on the "username" field.
+func UsernameNotNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotNull(FieldUsername))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// ApplicationPasswordEQ applies the EQ predicate on the "application_password" field.
+func ApplicationPasswordEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordNEQ applies the NEQ predicate on the "application_password" field.
+func ApplicationPasswordNEQ(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordIn applies the In predicate on the "application_password" field.
+func ApplicationPasswordIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldApplicationPassword, vs...))
+}
+
+// ApplicationPasswordNotIn applies the NotIn predicate on the "application_password" field.
+func ApplicationPasswordNotIn(vs ...string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldApplicationPassword, vs...))
+}
+
+// ApplicationPasswordGT applies the GT predicate on the "application_password" field.
+func ApplicationPasswordGT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordGTE applies the GTE predicate on the "application_password" field.
+func ApplicationPasswordGTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordLT applies the LT predicate on the "application_password" field.
+func ApplicationPasswordLT(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordLTE applies the LTE predicate on the "application_password" field.
+func ApplicationPasswordLTE(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordContains applies the Contains predicate on the "application_password" field.
+func ApplicationPasswordContains(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContains(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordHasPrefix applies the HasPrefix predicate on the "application_password" field.
+func ApplicationPasswordHasPrefix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasPrefix(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordHasSuffix applies the HasSuffix predicate on the "application_password" field.
+func ApplicationPasswordHasSuffix(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldHasSuffix(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordIsNil applies the IsNil predicate on the "application_password" field.
+func ApplicationPasswordIsNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIsNull(FieldApplicationPassword))
+}
+
+// ApplicationPasswordNotNil applies the NotNil predicate on the "application_password" field.
+func ApplicationPasswordNotNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotNull(FieldApplicationPassword))
+}
+
+// ApplicationPasswordEqualFold applies the EqualFold predicate on the "application_password" field.
+func ApplicationPasswordEqualFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEqualFold(FieldApplicationPassword, v))
+}
+
+// ApplicationPasswordContainsFold applies the ContainsFold predicate on the "application_password" field.
+func ApplicationPasswordContainsFold(v string) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldContainsFold(FieldApplicationPassword, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// LastSyncAtEQ applies the EQ predicate on the "last_sync_at" field.
+func LastSyncAtEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtNEQ applies the NEQ predicate on the "last_sync_at" field.
+func LastSyncAtNEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIn applies the In predicate on the "last_sync_at" field.
+func LastSyncAtIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtNotIn applies the NotIn predicate on the "last_sync_at" field.
+func LastSyncAtNotIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldLastSyncAt, vs...))
+}
+
+// LastSyncAtGT applies the GT predicate on the "last_sync_at" field.
+func LastSyncAtGT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtGTE applies the GTE predicate on the "last_sync_at" field.
+func LastSyncAtGTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLT applies the LT predicate on the "last_sync_at" field.
+func LastSyncAtLT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldLastSyncAt, v))
+}
+
+// LastSyncAtLTE applies the LTE predicate on the "last_sync_at" field.
+func LastSyncAtLTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldLastSyncAt, v))
+}
+
+// LastSyncAtIsNil applies the IsNil predicate on the "last_sync_at" field.
+func LastSyncAtIsNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIsNull(FieldLastSyncAt))
+}
+
+// LastSyncAtNotNil applies the NotNil predicate on the "last_sync_at" field.
+func LastSyncAtNotNil() predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotNull(FieldLastSyncAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSyncLogs applies the HasEdge predicate on the "sync_logs" edge.
+func HasSyncLogs() predicate.WordPressSite {
+	return predicate.WordPressSite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncLogsTable, SyncLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncLogsWith applies the HasEdge predicate on the "sync_logs" edge with a given conditions (other predicates).
+func HasSyncLogsWith(preds ...predicate.ContentSyncLog) predicate.WordPressSite {
+	return predicate.WordPressSite(func(s *sql.Selector) {
+		step := newSyncLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WordPressSite) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WordPressSite) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WordPressSite) predicate.WordPressSite {
+	return predicate.WordPressSite(sql.NotPredicates(p))
+}
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordpresssite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the wordpresssite type in the database.
+	Label = "word_press_site"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldAPIEndpoint holds the string denoting the api_endpoint field in the database.
+	FieldAPIEndpoint = "api_endpoint"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldApplicationPassword holds the string denoting the application_password field in the database.
+	FieldApplicationPassword = "application_password"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldLastSyncAt holds the string denoting the last_sync_at field in the database.
+	FieldLastSyncAt = "last_sync_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSyncLogs holds the string denoting the sync_logs edge name in mutations.
+	EdgeSyncLogs = "sync_logs"
+	// Table holds the table name of the wordpresssite in the database.
+	Table = "wordpress_sites"
+	// SyncLogsTable is the table that holds the sync_logs relation/edge.
+	SyncLogsTable = "content_sync_logs"
+	// SyncLogsInverseTable is the table name for the ContentSyncLog entity.
+	// It exists in this package in order to avoid circular dependency with the "contentsynclog" package.
+	SyncLogsInverseTable = "content_sync_logs"
+	// SyncLogsColumn is the table column denoting the sync_logs relation/edge.
+	SyncLogsColumn = "site_id"
+)
+
+// Columns holds all SQL columns for wordpresssite fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldURL,
+	FieldAPIEndpoint,
+	FieldUsername,
+	FieldApplicationPassword,
+	FieldIsActive,
+	FieldLastSyncAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// APIEndpointValidator is a validator for the "api_endpoint" field. It is called by the builders before save.
+	APIEndpointValidator func(string) error
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the WordPressSite queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByAPIEndpoint orders the results by the api_endpoint field.
+func ByAPIEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIEndpoint, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByApplicationPassword orders the results by the application_password field.
+func ByApplicationPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplicationPassword, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByLastSyncAt orders the results by the last_sync_at field.
+func ByLastSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSyncAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySyncLogsCount orders the results by sync_logs count.
+func BySyncLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSyncLogsStep(), opts...)
+	}
+}
+
+// BySyncLogs orders the results by sync_logs terms.
+func BySyncLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSyncLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SyncLogsTable, SyncLogsColumn),
+	)
+}
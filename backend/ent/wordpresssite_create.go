@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordPressSiteCreate is the builder for creating a WordPressSite entity.
+type WordPressSiteCreate struct {
+	config
+	mutation *WordPressSiteMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wpsc *WordPressSiteCreate) SetName(s string) *WordPressSiteCreate {
+	wpsc.mutation.SetName(s)
+	return wpsc
+}
+
+// SetURL sets the "url" field.
+func (wpsc *WordPressSiteCreate) SetURL(s string) *WordPressSiteCreate {
+	wpsc.mutation.SetURL(s)
+	return wpsc
+}
+
+// SetAPIEndpoint sets the "api_endpoint" field.
+func (wpsc *WordPressSiteCreate) SetAPIEndpoint(s string) *WordPressSiteCreate {
+	wpsc.mutation.SetAPIEndpoint(s)
+	return wpsc
+}
+
+// SetUsername sets the "username" field.
+func (wpsc *WordPressSiteCreate) SetUsername(s string) *WordPressSiteCreate {
+	wpsc.mutation.SetUsername(s)
+	return wpsc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableUsername(s *string) *WordPressSiteCreate {
+	if s != nil {
+		wpsc.SetUsername(*s)
+	}
+	return wpsc
+}
+
+// SetApplicationPassword sets the "application_password" field.
+func (wpsc *WordPressSiteCreate) SetApplicationPassword(s string) *WordPressSiteCreate {
+	wpsc.mutation.SetApplicationPassword(s)
+	return wpsc
+}
+
+// SetNillableApplicationPassword sets the "application_password" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableApplicationPassword(s *string) *WordPressSiteCreate {
+	if s != nil {
+		wpsc.SetApplicationPassword(*s)
+	}
+	return wpsc
+}
+
+// SetIsActive sets the "is_active" field.
+func (wpsc *WordPressSiteCreate) SetIsActive(b bool) *WordPressSiteCreate {
+	wpsc.mutation.SetIsActive(b)
+	return wpsc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableIsActive(b *bool) *WordPressSiteCreate {
+	if b != nil {
+		wpsc.SetIsActive(*b)
+	}
+	return wpsc
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (wpsc *WordPressSiteCreate) SetLastSyncAt(t time.Time) *WordPressSiteCreate {
+	wpsc.mutation.SetLastSyncAt(t)
+	return wpsc
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableLastSyncAt(t *time.Time) *WordPressSiteCreate {
+	if t != nil {
+		wpsc.SetLastSyncAt(*t)
+	}
+	return wpsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wpsc *WordPressSiteCreate) SetCreatedAt(t time.Time) *WordPressSiteCreate {
+	wpsc.mutation.SetCreatedAt(t)
+	return wpsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableCreatedAt(t *time.Time) *WordPressSiteCreate {
+	if t != nil {
+		wpsc.SetCreatedAt(*t)
+	}
+	return wpsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpsc *WordPressSiteCreate) SetUpdatedAt(t time.Time) *WordPressSiteCreate {
+	wpsc.mutation.SetUpdatedAt(t)
+	return wpsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wpsc *WordPressSiteCreate) SetNillableUpdatedAt(t *time.Time) *WordPressSiteCreate {
+	if t != nil {
+		wpsc.SetUpdatedAt(*t)
+	}
+	return wpsc
+}
+
+// AddSyncLogIDs adds the "sync_logs" edge to the ContentSyncLog entity by IDs.
+func (wpsc *WordPressSiteCreate) AddSyncLogIDs(ids ...int) *WordPressSiteCreate {
+	wpsc.mutation.AddSyncLogIDs(ids...)
+	return wpsc
+}
+
+// AddSyncLogs adds the "sync_logs" edges to the ContentSyncLog entity.
+func (wpsc *WordPressSiteCreate) AddSyncLogs(c ...*ContentSyncLog) *WordPressSiteCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wpsc.AddSyncLogIDs(ids...)
+}
+
+// Mutation returns the WordPressSiteMutation object of the builder.
+func (wpsc *WordPressSiteCreate) Mutation() *WordPressSiteMutation {
+	return wpsc.mutation
+}
+
+// Save creates the WordPressSite in the database.
+func (wpsc *WordPressSiteCreate) Save(ctx context.Context) (*WordPressSite, error) {
+	wpsc.defaults()
+	return withHooks(ctx, wpsc.sqlSave, wpsc.mutation, wpsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wpsc *WordPressSiteCreate) SaveX(ctx context.Context) *WordPressSite {
+	v, err := wpsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpsc *WordPressSiteCreate) Exec(ctx context.Context) error {
+	_, err := wpsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpsc *WordPressSiteCreate) ExecX(ctx context.Context) {
+	if err := wpsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpsc *WordPressSiteCreate) defaults() {
+	if _, ok := wpsc.mutation.IsActive(); !ok {
+		v := wordpresssite.DefaultIsActive
+		wpsc.mutation.SetIsActive(v)
+	}
+	if _, ok := wpsc.mutation.CreatedAt(); !ok {
+		v := wordpresssite.DefaultCreatedAt()
+		wpsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wpsc.mutation.UpdatedAt(); !ok {
+		v := wordpresssite.DefaultUpdatedAt()
+		wpsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpsc *WordPressSiteCreate) check() error {
+	if _, ok := wpsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WordPressSite.name"`)}
+	}
+	if v, ok := wpsc.mutation.Name(); ok {
+		if err := wordpresssite.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.name": %w`, err)}
+		}
+	}
+	if _, ok := wpsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WordPressSite.url"`)}
+	}
+	if v, ok := wpsc.mutation.URL(); ok {
+		if err := wordpresssite.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.url": %w`, err)}
+		}
+	}
+	if _, ok := wpsc.mutation.APIEndpoint(); !ok {
+		return &ValidationError{Name: "api_endpoint", err: errors.New(`ent: missing required field "WordPressSite.api_endpoint"`)}
+	}
+	if v, ok := wpsc.mutation.APIEndpoint(); ok {
+		if err := wordpresssite.APIEndpointValidator(v); err != nil {
+			return &ValidationError{Name: "api_endpoint", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.api_endpoint": %w`, err)}
+		}
+	}
+	if v, ok := wpsc.mutation.Username(); ok {
+		if err := wordpresssite.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.username": %w`, err)}
+		}
+	}
+	if _, ok := wpsc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "WordPressSite.is_active"`)}
+	}
+	if _, ok := wpsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WordPressSite.created_at"`)}
+	}
+	if _, ok := wpsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WordPressSite.updated_at"`)}
+	}
+	return nil
+}
+
+func (wpsc *WordPressSiteCreate) sqlSave(ctx context.Context) (*WordPressSite, error) {
+	if err := wpsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wpsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wpsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wpsc.mutation.id = &_node.ID
+	wpsc.mutation.done = true
+	return _node, nil
+}
+
+func (wpsc *WordPressSiteCreate) createSpec() (*WordPressSite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WordPressSite{config: wpsc.config}
+		_spec = sqlgraph.NewCreateSpec(wordpresssite.Table, sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt))
+	)
+	if value, ok := wpsc.mutation.Name(); ok {
+		_spec.SetField(wordpresssite.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wpsc.mutation.URL(); ok {
+		_spec.SetField(wordpresssite.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wpsc.mutation.APIEndpoint(); ok {
+		_spec.SetField(wordpresssite.FieldAPIEndpoint, field.TypeString, value)
+		_node.APIEndpoint = value
+	}
+	if value, ok := wpsc.mutation.Username(); ok {
+		_spec.SetField(wordpresssite.FieldUsername, field.TypeString, value)
+		_node.Username = &value
+	}
+	if value, ok := wpsc.mutation.ApplicationPassword(); ok {
+		_spec.SetField(wordpresssite.FieldApplicationPassword, field.TypeString, value)
+		_node.ApplicationPassword = &value
+	}
+	if value, ok := wpsc.mutation.IsActive(); ok {
+		_spec.SetField(wordpresssite.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := wpsc.mutation.LastSyncAt(); ok {
+		_spec.SetField(wordpresssite.FieldLastSyncAt, field.TypeTime, value)
+		_node.LastSyncAt = &value
+	}
+	if value, ok := wpsc.mutation.CreatedAt(); ok {
+		_spec.SetField(wordpresssite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wpsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordpresssite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wpsc.mutation.SyncLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WordPressSiteCreateBulk is the builder for creating many WordPressSite entities in bulk.
+type WordPressSiteCreateBulk struct {
+	config
+	err      error
+	builders []*WordPressSiteCreate
+}
+
+// Save creates the WordPressSite entities in the database.
+func (wpscb *WordPressSiteCreateBulk) Save(ctx context.Context) ([]*WordPressSite, error) {
+	if wpscb.err != nil {
+		return nil, wpscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wpscb.builders))
+	nodes := make([]*WordPressSite, len(wpscb.builders))
+	mutators := make([]Mutator, len(wpscb.builders))
+	for i := range wpscb.builders {
+		func(i int, root context.Context) {
+			builder := wpscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WordPressSiteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wpscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wpscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wpscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpscb *WordPressSiteCreateBulk) SaveX(ctx context.Context) []*WordPressSite {
+	v, err := wpscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpscb *WordPressSiteCreateBulk) Exec(ctx context.Context) error {
+	_, err := wpscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpscb *WordPressSiteCreateBulk) ExecX(ctx context.Context) {
+	if err := wpscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordPressSiteDelete is the builder for deleting a WordPressSite entity.
+type WordPressSiteDelete struct {
+	config
+	hooks    []Hook
+	mutation *WordPressSiteMutation
+}
+
+// Where appends a list predicates to the WordPressSiteDelete builder.
+func (wpsd *WordPressSiteDelete) Where(ps ...predicate.WordPressSite) *WordPressSiteDelete {
+	wpsd.mutation.Where(ps...)
+	return wpsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wpsd *WordPressSiteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wpsd.sqlExec, wpsd.mutation, wpsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpsd *WordPressSiteDelete) ExecX(ctx context.Context) int {
+	n, err := wpsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wpsd *WordPressSiteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wordpresssite.Table, sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt))
+	if ps := wpsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wpsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wpsd.mutation.done = true
+	return affected, err
+}
+
+// WordPressSiteDeleteOne is the builder for deleting a single WordPressSite entity.
+type WordPressSiteDeleteOne struct {
+	wpsd *WordPressSiteDelete
+}
+
+// Where appends a list predicates to the WordPressSiteDelete builder.
+func (wpsdo *WordPressSiteDeleteOne) Where(ps ...predicate.WordPressSite) *WordPressSiteDeleteOne {
+	wpsdo.wpsd.mutation.Where(ps...)
+	return wpsdo
+}
+
+// Exec executes the deletion query.
+func (wpsdo *WordPressSiteDeleteOne) Exec(ctx context.Context) error {
+	n, err := wpsdo.wpsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wordpresssite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpsdo *WordPressSiteDeleteOne) ExecX(ctx context.Context) {
+	if err := wpsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
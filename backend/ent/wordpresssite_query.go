@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordPressSiteQuery is the builder for querying WordPressSite entities.
+type WordPressSiteQuery struct {
+	config
+	ctx          *QueryContext
+	order        []wordpresssite.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.WordPressSite
+	withSyncLogs *ContentSyncLogQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WordPressSiteQuery builder.
+func (wpsq *WordPressSiteQuery) Where(ps ...predicate.WordPressSite) *WordPressSiteQuery {
+	wpsq.predicates = append(wpsq.predicates, ps...)
+	return wpsq
+}
+
+// Limit the number of records to be returned by this query.
+func (wpsq *WordPressSiteQuery) Limit(limit int) *WordPressSiteQuery {
+	wpsq.ctx.Limit = &limit
+	return wpsq
+}
+
+// Offset to start from.
+func (wpsq *WordPressSiteQuery) Offset(offset int) *WordPressSiteQuery {
+	wpsq.ctx.Offset = &offset
+	return wpsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wpsq *WordPressSiteQuery) Unique(unique bool) *WordPressSiteQuery {
+	wpsq.ctx.Unique = &unique
+	return wpsq
+}
+
+// Order specifies how the records should be ordered.
+func (wpsq *WordPressSiteQuery) Order(o ...wordpresssite.OrderOption) *WordPressSiteQuery {
+	wpsq.order = append(wpsq.order, o...)
+	return wpsq
+}
+
+// QuerySyncLogs chains the current query on the "sync_logs" edge.
+func (wpsq *WordPressSiteQuery) QuerySyncLogs() *ContentSyncLogQuery {
+	query := (&ContentSyncLogClient{config: wpsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wordpresssite.Table, wordpresssite.FieldID, selector),
+			sqlgraph.To(contentsynclog.Table, contentsynclog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wordpresssite.SyncLogsTable, wordpresssite.SyncLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wpsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WordPressSite entity from the query.
+// Returns a *NotFoundError when no WordPressSite was found.
+func (wpsq *WordPressSiteQuery) First(ctx context.Context) (*WordPressSite, error) {
+	nodes, err := wpsq.Limit(1).All(setContextOp(ctx, wpsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{wordpresssite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) FirstX(ctx context.Context) *WordPressSite {
+	node, err := wpsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WordPressSite ID from the query.
+// Returns a *NotFoundError when no WordPressSite ID was found.
+func (wpsq *WordPressSiteQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpsq.Limit(1).IDs(setContextOp(ctx, wpsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{wordpresssite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) FirstIDX(ctx context.Context) int {
+	id, err := wpsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WordPressSite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WordPressSite entity is found.
+// Returns a *NotFoundError when no WordPressSite entities are found.
+func (wpsq *WordPressSiteQuery) Only(ctx context.Context) (*WordPressSite, error) {
+	nodes, err := wpsq.Limit(2).All(setContextOp(ctx, wpsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{wordpresssite.Label}
+	default:
+		return nil, &NotSingularError{wordpresssite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) OnlyX(ctx context.Context) *WordPressSite {
+	node, err := wpsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WordPressSite ID in the query.
+// Returns a *NotSingularError when more than one WordPressSite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wpsq *WordPressSiteQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpsq.Limit(2).IDs(setContextOp(ctx, wpsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{wordpresssite.Label}
+	default:
+		err = &NotSingularError{wordpresssite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wpsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WordPressSites.
+func (wpsq *WordPressSiteQuery) All(ctx context.Context) ([]*WordPressSite, error) {
+	ctx = setContextOp(ctx, wpsq.ctx, ent.OpQueryAll)
+	if err := wpsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WordPressSite, *WordPressSiteQuery]()
+	return withInterceptors[[]*WordPressSite](ctx, wpsq, qr, wpsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) AllX(ctx context.Context) []*WordPressSite {
+	nodes, err := wpsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WordPressSite IDs.
+func (wpsq *WordPressSiteQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if wpsq.ctx.Unique == nil && wpsq.path != nil {
+		wpsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wpsq.ctx, ent.OpQueryIDs)
+	if err = wpsq.Select(wordpresssite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) IDsX(ctx context.Context) []int {
+	ids, err := wpsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wpsq *WordPressSiteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wpsq.ctx, ent.OpQueryCount)
+	if err := wpsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wpsq, querierCount[*WordPressSiteQuery](), wpsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) CountX(ctx context.Context) int {
+	count, err := wpsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wpsq *WordPressSiteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wpsq.ctx, ent.OpQueryExist)
+	switch _, err := wpsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wpsq *WordPressSiteQuery) ExistX(ctx context.Context) bool {
+	exist, err := wpsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WordPressSiteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wpsq *WordPressSiteQuery) Clone() *WordPressSiteQuery {
+	if wpsq == nil {
+		return nil
+	}
+	return &WordPressSiteQuery{
+		config:       wpsq.config,
+		ctx:          wpsq.ctx.Clone(),
+		order:        append([]wordpresssite.OrderOption{}, wpsq.order...),
+		inters:       append([]Interceptor{}, wpsq.inters...),
+		predicates:   append([]predicate.WordPressSite{}, wpsq.predicates...),
+		withSyncLogs: wpsq.withSyncLogs.Clone(),
+		// clone intermediate query.
+		sql:  wpsq.sql.Clone(),
+		path: wpsq.path,
+	}
+}
+
+// WithSyncLogs tells the query-builder to eager-load the nodes that are connected to
+// the "sync_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpsq *WordPressSiteQuery) WithSyncLogs(opts ...func(*ContentSyncLogQuery)) *WordPressSiteQuery {
+	query := (&ContentSyncLogClient{config: wpsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpsq.withSyncLogs = query
+	return wpsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WordPressSite.Query().
+//		GroupBy(wordpresssite.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wpsq *WordPressSiteQuery) GroupBy(field string, fields ...string) *WordPressSiteGroupBy {
+	wpsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WordPressSiteGroupBy{build: wpsq}
+	grbuild.flds = &wpsq.ctx.Fields
+	grbuild.label = wordpresssite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.WordPressSite.Query().
+//		Select(wordpresssite.FieldName).
+//		Scan(ctx, &v)
+func (wpsq *WordPressSiteQuery) Select(fields ...string) *WordPressSiteSelect {
+	wpsq.ctx.Fields = append(wpsq.ctx.Fields, fields...)
+	sbuild := &WordPressSiteSelect{WordPressSiteQuery: wpsq}
+	sbuild.label = wordpresssite.Label
+	sbuild.flds, sbuild.scan = &wpsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WordPressSiteSelect configured with the given aggregations.
+func (wpsq *WordPressSiteQuery) Aggregate(fns ...AggregateFunc) *WordPressSiteSelect {
+	return wpsq.Select().Aggregate(fns...)
+}
+
+func (wpsq *WordPressSiteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wpsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wpsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wpsq.ctx.Fields {
+		if !wordpresssite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wpsq.path != nil {
+		prev, err := wpsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wpsq.sql = prev
+	}
+	return nil
+}
+
+func (wpsq *WordPressSiteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WordPressSite, error) {
+	var (
+		nodes       = []*WordPressSite{}
+		_spec       = wpsq.querySpec()
+		loadedTypes = [1]bool{
+			wpsq.withSyncLogs != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WordPressSite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WordPressSite{config: wpsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wpsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wpsq.withSyncLogs; query != nil {
+		if err := wpsq.loadSyncLogs(ctx, query, nodes,
+			func(n *WordPressSite) { n.Edges.SyncLogs = []*ContentSyncLog{} },
+			func(n *WordPressSite, e *ContentSyncLog) { n.Edges.SyncLogs = append(n.Edges.SyncLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wpsq *WordPressSiteQuery) loadSyncLogs(ctx context.Context, query *ContentSyncLogQuery, nodes []*WordPressSite, init func(*WordPressSite), assign func(*WordPressSite, *ContentSyncLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*WordPressSite)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentsynclog.FieldSiteID)
+	}
+	query.Where(predicate.ContentSyncLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(wordpresssite.SyncLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SiteID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "site_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (wpsq *WordPressSiteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wpsq.querySpec()
+	_spec.Node.Columns = wpsq.ctx.Fields
+	if len(wpsq.ctx.Fields) > 0 {
+		_spec.Unique = wpsq.ctx.Unique != nil && *wpsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wpsq.driver, _spec)
+}
+
+func (wpsq *WordPressSiteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(wordpresssite.Table, wordpresssite.Columns, sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt))
+	_spec.From = wpsq.sql
+	if unique := wpsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wpsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wpsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordpresssite.FieldID)
+		for i := range fields {
+			if fields[i] != wordpresssite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wpsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wpsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wpsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wpsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wpsq *WordPressSiteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wpsq.driver.Dialect())
+	t1 := builder.Table(wordpresssite.Table)
+	columns := wpsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = wordpresssite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wpsq.sql != nil {
+		selector = wpsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wpsq.ctx.Unique != nil && *wpsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wpsq.predicates {
+		p(selector)
+	}
+	for _, p := range wpsq.order {
+		p(selector)
+	}
+	if offset := wpsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wpsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WordPressSiteGroupBy is the group-by builder for WordPressSite entities.
+type WordPressSiteGroupBy struct {
+	selector
+	build *WordPressSiteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wpsgb *WordPressSiteGroupBy) Aggregate(fns ...AggregateFunc) *WordPressSiteGroupBy {
+	wpsgb.fns = append(wpsgb.fns, fns...)
+	return wpsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wpsgb *WordPressSiteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wpsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wpsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordPressSiteQuery, *WordPressSiteGroupBy](ctx, wpsgb.build, wpsgb, wpsgb.build.inters, v)
+}
+
+func (wpsgb *WordPressSiteGroupBy) sqlScan(ctx context.Context, root *WordPressSiteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wpsgb.fns))
+	for _, fn := range wpsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wpsgb.flds)+len(wpsgb.fns))
+		for _, f := range *wpsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wpsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wpsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WordPressSiteSelect is the builder for selecting fields of WordPressSite entities.
+type WordPressSiteSelect struct {
+	*WordPressSiteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wpss *WordPressSiteSelect) Aggregate(fns ...AggregateFunc) *WordPressSiteSelect {
+	wpss.fns = append(wpss.fns, fns...)
+	return wpss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wpss *WordPressSiteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wpss.ctx, ent.OpQuerySelect)
+	if err := wpss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordPressSiteQuery, *WordPressSiteSelect](ctx, wpss.WordPressSiteQuery, wpss, wpss.inters, v)
+}
+
+func (wpss *WordPressSiteSelect) sqlScan(ctx context.Context, root *WordPressSiteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wpss.fns))
+	for _, fn := range wpss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wpss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wpss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
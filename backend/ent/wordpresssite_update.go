@@ -0,0 +1,723 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"zplus_web/backend/ent/contentsynclog"
+	"zplus_web/backend/ent/predicate"
+	"zplus_web/backend/ent/wordpresssite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordPressSiteUpdate is the builder for updating WordPressSite entities.
+type WordPressSiteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WordPressSiteMutation
+}
+
+// Where appends a list predicates to the WordPressSiteUpdate builder.
+func (wpsu *WordPressSiteUpdate) Where(ps ...predicate.WordPressSite) *WordPressSiteUpdate {
+	wpsu.mutation.Where(ps...)
+	return wpsu
+}
+
+// SetName sets the "name" field.
+func (wpsu *WordPressSiteUpdate) SetName(s string) *WordPressSiteUpdate {
+	wpsu.mutation.SetName(s)
+	return wpsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableName(s *string) *WordPressSiteUpdate {
+	if s != nil {
+		wpsu.SetName(*s)
+	}
+	return wpsu
+}
+
+// SetURL sets the "url" field.
+func (wpsu *WordPressSiteUpdate) SetURL(s string) *WordPressSiteUpdate {
+	wpsu.mutation.SetURL(s)
+	return wpsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableURL(s *string) *WordPressSiteUpdate {
+	if s != nil {
+		wpsu.SetURL(*s)
+	}
+	return wpsu
+}
+
+// SetAPIEndpoint sets the "api_endpoint" field.
+func (wpsu *WordPressSiteUpdate) SetAPIEndpoint(s string) *WordPressSiteUpdate {
+	wpsu.mutation.SetAPIEndpoint(s)
+	return wpsu
+}
+
+// SetNillableAPIEndpoint sets the "api_endpoint" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableAPIEndpoint(s *string) *WordPressSiteUpdate {
+	if s != nil {
+		wpsu.SetAPIEndpoint(*s)
+	}
+	return wpsu
+}
+
+// SetUsername sets the "username" field.
+func (wpsu *WordPressSiteUpdate) SetUsername(s string) *WordPressSiteUpdate {
+	wpsu.mutation.SetUsername(s)
+	return wpsu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableUsername(s *string) *WordPressSiteUpdate {
+	if s != nil {
+		wpsu.SetUsername(*s)
+	}
+	return wpsu
+}
+
+// ClearUsername clears the value of the "username" field.
+func (wpsu *WordPressSiteUpdate) ClearUsername() *WordPressSiteUpdate {
+	wpsu.mutation.ClearUsername()
+	return wpsu
+}
+
+// SetApplicationPassword sets the "application_password" field.
+func (wpsu *WordPressSiteUpdate) SetApplicationPassword(s string) *WordPressSiteUpdate {
+	wpsu.mutation.SetApplicationPassword(s)
+	return wpsu
+}
+
+// SetNillableApplicationPassword sets the "application_password" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableApplicationPassword(s *string) *WordPressSiteUpdate {
+	if s != nil {
+		wpsu.SetApplicationPassword(*s)
+	}
+	return wpsu
+}
+
+// ClearApplicationPassword clears the value of the "application_password" field.
+func (wpsu *WordPressSiteUpdate) ClearApplicationPassword() *WordPressSiteUpdate {
+	wpsu.mutation.ClearApplicationPassword()
+	return wpsu
+}
+
+// SetIsActive sets the "is_active" field.
+func (wpsu *WordPressSiteUpdate) SetIsActive(b bool) *WordPressSiteUpdate {
+	wpsu.mutation.SetIsActive(b)
+	return wpsu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableIsActive(b *bool) *WordPressSiteUpdate {
+	if b != nil {
+		wpsu.SetIsActive(*b)
+	}
+	return wpsu
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (wpsu *WordPressSiteUpdate) SetLastSyncAt(t time.Time) *WordPressSiteUpdate {
+	wpsu.mutation.SetLastSyncAt(t)
+	return wpsu
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (wpsu *WordPressSiteUpdate) SetNillableLastSyncAt(t *time.Time) *WordPressSiteUpdate {
+	if t != nil {
+		wpsu.SetLastSyncAt(*t)
+	}
+	return wpsu
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (wpsu *WordPressSiteUpdate) ClearLastSyncAt() *WordPressSiteUpdate {
+	wpsu.mutation.ClearLastSyncAt()
+	return wpsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpsu *WordPressSiteUpdate) SetUpdatedAt(t time.Time) *WordPressSiteUpdate {
+	wpsu.mutation.SetUpdatedAt(t)
+	return wpsu
+}
+
+// AddSyncLogIDs adds the "sync_logs" edge to the ContentSyncLog entity by IDs.
+func (wpsu *WordPressSiteUpdate) AddSyncLogIDs(ids ...int) *WordPressSiteUpdate {
+	wpsu.mutation.AddSyncLogIDs(ids...)
+	return wpsu
+}
+
+// AddSyncLogs adds the "sync_logs" edges to the ContentSyncLog entity.
+func (wpsu *WordPressSiteUpdate) AddSyncLogs(c ...*ContentSyncLog) *WordPressSiteUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wpsu.AddSyncLogIDs(ids...)
+}
+
+// Mutation returns the WordPressSiteMutation object of the builder.
+func (wpsu *WordPressSiteUpdate) Mutation() *WordPressSiteMutation {
+	return wpsu.mutation
+}
+
+// ClearSyncLogs clears all "sync_logs" edges to the ContentSyncLog entity.
+func (wpsu *WordPressSiteUpdate) ClearSyncLogs() *WordPressSiteUpdate {
+	wpsu.mutation.ClearSyncLogs()
+	return wpsu
+}
+
+// RemoveSyncLogIDs removes the "sync_logs" edge to ContentSyncLog entities by IDs.
+func (wpsu *WordPressSiteUpdate) RemoveSyncLogIDs(ids ...int) *WordPressSiteUpdate {
+	wpsu.mutation.RemoveSyncLogIDs(ids...)
+	return wpsu
+}
+
+// RemoveSyncLogs removes "sync_logs" edges to ContentSyncLog entities.
+func (wpsu *WordPressSiteUpdate) RemoveSyncLogs(c ...*ContentSyncLog) *WordPressSiteUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wpsu.RemoveSyncLogIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wpsu *WordPressSiteUpdate) Save(ctx context.Context) (int, error) {
+	wpsu.defaults()
+	return withHooks(ctx, wpsu.sqlSave, wpsu.mutation, wpsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpsu *WordPressSiteUpdate) SaveX(ctx context.Context) int {
+	affected, err := wpsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wpsu *WordPressSiteUpdate) Exec(ctx context.Context) error {
+	_, err := wpsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpsu *WordPressSiteUpdate) ExecX(ctx context.Context) {
+	if err := wpsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpsu *WordPressSiteUpdate) defaults() {
+	if _, ok := wpsu.mutation.UpdatedAt(); !ok {
+		v := wordpresssite.UpdateDefaultUpdatedAt()
+		wpsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpsu *WordPressSiteUpdate) check() error {
+	if v, ok := wpsu.mutation.Name(); ok {
+		if err := wordpresssite.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.name": %w`, err)}
+		}
+	}
+	if v, ok := wpsu.mutation.URL(); ok {
+		if err := wordpresssite.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.url": %w`, err)}
+		}
+	}
+	if v, ok := wpsu.mutation.APIEndpoint(); ok {
+		if err := wordpresssite.APIEndpointValidator(v); err != nil {
+			return &ValidationError{Name: "api_endpoint", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.api_endpoint": %w`, err)}
+		}
+	}
+	if v, ok := wpsu.mutation.Username(); ok {
+		if err := wordpresssite.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wpsu *WordPressSiteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wpsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wordpresssite.Table, wordpresssite.Columns, sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt))
+	if ps := wpsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpsu.mutation.Name(); ok {
+		_spec.SetField(wordpresssite.FieldName, field.TypeString, value)
+	}
+	if value, ok := wpsu.mutation.URL(); ok {
+		_spec.SetField(wordpresssite.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wpsu.mutation.APIEndpoint(); ok {
+		_spec.SetField(wordpresssite.FieldAPIEndpoint, field.TypeString, value)
+	}
+	if value, ok := wpsu.mutation.Username(); ok {
+		_spec.SetField(wordpresssite.FieldUsername, field.TypeString, value)
+	}
+	if wpsu.mutation.UsernameCleared() {
+		_spec.ClearField(wordpresssite.FieldUsername, field.TypeString)
+	}
+	if value, ok := wpsu.mutation.ApplicationPassword(); ok {
+		_spec.SetField(wordpresssite.FieldApplicationPassword, field.TypeString, value)
+	}
+	if wpsu.mutation.ApplicationPasswordCleared() {
+		_spec.ClearField(wordpresssite.FieldApplicationPassword, field.TypeString)
+	}
+	if value, ok := wpsu.mutation.IsActive(); ok {
+		_spec.SetField(wordpresssite.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := wpsu.mutation.LastSyncAt(); ok {
+		_spec.SetField(wordpresssite.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if wpsu.mutation.LastSyncAtCleared() {
+		_spec.ClearField(wordpresssite.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := wpsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordpresssite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wpsu.mutation.SyncLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpsu.mutation.RemovedSyncLogsIDs(); len(nodes) > 0 && !wpsu.mutation.SyncLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpsu.mutation.SyncLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wpsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordpresssite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wpsu.mutation.done = true
+	return n, nil
+}
+
+// WordPressSiteUpdateOne is the builder for updating a single WordPressSite entity.
+type WordPressSiteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WordPressSiteMutation
+}
+
+// SetName sets the "name" field.
+func (wpsuo *WordPressSiteUpdateOne) SetName(s string) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetName(s)
+	return wpsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableName(s *string) *WordPressSiteUpdateOne {
+	if s != nil {
+		wpsuo.SetName(*s)
+	}
+	return wpsuo
+}
+
+// SetURL sets the "url" field.
+func (wpsuo *WordPressSiteUpdateOne) SetURL(s string) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetURL(s)
+	return wpsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableURL(s *string) *WordPressSiteUpdateOne {
+	if s != nil {
+		wpsuo.SetURL(*s)
+	}
+	return wpsuo
+}
+
+// SetAPIEndpoint sets the "api_endpoint" field.
+func (wpsuo *WordPressSiteUpdateOne) SetAPIEndpoint(s string) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetAPIEndpoint(s)
+	return wpsuo
+}
+
+// SetNillableAPIEndpoint sets the "api_endpoint" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableAPIEndpoint(s *string) *WordPressSiteUpdateOne {
+	if s != nil {
+		wpsuo.SetAPIEndpoint(*s)
+	}
+	return wpsuo
+}
+
+// SetUsername sets the "username" field.
+func (wpsuo *WordPressSiteUpdateOne) SetUsername(s string) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetUsername(s)
+	return wpsuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableUsername(s *string) *WordPressSiteUpdateOne {
+	if s != nil {
+		wpsuo.SetUsername(*s)
+	}
+	return wpsuo
+}
+
+// ClearUsername clears the value of the "username" field.
+func (wpsuo *WordPressSiteUpdateOne) ClearUsername() *WordPressSiteUpdateOne {
+	wpsuo.mutation.ClearUsername()
+	return wpsuo
+}
+
+// SetApplicationPassword sets the "application_password" field.
+func (wpsuo *WordPressSiteUpdateOne) SetApplicationPassword(s string) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetApplicationPassword(s)
+	return wpsuo
+}
+
+// SetNillableApplicationPassword sets the "application_password" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableApplicationPassword(s *string) *WordPressSiteUpdateOne {
+	if s != nil {
+		wpsuo.SetApplicationPassword(*s)
+	}
+	return wpsuo
+}
+
+// ClearApplicationPassword clears the value of the "application_password" field.
+func (wpsuo *WordPressSiteUpdateOne) ClearApplicationPassword() *WordPressSiteUpdateOne {
+	wpsuo.mutation.ClearApplicationPassword()
+	return wpsuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (wpsuo *WordPressSiteUpdateOne) SetIsActive(b bool) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetIsActive(b)
+	return wpsuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableIsActive(b *bool) *WordPressSiteUpdateOne {
+	if b != nil {
+		wpsuo.SetIsActive(*b)
+	}
+	return wpsuo
+}
+
+// SetLastSyncAt sets the "last_sync_at" field.
+func (wpsuo *WordPressSiteUpdateOne) SetLastSyncAt(t time.Time) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetLastSyncAt(t)
+	return wpsuo
+}
+
+// SetNillableLastSyncAt sets the "last_sync_at" field if the given value is not nil.
+func (wpsuo *WordPressSiteUpdateOne) SetNillableLastSyncAt(t *time.Time) *WordPressSiteUpdateOne {
+	if t != nil {
+		wpsuo.SetLastSyncAt(*t)
+	}
+	return wpsuo
+}
+
+// ClearLastSyncAt clears the value of the "last_sync_at" field.
+func (wpsuo *WordPressSiteUpdateOne) ClearLastSyncAt() *WordPressSiteUpdateOne {
+	wpsuo.mutation.ClearLastSyncAt()
+	return wpsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpsuo *WordPressSiteUpdateOne) SetUpdatedAt(t time.Time) *WordPressSiteUpdateOne {
+	wpsuo.mutation.SetUpdatedAt(t)
+	return wpsuo
+}
+
+// AddSyncLogIDs adds the "sync_logs" edge to the ContentSyncLog entity by IDs.
+func (wpsuo *WordPressSiteUpdateOne) AddSyncLogIDs(ids ...int) *WordPressSiteUpdateOne {
+	wpsuo.mutation.AddSyncLogIDs(ids...)
+	return wpsuo
+}
+
+// AddSyncLogs adds the "sync_logs" edges to the ContentSyncLog entity.
+func (wpsuo *WordPressSiteUpdateOne) AddSyncLogs(c ...*ContentSyncLog) *WordPressSiteUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wpsuo.AddSyncLogIDs(ids...)
+}
+
+// Mutation returns the WordPressSiteMutation object of the builder.
+func (wpsuo *WordPressSiteUpdateOne) Mutation() *WordPressSiteMutation {
+	return wpsuo.mutation
+}
+
+// ClearSyncLogs clears all "sync_logs" edges to the ContentSyncLog entity.
+func (wpsuo *WordPressSiteUpdateOne) ClearSyncLogs() *WordPressSiteUpdateOne {
+	wpsuo.mutation.ClearSyncLogs()
+	return wpsuo
+}
+
+// RemoveSyncLogIDs removes the "sync_logs" edge to ContentSyncLog entities by IDs.
+func (wpsuo *WordPressSiteUpdateOne) RemoveSyncLogIDs(ids ...int) *WordPressSiteUpdateOne {
+	wpsuo.mutation.RemoveSyncLogIDs(ids...)
+	return wpsuo
+}
+
+// RemoveSyncLogs removes "sync_logs" edges to ContentSyncLog entities.
+func (wpsuo *WordPressSiteUpdateOne) RemoveSyncLogs(c ...*ContentSyncLog) *WordPressSiteUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return wpsuo.RemoveSyncLogIDs(ids...)
+}
+
+// Where appends a list predicates to the WordPressSiteUpdate builder.
+func (wpsuo *WordPressSiteUpdateOne) Where(ps ...predicate.WordPressSite) *WordPressSiteUpdateOne {
+	wpsuo.mutation.Where(ps...)
+	return wpsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wpsuo *WordPressSiteUpdateOne) Select(field string, fields ...string) *WordPressSiteUpdateOne {
+	wpsuo.fields = append([]string{field}, fields...)
+	return wpsuo
+}
+
+// Save executes the query and returns the updated WordPressSite entity.
+func (wpsuo *WordPressSiteUpdateOne) Save(ctx context.Context) (*WordPressSite, error) {
+	wpsuo.defaults()
+	return withHooks(ctx, wpsuo.sqlSave, wpsuo.mutation, wpsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpsuo *WordPressSiteUpdateOne) SaveX(ctx context.Context) *WordPressSite {
+	node, err := wpsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wpsuo *WordPressSiteUpdateOne) Exec(ctx context.Context) error {
+	_, err := wpsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpsuo *WordPressSiteUpdateOne) ExecX(ctx context.Context) {
+	if err := wpsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpsuo *WordPressSiteUpdateOne) defaults() {
+	if _, ok := wpsuo.mutation.UpdatedAt(); !ok {
+		v := wordpresssite.UpdateDefaultUpdatedAt()
+		wpsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpsuo *WordPressSiteUpdateOne) check() error {
+	if v, ok := wpsuo.mutation.Name(); ok {
+		if err := wordpresssite.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.name": %w`, err)}
+		}
+	}
+	if v, ok := wpsuo.mutation.URL(); ok {
+		if err := wordpresssite.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.url": %w`, err)}
+		}
+	}
+	if v, ok := wpsuo.mutation.APIEndpoint(); ok {
+		if err := wordpresssite.APIEndpointValidator(v); err != nil {
+			return &ValidationError{Name: "api_endpoint", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.api_endpoint": %w`, err)}
+		}
+	}
+	if v, ok := wpsuo.mutation.Username(); ok {
+		if err := wordpresssite.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "WordPressSite.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wpsuo *WordPressSiteUpdateOne) sqlSave(ctx context.Context) (_node *WordPressSite, err error) {
+	if err := wpsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wordpresssite.Table, wordpresssite.Columns, sqlgraph.NewFieldSpec(wordpresssite.FieldID, field.TypeInt))
+	id, ok := wpsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WordPressSite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wpsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordpresssite.FieldID)
+		for _, f := range fields {
+			if !wordpresssite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wordpresssite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wpsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpsuo.mutation.Name(); ok {
+		_spec.SetField(wordpresssite.FieldName, field.TypeString, value)
+	}
+	if value, ok := wpsuo.mutation.URL(); ok {
+		_spec.SetField(wordpresssite.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wpsuo.mutation.APIEndpoint(); ok {
+		_spec.SetField(wordpresssite.FieldAPIEndpoint, field.TypeString, value)
+	}
+	if value, ok := wpsuo.mutation.Username(); ok {
+		_spec.SetField(wordpresssite.FieldUsername, field.TypeString, value)
+	}
+	if wpsuo.mutation.UsernameCleared() {
+		_spec.ClearField(wordpresssite.FieldUsername, field.TypeString)
+	}
+	if value, ok := wpsuo.mutation.ApplicationPassword(); ok {
+		_spec.SetField(wordpresssite.FieldApplicationPassword, field.TypeString, value)
+	}
+	if wpsuo.mutation.ApplicationPasswordCleared() {
+		_spec.ClearField(wordpresssite.FieldApplicationPassword, field.TypeString)
+	}
+	if value, ok := wpsuo.mutation.IsActive(); ok {
+		_spec.SetField(wordpresssite.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := wpsuo.mutation.LastSyncAt(); ok {
+		_spec.SetField(wordpresssite.FieldLastSyncAt, field.TypeTime, value)
+	}
+	if wpsuo.mutation.LastSyncAtCleared() {
+		_spec.ClearField(wordpresssite.FieldLastSyncAt, field.TypeTime)
+	}
+	if value, ok := wpsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(wordpresssite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wpsuo.mutation.SyncLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpsuo.mutation.RemovedSyncLogsIDs(); len(nodes) > 0 && !wpsuo.mutation.SyncLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpsuo.mutation.SyncLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wordpresssite.SyncLogsTable,
+			Columns: []string{wordpresssite.SyncLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentsynclog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WordPressSite{config: wpsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wpsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordpresssite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wpsuo.mutation.done = true
+	return _node, nil
+}
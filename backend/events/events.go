@@ -0,0 +1,158 @@
+// Package events fans live events (new orders, deposits, sign-ups, sync
+// progress) out to subscribers on every backend instance through Redis pub/sub.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"zplus_web/backend/models"
+)
+
+// Topics published by the services
+const (
+	OrderCreated          = "order.created"
+	DepositCompleted      = "deposit.completed"
+	UserRegistered        = "user.registered"
+	WordPressSyncProgress = "wordpress.sync_progress"
+)
+
+// channel is the Redis channel every instance publishes to and listens on
+const channel = "zplus:events"
+
+// subscriberBuffer is how many events a slow subscriber may fall behind
+// before further events are dropped for it
+const subscriberBuffer = 32
+
+// Event is a published event; Payload is the JSON encoded topic payload
+type Event struct {
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// DepositCompletedPayload is published when a wallet deposit is credited
+type DepositCompletedPayload struct {
+	Transaction models.WalletTransaction `json:"transaction"`
+}
+
+// WordPressSyncPayload reports the progress of a sync from a WordPress site
+type WordPressSyncPayload struct {
+	SiteID    int    `json:"site_id"`
+	Processed int    `json:"processed"`
+	Failed    int    `json:"failed"`
+	Total     int    `json:"total"`
+	Done      bool   `json:"done"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Bus publishes events through Redis and delivers the events received from
+// Redis to this instance's subscribers. While Redis is unreachable events
+// are delivered locally only, so a single instance keeps working without it.
+type Bus struct {
+	redis *redis.Client
+
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[string]map[int]chan Event
+}
+
+func NewBus(client *redis.Client) *Bus {
+	return &Bus{
+		redis:       client,
+		subscribers: make(map[string]map[int]chan Event),
+	}
+}
+
+// Run listens on the Redis channel until ctx is cancelled. The Redis client
+// reconnects on its own after a dropped connection.
+func (b *Bus) Run(ctx context.Context) {
+	if b.redis == nil {
+		return
+	}
+
+	pubsub := b.redis.Subscribe(ctx, channel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				log.Printf("Ignoring malformed event: %v", err)
+				continue
+			}
+			b.deliver(event)
+		}
+	}
+}
+
+// Publish sends an event to the subscribers of topic on every instance.
+// Failures are logged; a lost live event must never fail the request.
+func (b *Bus) Publish(topic string, payload interface{}) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("Failed to encode %s event: %v", topic, err)
+		return
+	}
+	event := Event{Topic: topic, Payload: data}
+
+	if b.redis != nil {
+		message, _ := json.Marshal(event)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		err := b.redis.Publish(ctx, channel, message).Err()
+		if err == nil {
+			// Delivered back to this instance by Run
+			return
+		}
+		log.Printf("Redis unavailable, delivering %s event locally only: %v", topic, err)
+	}
+
+	b.deliver(event)
+}
+
+// Subscribe returns the events published on topic until the returned
+// function is called
+func (b *Bus) Subscribe(topic string) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	b.mu.Lock()
+	b.nextID++
+	id := b.nextID
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[int]chan Event)
+	}
+	b.subscribers[topic][id] = ch
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers[topic], id)
+			b.mu.Unlock()
+		})
+	}
+}
+
+func (b *Bus) deliver(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, ch := range b.subscribers[event.Topic] {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("Dropping %s event for a slow subscriber", event.Topic)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// maxPageLimit is the largest page size a list query accepts; larger limits
+// fall back to the field's default, the same as the REST endpoints
+const maxPageLimit = 100
+
+// checkComplexity estimates the cost of the operation before it runs. Every
+// field costs 1, and the selection under a paginated field (one with a limit
+// argument) costs as many times as the number of items requested, so
+// "posts(limit: 100) { items { author { ... } } }" is priced per post.
+func (s *Schema) checkComplexity(query, operationName string, variables map[string]interface{}) []*gqlerrors.QueryError {
+	doc, errs := gqlparser.LoadQueryWithRules(s.ast, query, nil)
+	if len(errs) > 0 {
+		queryErrors := make([]*gqlerrors.QueryError, len(errs))
+		for i, err := range errs {
+			queryErrors[i] = &gqlerrors.QueryError{Message: err.Message}
+			for _, location := range err.Locations {
+				queryErrors[i].Locations = append(queryErrors[i].Locations, gqlerrors.Location{Line: location.Line, Column: location.Column})
+			}
+		}
+		return queryErrors
+	}
+
+	operation := doc.Operations.ForName(operationName)
+	if operation == nil {
+		// Let the executor report the unknown or ambiguous operation
+		return nil
+	}
+
+	cost := s.selectionCost(operation.SelectionSet, variables)
+	if cost > s.maxComplexity {
+		return []*gqlerrors.QueryError{queryError("QUERY_TOO_COMPLEX",
+			fmt.Sprintf("query complexity exceeds the limit of %d; request fewer fields or smaller pages", s.maxComplexity))}
+	}
+	return nil
+}
+
+// selectionCost adds up the cost of a selection set. It stops counting once
+// the limit is exceeded so deeply nested pages cannot overflow.
+func (s *Schema) selectionCost(set ast.SelectionSet, variables map[string]interface{}) int {
+	cost := 0
+	for _, selection := range set {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			children := s.selectionCost(selection.SelectionSet, variables)
+			if limit, ok := fieldLimit(selection, variables); ok {
+				children *= limit
+			}
+			cost += 1 + children
+		case *ast.InlineFragment:
+			cost += s.selectionCost(selection.SelectionSet, variables)
+		case *ast.FragmentSpread:
+			cost += s.selectionCost(selection.Definition.SelectionSet, variables)
+		}
+
+		if cost > s.maxComplexity {
+			return s.maxComplexity + 1
+		}
+	}
+	return cost
+}
+
+// fieldLimit returns the page size a paginated field will use
+func fieldLimit(field *ast.Field, variables map[string]interface{}) (int, bool) {
+	if field.Definition == nil {
+		return 0, false
+	}
+	definition := field.Definition.Arguments.ForName("limit")
+	if definition == nil {
+		return 0, false
+	}
+
+	defaultLimit := 0
+	if definition.DefaultValue != nil {
+		defaultLimit, _ = strconv.Atoi(definition.DefaultValue.Raw)
+	}
+
+	limit := defaultLimit
+	if argument := field.Arguments.ForName("limit"); argument != nil {
+		switch argument.Value.Kind {
+		case ast.Variable:
+			if value, ok := variables[argument.Value.Raw]; ok {
+				limit = intValue(value, defaultLimit)
+			}
+		case ast.IntValue:
+			limit, _ = strconv.Atoi(argument.Value.Raw)
+		}
+	}
+
+	return pageLimit(limit, defaultLimit), true
+}
+
+func intValue(value interface{}, fallback int) int {
+	switch value := value.(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	case int32:
+		return int(value)
+	case int64:
+		return int(value)
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return int(n)
+		}
+	}
+	return fallback
+}
+
+// pageLimit applies the REST pagination rule: out of range limits use the default
+func pageLimit(limit, defaultLimit int) int {
+	if limit < 1 || limit > maxPageLimit {
+		return defaultLimit
+	}
+	return limit
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+type contextKey int
+
+const (
+	viewerKey contextKey = iota
+	loadersKey
+)
+
+// Viewer is the authenticated caller, built from the same request locals
+// that AuthRequired sets for REST routes
+type Viewer struct {
+	UserID        int
+	Email         string
+	Role          string
+	Username      string
+	EmailVerified bool
+	// ImpersonatorID is the staff member logged in as the user, if any
+	ImpersonatorID *int
+	// APIKeyScopes further limit the role's permissions for API key requests
+	APIKeyScopes []string
+	IPAddress    string
+	UserAgent    string
+
+	// The role's permissions, looked up once per request
+	permissionsOnce sync.Once
+	permissions     []string
+	permissionsErr  error
+}
+
+// AuditActor returns who is making the request, for audit events
+func (v *Viewer) AuditActor() models.AuditActor {
+	userID := v.UserID
+	return models.AuditActor{
+		UserID:         &userID,
+		ImpersonatorID: v.ImpersonatorID,
+		Email:          v.Email,
+		IPAddress:      v.IPAddress,
+		UserAgent:      v.UserAgent,
+	}
+}
+
+// WithViewer stores the authenticated caller in ctx
+func WithViewer(ctx context.Context, viewer *Viewer) context.Context {
+	return context.WithValue(ctx, viewerKey, viewer)
+}
+
+// ViewerFrom returns the authenticated caller, or nil for anonymous requests
+func ViewerFrom(ctx context.Context) *Viewer {
+	viewer, _ := ctx.Value(viewerKey).(*Viewer)
+	return viewer
+}
+
+// requireUser returns the viewer or an AUTH_REQUIRED error
+func requireUser(ctx context.Context) (*Viewer, error) {
+	viewer := ViewerFrom(ctx)
+	if viewer == nil {
+		return nil, newError(apperr.CodeAuthRequired, "Authentication required", "Send an access token or API key as a Bearer token")
+	}
+	return viewer, nil
+}
+
+// requireCustomer returns the viewer for the customer's own wallet and
+// points, which staff logged in as the customer may not touch
+func requireCustomer(ctx context.Context) (*Viewer, error) {
+	viewer, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if viewer.ImpersonatorID != nil {
+		return nil, impersonationForbidden()
+	}
+	return viewer, nil
+}
+
+func impersonationForbidden() *Error {
+	return newError(apperr.CodeImpersonationForbidden, "Not allowed while impersonating a user", "This action cannot be performed in a login-as-customer session")
+}
+
+// hasPermission reports whether the viewer's role grants permission, the same
+// way RequirePermission and HasPermission do for REST routes
+func (r *Resolver) hasPermission(ctx context.Context, viewer *Viewer, permission string) (bool, error) {
+	if viewer == nil || viewer.ImpersonatorID != nil {
+		return false, nil
+	}
+	if viewer.APIKeyScopes != nil && !rbac.Has(viewer.APIKeyScopes, permission) {
+		return false, nil
+	}
+
+	viewer.permissionsOnce.Do(func() {
+		viewer.permissions, viewer.permissionsErr = r.roles.RolePermissions(ctx, viewer.Role)
+	})
+	if viewer.permissionsErr != nil {
+		return false, internalError("Failed to check permissions", viewer.permissionsErr)
+	}
+	return rbac.Has(viewer.permissions, permission), nil
+}
+
+// requirePermission returns the viewer if their role grants permission
+func (r *Resolver) requirePermission(ctx context.Context, permission string) (*Viewer, error) {
+	viewer, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if viewer.ImpersonatorID != nil {
+		return nil, impersonationForbidden()
+	}
+
+	ok, err := r.hasPermission(ctx, viewer, permission)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newError(apperr.CodePermissionDenied, "Permission denied", "Missing permission "+permission)
+	}
+	return viewer, nil
+}
@@ -0,0 +1,202 @@
+// Package graph serves the GraphQL API described in schema.graphql. Resolvers
+// are thin wrappers around the services package, so GraphQL and REST share
+// the same queries, validation rules and audit trail.
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/events"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/services"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Resolver is the root resolver; its methods are the Query, Mutation and Subscription fields
+type Resolver struct {
+	users     *services.UserService
+	blog      *services.BlogService
+	projects  *services.ProjectService
+	products  *services.ProductService
+	orders    *services.OrderService
+	payments  *services.PaymentService
+	audit     *services.AuditService
+	roles     middleware.PermissionResolver
+	events    *events.Bus
+	validator *validator.Validate
+}
+
+func NewResolver(
+	userService *services.UserService,
+	blogService *services.BlogService,
+	projectService *services.ProjectService,
+	productService *services.ProductService,
+	orderService *services.OrderService,
+	paymentService *services.PaymentService,
+	auditService *services.AuditService,
+	roles middleware.PermissionResolver,
+	bus *events.Bus,
+) *Resolver {
+	return &Resolver{
+		users:     userService,
+		blog:      blogService,
+		projects:  projectService,
+		products:  productService,
+		orders:    orderService,
+		payments:  paymentService,
+		audit:     auditService,
+		roles:     roles,
+		events:    bus,
+		validator: validator.New(),
+	}
+}
+
+// Schema is the executable GraphQL schema with its query limits
+type Schema struct {
+	exec          *graphql.Schema
+	ast           *ast.Schema
+	resolver      *Resolver
+	maxComplexity int
+	development   bool
+}
+
+// NewSchema parses the schema and binds it to the resolver. Queries nested
+// deeper than maxDepth or costing more than maxComplexity are rejected
+// before anything is resolved. The causes of internal errors are only
+// added to their details in development.
+func NewSchema(resolver *Resolver, maxDepth, maxComplexity int, development bool) (*Schema, error) {
+	exec, err := graphql.ParseSchema(schemaSDL, resolver, graphql.MaxDepth(maxDepth))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL schema: %w", err)
+	}
+
+	parsed, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load GraphQL schema: %w", err)
+	}
+
+	return &Schema{
+		exec:          exec,
+		ast:           parsed,
+		resolver:      resolver,
+		maxComplexity: maxComplexity,
+		development:   development,
+	}, nil
+}
+
+// Exec runs a query or mutation for the viewer stored in ctx. Every call gets
+// its own loaders, so batched lookups are never shared between requests.
+func (s *Schema) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Response {
+	if errs := s.checkComplexity(query, operationName, variables); len(errs) > 0 {
+		return &graphql.Response{Errors: errs}
+	}
+
+	ctx = withLoaders(ctx, newLoaders(s.resolver, false))
+	resp := s.exec.Exec(ctx, query, operationName, variables)
+	s.reportErrors(resp.Errors)
+	return resp
+}
+
+// reportErrors logs the causes of server errors and, in development, adds
+// every cause to the details sent to the client
+func (s *Schema) reportErrors(errs []*gqlerrors.QueryError) {
+	for _, queryErr := range errs {
+		var resolverErr *Error
+		if !errors.As(queryErr.ResolverError, &resolverErr) || resolverErr.cause == nil {
+			continue
+		}
+		if resolverErr.status >= 500 {
+			log.Printf("graphql %v: %v", queryErr.Path, resolverErr.cause)
+		}
+		if s.development {
+			details := resolverErr.Details
+			if details != "" {
+				details += ": "
+			}
+			queryErr.Extensions["details"] = details + resolverErr.cause.Error()
+		}
+	}
+}
+
+// Subscribe starts a subscription for the viewer stored in ctx. The channel
+// yields a *graphql.Response per event and is closed once ctx is cancelled
+// or the subscription fails.
+func (s *Schema) Subscribe(ctx context.Context, query, operationName string, variables map[string]interface{}) (<-chan interface{}, error) {
+	if errs := s.checkComplexity(query, operationName, variables); len(errs) > 0 {
+		responses := make(chan interface{}, 1)
+		responses <- &graphql.Response{Errors: errs}
+		close(responses)
+		return responses, nil
+	}
+
+	ctx = withLoaders(ctx, newLoaders(s.resolver, true))
+	return s.exec.Subscribe(ctx, query, operationName, variables)
+}
+
+// Error is returned by resolvers; its code is exposed in the error's
+// extensions using the same codes as the REST API
+type Error struct {
+	Code    string
+	Message string
+	Details string
+	Fields  map[string]string
+	// status and cause come from the service's *apperr.Error
+	status int
+	cause  error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.Code}
+	if e.Details != "" {
+		extensions["details"] = e.Details
+	}
+	if len(e.Fields) > 0 {
+		extensions["fields"] = e.Fields
+	}
+	return extensions
+}
+
+func newError(code, message, details string) *Error {
+	return &Error{Code: code, Message: message, Details: details}
+}
+
+// internalError reports a failed service call. Errors the service gave a
+// code, e.g. NOT_FOUND or ALREADY_EXISTS, keep it; anything else is an
+// INTERNAL_ERROR with message.
+func internalError(message string, err error) *Error {
+	return fromAppError(apperr.Internal(message, err))
+}
+
+func fromAppError(e *apperr.Error) *Error {
+	return &Error{
+		Code:    e.Code,
+		Message: e.Message,
+		Details: e.Details,
+		Fields:  e.Fields,
+		status:  e.Status,
+		cause:   e.Err,
+	}
+}
+
+func queryError(code, message string) *gqlerrors.QueryError {
+	return &gqlerrors.QueryError{
+		Message:    message,
+		Extensions: map[string]interface{}{"code": code},
+	}
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"zplus_web/backend/models"
+)
+
+// loaders batch the per-item lookups made while resolving lists (a post's
+// author, an order's items, ...) into one query per field. They live for a
+// single request, so their caches never serve stale or another user's data.
+// A subscription lives much longer, so its loaders forget everything after
+// each batch and every event is resolved from fresh data.
+type loaders struct {
+	users             *dataloader.Loader[int, *models.User]
+	postCategories    *dataloader.Loader[int, []models.BlogCategory]
+	categoryPostCount *dataloader.Loader[int, int]
+	products          *dataloader.Loader[int, *models.SoftwareProduct]
+	productCategories *dataloader.Loader[int, *models.ProductCategory]
+	orderItems        *dataloader.Loader[int, []models.OrderItem]
+}
+
+func newLoaders(r *Resolver, live bool) *loaders {
+	return &loaders{
+		users: dataloader.NewBatchedLoader(func(ctx context.Context, ids []int) []*dataloader.Result[*models.User] {
+			users, err := r.users.GetUsersByIDs(ctx, ids)
+			byID := make(map[int]*models.User, len(users))
+			for i := range users {
+				byID[users[i].ID] = &users[i]
+			}
+			return results(ids, byID, err)
+		}, cacheOptions[int, *models.User](live)...),
+		postCategories: dataloader.NewBatchedLoader(func(ctx context.Context, postIDs []int) []*dataloader.Result[[]models.BlogCategory] {
+			categories, err := r.blog.GetCategoriesByPostIDs(ctx, postIDs)
+			return results(postIDs, categories, err)
+		}, cacheOptions[int, []models.BlogCategory](live)...),
+		categoryPostCount: dataloader.NewBatchedLoader(func(ctx context.Context, categoryIDs []int) []*dataloader.Result[int] {
+			counts, err := r.blog.CountPublishedPosts(ctx, categoryIDs)
+			return results(categoryIDs, counts, err)
+		}, cacheOptions[int, int](live)...),
+		products: dataloader.NewBatchedLoader(func(ctx context.Context, ids []int) []*dataloader.Result[*models.SoftwareProduct] {
+			products, err := r.products.GetProductsByIDs(ctx, ids)
+			byID := make(map[int]*models.SoftwareProduct, len(products))
+			for i := range products {
+				byID[products[i].ID] = &products[i]
+			}
+			return results(ids, byID, err)
+		}, cacheOptions[int, *models.SoftwareProduct](live)...),
+		productCategories: dataloader.NewBatchedLoader(func(ctx context.Context, ids []int) []*dataloader.Result[*models.ProductCategory] {
+			categories, err := r.products.GetCategoriesByIDs(ctx, ids)
+			byID := make(map[int]*models.ProductCategory, len(categories))
+			for i := range categories {
+				byID[categories[i].ID] = &categories[i]
+			}
+			return results(ids, byID, err)
+		}, cacheOptions[int, *models.ProductCategory](live)...),
+		orderItems: dataloader.NewBatchedLoader(func(ctx context.Context, orderIDs []int) []*dataloader.Result[[]models.OrderItem] {
+			items, err := r.orders.GetItemsByOrderIDs(ctx, orderIDs)
+			return results(orderIDs, items, err)
+		}, cacheOptions[int, []models.OrderItem](live)...),
+	}
+}
+
+func cacheOptions[K comparable, V any](live bool) []dataloader.Option[K, V] {
+	if live {
+		return []dataloader.Option[K, V]{dataloader.WithClearCacheOnBatch[K, V]()}
+	}
+	return nil
+}
+
+// results lines a batch query's values up with the requested keys. Keys with
+// no value get the zero value, e.g. a nil user for a deleted author.
+func results[V any](keys []int, values map[int]V, err error) []*dataloader.Result[V] {
+	out := make([]*dataloader.Result[V], len(keys))
+	for i, key := range keys {
+		if err != nil {
+			out[i] = &dataloader.Result[V]{Error: err}
+			continue
+		}
+		out[i] = &dataloader.Result[V]{Data: values[key]}
+	}
+	return out
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey).(*loaders)
+}
@@ -0,0 +1,287 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/services"
+)
+
+// postInput mirrors the REST create/update post request
+type postInput struct {
+	Title         string `validate:"required,max=255"`
+	Slug          string `validate:"required,max=255"`
+	Content       string `validate:"required"`
+	Excerpt       *string
+	FeaturedImage *string
+	Status        string
+	Featured      *bool
+}
+
+func (r *Resolver) CreatePost(ctx context.Context, args struct{ Input postInput }) (*postResolver, error) {
+	viewer, status, err := r.checkPostInput(ctx, args.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	input := args.Input
+	post, err := r.blog.CreatePost(ctx, viewer.UserID, input.Title, input.Slug, input.Content,
+		deref(input.Excerpt), deref(input.FeaturedImage), status, input.Featured != nil && *input.Featured)
+	if err != nil {
+		return nil, internalError("Failed to create blog post", err)
+	}
+
+	r.audit.Record(ctx, viewer.AuditActor(), services.AuditBlogPostCreate, "blog_post", strconv.Itoa(post.ID), nil, post)
+
+	return &postResolver{r: r, post: post}, nil
+}
+
+func (r *Resolver) UpdatePost(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input postInput
+}) (*postResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	viewer, status, err := r.checkPostInput(ctx, args.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	input := args.Input
+	post, err := r.blog.UpdatePost(ctx, id, input.Title, input.Slug, input.Content,
+		deref(input.Excerpt), deref(input.FeaturedImage), status, input.Featured != nil && *input.Featured, viewer.AuditActor())
+	if err != nil {
+		return nil, internalError("Failed to update blog post", err)
+	}
+
+	return &postResolver{r: r, post: post}, nil
+}
+
+// checkPostInput validates a post and checks that the viewer may write it;
+// publishing also needs blog:publish. Returns the status as stored.
+func (r *Resolver) checkPostInput(ctx context.Context, input postInput) (*Viewer, string, error) {
+	viewer, err := r.requirePermission(ctx, rbac.BlogWrite)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := r.validate(input); err != nil {
+		return nil, "", err
+	}
+
+	status := strings.ToLower(input.Status)
+	if status == "published" {
+		ok, err := r.hasPermission(ctx, viewer, rbac.BlogPublish)
+		if err != nil {
+			return nil, "", err
+		}
+		if !ok {
+			return nil, "", newError(apperr.CodePermissionDenied, "Permission denied", "Missing permission "+rbac.BlogPublish)
+		}
+	}
+	return viewer, status, nil
+}
+
+func (r *Resolver) DeletePost(ctx context.Context, args struct{ ID graphql.ID }) (bool, error) {
+	viewer, err := r.requirePermission(ctx, rbac.BlogWrite)
+	if err != nil {
+		return false, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.blog.DeletePost(ctx, id); err != nil {
+		return false, internalError("Failed to delete blog post", err)
+	}
+
+	r.audit.Record(ctx, viewer.AuditActor(), services.AuditBlogPostDelete, "blog_post", strconv.Itoa(id), nil, nil)
+
+	return true, nil
+}
+
+type categoryInput struct {
+	Name        string `validate:"required,max=100"`
+	Slug        string `validate:"required,max=100"`
+	Description *string
+}
+
+func (r *Resolver) CreateCategory(ctx context.Context, args struct{ Input categoryInput }) (*categoryResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.BlogWrite); err != nil {
+		return nil, err
+	}
+	if err := r.validate(args.Input); err != nil {
+		return nil, err
+	}
+
+	category, err := r.blog.CreateCategory(ctx, args.Input.Name, args.Input.Slug, deref(args.Input.Description))
+	if err != nil {
+		return nil, internalError("Failed to create blog category", err)
+	}
+
+	return &categoryResolver{r: r, category: category}, nil
+}
+
+// projectInput mirrors the REST create/update project request
+type projectInput struct {
+	Name             string `validate:"required,max=255"`
+	Slug             string `validate:"required,max=255"`
+	Description      string `validate:"required"`
+	ShortDescription *string
+	FeaturedImage    *string
+	GalleryImages    *[]string
+	Technologies     *[]string
+	ProjectURL       *string
+	GithubURL        *string
+	DemoURL          *string
+	Status           *string `validate:"omitempty,oneof=planning development completed maintenance"`
+	StartDate        *graphql.Time
+	EndDate          *graphql.Time
+	Featured         *bool
+	SortOrder        *int32
+}
+
+// project converts the input to the model the project service stores
+func (p projectInput) project() models.Project {
+	project := models.Project{
+		Name:             p.Name,
+		Slug:             p.Slug,
+		Description:      p.Description,
+		ShortDescription: p.ShortDescription,
+		FeaturedImage:    p.FeaturedImage,
+		ProjectURL:       p.ProjectURL,
+		GithubURL:        p.GithubURL,
+		DemoURL:          p.DemoURL,
+		Status:           "development",
+		IsFeatured:       p.Featured != nil && *p.Featured,
+	}
+	if p.GalleryImages != nil {
+		project.GalleryImages = *p.GalleryImages
+	}
+	if p.Technologies != nil {
+		project.Technologies = *p.Technologies
+	}
+	if p.Status != nil {
+		project.Status = *p.Status
+	}
+	if p.StartDate != nil {
+		project.StartDate = &p.StartDate.Time
+	}
+	if p.EndDate != nil {
+		project.EndDate = &p.EndDate.Time
+	}
+	if p.SortOrder != nil {
+		project.SortOrder = int(*p.SortOrder)
+	}
+	return project
+}
+
+func (r *Resolver) CreateProject(ctx context.Context, args struct{ Input projectInput }) (*projectResolver, error) {
+	viewer, err := r.requirePermission(ctx, rbac.ProjectsWrite)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.validate(args.Input); err != nil {
+		return nil, err
+	}
+
+	project, err := r.projects.CreateProject(ctx, args.Input.project())
+	if err != nil {
+		return nil, internalError("Failed to create project", err)
+	}
+
+	r.audit.Record(ctx, viewer.AuditActor(), services.AuditProjectCreate, "project", strconv.Itoa(project.ID), nil, project)
+
+	return &projectResolver{project: project}, nil
+}
+
+func (r *Resolver) UpdateProject(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input projectInput
+}) (*projectResolver, error) {
+	viewer, err := r.requirePermission(ctx, rbac.ProjectsWrite)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.validate(args.Input); err != nil {
+		return nil, err
+	}
+
+	project, err := r.projects.UpdateProject(ctx, id, args.Input.project(), viewer.AuditActor())
+	if err != nil {
+		return nil, internalError("Failed to update project", err)
+	}
+
+	return &projectResolver{project: project}, nil
+}
+
+func (r *Resolver) DeleteProject(ctx context.Context, args struct{ ID graphql.ID }) (bool, error) {
+	viewer, err := r.requirePermission(ctx, rbac.ProjectsWrite)
+	if err != nil {
+		return false, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.projects.DeleteProject(ctx, id); err != nil {
+		return false, internalError("Failed to delete project", err)
+	}
+
+	r.audit.Record(ctx, viewer.AuditActor(), services.AuditProjectDelete, "project", strconv.Itoa(id), nil, nil)
+
+	return true, nil
+}
+
+type depositInput struct {
+	Amount        float64 `validate:"required,min=1000"`
+	PaymentMethod string  `validate:"required,oneof=vnpay momo zalopay banking"`
+}
+
+// RequestDeposit starts a wallet deposit, like POST /wallet/deposit
+func (r *Resolver) RequestDeposit(ctx context.Context, args struct{ Input depositInput }) (*depositPayloadResolver, error) {
+	viewer, err := requireCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !viewer.EmailVerified {
+		return nil, newError(apperr.CodeEmailNotVerified, "Email verification required", "Verify your email address to access this resource")
+	}
+	if err := r.validate(args.Input); err != nil {
+		return nil, err
+	}
+
+	transaction, err := r.payments.CreateDepositTransaction(ctx, viewer.UserID, args.Input.Amount, args.Input.PaymentMethod)
+	if err != nil {
+		return nil, internalError("Failed to create deposit request", err)
+	}
+
+	referenceID := ""
+	if transaction.ReferenceID != nil {
+		referenceID = *transaction.ReferenceID
+	}
+
+	return &depositPayloadResolver{
+		transaction: &walletTransactionResolver{transaction: transaction},
+		paymentURL:  r.payments.PaymentURL(args.Input.PaymentMethod, referenceID, args.Input.Amount),
+	}, nil
+}
+
+func (r *Resolver) validate(input interface{}) error {
+	if err := r.validator.Struct(input); err != nil {
+		return fromAppError(apperr.Invalid("Validation failed", err))
+	}
+	return nil
+}
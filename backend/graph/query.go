@@ -0,0 +1,345 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/repository"
+)
+
+// Me returns the logged-in user, or null for anonymous requests
+func (r *Resolver) Me(ctx context.Context) (*userResolver, error) {
+	viewer := ViewerFrom(ctx)
+	if viewer == nil {
+		return nil, nil
+	}
+
+	user, err := r.users.GetUserByID(ctx, viewer.UserID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to get user profile", err)
+	}
+	return &userResolver{r: r, user: user}, nil
+}
+
+type postsArgs struct {
+	Page     int32
+	Limit    int32
+	Category *string
+	Featured *bool
+	Search   *string
+}
+
+// Posts returns published blog posts
+func (r *Resolver) Posts(ctx context.Context, args postsArgs) (*listPage[*postResolver], error) {
+	page, limit := pagination(args.Page, args.Limit, 10)
+
+	posts, total, err := r.blog.GetPosts(ctx, page, limit, deref(args.Category), featuredFilter(args.Featured), deref(args.Search))
+	if err != nil {
+		return nil, internalError("Failed to retrieve blog posts", err)
+	}
+
+	items := make([]*postResolver, len(posts))
+	for i := range posts {
+		items[i] = &postResolver{r: r, post: &posts[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+// Post returns a published blog post, or null if there is none with the slug
+func (r *Resolver) Post(ctx context.Context, args struct{ Slug string }) (*postResolver, error) {
+	post, err := r.blog.GetPostBySlug(ctx, args.Slug)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to retrieve blog post", err)
+	}
+	return &postResolver{r: r, post: post}, nil
+}
+
+func (r *Resolver) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	categories, err := r.blog.GetCategories(ctx)
+	if err != nil {
+		return nil, internalError("Failed to retrieve blog categories", err)
+	}
+	return r.categoryResolvers(categories), nil
+}
+
+type projectsArgs struct {
+	Page     int32
+	Limit    int32
+	Status   *string
+	Featured *bool
+	Search   *string
+}
+
+func (r *Resolver) Projects(ctx context.Context, args projectsArgs) (*listPage[*projectResolver], error) {
+	page, limit := pagination(args.Page, args.Limit, 10)
+
+	projects, total, err := r.projects.GetProjects(ctx, page, limit, deref(args.Status), featuredFilter(args.Featured), deref(args.Search))
+	if err != nil {
+		return nil, internalError("Failed to retrieve projects", err)
+	}
+
+	items := make([]*projectResolver, len(projects))
+	for i := range projects {
+		items[i] = &projectResolver{project: &projects[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+func (r *Resolver) Project(ctx context.Context, args struct{ Slug string }) (*projectResolver, error) {
+	project, err := r.projects.GetProjectBySlug(ctx, args.Slug)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to retrieve project", err)
+	}
+	return &projectResolver{project: project}, nil
+}
+
+type productsArgs struct {
+	Page     int32
+	Limit    int32
+	Category *string
+	Featured *bool
+	Search   *string
+}
+
+// Products returns active products
+func (r *Resolver) Products(ctx context.Context, args productsArgs) (*listPage[*productResolver], error) {
+	page, limit := pagination(args.Page, args.Limit, 10)
+
+	products, total, err := r.products.GetProducts(ctx, page, limit, deref(args.Category), featuredFilter(args.Featured), deref(args.Search))
+	if err != nil {
+		return nil, internalError("Failed to retrieve products", err)
+	}
+
+	items := make([]*productResolver, len(products))
+	for i := range products {
+		items[i] = &productResolver{r: r, product: &products[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+func (r *Resolver) Product(ctx context.Context, args struct{ Slug string }) (*productResolver, error) {
+	product, err := r.products.GetProductBySlug(ctx, args.Slug)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to retrieve product", err)
+	}
+	return &productResolver{r: r, product: product}, nil
+}
+
+func (r *Resolver) ProductCategories(ctx context.Context) ([]*productCategoryResolver, error) {
+	categories, err := r.products.GetCategories(ctx)
+	if err != nil {
+		return nil, internalError("Failed to retrieve product categories", err)
+	}
+
+	resolvers := make([]*productCategoryResolver, len(categories))
+	for i := range categories {
+		resolvers[i] = &productCategoryResolver{r: r, category: &categories[i]}
+	}
+	return resolvers, nil
+}
+
+// MyOrders returns the logged-in user's orders
+func (r *Resolver) MyOrders(ctx context.Context, args struct{ Page, Limit int32 }) (*listPage[*orderResolver], error) {
+	viewer, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination(args.Page, args.Limit, 10)
+	return r.orderPage(ctx, page, limit, repository.OrderFilter{UserID: &viewer.UserID})
+}
+
+func (r *Resolver) Wallet(ctx context.Context) (*walletResolver, error) {
+	viewer, err := requireCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := r.payments.GetWallet(ctx, viewer.UserID)
+	if err != nil {
+		return nil, internalError("Failed to get wallet information", err)
+	}
+	return &walletResolver{wallet: wallet}, nil
+}
+
+type walletTransactionsArgs struct {
+	Page  int32
+	Limit int32
+	Type  *string
+}
+
+func (r *Resolver) WalletTransactions(ctx context.Context, args walletTransactionsArgs) (*listPage[*walletTransactionResolver], error) {
+	viewer, err := requireCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination(args.Page, args.Limit, 20)
+	transactions, total, err := r.payments.GetWalletTransactions(ctx, viewer.UserID, page, limit, deref(args.Type))
+	if err != nil {
+		return nil, internalError("Failed to get wallet transactions", err)
+	}
+
+	items := make([]*walletTransactionResolver, len(transactions))
+	for i := range transactions {
+		items[i] = &walletTransactionResolver{transaction: &transactions[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+func (r *Resolver) Points(ctx context.Context) (*pointsResolver, error) {
+	viewer, err := requireCustomer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := r.payments.GetUserPoints(ctx, viewer.UserID)
+	if err != nil {
+		return nil, internalError("Failed to get points information", err)
+	}
+	return &pointsResolver{points: points}, nil
+}
+
+type usersArgs struct {
+	Page   int32
+	Limit  int32
+	Search *string
+}
+
+func (r *Resolver) Users(ctx context.Context, args usersArgs) (*listPage[*userResolver], error) {
+	if _, err := r.requirePermission(ctx, rbac.UsersRead); err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination(args.Page, args.Limit, 20)
+	users, total, err := r.users.GetUsers(ctx, page, limit, deref(args.Search))
+	if err != nil {
+		return nil, internalError("Failed to retrieve users", err)
+	}
+
+	items := make([]*userResolver, len(users))
+	for i := range users {
+		items[i] = &userResolver{r: r, user: &users[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+func (r *Resolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.UsersRead); err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.users.GetUserByID(ctx, id)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to retrieve user", err)
+	}
+	return &userResolver{r: r, user: user}, nil
+}
+
+// Order returns an order to its owner or to staff with orders:read. Other
+// users get null, the same as for an order that does not exist.
+func (r *Resolver) Order(ctx context.Context, args struct{ ID graphql.ID }) (*orderResolver, error) {
+	viewer, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orders.GetOrder(ctx, id)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, internalError("Failed to retrieve order", err)
+	}
+
+	if order.UserID == nil || *order.UserID != viewer.UserID {
+		ok, err := r.hasPermission(ctx, viewer, rbac.OrdersRead)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, nil
+		}
+	}
+	return &orderResolver{r: r, order: order}, nil
+}
+
+type ordersArgs struct {
+	Page          int32
+	Limit         int32
+	PaymentStatus *string
+	OrderStatus   *string
+}
+
+func (r *Resolver) Orders(ctx context.Context, args ordersArgs) (*listPage[*orderResolver], error) {
+	if _, err := r.requirePermission(ctx, rbac.OrdersRead); err != nil {
+		return nil, err
+	}
+
+	page, limit := pagination(args.Page, args.Limit, 20)
+	return r.orderPage(ctx, page, limit, repository.OrderFilter{
+		PaymentStatus: deref(args.PaymentStatus),
+		OrderStatus:   deref(args.OrderStatus),
+	})
+}
+
+func (r *Resolver) orderPage(ctx context.Context, page, limit int, filter repository.OrderFilter) (*listPage[*orderResolver], error) {
+	orders, total, err := r.orders.GetOrders(ctx, page, limit, filter)
+	if err != nil {
+		return nil, internalError("Failed to retrieve orders", err)
+	}
+
+	items := make([]*orderResolver, len(orders))
+	for i := range orders {
+		items[i] = &orderResolver{r: r, order: &orders[i]}
+	}
+	return newPage(items, page, limit, total), nil
+}
+
+// pagination applies the REST bounds to the page arguments
+func pagination(page, limit int32, defaultLimit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	return int(page), pageLimit(int(limit), defaultLimit)
+}
+
+// featuredFilter maps the featured argument to the services' "true" filter
+func featuredFilter(featured *bool) string {
+	if featured != nil && *featured {
+		return "true"
+	}
+	return ""
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
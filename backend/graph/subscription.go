@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/graph-gophers/graphql-go"
+	"zplus_web/backend/events"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+// OrderCreated streams new orders to staff with orders:read
+func (r *Resolver) OrderCreated(ctx context.Context) (<-chan *orderResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.OrdersRead); err != nil {
+		return nil, err
+	}
+
+	return subscribe(ctx, r, rbac.OrdersRead, events.OrderCreated, func(event events.Event) (*orderResolver, bool) {
+		var order models.Order
+		if !decode(event, &order) {
+			return nil, false
+		}
+		return &orderResolver{r: r, order: &order}, true
+	}), nil
+}
+
+// DepositCompleted streams credited wallet deposits to staff with orders:read
+func (r *Resolver) DepositCompleted(ctx context.Context) (<-chan *depositEventResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.OrdersRead); err != nil {
+		return nil, err
+	}
+
+	return subscribe(ctx, r, rbac.OrdersRead, events.DepositCompleted, func(event events.Event) (*depositEventResolver, bool) {
+		var payload events.DepositCompletedPayload
+		if !decode(event, &payload) {
+			return nil, false
+		}
+		return &depositEventResolver{r: r, transaction: &payload.Transaction}, true
+	}), nil
+}
+
+// UserRegistered streams new customer accounts to staff with users:read
+func (r *Resolver) UserRegistered(ctx context.Context) (<-chan *userResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.UsersRead); err != nil {
+		return nil, err
+	}
+
+	return subscribe(ctx, r, rbac.UsersRead, events.UserRegistered, func(event events.Event) (*userResolver, bool) {
+		var user models.User
+		if !decode(event, &user) {
+			return nil, false
+		}
+		return &userResolver{r: r, user: &user}, true
+	}), nil
+}
+
+// WordpressSyncProgress streams WordPress sync progress to staff with wordpress:manage
+func (r *Resolver) WordpressSyncProgress(ctx context.Context, args struct{ SiteID *graphql.ID }) (<-chan *syncProgressResolver, error) {
+	if _, err := r.requirePermission(ctx, rbac.WordPressManage); err != nil {
+		return nil, err
+	}
+
+	siteID := 0
+	if args.SiteID != nil {
+		id, err := parseID(*args.SiteID)
+		if err != nil {
+			return nil, err
+		}
+		siteID = id
+	}
+
+	return subscribe(ctx, r, rbac.WordPressManage, events.WordPressSyncProgress, func(event events.Event) (*syncProgressResolver, bool) {
+		var progress events.WordPressSyncPayload
+		if !decode(event, &progress) || (siteID != 0 && progress.SiteID != siteID) {
+			return nil, false
+		}
+		return &syncProgressResolver{progress: &progress}, true
+	}), nil
+}
+
+// subscribe relays the events published on topic until ctx is done. convert
+// turns an event into the field's resolver or reports that it is skipped.
+// The viewer's permission is looked up again for every event, so the
+// subscription ends once their role no longer grants it.
+func subscribe[T any](ctx context.Context, r *Resolver, permission, topic string, convert func(events.Event) (T, bool)) <-chan T {
+	viewer := ViewerFrom(ctx)
+	in, unsubscribe := r.events.Subscribe(topic)
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-in:
+				value, ok := convert(event)
+				if !ok {
+					continue
+				}
+				if !r.stillPermitted(ctx, viewer, permission) {
+					return
+				}
+				select {
+				case out <- value:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// stillPermitted reports whether the viewer's role grants permission now,
+// unlike hasPermission which keeps the permissions for the whole request
+func (r *Resolver) stillPermitted(ctx context.Context, viewer *Viewer, permission string) bool {
+	if viewer == nil || (viewer.APIKeyScopes != nil && !rbac.Has(viewer.APIKeyScopes, permission)) {
+		return false
+	}
+	permissions, err := r.roles.RolePermissions(ctx, viewer.Role)
+	if err != nil {
+		log.Printf("Ending %s subscription of user %d: %v", permission, viewer.UserID, err)
+		return false
+	}
+	return rbac.Has(permissions, permission)
+}
+
+func decode(event events.Event, v interface{}) bool {
+	if err := json.Unmarshal(event.Payload, v); err != nil {
+		log.Printf("Skipping malformed %s event: %v", event.Topic, err)
+		return false
+	}
+	return true
+}
+
+type depositEventResolver struct {
+	r           *Resolver
+	transaction *models.WalletTransaction
+}
+
+func (d *depositEventResolver) Transaction() *walletTransactionResolver {
+	return &walletTransactionResolver{transaction: d.transaction}
+}
+
+func (d *depositEventResolver) User(ctx context.Context) (*userResolver, error) {
+	return d.r.loadUser(ctx, &d.transaction.UserID)
+}
+
+type syncProgressResolver struct {
+	progress *events.WordPressSyncPayload
+}
+
+func (s *syncProgressResolver) SiteID() graphql.ID { return toID(s.progress.SiteID) }
+func (s *syncProgressResolver) Processed() int32   { return int32(s.progress.Processed) }
+func (s *syncProgressResolver) Failed() int32      { return int32(s.progress.Failed) }
+func (s *syncProgressResolver) Total() int32       { return int32(s.progress.Total) }
+func (s *syncProgressResolver) Done() bool         { return s.progress.Done }
+
+func (s *syncProgressResolver) Error() *string {
+	if s.progress.Error == "" {
+		return nil
+	}
+	return &s.progress.Error
+}
@@ -0,0 +1,389 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+// listPage is the resolver for every *Page type in the schema
+type listPage[T any] struct {
+	items    []T
+	pageInfo *pageInfoResolver
+}
+
+func (p *listPage[T]) Items() []T {
+	return p.items
+}
+
+func (p *listPage[T]) PageInfo() *pageInfoResolver {
+	return p.pageInfo
+}
+
+func newPage[T any](items []T, page, limit, total int) *listPage[T] {
+	totalPages := (total + limit - 1) / limit
+	return &listPage[T]{
+		items: items,
+		pageInfo: &pageInfoResolver{
+			page:       page,
+			limit:      limit,
+			totalItems: total,
+			totalPages: totalPages,
+		},
+	}
+}
+
+type pageInfoResolver struct {
+	page, limit, totalItems, totalPages int
+}
+
+func (p *pageInfoResolver) Page() int32           { return int32(p.page) }
+func (p *pageInfoResolver) Limit() int32          { return int32(p.limit) }
+func (p *pageInfoResolver) TotalItems() int32     { return int32(p.totalItems) }
+func (p *pageInfoResolver) TotalPages() int32     { return int32(p.totalPages) }
+func (p *pageInfoResolver) HasNextPage() bool     { return p.page < p.totalPages }
+func (p *pageInfoResolver) HasPreviousPage() bool { return p.page > 1 }
+
+// userResolver exposes the public profile to everyone and the account
+// details only to the user themselves and staff with users:read
+type userResolver struct {
+	r    *Resolver
+	user *models.User
+}
+
+func (u *userResolver) ID() graphql.ID          { return toID(u.user.ID) }
+func (u *userResolver) Username() string        { return u.user.Username }
+func (u *userResolver) FullName() *string       { return u.user.FullName }
+func (u *userResolver) AvatarURL() *string      { return u.user.AvatarURL }
+func (u *userResolver) CreatedAt() graphql.Time { return toTime(u.user.CreatedAt) }
+
+func (u *userResolver) Email(ctx context.Context) (*string, error) {
+	return private(ctx, u, &u.user.Email)
+}
+
+func (u *userResolver) Phone(ctx context.Context) (*string, error) {
+	if ok, err := u.canSeePrivate(ctx); !ok || err != nil {
+		return nil, err
+	}
+	return u.user.Phone, nil
+}
+
+func (u *userResolver) Role(ctx context.Context) (*string, error) {
+	return private(ctx, u, &u.user.Role)
+}
+
+func (u *userResolver) IsActive(ctx context.Context) (*bool, error) {
+	return private(ctx, u, &u.user.IsActive)
+}
+
+func (u *userResolver) EmailVerified(ctx context.Context) (*bool, error) {
+	return private(ctx, u, &u.user.EmailVerified)
+}
+
+func (u *userResolver) canSeePrivate(ctx context.Context) (bool, error) {
+	viewer := ViewerFrom(ctx)
+	if viewer != nil && viewer.UserID == u.user.ID {
+		return true, nil
+	}
+	return u.r.hasPermission(ctx, viewer, rbac.UsersRead)
+}
+
+func private[T any](ctx context.Context, u *userResolver, value *T) (*T, error) {
+	if ok, err := u.canSeePrivate(ctx); !ok || err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (r *Resolver) loadUser(ctx context.Context, id *int) (*userResolver, error) {
+	if id == nil {
+		return nil, nil
+	}
+	user, err := loadersFrom(ctx).users.Load(ctx, *id)()
+	if err != nil {
+		return nil, internalError("Failed to retrieve user", err)
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return &userResolver{r: r, user: user}, nil
+}
+
+type postResolver struct {
+	r    *Resolver
+	post *models.BlogPost
+}
+
+func (p *postResolver) ID() graphql.ID             { return toID(p.post.ID) }
+func (p *postResolver) Title() string              { return p.post.Title }
+func (p *postResolver) Slug() string               { return p.post.Slug }
+func (p *postResolver) Content() string            { return p.post.Content }
+func (p *postResolver) Excerpt() *string           { return p.post.Excerpt }
+func (p *postResolver) FeaturedImage() *string     { return p.post.FeaturedImage }
+func (p *postResolver) Status() string             { return strings.ToUpper(p.post.Status) }
+func (p *postResolver) Featured() bool             { return p.post.IsFeatured }
+func (p *postResolver) ViewCount() int32           { return int32(p.post.ViewCount) }
+func (p *postResolver) PublishedAt() *graphql.Time { return toTimePtr(p.post.PublishedAt) }
+func (p *postResolver) CreatedAt() graphql.Time    { return toTime(p.post.CreatedAt) }
+func (p *postResolver) UpdatedAt() graphql.Time    { return toTime(p.post.UpdatedAt) }
+
+func (p *postResolver) Author(ctx context.Context) (*userResolver, error) {
+	return p.r.loadUser(ctx, p.post.AuthorID)
+}
+
+func (p *postResolver) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	categories, err := loadersFrom(ctx).postCategories.Load(ctx, p.post.ID)()
+	if err != nil {
+		return nil, internalError("Failed to retrieve blog categories", err)
+	}
+	return p.r.categoryResolvers(categories), nil
+}
+
+type categoryResolver struct {
+	r        *Resolver
+	category *models.BlogCategory
+}
+
+func (c *categoryResolver) ID() graphql.ID          { return toID(c.category.ID) }
+func (c *categoryResolver) Name() string            { return c.category.Name }
+func (c *categoryResolver) Slug() string            { return c.category.Slug }
+func (c *categoryResolver) Description() *string    { return c.category.Description }
+func (c *categoryResolver) CreatedAt() graphql.Time { return toTime(c.category.CreatedAt) }
+
+func (c *categoryResolver) PostCount(ctx context.Context) (int32, error) {
+	count, err := loadersFrom(ctx).categoryPostCount.Load(ctx, c.category.ID)()
+	if err != nil {
+		return 0, internalError("Failed to count blog posts", err)
+	}
+	return int32(count), nil
+}
+
+func (r *Resolver) categoryResolvers(categories []models.BlogCategory) []*categoryResolver {
+	resolvers := make([]*categoryResolver, len(categories))
+	for i := range categories {
+		resolvers[i] = &categoryResolver{r: r, category: &categories[i]}
+	}
+	return resolvers
+}
+
+type projectResolver struct {
+	project *models.Project
+}
+
+func (p *projectResolver) ID() graphql.ID            { return toID(p.project.ID) }
+func (p *projectResolver) Name() string              { return p.project.Name }
+func (p *projectResolver) Slug() string              { return p.project.Slug }
+func (p *projectResolver) Description() string       { return p.project.Description }
+func (p *projectResolver) ShortDescription() *string { return p.project.ShortDescription }
+func (p *projectResolver) FeaturedImage() *string    { return p.project.FeaturedImage }
+func (p *projectResolver) GalleryImages() []string   { return toStrings(p.project.GalleryImages) }
+func (p *projectResolver) Technologies() []string    { return toStrings(p.project.Technologies) }
+func (p *projectResolver) ProjectURL() *string       { return p.project.ProjectURL }
+func (p *projectResolver) GithubURL() *string        { return p.project.GithubURL }
+func (p *projectResolver) DemoURL() *string          { return p.project.DemoURL }
+func (p *projectResolver) Status() string            { return p.project.Status }
+func (p *projectResolver) StartDate() *graphql.Time  { return toTimePtr(p.project.StartDate) }
+func (p *projectResolver) EndDate() *graphql.Time    { return toTimePtr(p.project.EndDate) }
+func (p *projectResolver) Featured() bool            { return p.project.IsFeatured }
+func (p *projectResolver) SortOrder() int32          { return int32(p.project.SortOrder) }
+func (p *projectResolver) CreatedAt() graphql.Time   { return toTime(p.project.CreatedAt) }
+func (p *projectResolver) UpdatedAt() graphql.Time   { return toTime(p.project.UpdatedAt) }
+
+type productResolver struct {
+	r       *Resolver
+	product *models.SoftwareProduct
+}
+
+func (p *productResolver) ID() graphql.ID            { return toID(p.product.ID) }
+func (p *productResolver) Name() string              { return p.product.Name }
+func (p *productResolver) Slug() string              { return p.product.Slug }
+func (p *productResolver) Description() string       { return p.product.Description }
+func (p *productResolver) ShortDescription() *string { return p.product.ShortDescription }
+func (p *productResolver) FeaturedImage() *string    { return p.product.FeaturedImage }
+func (p *productResolver) GalleryImages() []string   { return toStrings(p.product.GalleryImages) }
+func (p *productResolver) Price() float64            { return p.product.Price }
+func (p *productResolver) DiscountPrice() *float64   { return p.product.DiscountPrice }
+func (p *productResolver) Version() *string          { return p.product.Version }
+func (p *productResolver) Requirements() *string     { return p.product.Requirements }
+func (p *productResolver) Features() []string        { return toStrings(p.product.Features) }
+func (p *productResolver) DownloadCount() int32      { return int32(p.product.DownloadCount) }
+func (p *productResolver) Featured() bool            { return p.product.IsFeatured }
+func (p *productResolver) CreatedAt() graphql.Time   { return toTime(p.product.CreatedAt) }
+func (p *productResolver) UpdatedAt() graphql.Time   { return toTime(p.product.UpdatedAt) }
+
+// FileSize is a Float because sizes in bytes can exceed GraphQL's 32-bit Int
+func (p *productResolver) FileSize() *float64 {
+	if p.product.FileSize == nil {
+		return nil
+	}
+	size := float64(*p.product.FileSize)
+	return &size
+}
+
+func (p *productResolver) Category(ctx context.Context) (*productCategoryResolver, error) {
+	return p.r.loadProductCategory(ctx, p.product.CategoryID)
+}
+
+type productCategoryResolver struct {
+	r        *Resolver
+	category *models.ProductCategory
+}
+
+func (c *productCategoryResolver) ID() graphql.ID          { return toID(c.category.ID) }
+func (c *productCategoryResolver) Name() string            { return c.category.Name }
+func (c *productCategoryResolver) Slug() string            { return c.category.Slug }
+func (c *productCategoryResolver) Description() *string    { return c.category.Description }
+func (c *productCategoryResolver) CreatedAt() graphql.Time { return toTime(c.category.CreatedAt) }
+
+func (c *productCategoryResolver) Parent(ctx context.Context) (*productCategoryResolver, error) {
+	return c.r.loadProductCategory(ctx, c.category.ParentID)
+}
+
+func (r *Resolver) loadProductCategory(ctx context.Context, id *int) (*productCategoryResolver, error) {
+	if id == nil {
+		return nil, nil
+	}
+	category, err := loadersFrom(ctx).productCategories.Load(ctx, *id)()
+	if err != nil {
+		return nil, internalError("Failed to retrieve product category", err)
+	}
+	if category == nil {
+		return nil, nil
+	}
+	return &productCategoryResolver{r: r, category: category}, nil
+}
+
+type orderResolver struct {
+	r     *Resolver
+	order *models.Order
+}
+
+func (o *orderResolver) ID() graphql.ID          { return toID(o.order.ID) }
+func (o *orderResolver) OrderNumber() string     { return o.order.OrderNumber }
+func (o *orderResolver) TotalAmount() float64    { return o.order.TotalAmount }
+func (o *orderResolver) DiscountAmount() float64 { return o.order.DiscountAmount }
+func (o *orderResolver) FinalAmount() float64    { return o.order.FinalAmount }
+func (o *orderResolver) PaymentMethod() *string  { return o.order.PaymentMethod }
+func (o *orderResolver) PaymentStatus() string   { return o.order.PaymentStatus }
+func (o *orderResolver) OrderStatus() string     { return o.order.OrderStatus }
+func (o *orderResolver) CreatedAt() graphql.Time { return toTime(o.order.CreatedAt) }
+func (o *orderResolver) UpdatedAt() graphql.Time { return toTime(o.order.UpdatedAt) }
+
+func (o *orderResolver) User(ctx context.Context) (*userResolver, error) {
+	return o.r.loadUser(ctx, o.order.UserID)
+}
+
+func (o *orderResolver) Items(ctx context.Context) ([]*orderItemResolver, error) {
+	items, err := loadersFrom(ctx).orderItems.Load(ctx, o.order.ID)()
+	if err != nil {
+		return nil, internalError("Failed to retrieve order items", err)
+	}
+
+	resolvers := make([]*orderItemResolver, len(items))
+	for i := range items {
+		resolvers[i] = &orderItemResolver{r: o.r, item: &items[i]}
+	}
+	return resolvers, nil
+}
+
+type orderItemResolver struct {
+	r    *Resolver
+	item *models.OrderItem
+}
+
+func (i *orderItemResolver) ID() graphql.ID      { return toID(i.item.ID) }
+func (i *orderItemResolver) ProductName() string { return i.item.ProductName }
+func (i *orderItemResolver) Price() float64      { return i.item.Price }
+func (i *orderItemResolver) Quantity() int32     { return int32(i.item.Quantity) }
+
+// Product is null once the product has been deleted; the order keeps its name and price
+func (i *orderItemResolver) Product(ctx context.Context) (*productResolver, error) {
+	if i.item.ProductID == nil {
+		return nil, nil
+	}
+	product, err := loadersFrom(ctx).products.Load(ctx, *i.item.ProductID)()
+	if err != nil {
+		return nil, internalError("Failed to retrieve product", err)
+	}
+	if product == nil {
+		return nil, nil
+	}
+	return &productResolver{r: i.r, product: product}, nil
+}
+
+type walletResolver struct {
+	wallet *models.CustomerWallet
+}
+
+func (w *walletResolver) Balance() float64        { return w.wallet.Balance }
+func (w *walletResolver) TotalDeposited() float64 { return w.wallet.TotalDeposited }
+func (w *walletResolver) TotalSpent() float64     { return w.wallet.TotalSpent }
+func (w *walletResolver) UpdatedAt() graphql.Time { return toTime(w.wallet.UpdatedAt) }
+
+type walletTransactionResolver struct {
+	transaction *models.WalletTransaction
+}
+
+func (t *walletTransactionResolver) ID() graphql.ID          { return toID(t.transaction.ID) }
+func (t *walletTransactionResolver) Type() string            { return t.transaction.TransactionType }
+func (t *walletTransactionResolver) Amount() float64         { return t.transaction.Amount }
+func (t *walletTransactionResolver) BalanceAfter() float64   { return t.transaction.BalanceAfter }
+func (t *walletTransactionResolver) Description() *string    { return t.transaction.Description }
+func (t *walletTransactionResolver) ReferenceID() *string    { return t.transaction.ReferenceID }
+func (t *walletTransactionResolver) Status() string          { return t.transaction.Status }
+func (t *walletTransactionResolver) CreatedAt() graphql.Time { return toTime(t.transaction.CreatedAt) }
+
+type pointsResolver struct {
+	points *models.CustomerPoints
+}
+
+func (p *pointsResolver) TotalPoints() int32      { return int32(p.points.TotalPoints) }
+func (p *pointsResolver) AvailablePoints() int32  { return int32(p.points.AvailablePoints) }
+func (p *pointsResolver) UsedPoints() int32       { return int32(p.points.UsedPoints) }
+func (p *pointsResolver) UpdatedAt() graphql.Time { return toTime(p.points.UpdatedAt) }
+
+type depositPayloadResolver struct {
+	transaction *walletTransactionResolver
+	paymentURL  string
+}
+
+func (d *depositPayloadResolver) Transaction() *walletTransactionResolver { return d.transaction }
+func (d *depositPayloadResolver) PaymentURL() string                      { return d.paymentURL }
+
+func toID(id int) graphql.ID {
+	return graphql.ID(strconv.Itoa(id))
+}
+
+// parseID converts an ID argument back to the database ID
+func parseID(id graphql.ID) (int, error) {
+	n, err := strconv.Atoi(string(id))
+	if err != nil {
+		return 0, newError(apperr.CodeValidation, "Invalid ID", "ID must be a number")
+	}
+	return n, nil
+}
+
+func toTime(t time.Time) graphql.Time {
+	return graphql.Time{Time: t}
+}
+
+func toTimePtr(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+// toStrings turns a NULL array column into an empty list
+func toStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -1,103 +1,148 @@
 package admin
 
 import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/login"
+	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
-	"zplus_web/backend/utils"
 )
 
 type AdminHandler struct {
-	userService *services.UserService
-	validator   *validator.Validate
+	userService     *services.UserService
+	sessionService  *services.SessionService
+	roleService     *services.RoleService
+	mfaService      *services.MFAService
+	settingsService *services.SettingsService
+	loginGuard      *services.LoginGuard
+	auditService    *services.AuditService
+	privacyService  *services.PrivacyService
+	validator       *validator.Validate
 }
 
-func NewAdminHandler(userService *services.UserService) *AdminHandler {
+func NewAdminHandler(userService *services.UserService, sessionService *services.SessionService, roleService *services.RoleService, mfaService *services.MFAService, settingsService *services.SettingsService, loginGuard *services.LoginGuard, auditService *services.AuditService, privacyService *services.PrivacyService) *AdminHandler {
 	return &AdminHandler{
-		userService: userService,
-		validator:   validator.New(),
+		userService:     userService,
+		sessionService:  sessionService,
+		roleService:     roleService,
+		mfaService:      mfaService,
+		settingsService: settingsService,
+		loginGuard:      loginGuard,
+		auditService:    auditService,
+		privacyService:  privacyService,
+		validator:       validator.New(),
 	}
 }
 
+// RegisterRoutes mounts the admin login, dashboard and user management endpoints
+func (h *AdminHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodPost, "/admin/auth/login", h.Login).
+		Describe("Admin login", models.LoginRequest{}, models.LoginResponse{})
+
+	r.Admin(rbac.DashboardView, fiber.MethodGet, "/dashboard/stats", h.GetDashboardStats).
+		Describe("Get dashboard statistics", nil, models.DashboardStats{})
+	r.Admin(rbac.DashboardView, fiber.MethodGet, "/dashboard/recent-activity", h.GetRecentActivity).
+		Describe("Get recent system activity", nil, []fiber.Map{})
+
+	r.Admin(rbac.UsersRead, fiber.MethodGet, "/users", h.GetUsers).
+		Describe("Get all users", nil, []models.User{})
+	r.Admin(rbac.UsersRead, fiber.MethodGet, "/users/:id", h.GetUser).
+		Describe("Get user by ID", nil, models.User{})
+	r.Admin(rbac.UsersWrite, fiber.MethodPut, "/users/:id", h.UpdateUser).
+		Describe("Update user", models.UpdateProfileRequest{}, models.User{})
+	r.Admin(rbac.UsersWrite, fiber.MethodDelete, "/users/:id", h.DeleteUser).
+		Describe("Erase a user's personal data; orders and wallet history are kept anonymized", nil, nil)
+	r.Admin(rbac.RolesManage, fiber.MethodPut, "/users/:id/role", h.UpdateUserRole).
+		Describe("Update user role", models.UpdateUserRoleRequest{}, nil)
+	r.Admin(rbac.SecurityManage, fiber.MethodDelete, "/users/:id/2fa", h.ResetUserMFA).
+		Describe("Remove a user's second factor, e.g. after a lost device", nil, nil)
+	r.Admin(rbac.UsersImpersonate, fiber.MethodPost, "/users/:id/impersonate", h.ImpersonateUser).
+		Describe("Log in as a customer for support", models.ImpersonateRequest{}, models.LoginResponse{})
+
+	r.Admin(rbac.RolesManage, fiber.MethodGet, "/roles", h.GetRoles).
+		Describe("Get all roles with their permissions", nil, []models.Role{})
+	r.Admin(rbac.RolesManage, fiber.MethodGet, "/roles/:id", h.GetRole).
+		Describe("Get role by ID", nil, models.Role{})
+	r.Admin(rbac.RolesManage, fiber.MethodPost, "/roles", h.CreateRole).
+		Describe("Create a custom role", models.RoleRequest{}, models.Role{})
+	r.Admin(rbac.RolesManage, fiber.MethodPut, "/roles/:id", h.UpdateRole).
+		Describe("Update a role's description and permissions", models.RoleRequest{}, models.Role{})
+	r.Admin(rbac.RolesManage, fiber.MethodDelete, "/roles/:id", h.DeleteRole).
+		Describe("Delete a custom role", nil, nil)
+	r.Admin(rbac.RolesManage, fiber.MethodGet, "/permissions", h.GetPermissions).
+		Describe("Get every permission that can be granted to a role", nil, rbac.Catalog)
+
+	r.Admin(rbac.SettingsManage, fiber.MethodGet, "/settings/security", h.GetSecuritySettings).
+		Describe("Get security settings", nil, models.SecuritySettings{})
+	r.Admin(rbac.SettingsManage, fiber.MethodPut, "/settings/security", h.UpdateSecuritySettings).
+		Describe("Update security settings", models.SecuritySettings{}, models.SecuritySettings{})
+
+	r.Admin(rbac.SecurityManage, fiber.MethodGet, "/security/lockouts", h.GetLockoutEvents).
+		Describe("Get recent login lockout and unlock events", nil, []models.LoginLockoutEvent{})
+	r.Admin(rbac.SecurityManage, fiber.MethodPost, "/security/lockouts/unlock", h.UnlockLogin).
+		Describe("Lift an account or IP address lockout", models.UnlockLoginRequest{}, nil)
+
+	r.Admin(rbac.AuditRead, fiber.MethodGet, "/audit", h.GetAuditEvents).
+		Describe("Get audit events, filtered by actor_id, action, entity_type, entity_id, from and to", nil, fiber.Map{"events": []models.AuditEvent{}, "pagination": models.Pagination{}})
+	r.Admin(rbac.AuditRead, fiber.MethodGet, "/audit/export", h.ExportAuditEvents).
+		Describe("Download the audit events matching the filters as CSV", nil, routes.Content{Type: "text/csv"}).
+		WithTimeout(time.Minute)
+}
+
 // POST /admin/auth/login - Admin login
 func (h *AdminHandler) Login(c *fiber.Ctx) error {
 	var req models.LoginRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Refuse attempts while the account or client address is locked out
+	if err := h.loginGuard.Check(c.UserContext(), req.Email, c.IP()); err != nil {
+		return apperr.New(fiber.StatusTooManyRequests, apperr.CodeAuthLocked, "Too many failed login attempts").Wrap(err)
 	}
 
 	// Authenticate user
-	user, err := h.userService.AuthenticateUser(req.Email, req.Password)
-	if err != nil {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid credentials",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "Invalid email or password",
-			},
-		})
+	user, err := h.userService.AuthenticateUser(c.UserContext(), req.Email, req.Password)
+	if err != nil {
+		h.loginGuard.RecordFailure(c.UserContext(), req.Email, c.IP())
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid credentials").WithDetails("Invalid email or password")
 	}
 
-	// Check if user has admin role
-	if user.Role != "admin" {
-		return c.Status(403).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Admin access required",
-			Error: &models.ApiError{
-				Code:    "PERMISSION_DENIED",
-				Details: "Admin role required to access this resource",
-			},
-		})
+	// Only roles with at least one permission may use the admin panel
+	permissions, err := h.roleService.RolePermissions(c.UserContext(), user.Role)
+	if err != nil {
+		return apperr.Internal("Failed to check permissions", err)
+	}
+	if len(permissions) == 0 {
+		return apperr.Forbidden("Admin access required").WithDetails("Your role has no admin permissions")
 	}
 
-	// Generate JWT token
-	token, err := utils.GenerateJWTWithDetails(user.ID, user.Email, user.Role, user.Username)
+	h.loginGuard.RecordSuccess(c.UserContext(), req.Email)
+
+	// Accounts with two-factor authentication only get a limited token at this point
+	resp, err := login.Begin(c, h.sessionService, h.mfaService, user)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to generate token",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return err
+	}
+	if !resp.MFARequired {
+		resp.Permissions = permissions
 	}
 
-	return c.JSON(models.ApiResponse{
-		Success: true,
-		Message: "Admin login successful",
-		Data: map[string]interface{}{
-			"token": token,
-			"user": map[string]interface{}{
-				"id":       user.ID,
-				"username": user.Username,
-				"email":    user.Email,
-				"role":     user.Role,
-				"full_name": user.FullName,
-			},
-		},
-	})
+	return login.Respond(c, resp, "Admin login successful")
 }
 
 // GET /admin/dashboard/stats - Get dashboard statistics
@@ -158,16 +203,9 @@ func (h *AdminHandler) GetRecentActivity(c *fiber.Ctx) error {
 // GET /admin/users - Get all users with pagination
 func (h *AdminHandler) GetUsers(c *fiber.Ctx) error {
 	// TODO: Implement pagination and filtering
-	users, err := h.userService.GetAllUsers()
-	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve users",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+	users, err := h.userService.GetAllUsers(c.UserContext())
+	if err != nil {
+		return apperr.Internal("Failed to retrieve users", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -181,26 +219,12 @@ func (h *AdminHandler) GetUsers(c *fiber.Ctx) error {
 func (h *AdminHandler) GetUser(c *fiber.Ctx) error {
 	userID, err := c.ParamsInt("id")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "User ID must be a valid integer",
-			},
-		})
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
 	}
 
-	user, err := h.userService.GetUserByID(userID)
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
 	if err != nil {
-		return c.Status(404).JSON(models.ApiResponse{
-			Success: false,
-			Message: "User not found",
-			Error: &models.ApiError{
-				Code:    "NOT_FOUND",
-				Details: err.Error(),
-			},
-		})
+		return apperr.NotFound("User not found").Wrap(err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -210,221 +234,534 @@ func (h *AdminHandler) GetUser(c *fiber.Ctx) error {
 	})
 }
 
-// POST /admin/users - Create new user
-func (h *AdminHandler) CreateUser(c *fiber.Ctx) error {
-	var req models.RegisterRequest
+// PUT /admin/users/:id - Update user
+func (h *AdminHandler) UpdateUser(c *fiber.Ctx) error {
+	userID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
+	}
+
+	var req models.UpdateProfileRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
-	user, err := h.userService.CreateUser(req)
+	before, err := h.manageableUser(c, userID)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create user",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return err
 	}
 
+	user, err := h.userService.UpdateUser(c.UserContext(), userID, req)
+	if err != nil {
+		return apperr.Internal("Failed to update user", err)
+	}
+
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditUserUpdate, "user", strconv.Itoa(userID), before, user)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "User created successfully",
+		Message: "User updated successfully",
 		Data:    user,
 	})
 }
 
-// PUT /admin/users/:id - Update user
-func (h *AdminHandler) UpdateUser(c *fiber.Ctx) error {
+// DELETE /admin/users/:id - Erase a user's personal data; orders and wallet history are kept anonymized
+func (h *AdminHandler) DeleteUser(c *fiber.Ctx) error {
 	userID, err := c.ParamsInt("id")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "User ID must be a valid integer",
-			},
-		})
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
 	}
 
-	var req models.UpdateProfileRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+	// Check if trying to delete self
+	currentUserID := c.Locals("user_id")
+	if currentUserID == userID {
+		return apperr.Validation("Cannot delete your own account", nil).WithDetails("You cannot delete your own user account")
 	}
 
-	// Validate request
-	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+	if _, err = h.manageableUser(c, userID); err != nil {
+		return err
 	}
 
-	user, err := h.userService.UpdateUser(userID, req)
+	err = h.privacyService.EraseUser(c.UserContext(), userID, middleware.GetAuditActor(c))
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to update user",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to delete user", err)
 	}
 
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "User updated successfully",
-		Data:    user,
+		Message: "User erased successfully",
 	})
 }
 
-// DELETE /admin/users/:id - Delete user
-func (h *AdminHandler) DeleteUser(c *fiber.Ctx) error {
+// PUT /admin/users/:id/role - Update user role
+func (h *AdminHandler) UpdateUserRole(c *fiber.Ctx) error {
 	userID, err := c.ParamsInt("id")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "User ID must be a valid integer",
-			},
-		})
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
 	}
 
-	// Check if trying to delete self
+	var req models.UpdateUserRoleRequest
+
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Check if trying to change own role
 	currentUserID := c.Locals("user_id")
 	if currentUserID == userID {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Cannot delete your own account",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "You cannot delete your own user account",
-			},
-		})
+		return apperr.Validation("Cannot change your own role", nil).WithDetails("You cannot change your own role")
 	}
 
-	err = h.userService.DeleteUser(userID)
+	exists, err := h.roleService.RoleExists(c.UserContext(), req.Role)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to delete user",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to update user role", err)
+	}
+	if !exists {
+		return apperr.Validation("Validation failed", nil).WithDetails("Role " + req.Role + " does not exist")
+	}
+
+	// Neither the user nor the new role may hold permissions the caller lacks
+	if _, err = h.manageableUser(c, userID); err != nil {
+		return err
+	}
+	callerRole, _ := c.Locals("user_role").(string)
+	allowed, err := h.roleService.CanManage(c.UserContext(), callerRole, req.Role)
+	if err != nil {
+		return apperr.Internal("Failed to check permissions", err)
+	}
+	if !allowed {
+		return apperr.Forbidden("Cannot grant this role").WithDetails("Role " + req.Role + " has permissions your role does not have")
+	}
+
+	err = h.userService.UpdateUserRole(c.UserContext(), userID, req.Role, middleware.GetAuditActor(c))
+	if err != nil {
+		return apperr.Internal("Failed to update user role", err)
 	}
 
+	// Apply the new role to the user's open sessions
+	h.sessionService.RefreshUserSessions(c.UserContext(), userID)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "User deleted successfully",
+		Message: "User role updated successfully",
 	})
 }
 
-// PUT /admin/users/:id/role - Update user role
-func (h *AdminHandler) UpdateUserRole(c *fiber.Ctx) error {
+// DELETE /admin/users/:id/2fa - Remove a user's second factor, e.g. after a lost device
+func (h *AdminHandler) ResetUserMFA(c *fiber.Ctx) error {
 	userID, err := c.ParamsInt("id")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "User ID must be a valid integer",
-			},
-		})
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
+	}
+
+	if _, err = h.manageableUser(c, userID); err != nil {
+		return err
 	}
 
-	var req struct {
-		Role string `json:"role" validate:"required,oneof=admin user"`
+	if err := h.mfaService.Reset(c.UserContext(), userID); err != nil {
+		return apperr.Internal("Failed to reset two-factor authentication", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditUserMFAReset, "user", strconv.Itoa(userID), nil, nil)
+
+	// Existing sessions were opened with the old factor
+	h.sessionService.InvalidateUserSessions(c.UserContext(), userID)
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Two-factor authentication reset successfully",
+	})
+}
+
+// POST /admin/users/:id/impersonate - Get a short-lived token to use the site as a customer.
+// Staff accounts cannot be impersonated and the token cannot move money or change credentials.
+func (h *AdminHandler) ImpersonateUser(c *fiber.Ctx) error {
+	userID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid user ID", nil).WithDetails("User ID must be a valid integer")
+	}
+
+	var req models.ImpersonateRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
-	// Check if trying to change own role
-	currentUserID := c.Locals("user_id")
-	if currentUserID == userID {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Cannot change your own role",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "You cannot change your own role",
-			},
-		})
+	actor := middleware.GetAuditActor(c)
+	if actor.UserID != nil && *actor.UserID == userID {
+		return apperr.Validation("Cannot impersonate yourself", nil).WithDetails("You cannot impersonate your own account")
 	}
 
-	err = h.userService.UpdateUserRole(userID, req.Role)
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to update user role",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
+		return apperr.NotFound("User not found").Wrap(err)
+	}
+	if !user.IsActive {
+		return apperr.Validation("Cannot impersonate a deactivated user", nil).WithDetails("User account is deactivated")
+	}
+
+	// Only customers: acting as staff would grant the target's admin permissions
+	permissions, err := h.roleService.RolePermissions(c.UserContext(), user.Role)
+	if err != nil {
+		return apperr.Internal("Failed to check permissions", err)
+	}
+	if len(permissions) > 0 {
+		return apperr.Forbidden("Staff accounts cannot be impersonated").WithDetails("Role " + user.Role + " has admin permissions")
+	}
+
+	tokens, err := h.sessionService.StartImpersonation(c.UserContext(), user, actor, c.Get(fiber.HeaderUserAgent), c.IP())
+	if err != nil {
+		return apperr.Internal("Failed to generate token", err)
+	}
+
+	h.auditService.Record(c.UserContext(), actor, services.AuditUserImpersonate, "user", strconv.Itoa(userID), nil,
+		map[string]string{"reason": req.Reason})
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Impersonation session started",
+		Data: map[string]interface{}{
+			"token":      tokens.AccessToken,
+			"expires_in": tokens.ExpiresIn,
+			"user": map[string]interface{}{
+				"id":        user.ID,
+				"username":  user.Username,
+				"email":     user.Email,
+				"role":      user.Role,
+				"full_name": user.FullName,
 			},
-		})
+		},
+	})
+}
+
+// manageableUser loads the user an admin action targets and refuses users whose
+// role has permissions the caller's role does not, so nobody can edit, erase or
+// strip the second factor of someone who outranks them
+func (h *AdminHandler) manageableUser(c *fiber.Ctx, userID int) (*models.User, error) {
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
+	if err != nil {
+		return nil, apperr.NotFound("User not found").Wrap(err)
+	}
+
+	callerRole, _ := c.Locals("user_role").(string)
+	allowed, err := h.roleService.CanManage(c.UserContext(), callerRole, user.Role)
+	if err != nil {
+		return nil, apperr.Internal("Failed to check permissions", err)
+	}
+	if !allowed {
+		return nil, apperr.Forbidden("Cannot manage this user").WithDetails("Role " + user.Role + " has permissions your role does not have")
+	}
+
+	return user, nil
+}
+
+// GET /admin/roles - Get all roles with their permissions
+func (h *AdminHandler) GetRoles(c *fiber.Ctx) error {
+	roles, err := h.roleService.GetRoles(c.UserContext())
+	if err != nil {
+		return apperr.Internal("Failed to get roles", err)
 	}
 
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "User role updated successfully",
+		Message: "Roles retrieved successfully",
+		Data:    roles,
+	})
+}
+
+// GET /admin/roles/:id - Get role by ID
+func (h *AdminHandler) GetRole(c *fiber.Ctx) error {
+	roleID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid role ID", nil).WithDetails("Role ID must be a valid integer")
+	}
+
+	role, err := h.roleService.GetRoleByID(c.UserContext(), roleID)
+	if err != nil {
+		return apperr.Internal("Failed to get role", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Role retrieved successfully",
+		Data:    role,
+	})
+}
+
+// POST /admin/roles - Create a custom role
+func (h *AdminHandler) CreateRole(c *fiber.Ctx) error {
+	var req models.RoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	role, err := h.roleService.CreateRole(c.UserContext(), req, middleware.GetAuditActor(c))
+	if err != nil {
+		return apperr.Internal("Failed to create role", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Role created successfully",
+		Data:    role,
+	})
+}
+
+// PUT /admin/roles/:id - Update a role's description and permissions
+func (h *AdminHandler) UpdateRole(c *fiber.Ctx) error {
+	roleID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid role ID", nil).WithDetails("Role ID must be a valid integer")
+	}
+
+	var req models.RoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	role, err := h.roleService.UpdateRole(c.UserContext(), roleID, req, middleware.GetAuditActor(c))
+	if err != nil {
+		return apperr.Internal("Failed to update role", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Role updated successfully",
+		Data:    role,
+	})
+}
+
+// DELETE /admin/roles/:id - Delete a custom role
+func (h *AdminHandler) DeleteRole(c *fiber.Ctx) error {
+	roleID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid role ID", nil).WithDetails("Role ID must be a valid integer")
+	}
+
+	if err := h.roleService.DeleteRole(c.UserContext(), roleID, middleware.GetAuditActor(c)); err != nil {
+		return apperr.Internal("Failed to delete role", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Role deleted successfully",
+	})
+}
+
+// GET /admin/permissions - Get every permission that can be granted to a role
+func (h *AdminHandler) GetPermissions(c *fiber.Ctx) error {
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Permissions retrieved successfully",
+		Data:    rbac.Catalog,
 	})
-}
\ No newline at end of file
+}
+
+// GET /admin/settings/security - Get security settings
+func (h *AdminHandler) GetSecuritySettings(c *fiber.Ctx) error {
+	settings, err := h.settingsService.GetSecuritySettings(c.UserContext())
+	if err != nil {
+		return apperr.Internal("Failed to get security settings", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Security settings retrieved successfully",
+		Data:    settings,
+	})
+}
+
+// PUT /admin/settings/security - Update security settings
+func (h *AdminHandler) UpdateSecuritySettings(c *fiber.Ctx) error {
+	var req models.SecuritySettings
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	before, _ := h.settingsService.GetSecuritySettings(c.UserContext())
+
+	settings, err := h.settingsService.UpdateSecuritySettings(c.UserContext(), req)
+	if err != nil {
+		return apperr.Internal("Failed to update security settings", err)
+	}
+
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditSettingsUpdate, "settings", "security", before, settings)
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Security settings updated successfully",
+		Data:    settings,
+	})
+}
+
+// GET /admin/security/lockouts - Get recent login lockout and unlock events
+func (h *AdminHandler) GetLockoutEvents(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 500 {
+		limit = 50
+	}
+
+	events, err := h.loginGuard.GetLockoutEvents(c.UserContext(), limit)
+	if err != nil {
+		return apperr.Internal("Failed to get lockout events", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Lockout events retrieved successfully",
+		Data:    events,
+	})
+}
+
+// POST /admin/security/lockouts/unlock - Lift an account or IP address lockout
+func (h *AdminHandler) UnlockLogin(c *fiber.Ctx) error {
+	var req models.UnlockLoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	currentUser := middleware.GetCurrentUser(c)
+	adminID, _ := currentUser["id"].(int)
+
+	if err := h.loginGuard.Unlock(c.UserContext(), req.SubjectType, req.Subject, adminID); err != nil {
+		return apperr.Internal("Failed to unlock", err)
+	}
+
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditLoginUnlock, "login_lockout", req.SubjectType+":"+req.Subject, nil, nil)
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Lockout lifted successfully",
+	})
+}
+
+// GET /admin/audit - Get audit events, filtered by actor_id, action, entity_type, entity_id, from and to
+func (h *AdminHandler) GetAuditEvents(c *fiber.Ctx) error {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return apperr.Invalid("Invalid filter", err)
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 50)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	events, total, err := h.auditService.GetEvents(c.UserContext(), filter, page, limit)
+	if err != nil {
+		return apperr.Internal("Failed to get audit events", err)
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Audit events retrieved successfully",
+		Data: map[string]interface{}{
+			"events": events,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      page < totalPages,
+				HasPrev:      page > 1,
+			},
+		},
+	})
+}
+
+// GET /admin/audit/export - Download the audit events matching the filters as CSV
+func (h *AdminHandler) ExportAuditEvents(c *fiber.Ctx) error {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return apperr.Invalid("Invalid filter", err)
+	}
+
+	var buf bytes.Buffer
+	if err := h.auditService.ExportEvents(c.UserContext(), filter, &buf); err != nil {
+		return apperr.Internal("Failed to export audit events", err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="audit-`+time.Now().UTC().Format("20060102-150405")+`.csv"`)
+	return c.Send(buf.Bytes())
+}
+
+// parseAuditFilter reads the audit filters from the query string. from and to
+// accept RFC 3339 timestamps or dates; a date in to includes that whole day.
+func parseAuditFilter(c *fiber.Ctx) (models.AuditFilter, error) {
+	filter := models.AuditFilter{
+		Action:     c.Query("action"),
+		EntityType: c.Query("entity_type"),
+		EntityID:   c.Query("entity_id"),
+	}
+
+	if value := c.Query("actor_id"); value != "" {
+		actorID, err := strconv.Atoi(value)
+		if err != nil {
+			return filter, fmt.Errorf("actor_id must be a valid integer")
+		}
+		filter.ActorID = &actorID
+	}
+
+	var err error
+	if filter.From, err = parseAuditTime(c.Query("from"), false); err != nil {
+		return filter, fmt.Errorf("from %v", err)
+	}
+	if filter.To, err = parseAuditTime(c.Query("to"), true); err != nil {
+		return filter, fmt.Errorf("to %v", err)
+	}
+
+	return filter, nil
+}
+
+func parseAuditTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	}
+	if endOfDay {
+		day = day.AddDate(0, 0, 1)
+	}
+	return &day, nil
+}
@@ -0,0 +1,373 @@
+package admin_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/admin"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/services"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(admin.NewAdminHandler(env.Users, env.Sessions, env.Roles, env.MFA, env.Settings, env.LoginGuard, env.Audit, env.Privacy))
+	return env, app
+}
+
+func userPath(user *models.User, suffix string) string {
+	return "/api/v1/admin/users/" + strconv.Itoa(user.ID) + suffix
+}
+
+func TestLogin(t *testing.T) {
+	env, app := newApp(t)
+	editor := env.CreateUser(t, "editor")
+	customer := env.CreateUser(t, "user")
+
+	resp := handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/auth/login", models.LoginRequest{Email: editor.Email, Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusOK, "")
+	var data struct {
+		Token       string   `json:"token"`
+		Permissions []string `json:"permissions"`
+	}
+	resp.Decode(t, &data)
+	if data.Token == "" || len(data.Permissions) == 0 {
+		t.Errorf("login data = %s", resp.Data)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/auth/login", models.LoginRequest{Email: customer.Email, Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/auth/login", models.LoginRequest{Email: editor.Email, Password: "wrong-password"}, "").
+		Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/auth/login", models.LoginRequest{Email: "not-an-email"}, "").
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestPermissions(t *testing.T) {
+	env, app := newApp(t)
+	customer := env.Login(t, env.CreateUser(t, "user"))
+	editor := env.Login(t, env.CreateUser(t, "editor"))
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/dashboard/stats", nil, "").Expect(t, fiber.StatusUnauthorized, "AUTH_REQUIRED")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/dashboard/stats", nil, customer).Expect(t, fiber.StatusForbidden, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/dashboard/stats", nil, editor).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/dashboard/recent-activity", nil, editor).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/users", nil, editor).Expect(t, fiber.StatusForbidden, "")
+}
+
+func TestUsers(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	customer := env.CreateUser(t, "user")
+	token := env.Login(t, adminUser)
+
+	var users []models.User
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/users", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &users)
+	if len(users) != 2 {
+		t.Errorf("got %d users, want 2", len(users))
+	}
+
+	var user models.User
+	handlertest.Do(t, app, fiber.MethodGet, userPath(customer, ""), nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &user)
+	if user.Email != customer.Email {
+		t.Errorf("user = %+v", user)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/users/999", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/users/abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, ""), models.UpdateProfileRequest{FullName: "Renamed", AvatarURL: "https://example.com/a.png"}, token).
+		Expect(t, fiber.StatusOK, "").Decode(t, &user)
+	if user.FullName == nil || *user.FullName != "Renamed" {
+		t.Errorf("updated user = %+v", user)
+	}
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, ""), models.UpdateProfileRequest{}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(adminUser, ""), nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(customer, ""), nil, token).Expect(t, fiber.StatusOK, "")
+	erased, err := env.Users.GetUserByID(t.Context(), customer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if erased.Email == customer.Email {
+		t.Errorf("erased user kept the email %s", erased.Email)
+	}
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/admin/users/999", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+}
+
+func TestUsersOutranked(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "user_manager", "users:read", "users:write")
+	adminUser := env.CreateUser(t, "admin")
+	editor := env.CreateUser(t, "editor")
+	peer := env.CreateUser(t, "user_manager")
+	token := env.Login(t, env.CreateUser(t, "user_manager"))
+
+	// users:write does not reach roles with permissions the caller lacks
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(adminUser, ""), nil, token).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(editor, ""), nil, token).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(adminUser, ""), models.UpdateProfileRequest{FullName: "Renamed", AvatarURL: "https://example.com/a.png"}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	if user, err := env.Users.GetUserByID(t.Context(), adminUser.ID); err != nil || user.Email != adminUser.Email {
+		t.Errorf("admin after refused erasure = %+v, %v", user, err)
+	}
+
+	// Equal roles and customers are fine
+	handlertest.Do(t, app, fiber.MethodPut, userPath(peer, ""), models.UpdateProfileRequest{FullName: "Peer", AvatarURL: "https://example.com/a.png"}, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(env.CreateUser(t, "user"), ""), nil, token).Expect(t, fiber.StatusOK, "")
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	customer := env.CreateUser(t, "user")
+	token := env.Login(t, adminUser)
+
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, "/role"), models.UpdateUserRoleRequest{Role: "editor"}, token).Expect(t, fiber.StatusOK, "")
+	user, err := env.Users.GetUserByID(t.Context(), customer.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Role != "editor" {
+		t.Errorf("role = %s, want editor", user.Role)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, "/role"), models.UpdateUserRoleRequest{Role: "pirate"}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(adminUser, "/role"), models.UpdateUserRoleRequest{Role: "user"}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, "/role"), models.UpdateUserRoleRequest{}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	// A role manager cannot hand out or take away more than their own role has
+	env.CreateRole(t, "role_manager", "roles:manage", "blog:write")
+	manager := env.Login(t, env.CreateUser(t, "role_manager"))
+	handlertest.Do(t, app, fiber.MethodPut, userPath(env.CreateUser(t, "user"), "/role"), models.UpdateUserRoleRequest{Role: "admin"}, manager).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(customer, "/role"), models.UpdateUserRoleRequest{Role: "user"}, manager).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(adminUser, "/role"), models.UpdateUserRoleRequest{Role: "user"}, manager).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, userPath(env.CreateUser(t, "user"), "/role"), models.UpdateUserRoleRequest{Role: "role_manager"}, manager).Expect(t, fiber.StatusOK, "")
+}
+
+func TestResetUserMFA(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "security", "security:manage")
+	customer := env.CreateUser(t, "user")
+	token := env.Login(t, env.CreateUser(t, "security"))
+	customerToken := env.Login(t, customer)
+
+	// Support only looks accounts up
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(customer, "/2fa"), nil, env.Login(t, env.CreateUser(t, "support"))).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	// Nor can anyone strip the second factor of a role above theirs
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(env.CreateUser(t, "admin"), "/2fa"), nil, token).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/admin/users/999/2fa", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	handlertest.Do(t, app, fiber.MethodDelete, userPath(customer, "/2fa"), nil, token).Expect(t, fiber.StatusOK, "")
+
+	// The customer's sessions were opened with the removed factor
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/users", nil, customerToken).Expect(t, fiber.StatusUnauthorized, "")
+}
+
+func TestImpersonateUser(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "concierge", "users:impersonate")
+	support := env.CreateUser(t, "concierge")
+	customer := env.CreateUser(t, "user")
+	editor := env.CreateUser(t, "editor")
+	token := env.Login(t, support)
+	reason := models.ImpersonateRequest{Reason: "Customer cannot find their order"}
+
+	resp := handlertest.Do(t, app, fiber.MethodPost, userPath(customer, "/impersonate"), reason, token).Expect(t, fiber.StatusOK, "")
+	var data struct {
+		Token string `json:"token"`
+	}
+	resp.Decode(t, &data)
+	if data.Token == "" {
+		t.Errorf("impersonation data = %s", resp.Data)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, userPath(editor, "/impersonate"), reason, token).Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, userPath(support, "/impersonate"), reason, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/users/999/impersonate", reason, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodPost, userPath(customer, "/impersonate"), models.ImpersonateRequest{}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	events, _, err := env.Audit.GetEvents(t.Context(), models.AuditFilter{Action: services.AuditUserImpersonate}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Errorf("got %d impersonation audit events, want 1", len(events))
+	}
+}
+
+func TestRoles(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	token := env.Login(t, adminUser)
+
+	var roles []models.Role
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/roles", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &roles)
+	var adminRole models.Role
+	for _, role := range roles {
+		if role.Name == "admin" {
+			adminRole = role
+		}
+	}
+	if adminRole.ID == 0 {
+		t.Fatalf("roles = %+v, missing admin", roles)
+	}
+	adminRolePath := "/api/v1/admin/roles/" + strconv.Itoa(adminRole.ID)
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/permissions", nil, token).Expect(t, fiber.StatusOK, "")
+
+	var role models.Role
+	req := models.RoleRequest{Name: "writer", Permissions: []string{"blog:write"}}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &role)
+	if role.Name != "writer" || len(role.Permissions) != 1 {
+		t.Errorf("created role = %+v", role)
+	}
+	rolePath := "/api/v1/admin/roles/" + strconv.Itoa(role.ID)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "Bad Name"}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "hacker", Permissions: []string{"everything"}}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodGet, rolePath, nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/roles/999", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	req.Permissions = []string{"blog:write", "blog:publish"}
+	handlertest.Do(t, app, fiber.MethodPut, rolePath, req, token).Expect(t, fiber.StatusOK, "").Decode(t, &role)
+	if len(role.Permissions) != 2 {
+		t.Errorf("updated role = %+v", role)
+	}
+	handlertest.Do(t, app, fiber.MethodPut, rolePath, models.RoleRequest{Name: "author"}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPut, adminRolePath, models.RoleRequest{Name: "admin"}, token).Expect(t, fiber.StatusConflict, "STATE_CONFLICT")
+
+	writer := env.CreateUser(t, "writer")
+	handlertest.Do(t, app, fiber.MethodDelete, rolePath, nil, token).Expect(t, fiber.StatusConflict, "STATE_CONFLICT")
+	if err := env.Users.UpdateUserRole(t.Context(), writer.ID, "user", handlertest.Actor(adminUser)); err != nil {
+		t.Fatal(err)
+	}
+	handlertest.Do(t, app, fiber.MethodDelete, rolePath, nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, rolePath, nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodDelete, adminRolePath, nil, token).Expect(t, fiber.StatusConflict, "STATE_CONFLICT")
+}
+
+func TestRoleEscalation(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "role_manager", "roles:manage", "blog:write")
+	manager := env.Login(t, env.CreateUser(t, "role_manager"))
+
+	var roles []models.Role
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/roles", nil, manager).Expect(t, fiber.StatusOK, "").Decode(t, &roles)
+	rolePaths := map[string]string{}
+	for _, role := range roles {
+		rolePaths[role.Name] = "/api/v1/admin/roles/" + strconv.Itoa(role.ID)
+	}
+
+	// Neither a wildcard nor any permission the manager lacks can be handed out
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "root", Permissions: []string{"*"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "blogger", Permissions: []string{"blog:*"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+
+	// Nor can the manager widen their own role or change a stronger one
+	handlertest.Do(t, app, fiber.MethodPut, rolePaths["role_manager"], models.RoleRequest{Name: "role_manager", Permissions: []string{"*"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, rolePaths["role_manager"], models.RoleRequest{Name: "role_manager", Permissions: []string{"roles:manage"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, rolePaths["editor"], models.RoleRequest{Name: "editor", Permissions: []string{"blog:write"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	permissions, err := env.Roles.RolePermissions(t.Context(), "role_manager")
+	if err != nil || len(permissions) != 2 {
+		t.Errorf("role_manager permissions = %v, %v", permissions, err)
+	}
+
+	// Roles within the manager's own permissions are fine
+	var role models.Role
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "writer", Permissions: []string{"blog:write"}}, manager).
+		Expect(t, fiber.StatusOK, "").Decode(t, &role)
+	rolePath := "/api/v1/admin/roles/" + strconv.Itoa(role.ID)
+	handlertest.Do(t, app, fiber.MethodPut, rolePath, models.RoleRequest{Name: "writer", Permissions: []string{"*"}}, manager).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPut, rolePath, models.RoleRequest{Name: "writer"}, manager).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, rolePath, nil, manager).Expect(t, fiber.StatusOK, "")
+}
+
+func TestSecuritySettings(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "admin"))
+
+	var settings models.SecuritySettings
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/settings/security", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &settings)
+	if settings.RequireAdmin2FA {
+		t.Errorf("admin 2FA is required by default")
+	}
+
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/settings/security", models.SecuritySettings{RequireAdmin2FA: true}, token).
+		Expect(t, fiber.StatusOK, "").Decode(t, &settings)
+	if !settings.RequireAdmin2FA {
+		t.Errorf("admin 2FA was not turned on")
+	}
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/settings/security", []byte("{"), token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestLockouts(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "security", "security:manage")
+	token := env.Login(t, env.CreateUser(t, "security"))
+	unlock := models.UnlockLoginRequest{SubjectType: "account", Subject: "victim@example.com"}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/security/lockouts/unlock", unlock, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	for i := 0; i < 10; i++ {
+		env.LoginGuard.RecordFailure(t.Context(), unlock.Subject, "203.0.113.7")
+	}
+	if err := env.LoginGuard.Check(t.Context(), unlock.Subject, "198.51.100.1"); err == nil {
+		t.Fatalf("account was not locked")
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/security/lockouts/unlock", unlock, token).Expect(t, fiber.StatusOK, "")
+	if err := env.LoginGuard.Check(t.Context(), unlock.Subject, "198.51.100.1"); err != nil {
+		t.Errorf("account is still locked: %v", err)
+	}
+
+	var events []models.LoginLockoutEvent
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/security/lockouts", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &events)
+	if len(events) == 0 || events[0].Event != "unlocked" {
+		t.Errorf("lockout events = %+v", events)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/security/lockouts/unlock", models.UnlockLoginRequest{SubjectType: "device", Subject: "x"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestAudit(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	token := env.Login(t, adminUser)
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/roles", models.RoleRequest{Name: "writer"}, token).Expect(t, fiber.StatusOK, "")
+
+	resp := handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/audit?action=role.create&actor_id="+strconv.Itoa(adminUser.ID)+"&from=2000-01-01", nil, token).
+		Expect(t, fiber.StatusOK, "")
+	var data struct {
+		Events     []models.AuditEvent `json:"events"`
+		Pagination models.Pagination   `json:"pagination"`
+	}
+	resp.Decode(t, &data)
+	if len(data.Events) != 1 || data.Pagination.TotalItems != 1 {
+		t.Errorf("audit data = %s", resp.Data)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/audit?actor_id=abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/audit?to=yesterday", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	csv := handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/audit/export?action=role.create", nil, token).Expect(t, fiber.StatusOK, "")
+	if !strings.HasPrefix(csv.Header.Get(fiber.HeaderContentType), "text/csv") {
+		t.Errorf("export content type = %s", csv.Header.Get(fiber.HeaderContentType))
+	}
+	if lines := strings.Split(strings.TrimSpace(string(csv.Body)), "\n"); len(lines) != 2 || !strings.Contains(lines[1], "role.create") {
+		t.Errorf("export = %s", csv.Body)
+	}
+}
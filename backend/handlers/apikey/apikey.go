@@ -0,0 +1,158 @@
+package apikey
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+)
+
+type APIKeyHandler struct {
+	apiKeyService *services.APIKeyService
+	roleService   *services.RoleService
+	validator     *validator.Validate
+}
+
+func NewAPIKeyHandler(apiKeyService *services.APIKeyService, roleService *services.RoleService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+		roleService:   roleService,
+		validator:     validator.New(),
+	}
+}
+
+// RegisterRoutes mounts the service account and API key management endpoints
+func (h *APIKeyHandler) RegisterRoutes(r *routes.Registry) {
+	r.Admin(rbac.APIKeysManage, fiber.MethodGet, "/service-accounts", h.GetServiceAccounts).
+		Describe("Get all service accounts", nil, []models.User{})
+	r.Admin(rbac.APIKeysManage, fiber.MethodPost, "/service-accounts", h.CreateServiceAccount).
+		Describe("Create a service account with a role", models.CreateServiceAccountRequest{}, models.User{})
+
+	r.Admin(rbac.APIKeysManage, fiber.MethodGet, "/api-keys", h.GetKeys).
+		Describe("Get API keys, optionally only those of one service account (?user_id=)", nil, []models.APIKey{})
+	r.Admin(rbac.APIKeysManage, fiber.MethodPost, "/api-keys", h.CreateKey).
+		Describe("Issue an API key for a service account; the key is only shown once", models.CreateAPIKeyRequest{}, fiber.Map{"key": "", "api_key": models.APIKey{}})
+	r.Admin(rbac.APIKeysManage, fiber.MethodDelete, "/api-keys/:id", h.RevokeKey).
+		Describe("Revoke an API key", nil, nil)
+}
+
+// GET /admin/service-accounts - Get all service accounts
+func (h *APIKeyHandler) GetServiceAccounts(c *fiber.Ctx) error {
+	accounts, err := h.apiKeyService.GetServiceAccounts(c.UserContext())
+	if err != nil {
+		return apperr.Internal("Failed to get service accounts", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Service accounts retrieved successfully",
+		Data:    accounts,
+	})
+}
+
+// POST /admin/service-accounts - Create a service account with a role
+func (h *APIKeyHandler) CreateServiceAccount(c *fiber.Ctx) error {
+	var req models.CreateServiceAccountRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Choosing the account's role is the same privilege as assigning roles to users
+	if !middleware.HasPermission(c, rbac.RolesManage) {
+		return apperr.Forbidden("Permission denied").WithDetails("Missing permission " + rbac.RolesManage)
+	}
+
+	exists, err := h.roleService.RoleExists(c.UserContext(), req.Role)
+	if err != nil {
+		return apperr.Internal("Failed to create service account", err)
+	}
+	if !exists {
+		return apperr.Validation("Validation failed", nil).WithDetails("Role " + req.Role + " does not exist")
+	}
+
+	account, err := h.apiKeyService.CreateServiceAccount(c.UserContext(), req, middleware.GetAuditActor(c))
+	if err != nil {
+		return apperr.Internal("Failed to create service account", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Service account created successfully",
+		Data:    account,
+	})
+}
+
+// GET /admin/api-keys - Get API keys, optionally only those of one service account (?user_id=)
+func (h *APIKeyHandler) GetKeys(c *fiber.Ctx) error {
+	keys, err := h.apiKeyService.GetKeys(c.UserContext(), c.QueryInt("user_id", 0))
+	if err != nil {
+		return apperr.Internal("Failed to get API keys", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "API keys retrieved successfully",
+		Data:    keys,
+	})
+}
+
+// POST /admin/api-keys - Issue an API key for a service account
+func (h *APIKeyHandler) CreateKey(c *fiber.Ctx) error {
+	var req models.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// The service checks the creator's role; a request made with an API key
+	// is further limited to that key's scopes
+	for _, scope := range req.Scopes {
+		if !middleware.HasPermission(c, scope) {
+			return apperr.Forbidden("Permission denied").WithDetails("You cannot grant scope " + scope)
+		}
+	}
+
+	rawKey, key, err := h.apiKeyService.CreateKey(c.UserContext(), req, middleware.GetAuditActor(c))
+	if err != nil {
+		return apperr.Internal("Failed to create API key", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "API key created. Store it somewhere safe, it is shown only once",
+		Data: map[string]interface{}{
+			"key":     rawKey,
+			"api_key": key,
+		},
+	})
+}
+
+// DELETE /admin/api-keys/:id - Revoke an API key
+func (h *APIKeyHandler) RevokeKey(c *fiber.Ctx) error {
+	keyID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid API key ID", nil).WithDetails("API key ID must be a valid integer")
+	}
+
+	if err := h.apiKeyService.RevokeKey(c.UserContext(), keyID, middleware.GetAuditActor(c)); err != nil {
+		return apperr.Internal("Failed to revoke API key", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "API key revoked successfully",
+	})
+}
@@ -0,0 +1,126 @@
+package apikey_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/apikey"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(apikey.NewAPIKeyHandler(env.APIKeys, env.Roles))
+	return env, app
+}
+
+func TestServiceAccounts(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "admin"))
+	req := models.CreateServiceAccountRequest{Name: "reporting", FullName: "Reporting job", Role: "accountant"}
+
+	var account models.User
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &account)
+	if account.Username != "svc_reporting" || account.Role != "accountant" {
+		t.Errorf("service account = %+v", account)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+
+	var accounts []models.User
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/service-accounts", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &accounts)
+	if len(accounts) != 1 {
+		t.Errorf("got %d service accounts, want 1", len(accounts))
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", models.CreateServiceAccountRequest{Name: "Bad-Name", Role: "user"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", models.CreateServiceAccountRequest{Name: "ghost", Role: "pirate"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", models.CreateServiceAccountRequest{Name: "x"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestKeys(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	token := env.Login(t, adminUser)
+	account, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "sync", Role: "admin"}, handlertest.Actor(adminUser))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := models.CreateAPIKeyRequest{UserID: account.ID, Name: "nightly", Scopes: []string{rbac.APIKeysManage}}
+	resp := handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", req, token).Expect(t, fiber.StatusOK, "")
+	var created struct {
+		Key    string        `json:"key"`
+		APIKey models.APIKey `json:"api_key"`
+	}
+	resp.Decode(t, &created)
+	if created.Key == "" || created.APIKey.UserID != account.ID {
+		t.Fatalf("created key = %s", resp.Data)
+	}
+
+	// The key authenticates as the service account, limited to its scopes
+	var keys []models.APIKey
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/api-keys?user_id="+strconv.Itoa(account.ID), nil, created.Key).
+		Expect(t, fiber.StatusOK, "").Decode(t, &keys)
+	if len(keys) != 1 || keys[0].ID != created.APIKey.ID {
+		t.Errorf("keys = %+v", keys)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", models.CreateServiceAccountRequest{Name: "other", Role: "user"}, created.Key).
+		Expect(t, fiber.StatusForbidden, "")
+
+	keyPath := "/api/v1/admin/api-keys/" + strconv.Itoa(created.APIKey.ID)
+	handlertest.Do(t, app, fiber.MethodDelete, keyPath, nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, keyPath, nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/api-keys", nil, created.Key).Expect(t, fiber.StatusUnauthorized, "")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/admin/api-keys/abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	past := time.Now().Add(-time.Hour)
+	invalid := []models.CreateAPIKeyRequest{
+		{UserID: account.ID, Name: "bad scope", Scopes: []string{"everything"}},
+		{UserID: account.ID, Name: "expired", Scopes: []string{rbac.UsersRead}, ExpiresAt: &past},
+		{UserID: adminUser.ID, Name: "person", Scopes: []string{rbac.UsersRead}},
+		{UserID: account.ID, Name: "no scopes"},
+	}
+	for _, req := range invalid {
+		handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", req, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", models.CreateAPIKeyRequest{UserID: 999, Name: "ghost", Scopes: []string{rbac.UsersRead}}, token).
+		Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+}
+
+func TestKeysCannotExceedCreator(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	if _, err := env.Roles.CreateRole(t.Context(), models.RoleRequest{Name: "integrator", Permissions: []string{rbac.APIKeysManage}}, handlertest.Actor(adminUser)); err != nil {
+		t.Fatal(err)
+	}
+	token := env.Login(t, env.CreateUser(t, "integrator"))
+	account, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "sync", Role: "admin"}, handlertest.Actor(adminUser))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", models.CreateAPIKeyRequest{UserID: account.ID, Name: "wide", Scopes: []string{rbac.UsersRead}}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/service-accounts", models.CreateServiceAccountRequest{Name: "other", Role: "admin"}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	// Even a narrow key for an account above the creator's role is refused
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", models.CreateAPIKeyRequest{UserID: account.ID, Name: "narrow", Scopes: []string{rbac.APIKeysManage}}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+
+	peer, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "peer", Role: "integrator"}, handlertest.Actor(adminUser))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", models.CreateAPIKeyRequest{UserID: peer.ID, Name: "wide", Scopes: []string{rbac.UsersRead}}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/api-keys", models.CreateAPIKeyRequest{UserID: peer.ID, Name: "narrow", Scopes: []string{rbac.APIKeysManage}}, token).
+		Expect(t, fiber.StatusOK, "")
+}
@@ -1,75 +1,102 @@
 package auth
 
 import (
-	"strings"
-	"time"
+	"errors"
+	"log"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/login"
+	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
 	"zplus_web/backend/utils"
 )
 
 type AuthHandler struct {
-	userService *services.UserService
-	validator   *validator.Validate
+	userService              *services.UserService
+	sessionService           *services.SessionService
+	passwordResetService     *services.PasswordResetService
+	emailVerificationService *services.EmailVerificationService
+	mfaService               *services.MFAService
+	loginGuard               *services.LoginGuard
+	magicLinkService         *services.MagicLinkService
+	validator                *validator.Validate
 }
 
-func NewAuthHandler(userService *services.UserService) *AuthHandler {
+func NewAuthHandler(userService *services.UserService, sessionService *services.SessionService, passwordResetService *services.PasswordResetService, emailVerificationService *services.EmailVerificationService, mfaService *services.MFAService, loginGuard *services.LoginGuard, magicLinkService *services.MagicLinkService) *AuthHandler {
 	return &AuthHandler{
-		userService: userService,
-		validator:   validator.New(),
+		userService:              userService,
+		sessionService:           sessionService,
+		passwordResetService:     passwordResetService,
+		emailVerificationService: emailVerificationService,
+		mfaService:               mfaService,
+		loginGuard:               loginGuard,
+		magicLinkService:         magicLinkService,
+		validator:                validator.New(),
 	}
 }
 
+// RegisterRoutes mounts the authentication endpoints
+func (h *AuthHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodPost, "/auth/register", h.Register).
+		Describe("Register new customer", models.RegisterRequest{}, fiber.Map{"user": models.LoginUser{}, "message": ""})
+	r.Public(fiber.MethodPost, "/auth/login", h.Login).
+		Describe("User login", models.LoginRequest{}, models.LoginResponse{})
+	r.Public(fiber.MethodPost, "/auth/refresh", h.Refresh).
+		Describe("Exchange a refresh token for a new token pair", models.RefreshTokenRequest{}, models.AuthTokens{})
+	r.Authenticated(fiber.MethodPost, "/auth/logout", h.Logout).
+		Describe("User logout", nil, nil)
+	r.Authenticated(fiber.MethodGet, "/auth/me", h.Me).
+		Describe("Get current user info", nil, fiber.Map{"user": models.LoginUser{}})
+	r.Public(fiber.MethodPost, "/auth/forgot-password", h.ForgotPassword).
+		Describe("Request password reset", models.ForgotPasswordRequest{}, nil)
+	r.Public(fiber.MethodPost, "/auth/reset-password", h.ResetPassword).
+		Describe("Reset password with token", models.ResetPasswordRequest{}, nil)
+	r.Authenticated(fiber.MethodPost, "/auth/change-password", middleware.NoImpersonation(), h.ChangePassword).
+		Describe("Change the password, signing out every other session", models.ChangePasswordRequest{}, nil)
+	r.Public(fiber.MethodPost, "/auth/verify-email", h.VerifyEmail).
+		Describe("Verify email address with token", models.VerifyEmailRequest{}, nil)
+	r.Public(fiber.MethodPost, "/auth/magic-link", h.RequestMagicLink).
+		Describe("Email a login link; keep the returned nonce for the login", models.MagicLinkRequest{}, fiber.Map{"nonce": ""})
+	r.Public(fiber.MethodPost, "/auth/magic-link/login", h.MagicLinkLogin).
+		Describe("Log in with the token from a login link and the browser's nonce", models.MagicLinkLoginRequest{}, models.LoginResponse{})
+	r.Authenticated(fiber.MethodPost, "/auth/resend-verification", h.ResendVerification).
+		Describe("Send a new verification email to the current user", nil, nil)
+	r.Authenticated(fiber.MethodGet, "/auth/sessions", h.GetSessions).
+		Describe("List the current user's active sessions and devices", nil, []models.UserSession{})
+	r.Authenticated(fiber.MethodDelete, "/auth/sessions", middleware.NoImpersonation(), h.RevokeOtherSessions).
+		Describe("Revoke every session of the current user except this one", nil, fiber.Map{"revoked": 0})
+	r.Authenticated(fiber.MethodDelete, "/auth/sessions/:id", middleware.NoImpersonation(), h.RevokeSession).
+		Describe("Revoke one of the current user's sessions", nil, nil)
+	r.Root(fiber.MethodGet, "/.well-known/jwks.json", h.JWKS).
+		Describe("Public keys for verifying access tokens", nil, routes.Content{Type: "application/json"})
+}
+
 // POST /auth/register - Register new customer
 func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	var req models.RegisterRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create user
-	user, err := h.userService.CreateUser(req)
+	user, err := h.userService.CreateUser(c.UserContext(), req)
 	if err != nil {
-		if strings.Contains(err.Error(), "already exists") {
-			return c.Status(409).JSON(models.ApiResponse{
-				Success: false,
-				Message: "User already exists",
-				Error: &models.ApiError{
-					Code:    "ALREADY_EXISTS",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create user",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create user", err)
+	}
+
+	// Registration succeeds even if the mail cannot be sent, the user can ask for a new link
+	if err := h.emailVerificationService.SendVerification(c.UserContext(), user); err != nil {
+		log.Printf("Failed to send verification email to user %d: %v", user.ID, err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -82,7 +109,7 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 				"email":    user.Email,
 				"role":     user.Role,
 			},
-			"message": "Registration completed successfully",
+			"message": "Registration completed successfully, please check your email to verify your account",
 		},
 	})
 }
@@ -91,86 +118,66 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var req models.LoginRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Refuse attempts while the account or client address is locked out
+	if err := h.loginGuard.Check(c.UserContext(), req.Email, c.IP()); err != nil {
+		return apperr.New(fiber.StatusTooManyRequests, apperr.CodeAuthLocked, "Too many failed login attempts").Wrap(err)
 	}
 
 	// Authenticate user
-	user, err := h.userService.AuthenticateUser(req.Email, req.Password)
+	user, err := h.userService.AuthenticateUser(c.UserContext(), req.Email, req.Password)
 	if err != nil {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid credentials",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "Invalid email or password",
-			},
-		})
+		h.loginGuard.RecordFailure(c.UserContext(), req.Email, c.IP())
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid credentials").WithDetails("Invalid email or password")
 	}
 
-	// Generate JWT token
-	token, err := utils.GenerateJWTWithDetails(user.ID, user.Email, user.Role, user.Username)
+	h.loginGuard.RecordSuccess(c.UserContext(), req.Email)
+
+	// Accounts with two-factor authentication only get a limited token at this point
+	resp, err := login.Begin(c, h.sessionService, h.mfaService, user)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to generate token",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return err
+	}
+
+	return login.Respond(c, resp, "Login successful")
+}
+
+// POST /auth/refresh - Exchange a refresh token for a new token pair
+func (h *AuthHandler) Refresh(c *fiber.Ctx) error {
+	var req models.RefreshTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
 	}
 
-	// Store session
-	expiresAt := time.Now().Add(24 * time.Hour)
-	err = h.userService.CreateSession(user.ID, token, expiresAt)
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	tokens, err := h.sessionService.Refresh(c.UserContext(), req.RefreshToken)
 	if err != nil {
-		// Log error but don't fail login
-		// Session storage is not critical for JWT-based auth
+		return apperr.Internal("Failed to refresh token", err)
 	}
 
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "Login successful",
-		Data: map[string]interface{}{
-			"token": token,
-			"user": map[string]interface{}{
-				"id":       user.ID,
-				"username": user.Username,
-				"email":    user.Email,
-				"role":     user.Role,
-				"full_name": user.FullName,
-			},
-		},
+		Message: "Token refreshed successfully",
+		Data:    tokens,
 	})
 }
 
 // POST /auth/logout - User logout
 func (h *AuthHandler) Logout(c *fiber.Ctx) error {
-	// Get token from Authorization header
-	authHeader := c.Get("Authorization")
-	if authHeader != "" && strings.HasPrefix(authHeader, "Bearer ") {
-		token := strings.TrimPrefix(authHeader, "Bearer ")
-		// Invalidate session
-		h.userService.InvalidateSession(token)
+	// Revoke the session the access token belongs to, along with its refresh tokens
+	if sessionID, ok := c.Locals("session_id").(string); ok && sessionID != "" {
+		h.sessionService.InvalidateSession(c.UserContext(), sessionID)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -179,58 +186,161 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 	})
 }
 
+// GET /auth/sessions - List the current user's active sessions and devices
+func (h *AuthHandler) GetSessions(c *fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
+	sessionID, _ := c.Locals("session_id").(string)
+
+	sessions, err := h.sessionService.ListUserSessions(c.UserContext(), userID, sessionID)
+	if err != nil {
+		return apperr.Internal("Failed to retrieve sessions", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Sessions retrieved successfully",
+		Data:    sessions,
+	})
+}
+
+// DELETE /auth/sessions/:id - Revoke one of the current user's sessions
+func (h *AuthHandler) RevokeSession(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid session ID", nil).WithDetails("Session ID must be a valid integer")
+	}
+
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
+	if err := h.sessionService.RevokeUserSession(c.UserContext(), userID, id); err != nil {
+		return apperr.Internal("Failed to revoke session", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+	})
+}
+
+// DELETE /auth/sessions - Revoke every session of the current user except this one
+func (h *AuthHandler) RevokeOtherSessions(c *fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
+	sessionID, _ := c.Locals("session_id").(string)
+
+	revoked, err := h.sessionService.RevokeOtherSessions(c.UserContext(), userID, sessionID)
+	if err != nil {
+		return apperr.Internal("Failed to revoke sessions", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Other sessions revoked successfully",
+		Data: map[string]interface{}{
+			"revoked": revoked,
+		},
+	})
+}
+
 // POST /auth/forgot-password - Request password reset
 func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
-	type ForgotPasswordRequest struct {
-		Email string `json:"email" validate:"required,email"`
+	var req models.ForgotPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
 	}
 
-	var req ForgotPasswordRequest
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	if err := h.passwordResetService.RequestReset(c.UserContext(), req.Email); err != nil {
+		return apperr.Internal("Failed to process password reset request", err)
+	}
+
+	// Same response whether or not the email is registered
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "If the email is registered, a password reset link has been sent",
+	})
+}
+
+// POST /auth/magic-link - Email a passwordless login link. The returned nonce
+// must be kept by the browser and sent back with the link's token.
+func (h *AuthHandler) RequestMagicLink(c *fiber.Ctx) error {
+	var req models.MagicLinkRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
 	}
 
-	// TODO: Implement forgot password logic
-	// - Find user by email
-	// - Generate reset token
-	// - Send reset email
+	nonce, err := h.magicLinkService.RequestLink(c.UserContext(), req.Email)
+	if err != nil {
+		return apperr.Internal("Failed to process login link request", err)
+	}
 
+	// Same response whether or not the email is registered
 	return c.JSON(models.ApiResponse{
 		Success: true,
-		Message: "Password reset email sent",
+		Message: "If the email is registered, a login link has been sent",
+		Data: map[string]interface{}{
+			"nonce": nonce,
+		},
 	})
 }
 
+// POST /auth/magic-link/login - Log in with the token from a login link and the browser's nonce
+func (h *AuthHandler) MagicLinkLogin(c *fiber.Ctx) error {
+	var req models.MagicLinkLoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	user, err := h.magicLinkService.Login(c.UserContext(), req.Token, req.Nonce)
+	if err != nil {
+		return apperr.Internal("Login failed", err)
+	}
+
+	// The second factor is still required when the account has one
+	resp, err := login.Begin(c, h.sessionService, h.mfaService, user)
+	if err != nil {
+		return err
+	}
+
+	return login.Respond(c, resp, "Login successful")
+}
+
 // POST /auth/reset-password - Reset password with token
 func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
-	type ResetPasswordRequest struct {
-		Token    string `json:"token" validate:"required"`
-		Password string `json:"password" validate:"required,min=6"`
+	var req models.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
 	}
 
-	var req ResetPasswordRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
 	}
 
-	// TODO: Implement reset password logic
-	// - Validate reset token
-	// - Update user password
-	// - Invalidate reset token
+	if err := h.passwordResetService.ResetPassword(c.UserContext(), req.Token, req.Password, middleware.GetAuditActor(c)); err != nil {
+		return apperr.Internal("Failed to reset password", err)
+	}
 
 	return c.JSON(models.ApiResponse{
 		Success: true,
@@ -238,20 +348,93 @@ func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
 	})
 }
 
+// POST /auth/change-password - Change the password, signing out every other session
+func (h *AuthHandler) ChangePassword(c *fiber.Ctx) error {
+	var req models.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
+	if err := h.userService.ChangePassword(c.UserContext(), userID, req.CurrentPassword, req.NewPassword, middleware.GetAuditActor(c)); err != nil {
+		return apperr.Internal("Failed to change password", err)
+	}
+
+	// The password has changed either way, a leftover session is logged rather than reported
+	sessionID, _ := c.Locals("session_id").(string)
+	if _, err := h.sessionService.RevokeOtherSessions(c.UserContext(), userID, sessionID); err != nil {
+		log.Printf("Failed to revoke other sessions of user %d: %v", userID, err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Password changed successfully, other sessions have been signed out",
+	})
+}
+
+// POST /auth/verify-email - Verify email address with token
+func (h *AuthHandler) VerifyEmail(c *fiber.Ctx) error {
+	var req models.VerifyEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	if err := h.emailVerificationService.VerifyEmail(c.UserContext(), req.Token); err != nil {
+		return apperr.Internal("Failed to verify email", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Email verified successfully",
+	})
+}
+
+// POST /auth/resend-verification - Send a new verification email to the current user
+func (h *AuthHandler) ResendVerification(c *fiber.Ctx) error {
+	currentUser := middleware.GetCurrentUser(c)
+	userID, ok := currentUser["id"].(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
+
+	err := h.emailVerificationService.ResendVerification(c.UserContext(), userID)
+	if err != nil {
+		var limited *ratelimit.LimitedError
+		if errors.As(err, &limited) {
+			return apperr.RateLimited("Verification email requested too often").Wrap(err)
+		}
+		return apperr.Internal("Failed to send verification email", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Verification email sent",
+	})
+}
+
 // GET /auth/me - Get current user info
 func (h *AuthHandler) Me(c *fiber.Ctx) error {
-	userID := c.Locals("user_id").(int)
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
+	}
 	
-	user, err := h.userService.GetUserByID(userID)
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
 	if err != nil {
-		return c.Status(404).JSON(models.ApiResponse{
-			Success: false,
-			Message: "User not found",
-			Error: &models.ApiError{
-				Code:    "NOT_FOUND",
-				Details: err.Error(),
-			},
-		})
+		return apperr.NotFound("User not found").Wrap(err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -267,4 +450,10 @@ func (h *AuthHandler) Me(c *fiber.Ctx) error {
 			},
 		},
 	})
-}
\ No newline at end of file
+}
+
+// GET /.well-known/jwks.json - Public keys for verifying access tokens
+func (h *AuthHandler) JWKS(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(utils.JWKS())
+}
@@ -0,0 +1,306 @@
+package auth_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/auth"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(auth.NewAuthHandler(env.Users, env.Sessions, env.PasswordResets, env.EmailVerifications, env.MFA, env.LoginGuard, env.MagicLinks))
+	return env, app
+}
+
+func login(t *testing.T, app *fiber.App, email, password string) models.LoginResponse {
+	t.Helper()
+	var resp models.LoginResponse
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/login", models.LoginRequest{Email: email, Password: password}, "").
+		Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	return resp
+}
+
+func TestRegister(t *testing.T) {
+	env, app := newApp(t)
+	req := models.RegisterRequest{Username: "alice", Email: "alice@example.com", Password: handlertest.Password, FullName: "Alice"}
+
+	resp := handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", req, "").Expect(t, fiber.StatusOK, "")
+	var data struct {
+		User models.LoginUser `json:"user"`
+	}
+	resp.Decode(t, &data)
+	if data.User.Email != req.Email || data.User.Role != "user" {
+		t.Errorf("registered user = %+v", data.User)
+	}
+	if len(env.Mail.Messages(req.Email)) != 1 {
+		t.Errorf("no verification email was sent")
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", req, "").Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+
+	weak := models.RegisterRequest{Username: "bob", Email: "bob@example.com", Password: "password", FullName: "Bob"}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", weak, "").Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", []byte("{"), "").Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", models.RegisterRequest{Email: "x"}, "").Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestLogin(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+
+	resp := login(t, app, user.Email, handlertest.Password)
+	if resp.Token == "" || resp.RefreshToken == "" || resp.User == nil || resp.User.ID != user.ID {
+		t.Fatalf("login response = %+v", resp)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, resp.Token).Expect(t, fiber.StatusOK, "")
+
+	for i := 0; i < 3; i++ {
+		handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/login", models.LoginRequest{Email: user.Email, Password: "wrong-password"}, "").
+			Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+	}
+	locked := handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/login", models.LoginRequest{Email: user.Email, Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusTooManyRequests, "AUTH_LOCKED")
+	if locked.Header.Get(fiber.HeaderRetryAfter) == "" {
+		t.Errorf("locked response has no Retry-After header")
+	}
+}
+
+func TestLoginRequiresSecondFactor(t *testing.T) {
+	env, app := newApp(t)
+	admin := env.CreateUser(t, "admin")
+	if _, err := env.Settings.UpdateSecuritySettings(t.Context(), models.SecuritySettings{RequireAdmin2FA: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := login(t, app, admin.Email, handlertest.Password)
+	if !resp.MFARequired || !resp.EnrollmentRequired || resp.MFAToken == "" || resp.Token != "" {
+		t.Errorf("login response = %+v, want a two-factor challenge", resp)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	first := login(t, app, user.Email, handlertest.Password)
+
+	var tokens models.AuthTokens
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/refresh", models.RefreshTokenRequest{RefreshToken: first.RefreshToken}, "").
+		Expect(t, fiber.StatusOK, "").Decode(t, &tokens)
+	if tokens.AccessToken == "" || tokens.RefreshToken == first.RefreshToken {
+		t.Fatalf("refreshed tokens = %+v", tokens)
+	}
+
+	// Replaying a used refresh token revokes the whole session
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/refresh", models.RefreshTokenRequest{RefreshToken: first.RefreshToken}, "").
+		Expect(t, fiber.StatusUnauthorized, "AUTH_TOKEN_REUSED")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, tokens.AccessToken).Expect(t, fiber.StatusUnauthorized, "AUTH_REVOKED")
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/refresh", models.RefreshTokenRequest{RefreshToken: "unknown"}, "").
+		Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/refresh", models.RefreshTokenRequest{}, "").
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestLogout(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "user"))
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/logout", nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, token).Expect(t, fiber.StatusUnauthorized, "AUTH_REVOKED")
+}
+
+func TestMe(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "editor")
+
+	var data struct {
+		User models.LoginUser `json:"user"`
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, env.Login(t, user)).Expect(t, fiber.StatusOK, "").Decode(t, &data)
+	if data.User.ID != user.ID || data.User.Role != "editor" {
+		t.Errorf("me = %+v", data.User)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, "").Expect(t, fiber.StatusUnauthorized, "AUTH_REQUIRED")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, "not-a-jwt").Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+}
+
+func TestPasswordReset(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/forgot-password", models.ForgotPasswordRequest{Email: "nobody@example.com"}, "").
+		Expect(t, fiber.StatusOK, "")
+	if len(env.Mail.Messages("nobody@example.com")) != 0 {
+		t.Errorf("a reset email was sent to an unknown address")
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/forgot-password", models.ForgotPasswordRequest{Email: user.Email}, "").
+		Expect(t, fiber.StatusOK, "")
+	resetToken := env.Mail.Token(t, user.Email)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/reset-password", models.ResetPasswordRequest{Token: resetToken, Password: "password"}, "").
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	newPassword := "N3w-Secret-Pass!"
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/reset-password", models.ResetPasswordRequest{Token: resetToken, Password: newPassword}, "").
+		Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/reset-password", models.ResetPasswordRequest{Token: resetToken, Password: newPassword}, "").
+		Expect(t, fiber.StatusBadRequest, "INVALID_TOKEN")
+
+	// Resetting the password signs out every session
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, token).Expect(t, fiber.StatusUnauthorized, "AUTH_REVOKED")
+	login(t, app, user.Email, newPassword)
+}
+
+func TestChangePassword(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+	other := env.Login(t, user)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/change-password",
+		models.ChangePasswordRequest{CurrentPassword: "wrong-password", NewPassword: "N3w-Secret-Pass!"}, token).
+		Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/change-password",
+		models.ChangePasswordRequest{CurrentPassword: handlertest.Password, NewPassword: handlertest.Password}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/change-password",
+		models.ChangePasswordRequest{CurrentPassword: handlertest.Password, NewPassword: "N3w-Secret-Pass!"}, token).
+		Expect(t, fiber.StatusOK, "")
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, other).Expect(t, fiber.StatusUnauthorized, "AUTH_REVOKED")
+}
+
+func TestVerifyEmail(t *testing.T) {
+	env, app := newApp(t)
+	req := models.RegisterRequest{Username: "carol", Email: "carol@example.com", Password: handlertest.Password, FullName: "Carol"}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/register", req, "").Expect(t, fiber.StatusOK, "")
+	user, err := env.Users.GetUserByEmail(t.Context(), req.Email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := env.Login(t, user)
+
+	// The registration email was just sent
+	resp := handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/resend-verification", nil, token).
+		Expect(t, fiber.StatusTooManyRequests, "RATE_LIMITED")
+	if resp.Header.Get(fiber.HeaderRetryAfter) == "" {
+		t.Errorf("rate limited response has no Retry-After header")
+	}
+
+	verifyToken := env.Mail.Token(t, req.Email)
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/verify-email", models.VerifyEmailRequest{Token: verifyToken}, "").
+		Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/verify-email", models.VerifyEmailRequest{Token: verifyToken}, "").
+		Expect(t, fiber.StatusBadRequest, "INVALID_TOKEN")
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/resend-verification", nil, token).Expect(t, fiber.StatusBadRequest, "ALREADY_VERIFIED")
+}
+
+func TestMagicLink(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+
+	var data struct {
+		Nonce string `json:"nonce"`
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/magic-link", models.MagicLinkRequest{Email: user.Email}, "").
+		Expect(t, fiber.StatusOK, "").Decode(t, &data)
+	if data.Nonce == "" {
+		t.Fatal("no nonce returned")
+	}
+	linkToken := env.Mail.Token(t, user.Email)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/magic-link/login", models.MagicLinkLoginRequest{Token: linkToken, Nonce: "other-browser"}, "").
+		Expect(t, fiber.StatusBadRequest, "INVALID_TOKEN")
+
+	var resp models.LoginResponse
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/magic-link/login", models.MagicLinkLoginRequest{Token: linkToken, Nonce: data.Nonce}, "").
+		Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	if resp.Token == "" || resp.User == nil || resp.User.ID != user.ID {
+		t.Errorf("login response = %+v", resp)
+	}
+
+	// Unknown addresses get the same answer but no email
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/magic-link", models.MagicLinkRequest{Email: "nobody@example.com"}, "").
+		Expect(t, fiber.StatusOK, "")
+	if len(env.Mail.Messages("nobody@example.com")) != 0 {
+		t.Errorf("a login link was sent to an unknown address")
+	}
+}
+
+func TestSessions(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+	env.Login(t, user)
+	env.Login(t, user)
+
+	var sessions []models.UserSession
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/sessions", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &sessions)
+	if len(sessions) != 3 {
+		t.Fatalf("%d sessions listed, want 3", len(sessions))
+	}
+	var current, other int
+	for _, session := range sessions {
+		if session.Current {
+			current = session.ID
+		} else {
+			other = session.ID
+		}
+	}
+	if current == 0 {
+		t.Fatal("the current session is not flagged")
+	}
+
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/auth/sessions/"+strconv.Itoa(other), nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/auth/sessions/"+strconv.Itoa(other), nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/auth/sessions/abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var revoked struct {
+		Revoked int `json:"revoked"`
+	}
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/auth/sessions", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &revoked)
+	if revoked.Revoked != 1 {
+		t.Errorf("revoked %d sessions, want 1", revoked.Revoked)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/sessions", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &sessions)
+	if len(sessions) != 1 || !sessions[0].Current {
+		t.Errorf("sessions after revoking the others = %+v", sessions)
+	}
+}
+
+func TestImpersonationCannotChangeCredentials(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	staff := env.CreateUser(t, "support")
+	tokens, err := env.Sessions.StartImpersonation(t.Context(), user, handlertest.Actor(staff), "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/me", nil, tokens.AccessToken).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/change-password",
+		models.ChangePasswordRequest{CurrentPassword: handlertest.Password, NewPassword: "N3w-Secret-Pass!"}, tokens.AccessToken).
+		Expect(t, fiber.StatusForbidden, "")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/auth/sessions", nil, tokens.AccessToken).Expect(t, fiber.StatusForbidden, "")
+}
+
+func TestJWKS(t *testing.T) {
+	_, app := newApp(t)
+
+	resp := handlertest.Do(t, app, fiber.MethodGet, "/.well-known/jwks.json", nil, "").Expect(t, fiber.StatusOK, "")
+	if resp.Header.Get(fiber.HeaderCacheControl) == "" {
+		t.Errorf("JWKS response has no Cache-Control header")
+	}
+}
@@ -2,27 +2,52 @@ package blog
 
 import (
 	"strconv"
-	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
 	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
 )
 
 type BlogHandler struct {
-	blogService *services.BlogService
-	validator   *validator.Validate
+	blogService  *services.BlogService
+	auditService *services.AuditService
+	validator    *validator.Validate
 }
 
-func NewBlogHandler(blogService *services.BlogService) *BlogHandler {
+func NewBlogHandler(blogService *services.BlogService, auditService *services.AuditService) *BlogHandler {
 	return &BlogHandler{
-		blogService: blogService,
-		validator:   validator.New(),
+		blogService:  blogService,
+		auditService: auditService,
+		validator:    validator.New(),
 	}
 }
 
+// RegisterRoutes mounts the public blog and admin blog management endpoints
+func (h *BlogHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodGet, "/blog/posts", h.GetPosts).
+		Describe("Get published blog posts", nil, fiber.Map{"posts": []models.BlogPost{}, "pagination": models.Pagination{}})
+	r.Public(fiber.MethodGet, "/blog/posts/:slug", h.GetPost).
+		Describe("Get single blog post by slug", nil, models.BlogPost{})
+	r.Public(fiber.MethodGet, "/blog/categories", h.GetCategories).
+		Describe("Get all blog categories", nil, []models.BlogCategory{})
+
+	r.Admin(rbac.BlogWrite, fiber.MethodGet, "/blog/posts", h.AdminGetPosts).
+		Describe("Get all blog posts for admin", nil, fiber.Map{"posts": []models.BlogPost{}, "pagination": models.Pagination{}})
+	r.Admin(rbac.BlogWrite, fiber.MethodPost, "/blog/posts", h.AdminCreatePost).
+		Describe("Create new blog post", models.CreatePostRequest{}, models.BlogPost{})
+	r.Admin(rbac.BlogWrite, fiber.MethodPut, "/blog/posts/:id", h.AdminUpdatePost).
+		Describe("Update blog post", models.UpdatePostRequest{}, models.BlogPost{})
+	r.Admin(rbac.BlogWrite, fiber.MethodDelete, "/blog/posts/:id", h.AdminDeletePost).
+		Describe("Delete blog post", nil, nil)
+	r.Admin(rbac.BlogWrite, fiber.MethodPost, "/blog/categories", h.AdminCreateCategory).
+		Describe("Create blog category", models.CreateCategoryRequest{}, models.BlogCategory{})
+}
+
 // GET /blog/posts - Get published blog posts (public)
 func (h *BlogHandler) GetPosts(c *fiber.Ctx) error {
 	// Parse query parameters
@@ -41,16 +66,9 @@ func (h *BlogHandler) GetPosts(c *fiber.Ctx) error {
 	}
 
 	// Get posts from database
-	posts, total, err := h.blogService.GetPosts(page, limit, category, featured, search)
+	posts, total, err := h.blogService.GetPosts(c.UserContext(), page, limit, category, featured, search)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve blog posts",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve blog posts", err)
 	}
 
 	// Calculate pagination info
@@ -63,13 +81,13 @@ func (h *BlogHandler) GetPosts(c *fiber.Ctx) error {
 		Message: "Blog posts retrieved successfully",
 		Data: map[string]interface{}{
 			"posts": posts,
-			"pagination": map[string]interface{}{
-				"current_page": page,
-				"total_pages":  totalPages,
-				"total_items":  total,
-				"items_per_page": limit,
-				"has_next":     hasNext,
-				"has_prev":     hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -80,37 +98,13 @@ func (h *BlogHandler) GetPost(c *fiber.Ctx) error {
 	slug := c.Params("slug")
 
 	if slug == "" {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Post slug is required",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Slug parameter is missing",
-			},
-		})
+		return apperr.Validation("Post slug is required", nil).WithDetails("Slug parameter is missing")
 	}
 
 	// Get post from database
-	post, err := h.blogService.GetPostBySlug(slug)
+	post, err := h.blogService.GetPostBySlug(c.UserContext(), slug)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Blog post not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: "No published post found with this slug",
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve blog post",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve blog post", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -123,16 +117,9 @@ func (h *BlogHandler) GetPost(c *fiber.Ctx) error {
 // GET /blog/categories - Get all blog categories
 func (h *BlogHandler) GetCategories(c *fiber.Ctx) error {
 	// Get categories from database
-	categories, err := h.blogService.GetCategories()
+	categories, err := h.blogService.GetCategories(c.UserContext())
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve blog categories",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve blog categories", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -159,16 +146,9 @@ func (h *BlogHandler) AdminGetPosts(c *fiber.Ctx) error {
 	}
 
 	// Get posts from database
-	posts, total, err := h.blogService.AdminGetPosts(page, limit)
+	posts, total, err := h.blogService.AdminGetPosts(c.UserContext(), page, limit)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve blog posts",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve blog posts", err)
 	}
 
 	// Calculate pagination info
@@ -181,13 +161,13 @@ func (h *BlogHandler) AdminGetPosts(c *fiber.Ctx) error {
 		Message: "Admin blog posts retrieved successfully",
 		Data: map[string]interface{}{
 			"posts": posts,
-			"pagination": map[string]interface{}{
-				"current_page": page,
-				"total_pages":  totalPages,
-				"total_items":  total,
-				"items_per_page": limit,
-				"has_next":     hasNext,
-				"has_prev":     hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -195,57 +175,30 @@ func (h *BlogHandler) AdminGetPosts(c *fiber.Ctx) error {
 
 // POST /admin/blog/posts - Create new blog post
 func (h *BlogHandler) AdminCreatePost(c *fiber.Ctx) error {
-	type CreatePostRequest struct {
-		Title         string   `json:"title" validate:"required,max=255"`
-		Slug          string   `json:"slug" validate:"required,max=255"`
-		Content       string   `json:"content" validate:"required"`
-		Excerpt       string   `json:"excerpt,omitempty"`
-		FeaturedImage string   `json:"featured_image,omitempty"`
-		Status        string   `json:"status" validate:"required,oneof=draft published private"`
-		IsFeatured    bool     `json:"is_featured"`
-		Categories    []int    `json:"categories"`
-	}
-
-	var req CreatePostRequest
+	var req models.CreatePostRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Publishing needs its own permission on top of blog:write
+	if req.Status == "published" && !middleware.HasPermission(c, rbac.BlogPublish) {
+		return apperr.Forbidden("Permission denied").WithDetails("Missing permission " + rbac.BlogPublish)
 	}
 
 	// Get current user from context
 	currentUser := middleware.GetCurrentUser(c)
 	authorID, ok := currentUser["id"].(int)
 	if !ok {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Unable to get user information",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "User ID not found in token",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Unable to get user information").WithDetails("User ID not found in token")
 	}
 
 	// Create post
-	post, err := h.blogService.CreatePost(
+	post, err := h.blogService.CreatePost(c.UserContext(), 
 		authorID,
 		req.Title,
 		req.Slug,
@@ -256,26 +209,11 @@ func (h *BlogHandler) AdminCreatePost(c *fiber.Ctx) error {
 		req.IsFeatured,
 	)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
-			return c.Status(409).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Post with this slug already exists",
-				Error: &models.ApiError{
-					Code:    "ALREADY_EXISTS",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create blog post",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create blog post", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditBlogPostCreate, "blog_post", strconv.Itoa(post.ID), nil, post)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "Blog post created successfully",
@@ -287,52 +225,26 @@ func (h *BlogHandler) AdminCreatePost(c *fiber.Ctx) error {
 func (h *BlogHandler) AdminUpdatePost(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid post ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Post ID must be a number",
-			},
-		})
-	}
-
-	type UpdatePostRequest struct {
-		Title         string `json:"title" validate:"required,max=255"`
-		Slug          string `json:"slug" validate:"required,max=255"`
-		Content       string `json:"content" validate:"required"`
-		Excerpt       string `json:"excerpt,omitempty"`
-		FeaturedImage string `json:"featured_image,omitempty"`
-		Status        string `json:"status" validate:"required,oneof=draft published private"`
-		IsFeatured    bool   `json:"is_featured"`
+		return apperr.Validation("Invalid post ID", nil).WithDetails("Post ID must be a number")
 	}
 
-	var req UpdatePostRequest
+	var req models.UpdatePostRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	// Publishing needs its own permission on top of blog:write
+	if req.Status == "published" && !middleware.HasPermission(c, rbac.BlogPublish) {
+		return apperr.Forbidden("Permission denied").WithDetails("Missing permission " + rbac.BlogPublish)
 	}
 
 	// Update post
-	post, err := h.blogService.UpdatePost(
+	post, err := h.blogService.UpdatePost(c.UserContext(), 
 		id,
 		req.Title,
 		req.Slug,
@@ -341,26 +253,10 @@ func (h *BlogHandler) AdminUpdatePost(c *fiber.Ctx) error {
 		req.FeaturedImage,
 		req.Status,
 		req.IsFeatured,
+		middleware.GetAuditActor(c),
 	)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Blog post not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to update blog post",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to update blog post", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -374,39 +270,17 @@ func (h *BlogHandler) AdminUpdatePost(c *fiber.Ctx) error {
 func (h *BlogHandler) AdminDeletePost(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid post ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Post ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid post ID", nil).WithDetails("Post ID must be a number")
 	}
 
 	// Delete post
-	err = h.blogService.DeletePost(id)
+	err = h.blogService.DeletePost(c.UserContext(), id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Blog post not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to delete blog post",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to delete blog post", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditBlogPostDelete, "blog_post", strconv.Itoa(id), nil, nil)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "Blog post deleted successfully",
@@ -415,57 +289,20 @@ func (h *BlogHandler) AdminDeletePost(c *fiber.Ctx) error {
 
 // POST /admin/blog/categories - Create blog category
 func (h *BlogHandler) AdminCreateCategory(c *fiber.Ctx) error {
-	type CreateCategoryRequest struct {
-		Name        string `json:"name" validate:"required,max=100"`
-		Slug        string `json:"slug" validate:"required,max=100"`
-		Description string `json:"description,omitempty"`
-	}
-
-	var req CreateCategoryRequest
+	var req models.CreateCategoryRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create category
-	category, err := h.blogService.CreateCategory(req.Name, req.Slug, req.Description)
+	category, err := h.blogService.CreateCategory(c.UserContext(), req.Name, req.Slug, req.Description)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
-			return c.Status(409).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Category with this slug already exists",
-				Error: &models.ApiError{
-					Code:    "ALREADY_EXISTS",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create blog category",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create blog category", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -0,0 +1,153 @@
+package blog_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/blog"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/services"
+)
+
+type postPage struct {
+	Posts      []models.BlogPost `json:"posts"`
+	Pagination models.Pagination `json:"pagination"`
+}
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(blog.NewBlogHandler(env.Blog, env.Audit))
+	return env, app
+}
+
+func TestPublicPosts(t *testing.T) {
+	env, app := newApp(t)
+	author := env.CreateUser(t, "editor")
+	published, err := env.Blog.CreatePost(t.Context(), author.ID, "Hello World", "hello-world", "Body", "Intro", "", "published", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.Blog.CreatePost(t.Context(), author.ID, "Draft", "draft", "Body", "", "", "draft", false); err != nil {
+		t.Fatal(err)
+	}
+	category, err := env.Blog.CreateCategory(t.Context(), "News", "news", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env.Store.AddBlogCategory(published.ID, category.ID)
+
+	var page postPage
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Posts) != 1 || page.Posts[0].Slug != "hello-world" || page.Pagination.TotalItems != 1 {
+		t.Errorf("posts = %+v", page)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts?category=news&featured=true&search=hello", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Posts) != 1 {
+		t.Errorf("filtered posts = %+v", page.Posts)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts?search=nothing", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Posts) != 0 {
+		t.Errorf("search matched %+v", page.Posts)
+	}
+
+	var post models.BlogPost
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts/hello-world", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &post)
+	if post.ID != published.ID || len(post.Categories) != 1 {
+		t.Errorf("post = %+v", post)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts/draft", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	var categories []models.BlogCategory
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/categories", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &categories)
+	if len(categories) != 1 || categories[0].Slug != "news" {
+		t.Errorf("categories = %+v", categories)
+	}
+}
+
+func TestAdminPosts(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "editor"))
+	req := models.CreatePostRequest{Title: "Launch", Slug: "launch", Content: "We launched", Status: "draft"}
+
+	var post models.BlogPost
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/posts", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &post)
+	if post.Slug != "launch" || post.Status != "draft" {
+		t.Errorf("created post = %+v", post)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/posts", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/posts", models.CreatePostRequest{Title: "No status"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var page postPage
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/blog/posts", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Posts) != 1 {
+		t.Errorf("admin posts = %+v", page.Posts)
+	}
+
+	postPath := "/api/v1/admin/blog/posts/" + strconv.Itoa(post.ID)
+	update := models.UpdatePostRequest{Title: "Launch day", Slug: "launch", Content: "We launched", Status: "published"}
+	handlertest.Do(t, app, fiber.MethodPut, postPath, update, token).Expect(t, fiber.StatusOK, "").Decode(t, &post)
+	if post.Title != "Launch day" || post.PublishedAt == nil {
+		t.Errorf("updated post = %+v", post)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/blog/posts/launch", nil, "").Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/blog/posts/999", update, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/blog/posts/abc", update, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodDelete, postPath, nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, postPath, nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	events, _, err := env.Audit.GetEvents(t.Context(), models.AuditFilter{EntityType: "blog_post"}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("got %d blog audit events, want 3", len(events))
+	}
+	// The update keeps the post as it was before
+	if update := events[1]; update.Action != services.AuditBlogPostUpdate ||
+		!strings.Contains(string(update.Before), `"title":"Launch"`) || !strings.Contains(string(update.After), `"title":"Launch day"`) {
+		t.Errorf("update event = %s, before %s, after %s", update.Action, update.Before, update.After)
+	}
+}
+
+func TestPublishingNeedsPermission(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	if _, err := env.Roles.CreateRole(t.Context(), models.RoleRequest{Name: "writer", Permissions: []string{rbac.BlogWrite}}, handlertest.Actor(adminUser)); err != nil {
+		t.Fatal(err)
+	}
+	token := env.Login(t, env.CreateUser(t, "writer"))
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/posts", models.CreatePostRequest{Title: "Now", Slug: "now", Content: "x", Status: "published"}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+
+	var post models.BlogPost
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/posts", models.CreatePostRequest{Title: "Later", Slug: "later", Content: "x", Status: "draft"}, token).
+		Expect(t, fiber.StatusOK, "").Decode(t, &post)
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/blog/posts/"+strconv.Itoa(post.ID), models.UpdatePostRequest{Title: "Later", Slug: "later", Content: "x", Status: "published"}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+
+	customer := env.Login(t, env.CreateUser(t, "user"))
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/blog/posts", nil, customer).Expect(t, fiber.StatusForbidden, "")
+}
+
+func TestAdminCreateCategory(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "editor"))
+	req := models.CreateCategoryRequest{Name: "Guides", Slug: "guides", Description: "How-tos"}
+
+	var category models.BlogCategory
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/categories", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &category)
+	if category.Slug != "guides" {
+		t.Errorf("category = %+v", category)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/categories", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/blog/categories", models.CreateCategoryRequest{Name: "No slug"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
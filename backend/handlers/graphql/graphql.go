@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/graph"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/routes"
+)
+
+type GraphQLHandler struct {
+	schema     *graph.Schema
+	sessions   middleware.SessionValidator
+	apiKeys    middleware.APIKeyValidator
+	playground bool
+}
+
+// NewGraphQLHandler serves the schema at /graphql. The GraphiQL playground is
+// only mounted when playground is set, i.e. outside production.
+func NewGraphQLHandler(schema *graph.Schema, sessions middleware.SessionValidator, apiKeys middleware.APIKeyValidator, playground bool) *GraphQLHandler {
+	return &GraphQLHandler{
+		schema:     schema,
+		sessions:   sessions,
+		apiKeys:    apiKeys,
+		playground: playground,
+	}
+}
+
+// RegisterRoutes mounts the GraphQL endpoint and playground outside the REST prefix.
+// The endpoint is public; resolvers check the caller for anything private.
+// Subscriptions are served from the same path over a websocket.
+func (h *GraphQLHandler) RegisterRoutes(r *routes.Registry) {
+	r.Root(fiber.MethodPost, "/graphql", middleware.OptionalAuth(h.sessions, h.apiKeys), h.Serve).
+		Describe("Execute a GraphQL query or mutation", fiber.Map{"query": "", "operationName": "", "variables": map[string]interface{}{}}, routes.Content{Type: "application/json"})
+	r.Root(fiber.MethodGet, "/graphql", middleware.OptionalAuth(h.sessions, h.apiKeys), h.Subscribe).
+		Describe("Run GraphQL subscriptions over a graphql-transport-ws websocket", nil, routes.Content{Type: "application/json"})
+	if h.playground {
+		r.Root(fiber.MethodGet, "/playground", h.Playground).
+			Describe("GraphiQL page for trying out the API", nil, routes.Content{Type: "text/html"})
+	}
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// POST /graphql - Execute a GraphQL query or mutation
+func (h *GraphQLHandler) Serve(c *fiber.Ctx) error {
+	var req graphQLRequest
+	if err := json.Unmarshal(c.Body(), &req); err != nil || req.Query == "" {
+		return c.Status(400).JSON(fiber.Map{
+			"errors": []fiber.Map{{
+				"message":    "Request body must be JSON with a query",
+				"extensions": fiber.Map{"code": apperr.CodeValidation},
+			}},
+		})
+	}
+
+	ctx := c.UserContext()
+	if viewer := viewerFromRequest(c); viewer != nil {
+		ctx = graph.WithViewer(ctx, viewer)
+	}
+
+	result := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	if len(result.Errors) > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// Resolvers cut short by the request deadline
+		c.Status(fiber.StatusGatewayTimeout)
+	}
+	return c.JSON(result)
+}
+
+// viewerFromRequest builds the GraphQL viewer from the locals set by AuthRequired
+func viewerFromRequest(c *fiber.Ctx) *graph.Viewer {
+	userID, ok := c.Locals("user_id").(int)
+	if !ok {
+		return nil
+	}
+
+	identity := &middleware.Identity{UserID: userID}
+	identity.Email, _ = c.Locals("user_email").(string)
+	identity.Role, _ = c.Locals("user_role").(string)
+	identity.Username, _ = c.Locals("user_username").(string)
+	identity.EmailVerified, _ = c.Locals("user_email_verified").(bool)
+	identity.ImpersonatorID, _ = c.Locals("impersonator_id").(int)
+	identity.APIKeyScopes, identity.APIKey = c.Locals("api_key_scopes").([]string)
+	return viewerFromIdentity(identity, c.IP(), c.Get(fiber.HeaderUserAgent))
+}
+
+// viewerFromIdentity builds the GraphQL viewer for an authenticated caller
+func viewerFromIdentity(identity *middleware.Identity, ip, userAgent string) *graph.Viewer {
+	viewer := &graph.Viewer{
+		UserID:        identity.UserID,
+		Email:         identity.Email,
+		Role:          identity.Role,
+		Username:      identity.Username,
+		EmailVerified: identity.EmailVerified,
+		IPAddress:     ip,
+		UserAgent:     userAgent,
+	}
+	if identity.ImpersonatorID != 0 {
+		impersonatorID := identity.ImpersonatorID
+		viewer.ImpersonatorID = &impersonatorID
+	}
+	if identity.APIKey {
+		// An API key without scopes grants nothing, unlike a token without a key
+		viewer.APIKeyScopes = append([]string{}, identity.APIKeyScopes...)
+	}
+	return viewer
+}
+
+// GET /playground - GraphiQL page for trying out the API
+func (h *GraphQLHandler) Playground(c *fiber.Ctx) error {
+	c.Type("html")
+	return c.SendString(playgroundPage)
+}
+
+const playgroundPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>ZPlus Web GraphQL Playground</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css">
+  <style>body { margin: 0; height: 100vh; } #graphiql { height: 100vh; }</style>
+</head>
+<body>
+  <div id="graphiql">Loading...</div>
+  <script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+  <script>
+    // Paste "Authorization: Bearer <access token>" into the headers tab to query as a user
+    const fetcher = GraphiQL.createFetcher({ url: '/graphql' });
+    ReactDOM.createRoot(document.getElementById('graphiql')).render(
+      React.createElement(GraphiQL, { fetcher: fetcher, headerEditorEnabled: true })
+    );
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,356 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/events"
+	"zplus_web/backend/graph"
+	"zplus_web/backend/handlers/graphql"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	schema, err := graph.NewSchema(
+		graph.NewResolver(env.Users, env.Blog, env.Projects, env.Products, env.Orders, env.Payments, env.Audit, env.Roles, env.Bus),
+		8, 1000, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return env, env.App(graphql.NewGraphQLHandler(schema, env.Sessions, env.APIKeys, true))
+}
+
+type gqlError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []gqlError      `json:"errors"`
+}
+
+// code returns the code of the first error, or "" when the query succeeded
+func (r gqlResponse) code() string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+	return r.Errors[0].Extensions.Code
+}
+
+func query(t *testing.T, app *fiber.App, token, q string, variables map[string]interface{}) gqlResponse {
+	t.Helper()
+	resp := handlertest.Do(t, app, fiber.MethodPost, "/graphql", map[string]interface{}{"query": q, "variables": variables}, token).
+		Expect(t, fiber.StatusOK, "")
+	var result gqlResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		t.Fatalf("failed to decode %s: %v", resp.Body, err)
+	}
+	return result
+}
+
+func TestQueries(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+	if _, err := env.Blog.CreatePost(t.Context(), user.ID, "Hello", "hello", "Body", "", "", "published", false); err != nil {
+		t.Fatal(err)
+	}
+
+	var posts struct {
+		Posts struct {
+			Items []struct {
+				Slug   string `json:"slug"`
+				Author struct {
+					Username string  `json:"username"`
+					Email    *string `json:"email"`
+				} `json:"author"`
+			} `json:"items"`
+			PageInfo struct {
+				TotalItems int `json:"totalItems"`
+			} `json:"pageInfo"`
+		} `json:"posts"`
+	}
+	resp := query(t, app, "", `{ posts { items { slug author { username email } } pageInfo { totalItems } } }`, nil)
+	if resp.code() != "" || json.Unmarshal(resp.Data, &posts) != nil || posts.Posts.PageInfo.TotalItems != 1 {
+		t.Fatalf("posts = %s %+v", resp.Data, resp.Errors)
+	}
+	if author := posts.Posts.Items[0].Author; author.Username != user.Username || author.Email != nil {
+		t.Errorf("anonymous readers see author %+v", author)
+	}
+
+	var me struct {
+		Me *struct {
+			Email string `json:"email"`
+		} `json:"me"`
+	}
+	resp = query(t, app, "", `{ me { email } }`, nil)
+	if json.Unmarshal(resp.Data, &me) != nil || me.Me != nil {
+		t.Errorf("anonymous me = %s", resp.Data)
+	}
+	resp = query(t, app, token, `{ me { email } }`, nil)
+	if json.Unmarshal(resp.Data, &me) != nil || me.Me == nil || me.Me.Email != user.Email {
+		t.Errorf("me = %s", resp.Data)
+	}
+
+	if code := query(t, app, "", `{ wallet { balance } }`, nil).code(); code != "AUTH_REQUIRED" {
+		t.Errorf("anonymous wallet error = %q", code)
+	}
+	if code := query(t, app, token, `{ users { items { id } } }`, nil).code(); code != "PERMISSION_DENIED" {
+		t.Errorf("customer users error = %q", code)
+	}
+	support := env.Login(t, env.CreateUser(t, "support"))
+	if resp := query(t, app, support, `{ users { items { email } } }`, nil); resp.code() != "" {
+		t.Errorf("support users = %+v", resp.Errors)
+	}
+}
+
+func TestMutations(t *testing.T) {
+	env, app := newApp(t)
+	editor := env.Login(t, env.CreateUser(t, "editor"))
+	createPost := `mutation($input: PostInput!) { createPost(input: $input) { id slug status } }`
+	input := map[string]interface{}{"input": map[string]interface{}{"title": "Launch", "slug": "launch", "content": "Shipped", "status": "PUBLISHED"}}
+
+	resp := query(t, app, editor, createPost, input)
+	if resp.code() != "" || !strings.Contains(string(resp.Data), `"status":"PUBLISHED"`) {
+		t.Fatalf("createPost = %s %+v", resp.Data, resp.Errors)
+	}
+	if code := query(t, app, editor, createPost, input).code(); code != "ALREADY_EXISTS" {
+		t.Errorf("duplicate createPost error = %q", code)
+	}
+
+	customer := env.Login(t, env.CreateUser(t, "user"))
+	if code := query(t, app, customer, createPost, input).code(); code != "PERMISSION_DENIED" {
+		t.Errorf("customer createPost error = %q", code)
+	}
+}
+
+func TestRequestLimits(t *testing.T) {
+	_, app := newApp(t)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/graphql", []byte("{"), "").Expect(t, fiber.StatusBadRequest, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/graphql", map[string]string{"query": ""}, "").Expect(t, fiber.StatusBadRequest, "")
+
+	if code := query(t, app, "", `{
+		posts(limit: 100) { items { id slug title } }
+		projects(limit: 100) { items { id slug name } }
+		products(limit: 100) { items { id slug name } }
+	}`, nil).code(); code != "QUERY_TOO_COMPLEX" {
+		t.Errorf("complex query error = %q", code)
+	}
+	if resp := query(t, app, "", `{ posts { items { author { id } categories { id } } } }`, nil); resp.code() != "" {
+		t.Errorf("simple query = %+v", resp.Errors)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/graphql", nil, "").Expect(t, fiber.StatusUpgradeRequired, "")
+	if resp := handlertest.Do(t, app, fiber.MethodGet, "/playground", nil, "").Expect(t, fiber.StatusOK, ""); !strings.Contains(string(resp.Body), "GraphiQL") {
+		t.Errorf("playground = %s", resp.Body)
+	}
+}
+
+// dial serves the app on a local port and opens a graphql-transport-ws connection to it
+func dial(t *testing.T, app *fiber.App) *websocket.Conn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go app.Listener(ln)
+	t.Cleanup(func() { app.Shutdown() })
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}, HandshakeTimeout: 5 * time.Second}
+	conn, _, err := dialer.Dial("ws://"+ln.Addr().String()+"/graphql", http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg interface{}) {
+	t.Helper()
+	if err := conn.WriteJSON(msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func receive(t *testing.T, conn *websocket.Conn) wsMessage {
+	t.Helper()
+	var msg wsMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// subscribeToSync opens a connection as token and subscribes to the progress
+// of site 3, publishing progress in the background until the test ends
+func subscribeToSync(t *testing.T, env *handlertest.Env, app *fiber.App, token string) *websocket.Conn {
+	t.Helper()
+	conn := dial(t, app)
+
+	send(t, conn, map[string]interface{}{"type": "connection_init", "payload": map[string]string{"Authorization": "Bearer " + token}})
+	if msg := receive(t, conn); msg.Type != "connection_ack" {
+		t.Fatalf("init answered with %+v", msg)
+	}
+	send(t, conn, map[string]interface{}{"id": "1", "type": "subscribe", "payload": map[string]string{
+		"query": `subscription { wordpressSyncProgress(siteId: 3) { siteId processed total done } }`,
+	}})
+
+	// The subscription starts in the background, so publish until it sees an event
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	go func() {
+		for {
+			env.Bus.Publish(events.WordPressSyncProgress, events.WordPressSyncPayload{SiteID: 9, Total: 1})
+			env.Bus.Publish(events.WordPressSyncProgress, events.WordPressSyncPayload{SiteID: 3, Processed: 1, Total: 1, Done: true})
+			select {
+			case <-stop:
+				return
+			case <-time.After(20 * time.Millisecond):
+			}
+		}
+	}()
+
+	msg := receive(t, conn)
+	if msg.Type != "next" || msg.ID != "1" || !strings.Contains(string(msg.Payload), `"siteId":"3"`) {
+		t.Fatalf("event = %+v %s", msg, msg.Payload)
+	}
+	return conn
+}
+
+// closeCode reads past any events until the server closes the connection
+func closeCode(t *testing.T, conn *websocket.Conn) int {
+	t.Helper()
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+		var closeErr *websocket.CloseError
+		if !errors.As(err, &closeErr) {
+			t.Fatalf("connection ended with %v", err)
+		}
+		return closeErr.Code
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	env, app := newApp(t)
+	admin := env.Login(t, env.CreateUser(t, "admin"))
+	conn := subscribeToSync(t, env, app, admin)
+
+	send(t, conn, map[string]string{"type": "ping"})
+	msg := receive(t, conn)
+	for msg.Type != "pong" {
+		msg = receive(t, conn)
+	}
+}
+
+func TestSubscriptionEndsWithSession(t *testing.T) {
+	env, app := newApp(t)
+	admin := env.CreateUser(t, "admin")
+	tokens, err := env.Sessions.StartSession(t.Context(), admin, "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := subscribeToSync(t, env, app, tokens.AccessToken)
+
+	if err := env.Sessions.InvalidateUserSessions(t.Context(), admin.ID); err != nil {
+		t.Fatal(err)
+	}
+	if code := closeCode(t, conn); code != 4401 {
+		t.Errorf("revoked session closed with %d", code)
+	}
+}
+
+func TestSubscriptionEndsWithRole(t *testing.T) {
+	env, app := newApp(t)
+	admin := env.CreateUser(t, "admin")
+	conn := subscribeToSync(t, env, app, env.Login(t, admin))
+
+	if err := env.Users.UpdateUserRole(t.Context(), admin.ID, "user", models.AuditActor{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.Sessions.RefreshUserSessions(t.Context(), admin.ID); err != nil {
+		t.Fatal(err)
+	}
+	if code := closeCode(t, conn); code != 4403 {
+		t.Errorf("demoted user closed with %d", code)
+	}
+}
+
+func TestSubscriptionEndsWithPermission(t *testing.T) {
+	env, app := newApp(t)
+	env.CreateRole(t, "webmaster", rbac.WordPressManage)
+	conn := subscribeToSync(t, env, app, env.Login(t, env.CreateUser(t, "webmaster")))
+
+	roles, err := env.Roles.GetRoles(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, role := range roles {
+		if role.Name == "webmaster" {
+			_, err = env.Roles.UpdateRole(t.Context(), role.ID, models.RoleRequest{Name: role.Name, Permissions: []string{rbac.DashboardView}}, handlertest.Actor(env.CreateUser(t, "admin")))
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := receive(t, conn)
+	for msg.Type == "next" {
+		msg = receive(t, conn)
+	}
+	if msg.Type != "complete" || msg.ID != "1" {
+		t.Errorf("subscription without permission answered with %+v", msg)
+	}
+}
+
+func TestSubscriptionNeedsPermission(t *testing.T) {
+	env, app := newApp(t)
+	customer := env.Login(t, env.CreateUser(t, "user"))
+	conn := dial(t, app)
+
+	send(t, conn, map[string]interface{}{"type": "connection_init", "payload": map[string]string{"Authorization": "Bearer " + customer}})
+	receive(t, conn)
+	send(t, conn, map[string]interface{}{"id": "1", "type": "subscribe", "payload": map[string]string{"query": `subscription { userRegistered { id } }`}})
+
+	// Errors returned when a subscription starts carry no extensions
+	msg := receive(t, conn)
+	if msg.ID != "1" || !strings.Contains(string(msg.Payload), "Permission denied") {
+		t.Errorf("subscription answered with %+v %s", msg, msg.Payload)
+	}
+}
+
+func TestSubscriptionRefusesBadToken(t *testing.T) {
+	_, app := newApp(t)
+	conn := dial(t, app)
+
+	send(t, conn, map[string]interface{}{"type": "connection_init", "payload": map[string]string{"Authorization": "Bearer nope"}})
+	_, _, err := conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	if !errors.As(err, &closeErr) || closeErr.Code != 4403 {
+		t.Errorf("bad token closed with %v", err)
+	}
+}
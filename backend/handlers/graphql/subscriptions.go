@@ -0,0 +1,327 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/graph"
+	"zplus_web/backend/middleware"
+)
+
+// subscriptionProtocol is the websocket subprotocol spoken by graphql-ws clients
+const subscriptionProtocol = "graphql-transport-ws"
+
+// connectionInitTimeout is how long a client has to send connection_init
+const connectionInitTimeout = 10 * time.Second
+
+// sessionCheckInterval is how often an idle connection checks that the
+// viewer's session or API key is still valid. Events check it before sending.
+const sessionCheckInterval = 30 * time.Second
+
+// Close codes defined by the graphql-transport-ws protocol
+const (
+	closeBadRequest         = 4400
+	closeUnauthorized       = 4401
+	closeForbidden          = 4403
+	closeInitTimeout        = 4408
+	closeSubscriberExists   = 4409
+	closeTooManyInitRequest = 4429
+)
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// GET /graphql - Run GraphQL subscriptions over a graphql-transport-ws websocket
+func (h *GraphQLHandler) Subscribe(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return c.Status(fiber.StatusUpgradeRequired).JSON(fiber.Map{
+			"errors": []fiber.Map{{
+				"message":    "Subscriptions require a websocket connection using the " + subscriptionProtocol + " protocol",
+				"extensions": fiber.Map{"code": apperr.CodeUpgradeRequired},
+			}},
+		})
+	}
+
+	// The viewer from the upgrade request's Authorization header, if any.
+	// Browsers cannot set headers on websockets, so connection_init may
+	// carry the token instead.
+	c.Locals("graphql_viewer", viewerFromRequest(c))
+	if _, ok := c.Locals("api_key_id").(int); ok {
+		c.Locals("graphql_api_key", strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer "))
+	}
+	c.Locals("graphql_ip", c.IP())
+	c.Locals("graphql_user_agent", c.Get(fiber.HeaderUserAgent))
+
+	return websocket.New(h.serveSubscriptions, websocket.Config{
+		Subprotocols: []string{subscriptionProtocol},
+	})(c)
+}
+
+// subscriptionConn is one websocket connection and its running subscriptions
+type subscriptionConn struct {
+	h      *GraphQLHandler
+	conn   *websocket.Conn
+	viewer *graph.Viewer
+	// What the viewer was authenticated with, checked again while streaming
+	sessionID string
+	apiKey    string
+
+	writeMu       sync.Mutex
+	mu            sync.Mutex
+	subscriptions map[string]context.CancelFunc
+}
+
+func (h *GraphQLHandler) serveSubscriptions(conn *websocket.Conn) {
+	s := &subscriptionConn{
+		h:             h,
+		conn:          conn,
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+	s.viewer, _ = conn.Locals("graphql_viewer").(*graph.Viewer)
+	s.sessionID, _ = conn.Locals("session_id").(string)
+	s.apiKey, _ = conn.Locals("graphql_api_key").(string)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	acknowledged := false
+	initTimer := time.AfterFunc(connectionInitTimeout, func() {
+		s.close(closeInitTimeout, "Connection initialisation timeout")
+	})
+	defer initTimer.Stop()
+
+	for {
+		var msg wsMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			// Closed by the client, or a frame that is not a JSON message
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+				s.close(closeBadRequest, "Invalid message")
+			}
+			return
+		}
+
+		switch msg.Type {
+		case "connection_init":
+			if acknowledged {
+				s.close(closeTooManyInitRequest, "Too many initialisation requests")
+				return
+			}
+			initTimer.Stop()
+			if reason, ok := s.authenticate(ctx, msg.Payload); !ok {
+				s.close(closeForbidden, reason)
+				return
+			}
+			acknowledged = true
+			s.write(wsMessage{Type: "connection_ack"})
+			if s.viewer != nil {
+				go s.watchSession(ctx)
+			}
+
+		case "ping":
+			s.write(wsMessage{Type: "pong"})
+
+		case "pong":
+
+		case "subscribe":
+			if !acknowledged {
+				s.close(closeUnauthorized, "Unauthorized")
+				return
+			}
+			if msg.ID == "" {
+				s.close(closeBadRequest, "Subscribe message requires an id")
+				return
+			}
+			var req graphQLRequest
+			if err := json.Unmarshal(msg.Payload, &req); err != nil || req.Query == "" {
+				s.close(closeBadRequest, "Subscribe payload must contain a query")
+				return
+			}
+			if !s.start(ctx, msg.ID, req) {
+				s.close(closeSubscriberExists, "Subscriber for "+msg.ID+" already exists")
+				return
+			}
+
+		case "complete":
+			s.stop(msg.ID)
+
+		default:
+			s.close(closeBadRequest, "Unknown message type "+msg.Type)
+			return
+		}
+	}
+}
+
+// authenticate replaces the viewer with the one behind the token in the
+// connection_init payload, e.g. {"Authorization": "Bearer <token>"}
+func (s *subscriptionConn) authenticate(ctx context.Context, payload json.RawMessage) (string, bool) {
+	var params map[string]interface{}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &params); err != nil {
+			return "Invalid connection_init payload", false
+		}
+	}
+
+	header, _ := params["Authorization"].(string)
+	if header == "" {
+		header, _ = params["authorization"].(string)
+	}
+	if header == "" {
+		return "", true
+	}
+
+	identity, apiErr := middleware.Authenticate(ctx, s.h.sessions, s.h.apiKeys, strings.TrimPrefix(header, "Bearer "), s.ip())
+	if apiErr != nil {
+		return apiErr.Message, false
+	}
+	s.viewer = viewerFromIdentity(identity, s.ip(), s.userAgent())
+	s.sessionID, s.apiKey = identity.SessionID, ""
+	if identity.APIKey {
+		s.apiKey = strings.TrimPrefix(header, "Bearer ")
+	}
+	return "", true
+}
+
+// revalidate checks that the viewer's session or API key is still valid and
+// their role unchanged, and closes the connection otherwise
+func (s *subscriptionConn) revalidate(ctx context.Context) bool {
+	if s.viewer == nil {
+		return true
+	}
+
+	var role string
+	if s.apiKey != "" {
+		principal, err := s.h.apiKeys.ValidateAPIKey(ctx, s.apiKey, s.ip())
+		if err != nil {
+			s.close(closeUnauthorized, "API key is no longer valid")
+			return false
+		}
+		role = principal.User.Role
+	} else {
+		var err error
+		role, err = s.h.sessions.ValidateSession(ctx, s.sessionID, s.viewer.UserID)
+		if err != nil {
+			s.close(closeUnauthorized, "Session is no longer valid")
+			return false
+		}
+	}
+
+	if role != s.viewer.Role {
+		s.close(closeForbidden, "Role has changed")
+		return false
+	}
+	return true
+}
+
+// watchSession revalidates the viewer every sessionCheckInterval until the
+// connection closes, so subscriptions without events end with the session too
+func (s *subscriptionConn) watchSession(ctx context.Context) {
+	ticker := time.NewTicker(sessionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.revalidate(ctx) {
+				return
+			}
+		}
+	}
+}
+
+// start runs a subscription until it ends, the client completes it or the
+// connection closes. It reports false if the id is already in use.
+func (s *subscriptionConn) start(parent context.Context, id string, req graphQLRequest) bool {
+	s.mu.Lock()
+	if _, exists := s.subscriptions[id]; exists {
+		s.mu.Unlock()
+		return false
+	}
+	ctx, cancel := context.WithCancel(parent)
+	s.subscriptions[id] = cancel
+	s.mu.Unlock()
+
+	if s.viewer != nil {
+		ctx = graph.WithViewer(ctx, s.viewer)
+	}
+
+	go func() {
+		defer s.stop(id)
+
+		responses, err := s.h.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+		if err != nil {
+			s.write(wsMessage{ID: id, Type: "error", Payload: mustMarshal([]fiber.Map{{"message": err.Error()}})})
+			return
+		}
+
+		for response := range responses {
+			if !s.revalidate(ctx) {
+				return
+			}
+			s.write(wsMessage{ID: id, Type: "next", Payload: mustMarshal(response)})
+		}
+
+		// Not sent when the client completed the subscription itself or left
+		if s.active(id) && parent.Err() == nil {
+			s.write(wsMessage{ID: id, Type: "complete"})
+		}
+	}()
+	return true
+}
+
+func (s *subscriptionConn) stop(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cancel, ok := s.subscriptions[id]; ok {
+		cancel()
+		delete(s.subscriptions, id)
+	}
+}
+
+func (s *subscriptionConn) active(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.subscriptions[id]
+	return ok
+}
+
+func (s *subscriptionConn) write(msg wsMessage) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.WriteJSON(msg)
+}
+
+func (s *subscriptionConn) close(code int, reason string) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	_ = s.conn.Close()
+}
+
+func (s *subscriptionConn) ip() string {
+	ip, _ := s.conn.Locals("graphql_ip").(string)
+	return ip
+}
+
+func (s *subscriptionConn) userAgent() string {
+	userAgent, _ := s.conn.Locals("graphql_user_agent").(string)
+	return userAgent
+}
+
+func mustMarshal(v interface{}) json.RawMessage {
+	data, _ := json.Marshal(v)
+	return data
+}
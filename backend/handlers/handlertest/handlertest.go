@@ -0,0 +1,353 @@
+// Package handlertest wires the real services over the in-memory repositories
+// so the handler packages can drive their routes through app.Test without
+// PostgreSQL or Redis.
+package handlertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/cache"
+	"zplus_web/backend/config"
+	"zplus_web/backend/events"
+	"zplus_web/backend/mailer"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/models"
+	"zplus_web/backend/oauth"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/repository/memory"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+	"zplus_web/backend/utils"
+)
+
+// Password is the password of every user made by CreateUser
+const Password = "Tr0ub4dor&3-horse"
+
+// AppURL is the frontend address used in emailed links
+const AppURL = "http://app.test"
+
+func init() {
+	err := utils.SetSigningKeys("test", []*utils.SigningKey{
+		utils.NewHMACKey("test", []byte("handlertest-signing-secret-0123456789"), time.Time{}),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// The cheapest bcrypt cost keeps the suites fast
+	cfg := config.Config{
+		PasswordHash:        utils.PasswordHashBcrypt,
+		PasswordBcryptCost:  4,
+		PasswordMinLength:   8,
+		PasswordMinClasses:  3,
+		PasswordCheckCommon: true,
+		PasswordHistory:     5,
+	}
+	if err := utils.ConfigurePasswords(&cfg); err != nil {
+		panic(err)
+	}
+}
+
+// Env holds the services of one test, sharing a fresh in-memory store
+type Env struct {
+	Store *memory.Store
+	Cache *cache.Cache
+	Bus   *events.Bus
+	Mail  *Outbox
+
+	Users              *services.UserService
+	Sessions           *services.SessionService
+	PasswordResets     *services.PasswordResetService
+	EmailVerifications *services.EmailVerificationService
+	MagicLinks         *services.MagicLinkService
+	Invitations        *services.InvitationService
+	Settings           *services.SettingsService
+	LoginGuard         *services.LoginGuard
+	OAuth              *services.OAuthService
+	MFA                *services.MFAService
+	Roles              *services.RoleService
+	Audit              *services.AuditService
+	APIKeys            *services.APIKeyService
+	Privacy            *services.PrivacyService
+	Blog               *services.BlogService
+	Projects           *services.ProjectService
+	Payments           *services.PaymentService
+	Products           *services.ProductService
+	Orders             *services.OrderService
+	WordPress          *services.WordPressService
+
+	users int
+}
+
+// New builds the services like main does. providers configures social login.
+func New(t testing.TB, providers ...config.OAuthProvider) *Env {
+	t.Helper()
+
+	store := memory.NewStore()
+	appCache := cache.New(nil)
+	bus := events.NewBus(nil)
+	mail := &Outbox{}
+	emailLimiter := ratelimit.NewLimiter(appCache, time.Minute, 5, time.Hour)
+
+	registry, err := oauth.NewRegistry(providers, nil)
+	if err != nil {
+		t.Fatalf("failed to configure login providers: %v", err)
+	}
+
+	e := &Env{Store: store, Cache: appCache, Bus: bus, Mail: mail}
+	e.Users = services.NewUserService(store, bus)
+	e.Sessions = services.NewSessionService(store, e.Users, appCache)
+	e.PasswordResets = services.NewPasswordResetService(store, e.Users, e.Sessions, mail, emailLimiter, AppURL)
+	e.EmailVerifications = services.NewEmailVerificationService(store, e.Users, mail, emailLimiter, AppURL)
+	e.MagicLinks = services.NewMagicLinkService(store, e.Users, mail, emailLimiter, AppURL)
+	e.Invitations = services.NewInvitationService(store, mail, AppURL)
+	e.Settings = services.NewSettingsService(store)
+	e.LoginGuard = services.NewLoginGuard(store, appCache)
+	e.OAuth = services.NewOAuthService(store, e.Users, registry, appCache, AppURL)
+	e.Roles = services.NewRoleService(store, appCache)
+	e.MFA = services.NewMFAService(store, e.Settings, e.Roles, ratelimit.NewLimiter(appCache, 0, 5, 5*time.Minute), "ZPlus Test")
+	e.Audit = services.NewAuditService(store)
+	e.APIKeys = services.NewAPIKeyService(store, e.Roles)
+	e.Privacy = services.NewPrivacyService(store, e.Sessions)
+	e.Blog = services.NewBlogService(store)
+	e.Projects = services.NewProjectService(store)
+	e.Payments = services.NewPaymentService(store, bus)
+	e.Products = services.NewProductService(store)
+	e.Orders = services.NewOrderService(store)
+	e.WordPress = services.NewWordPressService(store, bus)
+	return e
+}
+
+// App mounts the modules on a new Fiber app behind the same guards as main
+func (e *Env) App(modules ...routes.Module) *fiber.App {
+	return e.Mount(e.Registry(), modules...)
+}
+
+// Registry returns an empty route registry with the same guards as main, for
+// modules such as the API documentation that need it before mounting
+func (e *Env) Registry() *routes.Registry {
+	return routes.NewRegistry("/api/v1", routes.Guards{
+		Authenticated: []fiber.Handler{middleware.AuthRequired(e.Sessions, e.APIKeys)},
+		Permission: func(permission string) fiber.Handler {
+			return middleware.RequirePermission(e.Roles, permission)
+		},
+		Deadline: middleware.Deadline,
+		Timeout:  5 * time.Second,
+	})
+}
+
+// Mount registers the modules with registry and mounts it on a new Fiber app
+func (e *Env) Mount(registry *routes.Registry, modules ...routes.Module) *fiber.App {
+	registry.Register(modules...)
+
+	// Fiber reuses the buffers behind c.Params between requests; PostgreSQL
+	// copies them on write but the in-memory store would keep the reference
+	app := fiber.New(fiber.Config{
+		Immutable:             true,
+		DisableStartupMessage: true,
+		ErrorHandler:          middleware.ErrorHandler(true),
+	})
+	registry.Mount(app)
+	return app
+}
+
+// CreateUser stores an active user with a verified email, the given role and Password
+func (e *Env) CreateUser(t testing.TB, role string) *models.User {
+	t.Helper()
+
+	e.users++
+	hash, err := utils.HashPassword(Password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fullName := fmt.Sprintf("Test User %d", e.users)
+	user, err := e.Store.Users().Create(t.Context(), repository.NewUser{
+		Username:      fmt.Sprintf("user%d", e.users),
+		Email:         fmt.Sprintf("user%d@example.com", e.users),
+		PasswordHash:  hash,
+		FullName:      &fullName,
+		Role:          role,
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return user
+}
+
+// CreateRole stores a custom role with the given permissions
+func (e *Env) CreateRole(t testing.TB, name string, permissions ...string) {
+	t.Helper()
+
+	// Straight to the store, as roles are otherwise limited to the creator's permissions
+	id, err := e.Store.Roles().Create(t.Context(), name, nil)
+	if err != nil {
+		t.Fatalf("failed to create role: %v", err)
+	}
+	if err := e.Store.Roles().SetPermissions(t.Context(), id, permissions); err != nil {
+		t.Fatalf("failed to set role permissions: %v", err)
+	}
+}
+
+// Login starts a session for the user and returns its access token
+func (e *Env) Login(t testing.TB, user *models.User) string {
+	t.Helper()
+
+	tokens, err := e.Sessions.StartSession(t.Context(), user, "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("failed to start session: %v", err)
+	}
+	return tokens.AccessToken
+}
+
+// Actor is the audit actor of a user acting outside a request
+func Actor(user *models.User) models.AuditActor {
+	return models.AuditActor{UserID: &user.ID, Email: user.Email}
+}
+
+// Response is a recorded response. The models.ApiResponse fields are decoded
+// when the body is JSON, with Data kept raw for Decode.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+
+	Success bool             `json:"success"`
+	Message string           `json:"message"`
+	Data    json.RawMessage  `json:"data"`
+	Error   *models.ApiError `json:"error"`
+}
+
+// Decode unmarshals the response data into v
+func (r *Response) Decode(t testing.TB, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(r.Data, v); err != nil {
+		t.Fatalf("failed to decode data %s: %v", r.Data, err)
+	}
+}
+
+// Expect fails the test unless the response has the status and, for errors, the code
+func (r *Response) Expect(t testing.TB, status int, code string) *Response {
+	t.Helper()
+	if r.Status != status {
+		t.Fatalf("status = %d, want %d, body: %s", r.Status, status, r.Body)
+	}
+	if code != "" && (r.Error == nil || r.Error.Code != code) {
+		t.Fatalf("error code = %v, want %s, body: %s", r.Error, code, r.Body)
+	}
+	return r
+}
+
+// Do sends a request with an optional JSON body and bearer token
+func Do(t testing.TB, app *fiber.App, method, path string, body interface{}, token string) *Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		raw, ok := body.([]byte)
+		if !ok {
+			var err error
+			if raw, err = json.Marshal(body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+	return Send(t, app, req)
+}
+
+// Send runs a prepared request through the app and records the response
+func Send(t testing.TB, app *fiber.App, req *http.Request) *Response {
+	t.Helper()
+
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("%s %s: %v", req.Method, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Response{Status: resp.StatusCode, Header: resp.Header, Body: body}
+	if len(body) > 0 && body[0] == '{' {
+		json.Unmarshal(body, r)
+	}
+	return r
+}
+
+// Outbox is a mailer that keeps every message
+type Outbox struct {
+	// Err, when set, is returned by Send instead of keeping the message
+	Err error
+
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (o *Outbox) Send(msg mailer.Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.Err != nil {
+		return o.Err
+	}
+	o.messages = append(o.messages, msg)
+	return nil
+}
+
+// Messages returns the messages sent to an address, oldest first
+func (o *Outbox) Messages(to string) []mailer.Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var messages []mailer.Message
+	for _, msg := range o.messages {
+		if msg.To == to {
+			messages = append(messages, msg)
+		}
+	}
+	return messages
+}
+
+var linkToken = regexp.MustCompile(`[?&]token=([A-Za-z0-9_-]+)`)
+
+// Token returns the token of the link in the last message sent to an address.
+// Some mail is sent in the background, so it waits a moment for the first one.
+func (o *Outbox) Token(t testing.TB, to string) string {
+	t.Helper()
+
+	messages := o.Messages(to)
+	for deadline := time.Now().Add(2 * time.Second); len(messages) == 0 && time.Now().Before(deadline); {
+		time.Sleep(5 * time.Millisecond)
+		messages = o.Messages(to)
+	}
+	if len(messages) == 0 {
+		t.Fatalf("no email was sent to %s", to)
+	}
+	match := linkToken.FindStringSubmatch(messages[len(messages)-1].Body)
+	if match == nil {
+		t.Fatalf("no link token in the email to %s: %s", to, messages[len(messages)-1].Body)
+	}
+	return match[1]
+}
@@ -0,0 +1,167 @@
+package invitation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+)
+
+type InvitationHandler struct {
+	invitationService *services.InvitationService
+	roleService       *services.RoleService
+	validator         *validator.Validate
+}
+
+func NewInvitationHandler(invitationService *services.InvitationService, roleService *services.RoleService) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+		roleService:       roleService,
+		validator:         validator.New(),
+	}
+}
+
+// RegisterRoutes mounts the staff invitation endpoints
+func (h *InvitationHandler) RegisterRoutes(r *routes.Registry) {
+	r.Admin(rbac.UsersWrite, fiber.MethodGet, "/invitations", h.GetInvitations).
+		Describe("Get pending invitations", nil, []models.Invitation{})
+	r.Admin(rbac.UsersWrite, fiber.MethodPost, "/invitations", h.CreateInvitation).
+		Describe("Invite a staff member with a role", models.CreateInvitationRequest{}, models.Invitation{})
+	r.Admin(rbac.UsersWrite, fiber.MethodPost, "/invitations/:id/resend", h.ResendInvitation).
+		Describe("Email a new link for a pending invitation", nil, models.Invitation{})
+	r.Admin(rbac.UsersWrite, fiber.MethodDelete, "/invitations/:id", h.RevokeInvitation).
+		Describe("Revoke a pending invitation", nil, nil)
+
+	r.Public(fiber.MethodPost, "/auth/invitations/accept", h.AcceptInvitation).
+		Describe("Create the invited account with the invitee's own username and password", models.AcceptInvitationRequest{}, models.User{})
+}
+
+// GET /admin/invitations - Get pending invitations
+func (h *InvitationHandler) GetInvitations(c *fiber.Ctx) error {
+	invitations, err := h.invitationService.GetPendingInvitations(c.UserContext())
+	if err != nil {
+		return apperr.Internal("Failed to get invitations", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Invitations retrieved successfully",
+		Data:    invitations,
+	})
+}
+
+// POST /admin/invitations - Invite a staff member with a role
+func (h *InvitationHandler) CreateInvitation(c *fiber.Ctx) error {
+	var req models.CreateInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	exists, err := h.roleService.RoleExists(c.UserContext(), req.Role)
+	if err != nil {
+		return apperr.Internal("Failed to create invitation", err)
+	}
+	if !exists {
+		return apperr.Validation("Validation failed", nil).WithDetails("Role " + req.Role + " does not exist")
+	}
+
+	// Inviting someone into a role with permissions is the same privilege as assigning it
+	permissions, err := h.roleService.RolePermissions(c.UserContext(), req.Role)
+	if err != nil {
+		return apperr.Internal("Failed to create invitation", err)
+	}
+	if len(permissions) > 0 && !middleware.HasPermission(c, rbac.RolesManage) {
+		return apperr.Forbidden("Permission denied").WithDetails("Missing permission " + rbac.RolesManage)
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(c.UserContext(), req, middleware.GetAuditActor(c))
+	if err != nil {
+		return h.invitationError(err, "Failed to create invitation", invitation)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Invitation sent successfully",
+		Data:    invitation,
+	})
+}
+
+// POST /admin/invitations/:id/resend - Email a new link for a pending invitation
+func (h *InvitationHandler) ResendInvitation(c *fiber.Ctx) error {
+	invitationID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid invitation ID", nil).WithDetails("Invitation ID must be a valid integer")
+	}
+
+	invitation, err := h.invitationService.ResendInvitation(c.UserContext(), invitationID, middleware.GetAuditActor(c))
+	if err != nil {
+		return h.invitationError(err, "Failed to resend invitation", invitation)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Invitation resent successfully",
+		Data:    invitation,
+	})
+}
+
+// DELETE /admin/invitations/:id - Revoke a pending invitation
+func (h *InvitationHandler) RevokeInvitation(c *fiber.Ctx) error {
+	invitationID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid invitation ID", nil).WithDetails("Invitation ID must be a valid integer")
+	}
+
+	if err := h.invitationService.RevokeInvitation(c.UserContext(), invitationID, middleware.GetAuditActor(c)); err != nil {
+		return h.invitationError(err, "Failed to revoke invitation", nil)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Invitation revoked successfully",
+	})
+}
+
+// POST /auth/invitations/accept - Create the invited account with the invitee's own username and password
+func (h *InvitationHandler) AcceptInvitation(c *fiber.Ctx) error {
+	var req models.AcceptInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	user, err := h.invitationService.AcceptInvitation(c.UserContext(), req, middleware.GetAuditActor(c))
+	if err != nil {
+		return h.invitationError(err, "Failed to accept invitation", nil)
+	}
+
+	// No session is started here so staff roles go through the normal login and 2FA enrollment
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Invitation accepted, you can now log in",
+		Data:    user,
+	})
+}
+
+// invitationError passes InvitationService errors on. When the invitation
+// was saved but its email failed, the invitation is still returned.
+func (h *InvitationHandler) invitationError(err error, message string, invitation *models.Invitation) error {
+	appErr := apperr.Internal(message, err)
+	if invitation != nil {
+		return appErr.WithData(invitation)
+	}
+	return appErr
+}
@@ -0,0 +1,119 @@
+package invitation_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/invitation"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(invitation.NewInvitationHandler(env.Invitations, env.Roles))
+	return env, app
+}
+
+func invitationPath(invite models.Invitation, suffix string) string {
+	return "/api/v1/admin/invitations/" + strconv.Itoa(invite.ID) + suffix
+}
+
+func TestInvitations(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	token := env.Login(t, adminUser)
+	req := models.CreateInvitationRequest{Email: "new.editor@example.com", Role: "editor", FullName: "New Editor"}
+
+	var invite models.Invitation
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &invite)
+	if invite.Email != req.Email || invite.Role != "editor" {
+		t.Errorf("invitation = %+v", invite)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: adminUser.Email, Role: "editor"}, token).
+		Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: "x@example.com", Role: "pirate"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: "not-an-email", Role: "user"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var invitations []models.Invitation
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/invitations", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &invitations)
+	if len(invitations) != 1 {
+		t.Errorf("got %d invitations, want 1", len(invitations))
+	}
+
+	first := env.Mail.Token(t, req.Email)
+	handlertest.Do(t, app, fiber.MethodPost, invitationPath(invite, "/resend"), nil, token).Expect(t, fiber.StatusOK, "")
+	if second := env.Mail.Token(t, req.Email); second == first {
+		t.Errorf("resending kept the old token")
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations/999/resend", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	handlertest.Do(t, app, fiber.MethodDelete, invitationPath(invite, ""), nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, invitationPath(invite, ""), nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/admin/invitations/abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestInvitingStaffNeedsRolesPermission(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	if _, err := env.Roles.CreateRole(t.Context(), models.RoleRequest{Name: "recruiter", Permissions: []string{rbac.UsersWrite}}, handlertest.Actor(adminUser)); err != nil {
+		t.Fatal(err)
+	}
+	token := env.Login(t, env.CreateUser(t, "recruiter"))
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: "staff@example.com", Role: "support"}, token).
+		Expect(t, fiber.StatusForbidden, "PERMISSION_DENIED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: "customer@example.com", Role: "user"}, token).
+		Expect(t, fiber.StatusOK, "")
+}
+
+func TestInvitationEmailFailure(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "admin"))
+	env.Mail.Err = errors.New("smtp unavailable")
+
+	var invite models.Invitation
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/invitations", models.CreateInvitationRequest{Email: "late@example.com", Role: "user"}, token).
+		Expect(t, fiber.StatusBadGateway, "EMAIL_FAILED").Decode(t, &invite)
+	if invite.ID == 0 {
+		t.Fatalf("the saved invitation was not returned")
+	}
+
+	env.Mail.Err = nil
+	handlertest.Do(t, app, fiber.MethodPost, invitationPath(invite, "/resend"), nil, token).Expect(t, fiber.StatusOK, "")
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	invite, err := env.Invitations.CreateInvitation(t.Context(), models.CreateInvitationRequest{Email: "invitee@example.com", Role: "support"}, handlertest.Actor(adminUser))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := env.Mail.Token(t, invite.Email)
+
+	// A rejected password leaves the link usable
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/invitations/accept", models.AcceptInvitationRequest{Token: token, Username: "invitee", Password: "password"}, "").
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/invitations/accept", models.AcceptInvitationRequest{Token: token, Username: adminUser.Username, Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+
+	var user models.User
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/invitations/accept", models.AcceptInvitationRequest{Token: token, Username: "invitee", Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusOK, "").Decode(t, &user)
+	if user.Email != invite.Email || user.Role != "support" || !user.EmailVerified {
+		t.Errorf("accepted user = %+v", user)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/invitations/accept", models.AcceptInvitationRequest{Token: token, Username: "again", Password: handlertest.Password}, "").
+		Expect(t, fiber.StatusBadRequest, "INVALID_TOKEN")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/invitations/accept", models.AcceptInvitationRequest{Token: token}, "").
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
@@ -0,0 +1,84 @@
+// Package login finishes every way of logging in, so a password, a login link,
+// a social account and a second factor all pass the same account checks and
+// get the same response.
+package login
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/services"
+	"zplus_web/backend/utils"
+)
+
+// Begin answers a login whose first factor was accepted. Accounts that need
+// two-factor authentication only get a short-lived mfa_token to continue at
+// /auth/2fa/login, every other account gets a session right away.
+func Begin(c *fiber.Ctx, sessionService *services.SessionService, mfaService *services.MFAService, user *models.User) (*models.LoginResponse, error) {
+	if !user.IsActive {
+		return nil, errDeactivated
+	}
+
+	mfaStatus, err := mfaService.Status(c.UserContext(), user)
+	if err != nil {
+		return nil, apperr.Internal("Failed to check two-factor authentication", err)
+	}
+	if !mfaStatus.Required {
+		return Finish(c, sessionService, user)
+	}
+
+	mfaToken, err := utils.GenerateMFAPendingToken(user.ID)
+	if err != nil {
+		return nil, apperr.Internal("Failed to generate token", err)
+	}
+
+	return &models.LoginResponse{
+		MFARequired:        true,
+		MFAToken:           mfaToken,
+		EnrollmentRequired: !mfaStatus.Enabled,
+		ExpiresIn:          int(utils.MFAPendingTokenTTL.Seconds()),
+	}, nil
+}
+
+// Finish starts a session for a user who passed every factor their account needs
+func Finish(c *fiber.Ctx, sessionService *services.SessionService, user *models.User) (*models.LoginResponse, error) {
+	if !user.IsActive {
+		return nil, errDeactivated
+	}
+
+	// Start a session and issue the access/refresh token pair
+	tokens, err := sessionService.StartSession(c.UserContext(), user, c.Get(fiber.HeaderUserAgent), c.IP())
+	if err != nil {
+		return nil, apperr.Internal("Failed to generate token", err)
+	}
+
+	return &models.LoginResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+		User: &models.LoginUser{
+			ID:       user.ID,
+			Username: user.Username,
+			Email:    user.Email,
+			Role:     user.Role,
+			FullName: user.FullName,
+		},
+	}, nil
+}
+
+// Respond sends resp with message, or with a prompt for the second factor
+// when the login is not finished yet
+func Respond(c *fiber.Ctx, resp *models.LoginResponse, message string) error {
+	if resp.MFARequired {
+		message = "Two-factor authentication required"
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: message,
+		Data:    resp,
+	})
+}
+
+var errDeactivated = apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid credentials").WithDetails("User account is deactivated")
@@ -0,0 +1,268 @@
+package mfa
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/login"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+)
+
+type MFAHandler struct {
+	userService    *services.UserService
+	sessionService *services.SessionService
+	mfaService     *services.MFAService
+	auditService   *services.AuditService
+	validator      *validator.Validate
+}
+
+func NewMFAHandler(userService *services.UserService, sessionService *services.SessionService, mfaService *services.MFAService, auditService *services.AuditService) *MFAHandler {
+	return &MFAHandler{
+		userService:    userService,
+		sessionService: sessionService,
+		mfaService:     mfaService,
+		auditService:   auditService,
+		validator:      validator.New(),
+	}
+}
+
+// RegisterRoutes mounts the two-factor login step and the 2FA management endpoints.
+// The /auth/2fa/login routes take the mfa_token returned by a password login instead of an access token.
+func (h *MFAHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodPost, "/auth/2fa/login", middleware.MFAPendingRequired(), h.Login).
+		Describe("Complete a login with a TOTP or recovery code", models.MFACodeRequest{}, models.LoginResponse{})
+	r.Public(fiber.MethodPost, "/auth/2fa/login/setup", middleware.MFAPendingRequired(), h.Setup).
+		Describe("Generate a TOTP secret during a login where 2FA is enforced", nil, models.MFAEnrollment{})
+	r.Public(fiber.MethodPost, "/auth/2fa/login/enable", middleware.MFAPendingRequired(), h.EnableAndLogin).
+		Describe("Enable 2FA during a login where it is enforced, then log in", models.MFACodeRequest{}, models.LoginResponse{})
+
+	r.Authenticated(fiber.MethodGet, "/auth/2fa", h.GetStatus).
+		Describe("Get the current user's 2FA status", nil, models.MFAStatus{})
+	r.Authenticated(fiber.MethodPost, "/auth/2fa/setup", middleware.NoImpersonation(), h.Setup).
+		Describe("Generate a TOTP secret and provisioning URI", nil, models.MFAEnrollment{})
+	r.Authenticated(fiber.MethodPost, "/auth/2fa/enable", middleware.NoImpersonation(), h.Enable).
+		Describe("Confirm the TOTP secret and enable 2FA", models.MFACodeRequest{}, fiber.Map{"recovery_codes": []string{}})
+	r.Authenticated(fiber.MethodPost, "/auth/2fa/disable", middleware.NoImpersonation(), h.Disable).
+		Describe("Disable 2FA after checking a current code", models.MFACodeRequest{}, nil)
+	r.Authenticated(fiber.MethodPost, "/auth/2fa/recovery-codes", middleware.NoImpersonation(), h.RegenerateRecoveryCodes).
+		Describe("Replace the recovery codes after checking a current code", models.MFACodeRequest{}, fiber.Map{"recovery_codes": []string{}})
+}
+
+// POST /auth/2fa/login - Complete a login with a TOTP or recovery code
+func (h *MFAHandler) Login(c *fiber.Ctx) error {
+	req, err := h.parseCode(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.pendingUser(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := h.mfaService.Status(c.UserContext(), user)
+	if err != nil {
+		return apperr.Internal("Failed to get two-factor status", err)
+	}
+
+	if !status.Enabled {
+		return apperr.New(fiber.StatusForbidden, apperr.CodeMFAEnrollmentRequired, "Two-factor enrollment required").WithDetails("Set up two-factor authentication to finish logging in")
+	}
+
+	if err := h.mfaService.Verify(c.UserContext(), user.ID, req.Code); err != nil {
+		return h.mfaError(err)
+	}
+
+	return h.startSession(c, user, nil)
+}
+
+// POST /auth/2fa/setup - Generate a TOTP secret and provisioning URI
+func (h *MFAHandler) Setup(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
+	if err != nil {
+		return apperr.NotFound("User not found").Wrap(err)
+	}
+
+	enrollment, err := h.mfaService.BeginEnrollment(c.UserContext(), user)
+	if err != nil {
+		return h.mfaError(err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Scan the provisioning URI with an authenticator app, then confirm a code",
+		Data:    enrollment,
+	})
+}
+
+// POST /auth/2fa/enable - Confirm the TOTP secret and enable 2FA
+func (h *MFAHandler) Enable(c *fiber.Ctx) error {
+	req, err := h.parseCode(c)
+	if err != nil {
+		return err
+	}
+
+	userID := c.Locals("user_id").(int)
+	codes, err := h.mfaService.ConfirmEnrollment(c.UserContext(), userID, req.Code)
+	if err != nil {
+		return h.mfaError(err)
+	}
+
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditUserMFAEnable, "user", strconv.Itoa(userID), nil, nil)
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Two-factor authentication enabled. Store the recovery codes somewhere safe, they are shown only once",
+		Data: map[string]interface{}{
+			"recovery_codes": codes,
+		},
+	})
+}
+
+// POST /auth/2fa/login/enable - Enable 2FA during a login where it is enforced, then log in
+func (h *MFAHandler) EnableAndLogin(c *fiber.Ctx) error {
+	req, err := h.parseCode(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.pendingUser(c)
+	if err != nil {
+		return err
+	}
+
+	codes, err := h.mfaService.ConfirmEnrollment(c.UserContext(), user.ID, req.Code)
+	if err != nil {
+		return h.mfaError(err)
+	}
+
+	actor := middleware.GetAuditActor(c)
+	actor.UserID, actor.Email = &user.ID, user.Email
+	h.auditService.Record(c.UserContext(), actor, services.AuditUserMFAEnable, "user", strconv.Itoa(user.ID), nil, nil)
+
+	return h.startSession(c, user, codes)
+}
+
+// GET /auth/2fa - Get the current user's 2FA status
+func (h *MFAHandler) GetStatus(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
+	if err != nil {
+		return apperr.NotFound("User not found").Wrap(err)
+	}
+
+	status, err := h.mfaService.Status(c.UserContext(), user)
+	if err != nil {
+		return apperr.Internal("Failed to get two-factor status", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Two-factor status retrieved successfully",
+		Data:    status,
+	})
+}
+
+// POST /auth/2fa/disable - Disable 2FA after checking a current code
+func (h *MFAHandler) Disable(c *fiber.Ctx) error {
+	req, err := h.parseCode(c)
+	if err != nil {
+		return err
+	}
+
+	userID := c.Locals("user_id").(int)
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
+	if err != nil {
+		return apperr.NotFound("User not found").Wrap(err)
+	}
+
+	if err := h.mfaService.Disable(c.UserContext(), user, req.Code); err != nil {
+		return h.mfaError(err)
+	}
+
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditUserMFADisable, "user", strconv.Itoa(userID), nil, nil)
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Two-factor authentication disabled",
+	})
+}
+
+// POST /auth/2fa/recovery-codes - Replace the recovery codes after checking a current code
+func (h *MFAHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	req, err := h.parseCode(c)
+	if err != nil {
+		return err
+	}
+
+	userID := c.Locals("user_id").(int)
+	codes, err := h.mfaService.RegenerateRecoveryCodes(c.UserContext(), userID, req.Code)
+	if err != nil {
+		return h.mfaError(err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Recovery codes regenerated, the previous codes no longer work",
+		Data: map[string]interface{}{
+			"recovery_codes": codes,
+		},
+	})
+}
+
+// parseCode parses and validates an MFACodeRequest
+func (h *MFAHandler) parseCode(c *fiber.Ctx) (*models.MFACodeRequest, error) {
+	var req models.MFACodeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return nil, apperr.Invalid("Invalid request body", err)
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		return nil, apperr.Invalid("Validation failed", err)
+	}
+
+	return &req, nil
+}
+
+// pendingUser loads the active user behind an mfa_token
+func (h *MFAHandler) pendingUser(c *fiber.Ctx) (*models.User, error) {
+	userID := c.Locals("user_id").(int)
+
+	user, err := h.userService.GetUserByID(c.UserContext(), userID)
+	if err != nil || !user.IsActive {
+		return nil, apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid credentials").WithDetails("User not found or deactivated")
+	}
+
+	return user, nil
+}
+
+// startSession finishes a two-factor login with the same response as a password-only login
+func (h *MFAHandler) startSession(c *fiber.Ctx, user *models.User, recoveryCodes []string) error {
+	resp, err := login.Finish(c, h.sessionService, user)
+	if err != nil {
+		return err
+	}
+	resp.RecoveryCodes = recoveryCodes
+
+	return login.Respond(c, resp, "Login successful")
+}
+
+// mfaError passes MFAService errors on, naming the limit when too many codes were tried
+func (h *MFAHandler) mfaError(err error) error {
+	var limited *ratelimit.LimitedError
+	if errors.As(err, &limited) {
+		return apperr.RateLimited("Too many two-factor attempts").Wrap(err)
+	}
+
+	return apperr.Internal("Two-factor operation failed", err)
+}
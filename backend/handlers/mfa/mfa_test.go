@@ -0,0 +1,162 @@
+package mfa_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/mfa"
+	"zplus_web/backend/models"
+	"zplus_web/backend/utils"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(mfa.NewMFAHandler(env.Users, env.Sessions, env.MFA, env.Audit))
+	return env, app
+}
+
+// totp returns the code for the time step offset from now. Each code is
+// accepted once, so consecutive steps keep a test's codes usable.
+func totp(t *testing.T, secret string, offset int64) models.MFACodeRequest {
+	t.Helper()
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(time.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return models.MFACodeRequest{Code: code}
+}
+
+// enroll turns on 2FA for the user and returns the secret and recovery codes
+func enroll(t *testing.T, env *handlertest.Env, user *models.User) (string, []string) {
+	t.Helper()
+	enrollment, err := env.MFA.BeginEnrollment(t.Context(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := env.MFA.ConfirmEnrollment(t.Context(), user.ID, totp(t, enrollment.Secret, -1).Code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enrollment.Secret, codes
+}
+
+func pendingToken(t *testing.T, user *models.User) string {
+	t.Helper()
+	token, err := utils.GenerateMFAPendingToken(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+type recoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func TestEnrollment(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "user"))
+
+	var status models.MFAStatus
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/2fa", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &status)
+	if status.Enabled || status.Required {
+		t.Errorf("status before enrollment = %+v", status)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/enable", models.MFACodeRequest{Code: "123456"}, token).Expect(t, fiber.StatusBadRequest, "MFA_NOT_ENABLED")
+
+	var enrollment models.MFAEnrollment
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/setup", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &enrollment)
+	if enrollment.Secret == "" || enrollment.ProvisioningURI == "" {
+		t.Fatalf("enrollment = %+v", enrollment)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/enable", models.MFACodeRequest{Code: "000000"}, token).Expect(t, fiber.StatusUnauthorized, "MFA_INVALID")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/enable", models.MFACodeRequest{}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var codes recoveryCodes
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/enable", totp(t, enrollment.Secret, -1), token).Expect(t, fiber.StatusOK, "").Decode(t, &codes)
+	if len(codes.RecoveryCodes) == 0 {
+		t.Fatalf("no recovery codes were returned")
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/setup", nil, token).Expect(t, fiber.StatusBadRequest, "MFA_ALREADY_ENABLED")
+
+	var regenerated recoveryCodes
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/recovery-codes", totp(t, enrollment.Secret, 0), token).Expect(t, fiber.StatusOK, "").Decode(t, &regenerated)
+	if len(regenerated.RecoveryCodes) == 0 || regenerated.RecoveryCodes[0] == codes.RecoveryCodes[0] {
+		t.Errorf("recovery codes were not replaced")
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/disable", models.MFACodeRequest{Code: regenerated.RecoveryCodes[0]}, token).Expect(t, fiber.StatusOK, "")
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/2fa", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &status)
+	if status.Enabled {
+		t.Errorf("2FA is still enabled")
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/disable", totp(t, enrollment.Secret, 1), token).Expect(t, fiber.StatusBadRequest, "MFA_NOT_ENABLED")
+}
+
+func TestLogin(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	secret, recovery := enroll(t, env, user)
+	pending := pendingToken(t, user)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", totp(t, secret, 0), "").Expect(t, fiber.StatusUnauthorized, "AUTH_REQUIRED")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", totp(t, secret, 0), env.Login(t, user)).Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+
+	var resp models.LoginResponse
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", totp(t, secret, 0), pending).Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	if resp.Token == "" || resp.User == nil || resp.User.ID != user.ID {
+		t.Errorf("login response = %+v", resp)
+	}
+
+	// A code is accepted once, a recovery code also once
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", totp(t, secret, 0), pending).Expect(t, fiber.StatusUnauthorized, "MFA_INVALID")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", models.MFACodeRequest{Code: recovery[0]}, pending).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", models.MFACodeRequest{Code: recovery[0]}, pending).Expect(t, fiber.StatusUnauthorized, "MFA_INVALID")
+
+	// The limiter allows five attempts, counting the one that enabled 2FA
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", models.MFACodeRequest{Code: "000000"}, pending).
+		Expect(t, fiber.StatusTooManyRequests, "RATE_LIMITED")
+}
+
+func TestEnforcedEnrollment(t *testing.T) {
+	env, app := newApp(t)
+	adminUser := env.CreateUser(t, "admin")
+	if _, err := env.Settings.UpdateSecuritySettings(t.Context(), models.SecuritySettings{RequireAdmin2FA: true}); err != nil {
+		t.Fatal(err)
+	}
+	pending := pendingToken(t, adminUser)
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login", models.MFACodeRequest{Code: "123456"}, pending).Expect(t, fiber.StatusForbidden, "MFA_ENROLLMENT_REQUIRED")
+
+	var enrollment models.MFAEnrollment
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login/setup", nil, pending).Expect(t, fiber.StatusOK, "").Decode(t, &enrollment)
+
+	var resp struct {
+		models.LoginResponse
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/login/enable", totp(t, enrollment.Secret, -1), pending).Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	if resp.Token == "" || len(resp.RecoveryCodes) == 0 {
+		t.Fatalf("enforced enrollment response = %+v", resp)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/disable", totp(t, enrollment.Secret, 0), resp.Token).Expect(t, fiber.StatusForbidden, "MFA_REQUIRED")
+}
+
+func TestImpersonationCannotManage2FA(t *testing.T) {
+	env, app := newApp(t)
+	support := env.CreateUser(t, "support")
+	customer := env.CreateUser(t, "user")
+	tokens, err := env.Sessions.StartImpersonation(t.Context(), customer, handlertest.Actor(support), "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/2fa", nil, tokens.AccessToken).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/2fa/setup", nil, tokens.AccessToken).Expect(t, fiber.StatusForbidden, "")
+}
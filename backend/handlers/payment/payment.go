@@ -1,14 +1,16 @@
 package payment
 
 import (
+	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
 	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
 )
 
@@ -24,6 +26,23 @@ func NewPaymentHandler(paymentService *services.PaymentService) *PaymentHandler
 	}
 }
 
+// RegisterRoutes mounts the wallet and points endpoints. None of them are
+// available to staff logged in as the customer.
+func (h *PaymentHandler) RegisterRoutes(r *routes.Registry) {
+	r.Authenticated(fiber.MethodGet, "/wallet", middleware.NoImpersonation(), h.GetWallet).
+		Describe("Get current user's wallet information", nil, models.CustomerWallet{})
+	r.Authenticated(fiber.MethodGet, "/wallet/transactions", middleware.NoImpersonation(), h.GetWalletTransactions).
+		Describe("Get wallet transaction history", nil, fiber.Map{"transactions": []models.WalletTransaction{}, "pagination": models.Pagination{}})
+	r.Authenticated(fiber.MethodPost, "/wallet/deposit", middleware.NoImpersonation(), middleware.VerifiedEmailRequired(), h.RequestDeposit).
+		Describe("Request wallet deposit", models.DepositRequest{}, fiber.Map{"transaction": models.WalletTransaction{}, "payment_url": "", "redirect_info": ""})
+	r.Authenticated(fiber.MethodGet, "/points", middleware.NoImpersonation(), h.GetPoints).
+		Describe("Get current user's points information", nil, models.CustomerPoints{})
+
+	// HandleDepositCallback is deliberately not mounted. It must check the
+	// gateway's signature against a configured secret and the paid amount
+	// first, or anyone could complete their own pending deposit.
+}
+
 // Wallet Endpoints
 
 // GET /wallet - Get current user's wallet information
@@ -31,26 +50,12 @@ func (h *PaymentHandler) GetWallet(c *fiber.Ctx) error {
 	currentUser := middleware.GetCurrentUser(c)
 	userID, ok := currentUser["id"].(int)
 	if !ok {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user information",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "User ID not found in token",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
 	}
 
-	wallet, err := h.paymentService.GetWallet(userID)
+	wallet, err := h.paymentService.GetWallet(c.UserContext(), userID)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get wallet information",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get wallet information", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -65,14 +70,7 @@ func (h *PaymentHandler) GetWalletTransactions(c *fiber.Ctx) error {
 	currentUser := middleware.GetCurrentUser(c)
 	userID, ok := currentUser["id"].(int)
 	if !ok {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user information",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "User ID not found in token",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
 	}
 
 	// Parse query parameters
@@ -88,16 +86,9 @@ func (h *PaymentHandler) GetWalletTransactions(c *fiber.Ctx) error {
 		limit = 20
 	}
 
-	transactions, total, err := h.paymentService.GetWalletTransactions(userID, page, limit, transactionType)
+	transactions, total, err := h.paymentService.GetWalletTransactions(c.UserContext(), userID, page, limit, transactionType)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get transaction history",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get transaction history", err)
 	}
 
 	// Calculate pagination info
@@ -110,13 +101,13 @@ func (h *PaymentHandler) GetWalletTransactions(c *fiber.Ctx) error {
 		Message: "Transaction history retrieved successfully",
 		Data: map[string]interface{}{
 			"transactions": transactions,
-			"pagination": map[string]interface{}{
-				"current_page":   page,
-				"total_pages":    totalPages,
-				"total_items":    total,
-				"items_per_page": limit,
-				"has_next":       hasNext,
-				"has_prev":       hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -127,56 +118,23 @@ func (h *PaymentHandler) RequestDeposit(c *fiber.Ctx) error {
 	currentUser := middleware.GetCurrentUser(c)
 	userID, ok := currentUser["id"].(int)
 	if !ok {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user information",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "User ID not found in token",
-			},
-		})
-	}
-
-	type DepositRequest struct {
-		Amount        float64 `json:"amount" validate:"required,min=1000"`
-		PaymentMethod string  `json:"payment_method" validate:"required,oneof=vnpay momo zalopay banking"`
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
 	}
 
-	var req DepositRequest
+	var req models.DepositRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create deposit transaction
-	transaction, err := h.paymentService.CreateDepositTransaction(userID, req.Amount, req.PaymentMethod)
+	transaction, err := h.paymentService.CreateDepositTransaction(c.UserContext(), userID, req.Amount, req.PaymentMethod)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create deposit request",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create deposit request", err)
 	}
 
 	// TODO: Integrate with Vietnamese payment gateways
@@ -185,7 +143,7 @@ func (h *PaymentHandler) RequestDeposit(c *fiber.Ctx) error {
 	if transaction.ReferenceID != nil {
 		referenceID = *transaction.ReferenceID
 	}
-	paymentURL := h.generatePaymentURL(req.PaymentMethod, referenceID, req.Amount)
+	paymentURL := h.paymentService.PaymentURL(req.PaymentMethod, referenceID, req.Amount)
 
 	return c.JSON(models.ApiResponse{
 		Success: true,
@@ -198,43 +156,23 @@ func (h *PaymentHandler) RequestDeposit(c *fiber.Ctx) error {
 	})
 }
 
-// POST /wallet/deposit/callback - Handle payment callback (webhook)
+// POST /wallet/deposit/callback - Handle payment callback (webhook).
+// Not mounted until the gateway signature is verified, see RegisterRoutes.
 func (h *PaymentHandler) HandleDepositCallback(c *fiber.Ctx) error {
 	// TODO: Implement proper payment gateway callback handling
 	// This would validate the callback signature and process the payment result
 	
-	type CallbackRequest struct {
-		TransactionID int    `json:"transaction_id"`
-		Status        string `json:"status"`
-		ReferenceID   string `json:"reference_id"`
-		Signature     string `json:"signature"`
-	}
-
-	var req CallbackRequest
+	var req models.DepositCallbackRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid callback data",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid callback data", err)
 	}
 
 	// TODO: Verify signature with payment gateway
 
 	if req.Status == "success" {
-		err := h.paymentService.CompleteDepositTransaction(req.TransactionID)
+		err := h.paymentService.CompleteDepositTransaction(c.UserContext(), req.TransactionID, middleware.GetAuditActor(c))
 		if err != nil {
-			return c.Status(500).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Failed to complete deposit",
-				Error: &models.ApiError{
-					Code:    "INTERNAL_ERROR",
-					Details: err.Error(),
-				},
-			})
+			return apperr.Internal("Failed to complete deposit", err)
 		}
 	}
 
@@ -251,26 +189,12 @@ func (h *PaymentHandler) GetPoints(c *fiber.Ctx) error {
 	currentUser := middleware.GetCurrentUser(c)
 	userID, ok := currentUser["id"].(int)
 	if !ok {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid user information",
-			Error: &models.ApiError{
-				Code:    "AUTH_INVALID",
-				Details: "User ID not found in token",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid user information").WithDetails("User ID not found in token")
 	}
 
-	points, err := h.paymentService.GetUserPoints(userID)
+	points, err := h.paymentService.GetUserPoints(c.UserContext(), userID)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get points information",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get points information", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -282,31 +206,10 @@ func (h *PaymentHandler) GetPoints(c *fiber.Ctx) error {
 
 // Helper methods
 
-func (h *PaymentHandler) generatePaymentURL(method, referenceID string, amount float64) string {
-	// TODO: Implement actual payment gateway URL generation
-	// This is a placeholder that would integrate with Vietnamese payment gateways
-	
-	switch method {
-	case "vnpay":
-		return "https://sandbox.vnpayment.vn/paymentv2/vpcpay.html?vnp_Amount=" + strconv.FormatFloat(amount*100, 'f', 0, 64) + "&vnp_TxnRef=" + referenceID
-	case "momo":
-		return "https://test-payment.momo.vn/pay?amount=" + strconv.FormatFloat(amount, 'f', 0, 64) + "&orderInfo=" + referenceID
-	case "zalopay":
-		return "https://sbgateway.zalopay.vn/api/getlistmerchantbanks?amount=" + strconv.FormatFloat(amount, 'f', 0, 64) + "&orderid=" + referenceID
-	case "banking":
-		return "https://portal.vietcombank.com.vn/Personal/Login?amount=" + strconv.FormatFloat(amount, 'f', 0, 64) + "&ref=" + referenceID
-	default:
-		return "https://payment.zplus.com/deposit?ref=" + referenceID
-	}
-}
-
 // ProcessOrderPayment processes payment for an order
-func (h *PaymentHandler) ProcessOrderPayment(userID int, amount float64, orderID int) (*models.WalletTransaction, error) {
-	transaction, err := h.paymentService.ProcessPayment(userID, amount, "wallet", &orderID)
+func (h *PaymentHandler) ProcessOrderPayment(ctx context.Context, userID int, amount float64, orderID int) (*models.WalletTransaction, error) {
+	transaction, err := h.paymentService.ProcessPayment(ctx, userID, amount, "wallet", &orderID)
 	if err != nil {
-		if strings.Contains(err.Error(), "insufficient") {
-			return nil, fmt.Errorf("insufficient wallet balance")
-		}
 		return nil, fmt.Errorf("payment processing failed: %w", err)
 	}
 
@@ -317,7 +220,7 @@ func (h *PaymentHandler) ProcessOrderPayment(userID int, amount float64, orderID
 		if transaction.ReferenceID != nil {
 			referenceID = *transaction.ReferenceID
 		}
-		h.paymentService.AddPoints(userID, points, "Purchase reward", &referenceID)
+		h.paymentService.AddPoints(ctx, userID, points, "Purchase reward", &referenceID)
 	}
 
 	return transaction, nil
@@ -0,0 +1,147 @@
+package payment_test
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/payment"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *payment.PaymentHandler, *fiber.App) {
+	env := handlertest.New(t)
+	handler := payment.NewPaymentHandler(env.Payments)
+	return env, handler, env.App(handler)
+}
+
+type depositData struct {
+	Transaction models.WalletTransaction `json:"transaction"`
+	PaymentURL  string                   `json:"payment_url"`
+}
+
+func TestDeposit(t *testing.T) {
+	env, _, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+
+	var wallet models.CustomerWallet
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &wallet)
+	if wallet.UserID != user.ID || wallet.Balance != 0 {
+		t.Errorf("new wallet = %+v", wallet)
+	}
+
+	var deposit depositData
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/wallet/deposit", models.DepositRequest{Amount: 50000, PaymentMethod: "momo"}, token).
+		Expect(t, fiber.StatusOK, "").Decode(t, &deposit)
+	if deposit.Transaction.Status != "pending" || deposit.PaymentURL == "" {
+		t.Fatalf("deposit = %+v", deposit)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/wallet/deposit", models.DepositRequest{Amount: 10, PaymentMethod: "momo"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/wallet/deposit", models.DepositRequest{Amount: 50000, PaymentMethod: "cash"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	// The gateway callback stays unmounted until it verifies the gateway's signature
+	callback := models.DepositCallbackRequest{TransactionID: deposit.Transaction.ID, Status: "success"}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/wallet/deposit/callback", callback, "").Expect(t, fiber.StatusNotFound, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &wallet)
+	if wallet.Balance != 0 {
+		t.Errorf("balance after an unsigned callback = %v", wallet.Balance)
+	}
+
+	if err := env.Payments.CompleteDepositTransaction(t.Context(), deposit.Transaction.ID, handlertest.Actor(user)); err != nil {
+		t.Fatal(err)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &wallet)
+	if wallet.Balance != 50000 || wallet.TotalDeposited != 50000 {
+		t.Errorf("wallet after deposit = %+v", wallet)
+	}
+
+	// A deposit must not be credited twice
+	err := env.Payments.CompleteDepositTransaction(t.Context(), deposit.Transaction.ID, handlertest.Actor(user))
+	if apperr.Code(err) != apperr.CodeStateConflict {
+		t.Errorf("completing a deposit twice = %v", err)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &wallet)
+	if wallet.Balance != 50000 {
+		t.Errorf("balance after completing twice = %v", wallet.Balance)
+	}
+}
+
+func TestDepositNeedsVerifiedEmail(t *testing.T) {
+	env, _, app := newApp(t)
+	user, err := env.Store.Users().Create(t.Context(), repository.NewUser{Username: "unverified", Email: "unverified@example.com", PasswordHash: "!", Role: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/wallet/deposit", models.DepositRequest{Amount: 50000, PaymentMethod: "momo"}, env.Login(t, user)).
+		Expect(t, fiber.StatusForbidden, "EMAIL_NOT_VERIFIED")
+}
+
+func TestWalletIsHiddenFromImpersonators(t *testing.T) {
+	env, _, app := newApp(t)
+	customer := env.CreateUser(t, "user")
+	tokens, err := env.Sessions.StartImpersonation(t.Context(), customer, handlertest.Actor(env.CreateUser(t, "support")), "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"/api/v1/wallet", "/api/v1/wallet/transactions", "/api/v1/points"} {
+		handlertest.Do(t, app, fiber.MethodGet, path, nil, tokens.AccessToken).Expect(t, fiber.StatusForbidden, "")
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, "").Expect(t, fiber.StatusUnauthorized, "AUTH_REQUIRED")
+}
+
+func TestOrderPayment(t *testing.T) {
+	env, handler, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+
+	// Viewing them creates the wallet and points account a purchase updates
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet", nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/points", nil, token).Expect(t, fiber.StatusOK, "")
+
+	if _, err := handler.ProcessOrderPayment(t.Context(), user.ID, 20000, 1); apperr.Code(err) != apperr.CodeInsufficientFunds {
+		t.Fatalf("payment from an empty wallet: %v", err)
+	}
+
+	deposit, err := env.Payments.CreateDepositTransaction(t.Context(), user.ID, 50000, "banking")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := env.Payments.CompleteDepositTransaction(t.Context(), deposit.ID, handlertest.Actor(user)); err != nil {
+		t.Fatal(err)
+	}
+
+	transaction, err := handler.ProcessOrderPayment(t.Context(), user.ID, 20000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transaction.BalanceAfter != 30000 {
+		t.Errorf("balance after payment = %v, want 30000", transaction.BalanceAfter)
+	}
+
+	var points models.CustomerPoints
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/points", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &points)
+	if points.AvailablePoints != 20 {
+		t.Errorf("points = %+v, want 20 available", points)
+	}
+
+	var page struct {
+		Transactions []models.WalletTransaction `json:"transactions"`
+		Pagination   models.Pagination          `json:"pagination"`
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet/transactions", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Transactions) != 2 || page.Pagination.TotalItems != 2 {
+		t.Errorf("transactions = %+v", page)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/wallet/transactions?type=purchase", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Transactions) != 1 || page.Transactions[0].Amount != 20000 {
+		t.Errorf("purchases = %+v", page.Transactions)
+	}
+}
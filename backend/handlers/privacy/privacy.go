@@ -0,0 +1,123 @@
+package privacy
+
+import (
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/models"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+)
+
+type PrivacyHandler struct {
+	privacyService *services.PrivacyService
+	userService    *services.UserService
+	validator      *validator.Validate
+}
+
+func NewPrivacyHandler(privacyService *services.PrivacyService, userService *services.UserService) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+		userService:    userService,
+		validator:      validator.New(),
+	}
+}
+
+// RegisterRoutes mounts the personal data export and account erasure endpoints.
+// Staff logged in as the customer cannot use them.
+func (h *PrivacyHandler) RegisterRoutes(r *routes.Registry) {
+	r.Authenticated(fiber.MethodGet, "/account/exports", middleware.NoImpersonation(), h.GetExports).
+		Describe("Get the current user's data exports", nil, []models.DataExport{})
+	r.Authenticated(fiber.MethodPost, "/account/exports", middleware.NoImpersonation(), h.RequestExport).
+		Describe("Start building a ZIP of everything stored about the current user", nil, models.DataExport{})
+	r.Authenticated(fiber.MethodGet, "/account/exports/:id/download", middleware.NoImpersonation(), h.DownloadExport).
+		Describe("Download a completed export", nil, routes.Content{Type: "application/zip"})
+	r.Authenticated(fiber.MethodDelete, "/account", middleware.NoImpersonation(), h.EraseAccount).
+		Describe("Erase the current user's account and personal data", models.EraseAccountRequest{}, nil)
+}
+
+// GET /account/exports - Get the current user's data exports
+func (h *PrivacyHandler) GetExports(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	exports, err := h.privacyService.GetExports(c.UserContext(), userID)
+	if err != nil {
+		return apperr.Internal("Failed to get exports", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Exports retrieved successfully",
+		Data:    exports,
+	})
+}
+
+// POST /account/exports - Start building a ZIP of everything stored about the current user
+func (h *PrivacyHandler) RequestExport(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	export, err := h.privacyService.RequestExport(c.UserContext(), userID)
+	if err != nil {
+		return apperr.Internal("Failed to start export", err)
+	}
+
+	return c.Status(202).JSON(models.ApiResponse{
+		Success: true,
+		Message: "Export started, it can be downloaded once completed",
+		Data:    export,
+	})
+}
+
+// GET /account/exports/:id/download - Download a completed export
+func (h *PrivacyHandler) DownloadExport(c *fiber.Ctx) error {
+	exportID, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("Invalid export ID", nil).WithDetails("Export ID must be a valid integer")
+	}
+
+	userID := c.Locals("user_id").(int)
+	archive, err := h.privacyService.GetExportArchive(c.UserContext(), userID, exportID)
+	if err != nil {
+		return apperr.Internal("Failed to get export", err)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="zplus-data-export-`+strconv.Itoa(exportID)+`.zip"`)
+	return c.Send(archive)
+}
+
+// DELETE /account - Erase the current user's account after confirming the password.
+// Users who signed up with a social login set a password first with a reset.
+func (h *PrivacyHandler) EraseAccount(c *fiber.Ctx) error {
+	var req models.EraseAccountRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	userID := c.Locals("user_id").(int)
+	email, _ := c.Locals("user_email").(string)
+	user, err := h.userService.AuthenticateUser(c.UserContext(), email, req.Password)
+	if err != nil && apperr.Code(err) != apperr.CodeAuthInvalid {
+		return apperr.Internal("Failed to check password", err)
+	}
+	if err != nil || user.ID != userID {
+		return apperr.Unauthorized(apperr.CodeAuthInvalid, "Password is incorrect").WithDetails("Confirm the erasure with your current password")
+	}
+
+	if err := h.privacyService.EraseUser(c.UserContext(), userID, middleware.GetAuditActor(c)); err != nil {
+		return apperr.Internal("Failed to erase account", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Your account and personal data have been erased",
+	})
+}
@@ -0,0 +1,148 @@
+package privacy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/privacy"
+	"zplus_web/backend/models"
+)
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(privacy.NewPrivacyHandler(env.Privacy, env.Users))
+	return env, app
+}
+
+// waitForExport polls the exports until the export is no longer processing
+func waitForExport(t *testing.T, app *fiber.App, token string, id int) models.DataExport {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		var exports []models.DataExport
+		handlertest.Do(t, app, fiber.MethodGet, "/api/v1/account/exports", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &exports)
+		for _, export := range exports {
+			if export.ID == id && export.Status != "processing" {
+				return export
+			}
+		}
+	}
+	t.Fatalf("export %d did not finish", id)
+	return models.DataExport{}
+}
+
+func TestExport(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+	env.Store.AddOrder(models.Order{
+		OrderNumber: "ORD-1", UserID: &user.ID, TotalAmount: 100000, FinalAmount: 100000, PaymentStatus: "paid", OrderStatus: "completed",
+		Items: []models.OrderItem{{ProductName: "Toolkit", Price: 100000, Quantity: 1}},
+	})
+
+	var export models.DataExport
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/account/exports", nil, token).Expect(t, fiber.StatusAccepted, "").Decode(t, &export)
+	if export.Status != "processing" {
+		t.Errorf("new export = %+v", export)
+	}
+
+	export = waitForExport(t, app, token, export.ID)
+	if export.Status != "completed" {
+		t.Fatalf("export = %+v", export)
+	}
+
+	downloadPath := "/api/v1/account/exports/" + strconv.Itoa(export.ID) + "/download"
+	resp := handlertest.Do(t, app, fiber.MethodGet, downloadPath, nil, token).Expect(t, fiber.StatusOK, "")
+	if resp.Header.Get(fiber.HeaderContentType) != "application/zip" {
+		t.Errorf("download content type = %s", resp.Header.Get(fiber.HeaderContentType))
+	}
+	archive, err := zip.NewReader(bytes.NewReader(resp.Body), int64(len(resp.Body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	for _, file := range archive.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[file.Name], _ = io.ReadAll(r)
+		r.Close()
+	}
+	var profile struct {
+		User models.User `json:"user"`
+	}
+	if err := json.Unmarshal(files["profile.json"], &profile); err != nil || profile.User.Email != user.Email {
+		t.Errorf("profile.json = %s", files["profile.json"])
+	}
+	var orders []models.Order
+	if err := json.Unmarshal(files["orders.json"], &orders); err != nil || len(orders) != 1 || len(orders[0].Items) != 1 {
+		t.Errorf("orders.json = %s", files["orders.json"])
+	}
+
+	// Other users cannot download the export
+	other := env.Login(t, env.CreateUser(t, "user"))
+	handlertest.Do(t, app, fiber.MethodGet, downloadPath, nil, other).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/account/exports/abc/download", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestExportInProgress(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+
+	// A processing export is what an interrupted build leaves behind
+	if _, err := env.Store.Privacy().CreateExport(t.Context(), user.ID); err != nil {
+		t.Fatal(err)
+	}
+	var exports []models.DataExport
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/account/exports", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &exports)
+	if len(exports) != 1 {
+		t.Fatalf("exports = %+v", exports)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/account/exports", nil, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/account/exports/"+strconv.Itoa(exports[0].ID)+"/download", nil, token).
+		Expect(t, fiber.StatusConflict, "EXPORT_NOT_AVAILABLE")
+}
+
+func TestEraseAccount(t *testing.T) {
+	env, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	token := env.Login(t, user)
+
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/account", models.EraseAccountRequest{Password: "wrong-password"}, token).Expect(t, fiber.StatusUnauthorized, "AUTH_INVALID")
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/account", models.EraseAccountRequest{}, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/account", models.EraseAccountRequest{Password: handlertest.Password}, token).Expect(t, fiber.StatusOK, "")
+	erased, err := env.Users.GetUserByID(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if erased.Email == user.Email || erased.IsActive {
+		t.Errorf("erased user = %+v", erased)
+	}
+
+	// Erasing ends every session
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/account/exports", nil, token).Expect(t, fiber.StatusUnauthorized, "")
+}
+
+func TestImpersonationCannotEraseAccount(t *testing.T) {
+	env, app := newApp(t)
+	customer := env.CreateUser(t, "user")
+	tokens, err := env.Sessions.StartImpersonation(t.Context(), customer, handlertest.Actor(env.CreateUser(t, "support")), "handlertest", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handlertest.Do(t, app, fiber.MethodDelete, "/api/v1/account", models.EraseAccountRequest{Password: handlertest.Password}, tokens.AccessToken).
+		Expect(t, fiber.StatusForbidden, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/account/exports", nil, tokens.AccessToken).Expect(t, fiber.StatusForbidden, "")
+}
@@ -2,28 +2,48 @@ package project
 
 import (
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
-	"github.com/lib/pq"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
 )
 
 type ProjectHandler struct {
 	projectService *services.ProjectService
+	auditService   *services.AuditService
 	validator      *validator.Validate
 }
 
-func NewProjectHandler(projectService *services.ProjectService) *ProjectHandler {
+func NewProjectHandler(projectService *services.ProjectService, auditService *services.AuditService) *ProjectHandler {
 	return &ProjectHandler{
 		projectService: projectService,
+		auditService:   auditService,
 		validator:      validator.New(),
 	}
 }
 
+// RegisterRoutes mounts the public project and admin project management endpoints
+func (h *ProjectHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodGet, "/projects", h.GetProjects).
+		Describe("Get all projects", nil, fiber.Map{"projects": []models.Project{}, "pagination": models.Pagination{}})
+	r.Public(fiber.MethodGet, "/projects/:slug", h.GetProject).
+		Describe("Get single project by slug", nil, models.Project{})
+
+	r.Admin(rbac.ProjectsWrite, fiber.MethodGet, "/projects", h.AdminGetProjects).
+		Describe("Get all projects for admin", nil, fiber.Map{"projects": []models.Project{}, "pagination": models.Pagination{}})
+	r.Admin(rbac.ProjectsWrite, fiber.MethodPost, "/projects", h.AdminCreateProject).
+		Describe("Create new project", models.CreateProjectRequest{}, models.Project{})
+	r.Admin(rbac.ProjectsWrite, fiber.MethodPut, "/projects/:id", h.AdminUpdateProject).
+		Describe("Update project", models.UpdateProjectRequest{}, models.Project{})
+	r.Admin(rbac.ProjectsWrite, fiber.MethodDelete, "/projects/:id", h.AdminDeleteProject).
+		Describe("Delete project", nil, nil)
+}
+
 // Public Project Endpoints
 
 // GET /projects - Get all projects (public)
@@ -44,16 +64,9 @@ func (h *ProjectHandler) GetProjects(c *fiber.Ctx) error {
 	}
 
 	// Get projects from database
-	projects, total, err := h.projectService.GetProjects(page, limit, status, featured, search)
+	projects, total, err := h.projectService.GetProjects(c.UserContext(), page, limit, status, featured, search)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve projects",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve projects", err)
 	}
 
 	// Calculate pagination info
@@ -66,13 +79,13 @@ func (h *ProjectHandler) GetProjects(c *fiber.Ctx) error {
 		Message: "Projects retrieved successfully",
 		Data: map[string]interface{}{
 			"projects": projects,
-			"pagination": map[string]interface{}{
-				"current_page":   page,
-				"total_pages":    totalPages,
-				"total_items":    total,
-				"items_per_page": limit,
-				"has_next":       hasNext,
-				"has_prev":       hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -83,37 +96,13 @@ func (h *ProjectHandler) GetProject(c *fiber.Ctx) error {
 	slug := c.Params("slug")
 
 	if slug == "" {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Project slug is required",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Slug parameter is missing",
-			},
-		})
+		return apperr.Validation("Project slug is required", nil).WithDetails("Slug parameter is missing")
 	}
 
 	// Get project from database
-	project, err := h.projectService.GetProjectBySlug(slug)
+	project, err := h.projectService.GetProjectBySlug(c.UserContext(), slug)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Project not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: "No project found with this slug",
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve project",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve project", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -140,16 +129,9 @@ func (h *ProjectHandler) AdminGetProjects(c *fiber.Ctx) error {
 	}
 
 	// Get projects from database
-	projects, total, err := h.projectService.AdminGetProjects(page, limit)
+	projects, total, err := h.projectService.AdminGetProjects(c.UserContext(), page, limit)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to retrieve projects",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to retrieve projects", err)
 	}
 
 	// Calculate pagination info
@@ -162,13 +144,13 @@ func (h *ProjectHandler) AdminGetProjects(c *fiber.Ctx) error {
 		Message: "Admin projects retrieved successfully",
 		Data: map[string]interface{}{
 			"projects": projects,
-			"pagination": map[string]interface{}{
-				"current_page":   page,
-				"total_pages":    totalPages,
-				"total_items":    total,
-				"items_per_page": limit,
-				"has_next":       hasNext,
-				"has_prev":       hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -176,46 +158,14 @@ func (h *ProjectHandler) AdminGetProjects(c *fiber.Ctx) error {
 
 // POST /admin/projects - Create new project
 func (h *ProjectHandler) AdminCreateProject(c *fiber.Ctx) error {
-	type CreateProjectRequest struct {
-		Name             string         `json:"name" validate:"required,max=255"`
-		Slug             string         `json:"slug" validate:"required,max=255"`
-		Description      string         `json:"description" validate:"required"`
-		ShortDescription *string        `json:"short_description,omitempty"`
-		FeaturedImage    *string        `json:"featured_image,omitempty"`
-		GalleryImages    pq.StringArray `json:"gallery_images,omitempty"`
-		Technologies     pq.StringArray `json:"technologies,omitempty"`
-		ProjectURL       *string        `json:"project_url,omitempty"`
-		GithubURL        *string        `json:"github_url,omitempty"`
-		DemoURL          *string        `json:"demo_url,omitempty"`
-		Status           string         `json:"status" validate:"required,oneof=planning development completed maintenance"`
-		StartDate        *time.Time     `json:"start_date,omitempty"`
-		EndDate          *time.Time     `json:"end_date,omitempty"`
-		IsFeatured       bool           `json:"is_featured"`
-		SortOrder        int            `json:"sort_order"`
-	}
-
-	var req CreateProjectRequest
+	var req models.CreateProjectRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create project model
@@ -238,28 +188,13 @@ func (h *ProjectHandler) AdminCreateProject(c *fiber.Ctx) error {
 	}
 
 	// Create project
-	createdProject, err := h.projectService.CreateProject(project)
+	createdProject, err := h.projectService.CreateProject(c.UserContext(), project)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
-			return c.Status(409).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Project with this slug already exists",
-				Error: &models.ApiError{
-					Code:    "ALREADY_EXISTS",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create project",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create project", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditProjectCreate, "project", strconv.Itoa(createdProject.ID), nil, createdProject)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "Project created successfully",
@@ -271,56 +206,17 @@ func (h *ProjectHandler) AdminCreateProject(c *fiber.Ctx) error {
 func (h *ProjectHandler) AdminUpdateProject(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid project ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Project ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid project ID", nil).WithDetails("Project ID must be a number")
 	}
 
-	type UpdateProjectRequest struct {
-		Name             string         `json:"name" validate:"required,max=255"`
-		Slug             string         `json:"slug" validate:"required,max=255"`
-		Description      string         `json:"description" validate:"required"`
-		ShortDescription *string        `json:"short_description,omitempty"`
-		FeaturedImage    *string        `json:"featured_image,omitempty"`
-		GalleryImages    pq.StringArray `json:"gallery_images,omitempty"`
-		Technologies     pq.StringArray `json:"technologies,omitempty"`
-		ProjectURL       *string        `json:"project_url,omitempty"`
-		GithubURL        *string        `json:"github_url,omitempty"`
-		DemoURL          *string        `json:"demo_url,omitempty"`
-		Status           string         `json:"status" validate:"required,oneof=planning development completed maintenance"`
-		StartDate        *time.Time     `json:"start_date,omitempty"`
-		EndDate          *time.Time     `json:"end_date,omitempty"`
-		IsFeatured       bool           `json:"is_featured"`
-		SortOrder        int            `json:"sort_order"`
-	}
-
-	var req UpdateProjectRequest
+	var req models.UpdateProjectRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create project model
@@ -343,26 +239,9 @@ func (h *ProjectHandler) AdminUpdateProject(c *fiber.Ctx) error {
 	}
 
 	// Update project
-	updatedProject, err := h.projectService.UpdateProject(id, project)
+	updatedProject, err := h.projectService.UpdateProject(c.UserContext(), id, project, middleware.GetAuditActor(c))
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Project not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to update project",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to update project", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -376,39 +255,17 @@ func (h *ProjectHandler) AdminUpdateProject(c *fiber.Ctx) error {
 func (h *ProjectHandler) AdminDeleteProject(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid project ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Project ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid project ID", nil).WithDetails("Project ID must be a number")
 	}
 
 	// Delete project
-	err = h.projectService.DeleteProject(id)
+	err = h.projectService.DeleteProject(c.UserContext(), id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(404).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Project not found",
-				Error: &models.ApiError{
-					Code:    "NOT_FOUND",
-					Details: err.Error(),
-				},
-			})
-		}
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to delete project",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to delete project", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditProjectDelete, "project", strconv.Itoa(id), nil, nil)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "Project deleted successfully",
@@ -0,0 +1,111 @@
+package project_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/project"
+	"zplus_web/backend/models"
+	"zplus_web/backend/services"
+)
+
+type projectPage struct {
+	Projects   []models.Project  `json:"projects"`
+	Pagination models.Pagination `json:"pagination"`
+}
+
+func newApp(t *testing.T) (*handlertest.Env, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(project.NewProjectHandler(env.Projects, env.Audit))
+	return env, app
+}
+
+func TestPublicProjects(t *testing.T) {
+	env, app := newApp(t)
+	for _, p := range []models.Project{
+		{Name: "Storefront", Slug: "storefront", Description: "Online shop", Status: "completed", IsFeatured: true},
+		{Name: "Mobile app", Slug: "mobile-app", Description: "Companion app", Status: "development"},
+	} {
+		if _, err := env.Projects.CreateProject(t.Context(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var page projectPage
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Projects) != 2 || page.Pagination.TotalItems != 2 {
+		t.Errorf("projects = %+v", page)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects?featured=true", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Projects) != 1 || page.Projects[0].Slug != "storefront" {
+		t.Errorf("featured projects = %+v", page.Projects)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects?status=development&search=app", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Projects) != 1 || page.Projects[0].Slug != "mobile-app" {
+		t.Errorf("filtered projects = %+v", page.Projects)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects?page=2&limit=1", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Projects) != 1 || !page.Pagination.HasPrev || page.Pagination.HasNext {
+		t.Errorf("second page = %+v", page)
+	}
+
+	var p models.Project
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects/storefront", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &p)
+	if p.Name != "Storefront" {
+		t.Errorf("project = %+v", p)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/projects/missing", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+}
+
+func TestAdminProjects(t *testing.T) {
+	env, app := newApp(t)
+	token := env.Login(t, env.CreateUser(t, "editor"))
+	req := models.CreateProjectRequest{Name: "Portal", Slug: "portal", Description: "Customer portal", Status: "planning", Technologies: []string{"Go", "Vue"}}
+
+	var p models.Project
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/projects", req, token).Expect(t, fiber.StatusOK, "").Decode(t, &p)
+	if p.Slug != "portal" || len(p.Technologies) != 2 {
+		t.Errorf("created project = %+v", p)
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/projects", req, token).Expect(t, fiber.StatusConflict, "ALREADY_EXISTS")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/projects", models.CreateProjectRequest{Name: "Bad", Slug: "bad", Description: "x", Status: "abandoned"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var page projectPage
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/projects", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &page)
+	if len(page.Projects) != 1 {
+		t.Errorf("admin projects = %+v", page.Projects)
+	}
+
+	projectPath := "/api/v1/admin/projects/" + strconv.Itoa(p.ID)
+	update := models.UpdateProjectRequest{Name: "Portal v2", Slug: "portal", Description: "Customer portal", Status: "development"}
+	handlertest.Do(t, app, fiber.MethodPut, projectPath, update, token).Expect(t, fiber.StatusOK, "").Decode(t, &p)
+	if p.Name != "Portal v2" || p.Status != "development" {
+		t.Errorf("updated project = %+v", p)
+	}
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/projects/999", update, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodPut, "/api/v1/admin/projects/abc", update, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	handlertest.Do(t, app, fiber.MethodDelete, projectPath, nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodDelete, projectPath, nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	events, _, err := env.Audit.GetEvents(t.Context(), models.AuditFilter{EntityType: "project"}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("got %d project audit events, want 3", len(events))
+	}
+	// The update keeps the project as it was before
+	if update := events[1]; update.Action != services.AuditProjectUpdate ||
+		!strings.Contains(string(update.Before), `"status":"planning"`) || !strings.Contains(string(update.After), `"status":"development"`) {
+		t.Errorf("update event = %s, before %s, after %s", update.Action, update.Before, update.After)
+	}
+
+	customer := env.Login(t, env.CreateUser(t, "user"))
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/projects", req, customer).Expect(t, fiber.StatusForbidden, "")
+}
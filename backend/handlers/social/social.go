@@ -0,0 +1,106 @@
+package social
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/login"
+	"zplus_web/backend/models"
+	"zplus_web/backend/routes"
+	"zplus_web/backend/services"
+)
+
+type SocialHandler struct {
+	oauthService   *services.OAuthService
+	sessionService *services.SessionService
+	mfaService     *services.MFAService
+	validator      *validator.Validate
+}
+
+func NewSocialHandler(oauthService *services.OAuthService, sessionService *services.SessionService, mfaService *services.MFAService) *SocialHandler {
+	return &SocialHandler{
+		oauthService:   oauthService,
+		sessionService: sessionService,
+		mfaService:     mfaService,
+		validator:      validator.New(),
+	}
+}
+
+// RegisterRoutes mounts the social login endpoints
+func (h *SocialHandler) RegisterRoutes(r *routes.Registry) {
+	r.Public(fiber.MethodGet, "/auth/oauth/providers", h.GetProviders).
+		Describe("List the configured login providers", nil, []string{})
+	r.Public(fiber.MethodGet, "/auth/oauth/:provider/authorize", h.Authorize).
+		Describe("Get the provider URL to send the user to", nil, fiber.Map{"authorization_url": ""})
+	r.Public(fiber.MethodPost, "/auth/oauth/:provider/callback", h.Callback).
+		Describe("Finish a social login with the code and state the provider returned", models.OAuthCallbackRequest{}, models.LoginResponse{})
+	r.Authenticated(fiber.MethodGet, "/auth/identities", h.GetIdentities).
+		Describe("List the social accounts linked to the current user", nil, []models.UserIdentity{})
+}
+
+// GET /auth/oauth/providers - List the configured login providers
+func (h *SocialHandler) GetProviders(c *fiber.Ctx) error {
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Login providers retrieved successfully",
+		Data:    h.oauthService.Providers(),
+	})
+}
+
+// GET /auth/oauth/:provider/authorize - Get the provider URL to send the user to
+func (h *SocialHandler) Authorize(c *fiber.Ctx) error {
+	authURL, err := h.oauthService.AuthorizationURL(c.UserContext(), c.Params("provider"))
+	if err != nil {
+		return apperr.Internal("Failed to start social login", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Authorization URL created successfully",
+		Data: map[string]interface{}{
+			"authorization_url": authURL,
+		},
+	})
+}
+
+// POST /auth/oauth/:provider/callback - Finish a social login with the code and state the provider returned
+func (h *SocialHandler) Callback(c *fiber.Ctx) error {
+	var req models.OAuthCallbackRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.Invalid("Invalid request body", err)
+	}
+
+	// Validate request
+	if err := h.validator.Struct(req); err != nil {
+		return apperr.Invalid("Validation failed", err)
+	}
+
+	user, err := h.oauthService.CompleteLogin(c.UserContext(), c.Params("provider"), req.Code, req.State)
+	if err != nil {
+		return apperr.Internal("Social login failed", err)
+	}
+
+	// The second factor is still required when the account has one
+	resp, err := login.Begin(c, h.sessionService, h.mfaService, user)
+	if err != nil {
+		return err
+	}
+
+	return login.Respond(c, resp, "Login successful")
+}
+
+// GET /auth/identities - List the social accounts linked to the current user
+func (h *SocialHandler) GetIdentities(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(int)
+
+	identities, err := h.oauthService.GetUserIdentities(c.UserContext(), userID)
+	if err != nil {
+		return apperr.Internal("Failed to get linked accounts", err)
+	}
+
+	return c.JSON(models.ApiResponse{
+		Success: true,
+		Message: "Linked accounts retrieved successfully",
+		Data:    identities,
+	})
+}
@@ -0,0 +1,177 @@
+package social_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/config"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/social"
+	"zplus_web/backend/models"
+)
+
+// provider is an OpenID Connect provider that signs in whichever profile
+// was registered for the authorization code
+type provider struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	profiles map[string]map[string]interface{}
+}
+
+func newProvider(t *testing.T) *provider {
+	p := &provider{profiles: map[string]map[string]interface{}{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		code := r.FormValue("code")
+		if _, ok := p.profile(code); !ok || r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token-" + code})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		profile, ok := p.profile(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-"))
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(profile)
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+// signIn registers the profile the provider returns for code
+func (p *provider) signIn(code, subject, email string, verified bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.profiles[code] = map[string]interface{}{"sub": subject, "email": email, "email_verified": verified, "name": "Social User"}
+}
+
+func (p *provider) profile(code string) (map[string]interface{}, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	profile, ok := p.profiles[code]
+	return profile, ok
+}
+
+func newApp(t *testing.T) (*handlertest.Env, *provider, *fiber.App) {
+	p := newProvider(t)
+	env := handlertest.New(t, config.OAuthProvider{
+		Name:        "acme",
+		ClientID:    "client",
+		AuthURL:     p.URL + "/authorize",
+		TokenURL:    p.URL + "/token",
+		UserInfoURL: p.URL + "/userinfo",
+	})
+	app := env.App(social.NewSocialHandler(env.OAuth, env.Sessions, env.MFA))
+	return env, p, app
+}
+
+// authorize starts a login and returns the state the provider hands back
+func authorize(t *testing.T, app *fiber.App) string {
+	t.Helper()
+	var data struct {
+		AuthorizationURL string `json:"authorization_url"`
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/oauth/acme/authorize", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &data)
+	u, err := url.Parse(data.AuthorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Query().Get("client_id") != "client" || u.Query().Get("code_challenge") == "" {
+		t.Fatalf("authorization URL = %s", data.AuthorizationURL)
+	}
+	return u.Query().Get("state")
+}
+
+func callback(t *testing.T, app *fiber.App, code, state string) *handlertest.Response {
+	t.Helper()
+	return handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/oauth/acme/callback", models.OAuthCallbackRequest{Code: code, State: state}, "")
+}
+
+func TestProviders(t *testing.T) {
+	_, _, app := newApp(t)
+
+	var providers []string
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/oauth/providers", nil, "").Expect(t, fiber.StatusOK, "").Decode(t, &providers)
+	if len(providers) != 1 || providers[0] != "acme" {
+		t.Errorf("providers = %v", providers)
+	}
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/oauth/nope/authorize", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+}
+
+func TestSignUpAndLogin(t *testing.T) {
+	_, p, app := newApp(t)
+	p.signIn("first", "subject-1", "social@example.com", true)
+	p.signIn("second", "subject-1", "social@example.com", true)
+
+	var first models.LoginResponse
+	callback(t, app, "first", authorize(t, app)).Expect(t, fiber.StatusOK, "").Decode(t, &first)
+	if first.Token == "" || first.User == nil || first.User.Email != "social@example.com" {
+		t.Fatalf("sign up response = %+v", first)
+	}
+
+	// The state is single use
+	state := authorize(t, app)
+	var second models.LoginResponse
+	callback(t, app, "second", state).Expect(t, fiber.StatusOK, "").Decode(t, &second)
+	if second.User.ID != first.User.ID {
+		t.Errorf("second login signed in user %d, want %d", second.User.ID, first.User.ID)
+	}
+	callback(t, app, "second", state).Expect(t, fiber.StatusBadRequest, "INVALID_STATE")
+
+	var identities []models.UserIdentity
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/auth/identities", nil, second.Token).Expect(t, fiber.StatusOK, "").Decode(t, &identities)
+	if len(identities) != 1 || identities[0].Provider != "acme" {
+		t.Errorf("identities = %+v", identities)
+	}
+}
+
+func TestLinking(t *testing.T) {
+	env, p, app := newApp(t)
+	user := env.CreateUser(t, "user")
+	p.signIn("unverified", "subject-1", user.Email, false)
+	p.signIn("verified", "subject-1", user.Email, true)
+
+	// An unverified address at the provider must not take over the account
+	callback(t, app, "unverified", authorize(t, app)).Expect(t, fiber.StatusConflict, "ACCOUNT_EXISTS")
+
+	var resp models.LoginResponse
+	callback(t, app, "verified", authorize(t, app)).Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	if resp.User == nil || resp.User.ID != user.ID {
+		t.Errorf("linked login = %+v", resp)
+	}
+}
+
+func TestCallbackErrors(t *testing.T) {
+	env, p, app := newApp(t)
+	p.signIn("no-email", "subject-2", "", true)
+
+	callback(t, app, "unknown-code", authorize(t, app)).Expect(t, fiber.StatusBadGateway, "PROVIDER_ERROR")
+	callback(t, app, "no-email", authorize(t, app)).Expect(t, fiber.StatusBadGateway, "PROVIDER_ERROR")
+	callback(t, app, "any", "forged-state").Expect(t, fiber.StatusBadRequest, "INVALID_STATE")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/auth/oauth/acme/callback", models.OAuthCallbackRequest{}, "").Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	// Accounts with a second factor get the pending token instead of a session
+	adminUser := env.CreateUser(t, "admin")
+	if _, err := env.Settings.UpdateSecuritySettings(t.Context(), models.SecuritySettings{RequireAdmin2FA: true}); err != nil {
+		t.Fatal(err)
+	}
+	p.signIn("admin", "subject-3", adminUser.Email, true)
+	var resp models.LoginResponse
+	callback(t, app, "admin", authorize(t, app)).Expect(t, fiber.StatusOK, "").Decode(t, &resp)
+	if !resp.MFARequired || resp.MFAToken == "" || resp.Token != "" {
+		t.Errorf("admin social login = %+v", resp)
+	}
+}
@@ -0,0 +1,105 @@
+package system
+
+import (
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/openapi"
+	"zplus_web/backend/routes"
+)
+
+// Version is the API version reported by / and the OpenAPI document
+const Version = "1.0.0"
+
+type SystemHandler struct {
+	registry *routes.Registry
+
+	specOnce sync.Once
+	spec     *openapi.Document
+}
+
+// NewSystemHandler serves the health check and the API documentation built
+// from the routes in registry
+func NewSystemHandler(registry *routes.Registry) *SystemHandler {
+	return &SystemHandler{
+		registry: registry,
+	}
+}
+
+// RegisterRoutes mounts the health check and documentation endpoints outside the REST prefix
+func (h *SystemHandler) RegisterRoutes(r *routes.Registry) {
+	r.Root(fiber.MethodGet, "/", h.Index).
+		Describe("List the API entry points", nil, routes.Content{Type: "application/json", Schema: fiber.Map{
+			"message": "", "version": "", "documentation": "", "openapi": "", "graphql": "", "health": "",
+		}})
+	r.Root(fiber.MethodGet, "/health", h.Health).
+		Describe("Health check", nil, routes.Content{Type: "application/json", Schema: fiber.Map{
+			"status": "", "database": "", "version": "",
+		}})
+	r.Root(fiber.MethodGet, "/openapi.json", h.OpenAPI).
+		Describe("This OpenAPI document", nil, routes.Content{Type: "application/json"})
+	r.Root(fiber.MethodGet, "/docs", h.Docs).
+		Describe("Swagger UI for the OpenAPI document", nil, routes.Content{Type: "text/html"})
+}
+
+// GET / - List the API entry points
+func (h *SystemHandler) Index(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"message":       "ZPlus Web REST API",
+		"version":       Version,
+		"documentation": "/docs",
+		"openapi":       "/openapi.json",
+		"graphql":       "/graphql",
+		"health":        "/health",
+	})
+}
+
+// GET /health - Health check
+func (h *SystemHandler) Health(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"status":   "ok",
+		"database": "connected",
+		"version":  Version,
+	})
+}
+
+// GET /openapi.json - OpenAPI 3.1 document generated from the mounted routes
+func (h *SystemHandler) OpenAPI(c *fiber.Ctx) error {
+	// Built on first use, once every module has registered its routes
+	h.specOnce.Do(func() {
+		h.spec = openapi.Generate(openapi.Info{
+			Title:       "ZPlus Web REST API",
+			Version:     Version,
+			Description: "Every response is a JSON envelope with success, message, data and, on failure, error.code and error.details.",
+		}, h.registry.Routes())
+	})
+	return c.JSON(h.spec)
+}
+
+// GET /docs - Swagger UI for the OpenAPI document
+func (h *SystemHandler) Docs(c *fiber.Ctx) error {
+	c.Type("html")
+	return c.SendString(swaggerPage)
+}
+
+const swaggerPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>ZPlus Web REST API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script crossorigin src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    // Use "Authorize" with an access token or API key to try protected endpoints
+    window.ui = SwaggerUIBundle({
+      url: '/openapi.json',
+      dom_id: '#swagger-ui',
+      persistAuthorization: true,
+    });
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,71 @@
+package system_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/project"
+	"zplus_web/backend/handlers/system"
+	"zplus_web/backend/openapi"
+)
+
+func newApp(t *testing.T) *fiber.App {
+	env := handlertest.New(t)
+	registry := env.Registry()
+	return env.Mount(registry, system.NewSystemHandler(registry), project.NewProjectHandler(env.Projects, env.Audit))
+}
+
+func TestIndexAndHealth(t *testing.T) {
+	app := newApp(t)
+
+	var index map[string]string
+	if err := json.Unmarshal(handlertest.Do(t, app, fiber.MethodGet, "/", nil, "").Expect(t, fiber.StatusOK, "").Body, &index); err != nil {
+		t.Fatal(err)
+	}
+	if index["version"] != system.Version || index["openapi"] != "/openapi.json" {
+		t.Errorf("index = %v", index)
+	}
+
+	var health map[string]string
+	if err := json.Unmarshal(handlertest.Do(t, app, fiber.MethodGet, "/health", nil, "").Expect(t, fiber.StatusOK, "").Body, &health); err != nil {
+		t.Fatal(err)
+	}
+	if health["status"] != "ok" {
+		t.Errorf("health = %v", health)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	app := newApp(t)
+
+	var spec openapi.Document
+	if err := json.Unmarshal(handlertest.Do(t, app, fiber.MethodGet, "/openapi.json", nil, "").Expect(t, fiber.StatusOK, "").Body, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.Info.Version != system.Version {
+		t.Errorf("info = %+v", spec.Info)
+	}
+	if spec.Paths["/api/v1/projects"]["get"] == nil {
+		t.Error("GET /api/v1/projects is missing")
+	}
+	create := spec.Paths["/api/v1/admin/projects"]["post"]
+	if create == nil || create.Permission != "projects:write" || len(create.Security) == 0 {
+		t.Errorf("POST /api/v1/admin/projects = %+v", create)
+	}
+	if spec.Paths["/health"]["get"] == nil {
+		t.Error("GET /health is missing")
+	}
+}
+
+func TestDocs(t *testing.T) {
+	app := newApp(t)
+
+	resp := handlertest.Do(t, app, fiber.MethodGet, "/docs", nil, "").Expect(t, fiber.StatusOK, "")
+	if !strings.HasPrefix(resp.Header.Get(fiber.HeaderContentType), fiber.MIMETextHTML) || !strings.Contains(string(resp.Body), "url: '/openapi.json'") {
+		t.Errorf("docs = %s %s", resp.Header.Get(fiber.HeaderContentType), resp.Body)
+	}
+}
@@ -12,8 +12,10 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"zplus_web/backend/apperr"
 	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/routes"
 )
 
 type UploadHandler struct {
@@ -32,6 +34,19 @@ func NewUploadHandler() *UploadHandler {
 	}
 }
 
+// RegisterRoutes mounts the upload endpoints and the uploaded file server
+func (h *UploadHandler) RegisterRoutes(r *routes.Registry) {
+	r.Authenticated(fiber.MethodPost, "/upload/image", h.UploadImage).
+		Describe("Upload single image file", routes.Content{Type: "multipart/form-data", Schema: fiber.Map{"file": []byte{}}}, UploadResponse{})
+	r.Authenticated(fiber.MethodPost, "/upload/file", h.UploadFile).
+		Describe("Upload general file", routes.Content{Type: "multipart/form-data", Schema: fiber.Map{"file": []byte{}}}, UploadResponse{})
+	r.Authenticated(fiber.MethodPost, "/upload/multiple", h.UploadMultiple).
+		Describe("Upload multiple files", routes.Content{Type: "multipart/form-data", Schema: fiber.Map{"files": [][]byte{}}}, fiber.Map{"uploaded": []UploadResponse{}, "count": 0, "errors": []string{}, "error_count": 0})
+
+	r.Root(fiber.MethodGet, "/uploads/:category/:filename", h.ServeFile).
+		Describe("Serve uploaded files", nil, routes.Content{Type: "application/octet-stream"})
+}
+
 type UploadResponse struct {
 	FileName     string `json:"file_name"`
 	OriginalName string `json:"original_name"`
@@ -47,52 +62,24 @@ func (h *UploadHandler) UploadImage(c *fiber.Ctx) error {
 	// Check authentication
 	currentUser := middleware.GetCurrentUser(c)
 	if currentUser["id"] == nil {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Authentication required",
-			Error: &models.ApiError{
-				Code:    "AUTH_REQUIRED",
-				Details: "User must be authenticated to upload files",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthRequired, "Authentication required").WithDetails("User must be authenticated to upload files")
 	}
 
 	// Parse multipart form
 	file, err := c.FormFile("file")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "No file provided",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "File field is required",
-			},
-		})
+		return apperr.Validation("No file provided", nil).WithDetails("File field is required")
 	}
 
 	// Validate file
 	if err := h.validateImageFile(file); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid file",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid file", err)
 	}
 
 	// Save file
 	uploadResult, err := h.saveFile(file, "images")
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to save file",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to save file", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -107,52 +94,24 @@ func (h *UploadHandler) UploadFile(c *fiber.Ctx) error {
 	// Check authentication
 	currentUser := middleware.GetCurrentUser(c)
 	if currentUser["id"] == nil {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Authentication required",
-			Error: &models.ApiError{
-				Code:    "AUTH_REQUIRED",
-				Details: "User must be authenticated to upload files",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthRequired, "Authentication required").WithDetails("User must be authenticated to upload files")
 	}
 
 	// Parse multipart form
 	file, err := c.FormFile("file")
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "No file provided",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "File field is required",
-			},
-		})
+		return apperr.Validation("No file provided", nil).WithDetails("File field is required")
 	}
 
 	// Validate file
 	if err := h.validateFile(file); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid file",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid file", err)
 	}
 
 	// Save file
 	uploadResult, err := h.saveFile(file, "files")
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to save file",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to save file", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -167,51 +126,23 @@ func (h *UploadHandler) UploadMultiple(c *fiber.Ctx) error {
 	// Check authentication
 	currentUser := middleware.GetCurrentUser(c)
 	if currentUser["id"] == nil {
-		return c.Status(401).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Authentication required",
-			Error: &models.ApiError{
-				Code:    "AUTH_REQUIRED",
-				Details: "User must be authenticated to upload files",
-			},
-		})
+		return apperr.Unauthorized(apperr.CodeAuthRequired, "Authentication required").WithDetails("User must be authenticated to upload files")
 	}
 
 	// Parse multipart form
 	form, err := c.MultipartForm()
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid multipart form",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid multipart form", err)
 	}
 
 	files := form.File["files"]
 	if len(files) == 0 {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "No files provided",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "At least one file is required",
-			},
-		})
+		return apperr.Validation("No files provided", nil).WithDetails("At least one file is required")
 	}
 
 	// Limit number of files
 	if len(files) > 10 {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Too many files",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Maximum 10 files allowed per upload",
-			},
-		})
+		return apperr.Validation("Too many files", nil).WithDetails("Maximum 10 files allowed per upload")
 	}
 
 	var results []UploadResponse
@@ -267,14 +198,7 @@ func (h *UploadHandler) ServeFile(c *fiber.Ctx) error {
 	}
 
 	if !isValidCategory {
-		return c.Status(404).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid file category",
-			Error: &models.ApiError{
-				Code:    "NOT_FOUND",
-				Details: "File category not found",
-			},
-		})
+		return apperr.NotFound("Invalid file category").WithDetails("File category not found")
 	}
 
 	// Construct file path
@@ -282,14 +206,7 @@ func (h *UploadHandler) ServeFile(c *fiber.Ctx) error {
 
 	// Check if file exists
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return c.Status(404).JSON(models.ApiResponse{
-			Success: false,
-			Message: "File not found",
-			Error: &models.ApiError{
-				Code:    "NOT_FOUND",
-				Details: "The requested file does not exist",
-			},
-		})
+		return apperr.NotFound("File not found").WithDetails("The requested file does not exist")
 	}
 
 	// Serve file
@@ -0,0 +1,109 @@
+package upload_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/upload"
+)
+
+// newApp serves uploads from a temporary working directory
+func newApp(t *testing.T) (string, *fiber.App) {
+	t.Chdir(t.TempDir())
+	env := handlertest.New(t)
+	app := env.App(upload.NewUploadHandler())
+	return env.Login(t, env.CreateUser(t, "user")), app
+}
+
+type file struct {
+	field, name, content string
+}
+
+func post(t *testing.T, app *fiber.App, path, token string, files ...file) *handlertest.Response {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for _, f := range files {
+		w, err := form.CreateFormFile(f.field, f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(f.content))
+	}
+	form.Close()
+
+	req := httptest.NewRequest(fiber.MethodPost, path, &body)
+	req.Header.Set(fiber.HeaderContentType, form.FormDataContentType())
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+	return handlertest.Send(t, app, req)
+}
+
+func TestUploadImage(t *testing.T) {
+	token, app := newApp(t)
+
+	var result upload.UploadResponse
+	post(t, app, "/api/v1/upload/image", token, file{"file", "logo.png", "png bytes"}).Expect(t, fiber.StatusOK, "").Decode(t, &result)
+	if result.OriginalName != "logo.png" || result.Size != 9 || result.Hash != fmt.Sprintf("%x", sha256.Sum256([]byte("png bytes"))) {
+		t.Errorf("upload = %+v", result)
+	}
+
+	served := handlertest.Do(t, app, fiber.MethodGet, result.URL, nil, "").Expect(t, fiber.StatusOK, "")
+	if string(served.Body) != "png bytes" {
+		t.Errorf("served %q", served.Body)
+	}
+
+	post(t, app, "/api/v1/upload/image", token, file{"file", "notes.txt", "text"}).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	post(t, app, "/api/v1/upload/image", token, file{"other", "logo.png", "png"}).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	post(t, app, "/api/v1/upload/image", "", file{"file", "logo.png", "png"}).Expect(t, fiber.StatusUnauthorized, "AUTH_REQUIRED")
+}
+
+func TestUploadFile(t *testing.T) {
+	token, app := newApp(t)
+
+	var result upload.UploadResponse
+	post(t, app, "/api/v1/upload/file", token, file{"file", "report.pdf", "pdf"}).Expect(t, fiber.StatusOK, "").Decode(t, &result)
+	handlertest.Do(t, app, fiber.MethodGet, result.URL, nil, "").Expect(t, fiber.StatusOK, "")
+
+	post(t, app, "/api/v1/upload/file", token, file{"file", "run.sh", "#!/bin/sh"}).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestUploadMultiple(t *testing.T) {
+	token, app := newApp(t)
+
+	resp := post(t, app, "/api/v1/upload/multiple", token,
+		file{"files", "a.txt", "a"}, file{"files", "b.csv", "b"}, file{"files", "evil.exe", "MZ"}).Expect(t, fiber.StatusOK, "")
+	var data struct {
+		Uploaded   []upload.UploadResponse `json:"uploaded"`
+		Count      int                     `json:"count"`
+		Errors     []string                `json:"errors"`
+		ErrorCount int                     `json:"error_count"`
+	}
+	resp.Decode(t, &data)
+	if data.Count != 2 || len(data.Uploaded) != 2 || data.ErrorCount != 1 {
+		t.Errorf("multiple upload = %+v", data)
+	}
+
+	post(t, app, "/api/v1/upload/multiple", token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	var tooMany []file
+	for i := 0; i < 11; i++ {
+		tooMany = append(tooMany, file{"files", fmt.Sprintf("%d.txt", i), "x"})
+	}
+	post(t, app, "/api/v1/upload/multiple", token, tooMany...).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestServeFile(t *testing.T) {
+	_, app := newApp(t)
+
+	handlertest.Do(t, app, fiber.MethodGet, "/uploads/secrets/key.pem", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodGet, "/uploads/images/missing.png", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+}
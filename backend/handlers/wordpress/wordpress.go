@@ -2,39 +2,59 @@ package wordpress
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/middleware"
 	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
 )
 
 type WordPressHandler struct {
 	wordpressService *services.WordPressService
 	blogService      *services.BlogService
+	auditService     *services.AuditService
 	validator        *validator.Validate
 }
 
-func NewWordPressHandler(wordpressService *services.WordPressService, blogService *services.BlogService) *WordPressHandler {
+func NewWordPressHandler(wordpressService *services.WordPressService, blogService *services.BlogService, auditService *services.AuditService) *WordPressHandler {
 	return &WordPressHandler{
 		wordpressService: wordpressService,
 		blogService:      blogService,
+		auditService:     auditService,
 		validator:        validator.New(),
 	}
 }
 
+// RegisterRoutes mounts the WordPress integration endpoints
+func (h *WordPressHandler) RegisterRoutes(r *routes.Registry) {
+	r.Admin(rbac.WordPressManage, fiber.MethodGet, "/wordpress/sites", h.GetSites).
+		Describe("Get all WordPress sites", nil, []models.WordPressSite{})
+	r.Admin(rbac.WordPressManage, fiber.MethodPost, "/wordpress/sites", h.CreateSite).
+		Describe("Create new WordPress site", models.CreateWordPressSiteRequest{}, models.WordPressSite{})
+	r.Admin(rbac.WordPressManage, fiber.MethodPost, "/wordpress/sites/:id/test", h.TestConnection).
+		Describe("Test WordPress connection", nil, nil)
+	r.Admin(rbac.WordPressManage, fiber.MethodPost, "/wordpress/sites/:id/sync", h.SyncFromWordPress).
+		Describe("Sync content from WordPress", nil, nil).
+		WithTimeout(2 * time.Minute)
+	r.Admin(rbac.WordPressManage, fiber.MethodPost, "/wordpress/sites/:id/publish/:post_id", h.PublishToWordPress).
+		Describe("Publish post to WordPress", nil, nil).
+		WithTimeout(time.Minute)
+	r.Admin(rbac.WordPressManage, fiber.MethodGet, "/wordpress/sites/:id/logs", h.GetSyncLogs).
+		Describe("Get sync logs", nil, fiber.Map{"logs": []models.ContentSyncLog{}, "pagination": models.Pagination{}})
+	r.Admin(rbac.WordPressManage, fiber.MethodPost, "/wordpress/webhook", h.HandleWebhook).
+		Describe("Handle WordPress webhook", models.WordPressWebhookPayload{}, nil)
+}
+
 // GET /admin/wordpress/sites - Get all WordPress sites
 func (h *WordPressHandler) GetSites(c *fiber.Ctx) error {
-	sites, err := h.wordpressService.GetWordPressSites()
+	sites, err := h.wordpressService.GetWordPressSites(c.UserContext())
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get WordPress sites",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get WordPress sites", err)
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -46,37 +66,14 @@ func (h *WordPressHandler) GetSites(c *fiber.Ctx) error {
 
 // POST /admin/wordpress/sites - Create new WordPress site
 func (h *WordPressHandler) CreateSite(c *fiber.Ctx) error {
-	type CreateSiteRequest struct {
-		Name                string  `json:"name" validate:"required,max=255"`
-		URL                 string  `json:"url" validate:"required,url"`
-		APIEndpoint         string  `json:"api_endpoint" validate:"required,url"`
-		Username            *string `json:"username,omitempty"`
-		ApplicationPassword *string `json:"application_password,omitempty"`
-		IsActive            bool    `json:"is_active"`
-	}
-
-	var req CreateSiteRequest
+	var req models.CreateWordPressSiteRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid request body",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid request body", err)
 	}
 
 	// Validate request
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Validation failed",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Validation failed", err)
 	}
 
 	// Create site model
@@ -90,30 +87,18 @@ func (h *WordPressHandler) CreateSite(c *fiber.Ctx) error {
 	}
 
 	// Test connection before creating
-	if err := h.wordpressService.TestWordPressConnection(site); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to connect to WordPress site",
-			Error: &models.ApiError{
-				Code:    "CONNECTION_ERROR",
-				Details: err.Error(),
-			},
-		})
+	if err := h.wordpressService.TestWordPressConnection(c.UserContext(), site); err != nil {
+		return apperr.New(fiber.StatusBadRequest, apperr.CodeConnectionError, "Failed to connect to WordPress site").WithDetails(err.Error())
 	}
 
 	// Create site
-	createdSite, err := h.wordpressService.CreateWordPressSite(site)
+	createdSite, err := h.wordpressService.CreateWordPressSite(c.UserContext(), site)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to create WordPress site",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to create WordPress site", err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditWordPressSiteCreate, "wordpress_site", strconv.Itoa(createdSite.ID), nil, createdSite)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "WordPress site created successfully",
@@ -125,26 +110,12 @@ func (h *WordPressHandler) CreateSite(c *fiber.Ctx) error {
 func (h *WordPressHandler) TestConnection(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid site ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Site ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid site ID", nil).WithDetails("Site ID must be a number")
 	}
 
-	sites, err := h.wordpressService.GetWordPressSites()
+	sites, err := h.wordpressService.GetWordPressSites(c.UserContext())
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get WordPress site",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get WordPress site", err)
 	}
 
 	var site *models.WordPressSite
@@ -156,26 +127,12 @@ func (h *WordPressHandler) TestConnection(c *fiber.Ctx) error {
 	}
 
 	if site == nil {
-		return c.Status(404).JSON(models.ApiResponse{
-			Success: false,
-			Message: "WordPress site not found",
-			Error: &models.ApiError{
-				Code:    "NOT_FOUND",
-				Details: "Site not found",
-			},
-		})
+		return apperr.NotFound("WordPress site not found").WithDetails("Site not found")
 	}
 
-	err = h.wordpressService.TestWordPressConnection(*site)
+	err = h.wordpressService.TestWordPressConnection(c.UserContext(), *site)
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "WordPress connection failed",
-			Error: &models.ApiError{
-				Code:    "CONNECTION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.New(fiber.StatusBadRequest, apperr.CodeConnectionError, "WordPress connection failed").WithDetails(err.Error())
 	}
 
 	return c.JSON(models.ApiResponse{
@@ -188,28 +145,16 @@ func (h *WordPressHandler) TestConnection(c *fiber.Ctx) error {
 func (h *WordPressHandler) SyncFromWordPress(c *fiber.Ctx) error {
 	id, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid site ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Site ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid site ID", nil).WithDetails("Site ID must be a number")
 	}
 
-	err = h.wordpressService.SyncPostsFromWordPress(id, h.blogService)
+	err = h.wordpressService.SyncPostsFromWordPress(c.UserContext(), id, h.blogService, middleware.GetAuditActor(c))
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "WordPress sync failed",
-			Error: &models.ApiError{
-				Code:    "SYNC_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.New(fiber.StatusInternalServerError, apperr.CodeSyncError, "WordPress sync failed").Wrap(err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditWordPressSync, "wordpress_site", strconv.Itoa(id), nil, nil)
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "WordPress content synchronized successfully",
@@ -220,40 +165,22 @@ func (h *WordPressHandler) SyncFromWordPress(c *fiber.Ctx) error {
 func (h *WordPressHandler) PublishToWordPress(c *fiber.Ctx) error {
 	siteID, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid site ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Site ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid site ID", nil).WithDetails("Site ID must be a number")
 	}
 
 	postID, err := strconv.Atoi(c.Params("post_id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid post ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Post ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid post ID", nil).WithDetails("Post ID must be a number")
 	}
 
-	err = h.wordpressService.SyncPostToWordPress(siteID, postID)
+	err = h.wordpressService.SyncPostToWordPress(c.UserContext(), siteID, postID)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to publish post to WordPress",
-			Error: &models.ApiError{
-				Code:    "SYNC_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.New(fiber.StatusInternalServerError, apperr.CodeSyncError, "Failed to publish post to WordPress").Wrap(err)
 	}
 
+	h.auditService.Record(c.UserContext(), middleware.GetAuditActor(c), services.AuditWordPressPublish, "wordpress_site", strconv.Itoa(siteID), nil,
+		map[string]int{"post_id": postID})
+
 	return c.JSON(models.ApiResponse{
 		Success: true,
 		Message: "Post published to WordPress successfully",
@@ -264,14 +191,7 @@ func (h *WordPressHandler) PublishToWordPress(c *fiber.Ctx) error {
 func (h *WordPressHandler) GetSyncLogs(c *fiber.Ctx) error {
 	siteID, err := strconv.Atoi(c.Params("id"))
 	if err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid site ID",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: "Site ID must be a number",
-			},
-		})
+		return apperr.Validation("Invalid site ID", nil).WithDetails("Site ID must be a number")
 	}
 
 	// Parse query parameters
@@ -286,16 +206,9 @@ func (h *WordPressHandler) GetSyncLogs(c *fiber.Ctx) error {
 		limit = 50
 	}
 
-	logs, total, err := h.wordpressService.GetSyncLogs(siteID, page, limit)
+	logs, total, err := h.wordpressService.GetSyncLogs(c.UserContext(), siteID, page, limit)
 	if err != nil {
-		return c.Status(500).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Failed to get sync logs",
-			Error: &models.ApiError{
-				Code:    "INTERNAL_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Internal("Failed to get sync logs", err)
 	}
 
 	// Calculate pagination info
@@ -308,13 +221,13 @@ func (h *WordPressHandler) GetSyncLogs(c *fiber.Ctx) error {
 		Message: "Sync logs retrieved successfully",
 		Data: map[string]interface{}{
 			"logs": logs,
-			"pagination": map[string]interface{}{
-				"current_page":   page,
-				"total_pages":    totalPages,
-				"total_items":    total,
-				"items_per_page": limit,
-				"has_next":       hasNext,
-				"has_prev":       hasPrev,
+			"pagination": models.Pagination{
+				CurrentPage:  page,
+				TotalPages:   totalPages,
+				TotalItems:   total,
+				ItemsPerPage: limit,
+				HasNext:      hasNext,
+				HasPrev:      hasPrev,
 			},
 		},
 	})
@@ -325,22 +238,9 @@ func (h *WordPressHandler) HandleWebhook(c *fiber.Ctx) error {
 	// TODO: Implement WordPress webhook handling for real-time content sync
 	// This would handle incoming webhooks from WordPress when content is updated
 	
-	type WebhookPayload struct {
-		Action string                 `json:"action"`
-		PostID int                    `json:"post_id"`
-		Post   map[string]interface{} `json:"post"`
-	}
-
-	var payload WebhookPayload
+	var payload models.WordPressWebhookPayload
 	if err := c.BodyParser(&payload); err != nil {
-		return c.Status(400).JSON(models.ApiResponse{
-			Success: false,
-			Message: "Invalid webhook payload",
-			Error: &models.ApiError{
-				Code:    "VALIDATION_ERROR",
-				Details: err.Error(),
-			},
-		})
+		return apperr.Invalid("Invalid webhook payload", err)
 	}
 
 	// Process webhook based on action
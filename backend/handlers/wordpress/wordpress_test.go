@@ -0,0 +1,180 @@
+package wordpress_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/handlers/wordpress"
+	"zplus_web/backend/models"
+)
+
+// site is a WordPress REST API serving posts and recording the ones published to it
+type site struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	posts     []map[string]interface{}
+	published []map[string]interface{}
+	auth      string
+}
+
+func newSite(t *testing.T) *site {
+	s := &site{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/wp-json/wp/v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.Method == http.MethodPost {
+			user, password, _ := r.BasicAuth()
+			s.auth = user + ":" + password
+			var post map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&post)
+			s.published = append(s.published, post)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]int{"id": 100 + len(s.published)})
+			return
+		}
+		json.NewEncoder(w).Encode(s.posts)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *site) addPost(id int, slug, title string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.posts = append(s.posts, map[string]interface{}{
+		"id":       id,
+		"slug":     slug,
+		"status":   "published",
+		"title":    map[string]string{"rendered": title},
+		"content":  map[string]string{"rendered": "<p>" + title + "</p>"},
+		"excerpt":  map[string]string{"rendered": title},
+		"date":     "2024-01-02T03:04:05Z",
+		"modified": "2024-01-02T03:04:05Z",
+	})
+}
+
+func newApp(t *testing.T) (*handlertest.Env, *site, string, *fiber.App) {
+	env := handlertest.New(t)
+	app := env.App(wordpress.NewWordPressHandler(env.WordPress, env.Blog, env.Audit))
+	return env, newSite(t), env.Login(t, env.CreateUser(t, "admin")), app
+}
+
+func createSite(t *testing.T, app *fiber.App, token string, s *site) models.WordPressSite {
+	t.Helper()
+	username, password := "editor", "app-password"
+	var created models.WordPressSite
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites", models.CreateWordPressSiteRequest{
+		Name: "Company blog", URL: s.URL, APIEndpoint: s.URL + "/wp-json/wp/v2", Username: &username, ApplicationPassword: &password, IsActive: true,
+	}, token).Expect(t, fiber.StatusOK, "").Decode(t, &created)
+	return created
+}
+
+func TestSites(t *testing.T) {
+	_, s, token, app := newApp(t)
+
+	created := createSite(t, app, token, s)
+	if created.ID == 0 || created.APIEndpoint != s.URL+"/wp-json/wp/v2" {
+		t.Errorf("created site = %+v", created)
+	}
+
+	var sites []models.WordPressSite
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/wordpress/sites", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &sites)
+	if len(sites) != 1 {
+		t.Errorf("sites = %+v", sites)
+	}
+
+	sitePath := "/api/v1/admin/wordpress/sites/" + strconv.Itoa(created.ID)
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/test", nil, token).Expect(t, fiber.StatusOK, "")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites/999/test", nil, token).Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites/abc/test", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	// A site that cannot be reached is not saved
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites", models.CreateWordPressSiteRequest{
+		Name: "Broken", URL: s.URL, APIEndpoint: s.URL + "/missing",
+	}, token).Expect(t, fiber.StatusBadRequest, "CONNECTION_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites", models.CreateWordPressSiteRequest{Name: "No URL"}, token).
+		Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestSync(t *testing.T) {
+	env, s, token, app := newApp(t)
+	s.addPost(7, "hello-world", "Hello world")
+	s.addPost(8, "second-post", "Second post")
+	created := createSite(t, app, token, s)
+	sitePath := "/api/v1/admin/wordpress/sites/" + strconv.Itoa(created.ID)
+
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/sync", nil, token).Expect(t, fiber.StatusOK, "")
+	post, err := env.Blog.GetPostBySlug(t.Context(), "hello-world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Title != "Hello world" {
+		t.Errorf("synced post = %+v", post)
+	}
+
+	// Syncing again updates the posts instead of duplicating them
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/sync", nil, token).Expect(t, fiber.StatusOK, "")
+
+	var logs struct {
+		Logs       []models.ContentSyncLog `json:"logs"`
+		Pagination models.Pagination       `json:"pagination"`
+	}
+	handlertest.Do(t, app, fiber.MethodGet, sitePath+"/logs", nil, token).Expect(t, fiber.StatusOK, "").Decode(t, &logs)
+	counts := map[string]int{}
+	for _, log := range logs.Logs {
+		if log.Status != "success" {
+			t.Errorf("sync log = %+v", log)
+		}
+		counts[log.SyncType]++
+	}
+	if counts["post_create"] != 2 || counts["post_update"] != 2 || logs.Pagination.TotalItems != 4 {
+		t.Errorf("sync logs = %+v", logs)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/sites/999/sync", nil, token).Expect(t, fiber.StatusInternalServerError, "SYNC_ERROR")
+}
+
+func TestPublish(t *testing.T) {
+	env, s, token, app := newApp(t)
+	created := createSite(t, app, token, s)
+	post, err := env.Blog.CreatePost(t.Context(), 1, "Launch notes", "launch-notes", "<p>We shipped</p>", "Shipped", "", "published", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sitePath := "/api/v1/admin/wordpress/sites/" + strconv.Itoa(created.ID)
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/publish/"+strconv.Itoa(post.ID), nil, token).Expect(t, fiber.StatusOK, "")
+	s.mu.Lock()
+	published, auth := s.published, s.auth
+	s.mu.Unlock()
+	if len(published) != 1 || published[0]["slug"] != "launch-notes" || auth != "editor:app-password" {
+		t.Errorf("published %+v with %q", published, auth)
+	}
+
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/publish/999", nil, token).Expect(t, fiber.StatusInternalServerError, "SYNC_ERROR")
+	handlertest.Do(t, app, fiber.MethodPost, sitePath+"/publish/abc", nil, token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+}
+
+func TestWebhook(t *testing.T) {
+	env, _, token, app := newApp(t)
+
+	for _, action := range []string{"post_published", "post_deleted", "comment_added"} {
+		handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/webhook", models.WordPressWebhookPayload{Action: action}, token).
+			Expect(t, fiber.StatusOK, "")
+	}
+	handlertest.Do(t, app, fiber.MethodPost, "/api/v1/admin/wordpress/webhook", []byte("{"), token).Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+
+	// Only admins manage WordPress
+	editor := env.Login(t, env.CreateUser(t, "editor"))
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/admin/wordpress/sites", nil, editor).Expect(t, fiber.StatusForbidden, "")
+}
@@ -1,22 +1,45 @@
 package main
 
 import (
-	"database/sql"
-	"fmt"
+	"context"
 	"log"
 	"os"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/joho/godotenv"
-	_ "github.com/lib/pq"
 
+	"zplus_web/backend/cache"
 	"zplus_web/backend/config"
+	"zplus_web/backend/database"
+	"zplus_web/backend/events"
+	"zplus_web/backend/graph"
 	"zplus_web/backend/handlers/admin"
+	"zplus_web/backend/handlers/apikey"
 	"zplus_web/backend/handlers/auth"
+	"zplus_web/backend/handlers/blog"
+	"zplus_web/backend/handlers/graphql"
+	"zplus_web/backend/handlers/invitation"
+	"zplus_web/backend/handlers/mfa"
+	"zplus_web/backend/handlers/payment"
+	"zplus_web/backend/handlers/privacy"
+	"zplus_web/backend/handlers/project"
+	"zplus_web/backend/handlers/social"
+	"zplus_web/backend/handlers/system"
+	"zplus_web/backend/handlers/upload"
+	"zplus_web/backend/handlers/wordpress"
+	"zplus_web/backend/mailer"
 	"zplus_web/backend/middleware"
+	"zplus_web/backend/migrate"
+	"zplus_web/backend/migrations"
+	"zplus_web/backend/oauth"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository/postgres"
+	"zplus_web/backend/routes"
 	"zplus_web/backend/services"
+	"zplus_web/backend/utils"
 )
 
 func main() {
@@ -28,32 +51,42 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	if err := utils.ConfigureJWT(cfg); err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	if err := utils.ConfigurePasswords(cfg); err != nil {
+		log.Fatalf("Invalid password settings: %v", err)
+	}
+
 	log.Println("Database connecting...")
 
-	// Initialize SQL database connection pool for services
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName)
-	db, err := sql.Open("postgres", dsn)
+	// Initialize PostgreSQL connection pool and Redis client
+	db, err := database.NewDatabase(cfg)
 	if err != nil {
-		log.Fatalf("Failed to open database connection pool: %v", err)
+		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
-	// Test the connection
-	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	if cfg.MigrateOnStart {
+		migrator, err := migrate.New(db.PostgreSQL, migrations.Files)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		// Other instances starting at the same time wait for the migration lock
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to migrate the database: %v", err)
+		}
+		for _, m := range applied {
+			log.Printf("Applied migration %s", m)
+		}
 	}
 
-	// Initialize services
-	userService := services.NewUserService(db)
-
-	// Initialize handlers
-	authHandler := auth.NewAuthHandler(userService)
-	adminHandler := admin.NewAdminHandler(userService)
-
 	// Create Fiber app
 	app := fiber.New(fiber.Config{
 		AppName: "ZPlus Web GraphQL API v1.0.0",
+		// Error responses only include internal causes in development
+		ErrorHandler: middleware.ErrorHandler(cfg.Env == "development"),
 	})
 
 	// Middleware
@@ -64,56 +97,8 @@ func main() {
 		AllowHeaders: "*",
 	}))
 
-	// Health check endpoint
-	app.Get("/health", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{
-			"status":   "ok",
-			"database": "connected",
-			"version":  "1.0.0",
-		})
-	})
-
 	// API Routes
-	api := app.Group("/api/v1")
-
-	// Auth routes
-	authRoutes := api.Group("/auth")
-	authRoutes.Post("/register", authHandler.Register)
-	authRoutes.Post("/login", authHandler.Login)
-	authRoutes.Post("/logout", middleware.AuthRequired(), authHandler.Logout)
-	authRoutes.Get("/me", middleware.AuthRequired(), authHandler.Me)
-	authRoutes.Post("/forgot-password", authHandler.ForgotPassword)
-	authRoutes.Post("/reset-password", authHandler.ResetPassword)
-
-	// Admin routes
-	adminRoutes := api.Group("/admin")
-	adminRoutes.Post("/auth/login", adminHandler.Login)
-	
-	// Protected admin routes
-	adminProtected := adminRoutes.Group("", middleware.AuthRequired(), middleware.AdminRequired())
-	adminProtected.Get("/dashboard/stats", adminHandler.GetDashboardStats)
-	adminProtected.Get("/dashboard/recent-activity", adminHandler.GetRecentActivity)
-	
-	// User management routes
-	adminProtected.Get("/users", adminHandler.GetUsers)
-	adminProtected.Get("/users/:id", adminHandler.GetUser)
-	adminProtected.Post("/users", adminHandler.CreateUser)
-	adminProtected.Put("/users/:id", adminHandler.UpdateUser)
-	adminProtected.Delete("/users/:id", adminHandler.DeleteUser)
-	adminProtected.Put("/users/:id/role", adminHandler.UpdateUserRole)
-
-	// API documentation
-	app.Get("/", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{
-			"message": "ZPlus Web REST API",
-			"version": "1.0.0",
-			"endpoints": fiber.Map{
-				"auth":   "/api/v1/auth",
-				"admin":  "/api/v1/admin",
-				"health": "/health",
-			},
-		})
-	})
+	setupRoutes(app, db, cfg)
 
 	// Start the server
 	port := os.Getenv("PORT")
@@ -123,6 +108,8 @@ func main() {
 
 	log.Printf("Server starting on port %s", port)
 	log.Printf("REST API: http://localhost:%s/", port)
+	log.Printf("API docs: http://localhost:%s/docs", port)
+	log.Printf("GraphQL API: http://localhost:%s/graphql", port)
 	log.Printf("Health check: http://localhost:%s/health", port)
 
 	if err := app.Listen(":" + port); err != nil {
@@ -130,3 +117,77 @@ func main() {
 	}
 }
 
+
+// setupRoutes wires every handler module into the route registry and mounts it on the app
+func setupRoutes(app *fiber.App, db *database.Database, cfg *config.Config) *routes.Registry {
+	store := postgres.NewStore(db.PostgreSQL, database.NewEntClient(db.PostgreSQL))
+	appCache := cache.New(db.Redis)
+	bus := events.NewBus(db.Redis)
+	go bus.Run(context.Background())
+
+	// Initialize services
+	userService := services.NewUserService(store, bus)
+	blogService := services.NewBlogService(store)
+	projectService := services.NewProjectService(store)
+	paymentService := services.NewPaymentService(store, bus)
+	productService := services.NewProductService(store)
+	orderService := services.NewOrderService(store)
+	wordpressService := services.NewWordPressService(store, bus)
+	sessionService := services.NewSessionService(store, userService, appCache)
+	mail := mailer.New(cfg)
+	authEmailLimiter := ratelimit.NewLimiter(appCache, time.Minute, 5, time.Hour)
+	passwordResetService := services.NewPasswordResetService(store, userService, sessionService, mail, authEmailLimiter, cfg.AppURL)
+	emailVerificationService := services.NewEmailVerificationService(store, userService, mail, authEmailLimiter, cfg.AppURL)
+	magicLinkService := services.NewMagicLinkService(store, userService, mail, authEmailLimiter, cfg.AppURL)
+	invitationService := services.NewInvitationService(store, mail, cfg.AppURL)
+	settingsService := services.NewSettingsService(store)
+	loginGuard := services.NewLoginGuard(store, appCache)
+	oauthRegistry, err := oauth.NewRegistry(cfg.OAuthProviders, nil)
+	if err != nil {
+		log.Fatalf("Failed to configure login providers: %v", err)
+	}
+	oauthService := services.NewOAuthService(store, userService, oauthRegistry, appCache, cfg.AppURL)
+	roleService := services.NewRoleService(store, appCache)
+	mfaService := services.NewMFAService(store, settingsService, roleService, ratelimit.NewLimiter(appCache, 0, 5, 5*time.Minute), cfg.MFAIssuer)
+	auditService := services.NewAuditService(store)
+	apiKeyService := services.NewAPIKeyService(store, roleService)
+	privacyService := services.NewPrivacyService(store, sessionService)
+	if err := privacyService.FailInterruptedExports(context.Background()); err != nil {
+		log.Printf("Failed to clean up interrupted data exports: %v", err)
+	}
+
+	schema, err := graph.NewSchema(
+		graph.NewResolver(userService, blogService, projectService, productService, orderService, paymentService, auditService, roleService, bus),
+		cfg.GraphQLMaxDepth, cfg.GraphQLMaxComplexity, cfg.Env == "development")
+	if err != nil {
+		log.Fatalf("Failed to build GraphQL schema: %v", err)
+	}
+
+	registry := routes.NewRegistry("/api/v1", routes.Guards{
+		Authenticated: []fiber.Handler{middleware.AuthRequired(sessionService, apiKeyService)},
+		Permission: func(permission string) fiber.Handler {
+			return middleware.RequirePermission(roleService, permission)
+		},
+		Deadline: middleware.Deadline,
+		Timeout:  cfg.RequestTimeout,
+	})
+	registry.Register(
+		auth.NewAuthHandler(userService, sessionService, passwordResetService, emailVerificationService, mfaService, loginGuard, magicLinkService),
+		admin.NewAdminHandler(userService, sessionService, roleService, mfaService, settingsService, loginGuard, auditService, privacyService),
+		mfa.NewMFAHandler(userService, sessionService, mfaService, auditService),
+		social.NewSocialHandler(oauthService, sessionService, mfaService),
+		apikey.NewAPIKeyHandler(apiKeyService, roleService),
+		invitation.NewInvitationHandler(invitationService, roleService),
+		blog.NewBlogHandler(blogService, auditService),
+		project.NewProjectHandler(projectService, auditService),
+		payment.NewPaymentHandler(paymentService),
+		privacy.NewPrivacyHandler(privacyService, userService),
+		upload.NewUploadHandler(),
+		wordpress.NewWordPressHandler(wordpressService, blogService, auditService),
+		graphql.NewGraphQLHandler(schema, sessionService, apiKeyService, cfg.Env != "production"),
+		system.NewSystemHandler(registry),
+	)
+	registry.Mount(app)
+
+	return registry
+}
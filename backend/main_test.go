@@ -0,0 +1,66 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	_ "github.com/lib/pq"
+
+	"zplus_web/backend/config"
+	"zplus_web/backend/database"
+)
+
+// TestOpenAPICoversMountedRoutes fails when a route is mounted on the app
+// without going through the registry the OpenAPI document is built from
+func TestOpenAPICoversMountedRoutes(t *testing.T) {
+	// Nothing connects until a query runs, and no route is called but the spec
+	pg, err := sql.Open("postgres", "host=127.0.0.1 port=1 connect_timeout=1 sslmode=disable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pg.Close()
+
+	app := fiber.New()
+	setupRoutes(app, &database.Database{PostgreSQL: pg}, &config.Config{Env: "development"})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/openapi.json", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("GET /openapi.json returned %d", resp.StatusCode)
+	}
+
+	var spec struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		t.Fatalf("failed to decode the OpenAPI document: %v", err)
+	}
+	if spec.OpenAPI != "3.1.0" {
+		t.Errorf("openapi = %q, want 3.1.0", spec.OpenAPI)
+	}
+
+	param := regexp.MustCompile(`:([A-Za-z0-9_]+)\??`)
+	checked := 0
+	for _, route := range app.GetRoutes(true) {
+		// Fiber adds a HEAD route for every GET route
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+		path := param.ReplaceAllString(route.Path, "{$1}")
+		if _, ok := spec.Paths[path][strings.ToLower(route.Method)]; !ok {
+			t.Errorf("%s %s is mounted but missing from /openapi.json", route.Method, route.Path)
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatal("no routes were mounted")
+	}
+}
@@ -1,9 +1,13 @@
 package middleware
 
 import (
+	"context"
+	"log"
 	"strings"
 
+	"zplus_web/backend/apperr"
 	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
 	"zplus_web/backend/utils"
 
 	"github.com/gofiber/fiber/v2"
@@ -19,70 +23,239 @@ func Logger() fiber.Handler {
 	})
 }
 
-// AuthRequired middleware to check for valid JWT token
-func AuthRequired() fiber.Handler {
+// SessionValidator reports whether the server-side session behind a token is
+// still usable and returns the user's current role
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, sessionToken string, userID int) (string, error)
+}
+
+// APIKeyValidator resolves the service account behind an API key
+type APIKeyValidator interface {
+	ValidateAPIKey(ctx context.Context, rawKey, ip string) (*models.APIKeyPrincipal, error)
+}
+
+// PermissionResolver returns the permissions granted to a role
+type PermissionResolver interface {
+	RolePermissions(ctx context.Context, role string) ([]string, error)
+}
+
+// AuthRequired middleware to check for a valid JWT token with a live session,
+// or a service account API key
+func AuthRequired(sessions SessionValidator, apiKeys APIKeyValidator) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		authHeader := c.Get("Authorization")
-		if authHeader == "" {
-			return c.Status(401).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Authorization header required",
-				Error: &models.ApiError{
-					Code:    "AUTH_REQUIRED",
-					Details: "Authorization header is missing",
-				},
-			})
+		token, err := bearerToken(c)
+		if err != nil {
+			return err
 		}
 
-		// Check if header starts with "Bearer "
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			return c.Status(401).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Invalid authorization header format",
-				Error: &models.ApiError{
-					Code:    "AUTH_INVALID",
-					Details: "Authorization header must start with 'Bearer '",
-				},
-			})
+		identity, authErr := Authenticate(c.UserContext(), sessions, apiKeys, token, c.IP())
+		if authErr != nil {
+			return authErr
+		}
+
+		// Store user info in context
+		c.Locals("user_id", identity.UserID)
+		c.Locals("user_email", identity.Email)
+		c.Locals("user_role", identity.Role)
+		c.Locals("user_username", identity.Username)
+		c.Locals("user_email_verified", identity.EmailVerified)
+		if identity.APIKey {
+			c.Locals("api_key_id", identity.APIKeyID)
+			c.Locals("api_key_scopes", identity.APIKeyScopes)
+			return c.Next()
+		}
+		c.Locals("session_id", identity.SessionID)
+
+		// Flag everything done while a staff member is logged in as the user
+		if identity.ImpersonatorID != 0 {
+			c.Locals("impersonator_id", identity.ImpersonatorID)
+			log.Printf("[impersonation] %s (user %d) as user %d: %s %s",
+				identity.ImpersonatorEmail, identity.ImpersonatorID, identity.UserID, c.Method(), c.Path())
+		}
+
+		return c.Next()
+	}
+}
+
+// OptionalAuth middleware to authenticate the request like AuthRequired when it
+// carries an Authorization header and let it through anonymously otherwise.
+// A header with a bad token is still rejected.
+func OptionalAuth(sessions SessionValidator, apiKeys APIKeyValidator) fiber.Handler {
+	authRequired := AuthRequired(sessions, apiKeys)
+	return func(c *fiber.Ctx) error {
+		if c.Get("Authorization") == "" {
+			return c.Next()
 		}
+		return authRequired(c)
+	}
+}
+
+// Identity is the caller behind a bearer token
+type Identity struct {
+	UserID        int
+	Email         string
+	Role          string
+	Username      string
+	EmailVerified bool
+	SessionID     string
+	// ImpersonatorID is the staff member logged in as the user, zero for normal logins
+	ImpersonatorID    int
+	ImpersonatorEmail string
+	// APIKey is set for service account API keys, whose scopes further limit the role
+	APIKey       bool
+	APIKeyID     int
+	APIKeyScopes []string
+}
+
+// Authenticate resolves a bearer token, a JWT access token with a live
+// session or a service account API key, for transports that cannot go
+// through AuthRequired, e.g. websocket connection messages
+func Authenticate(ctx context.Context, sessions SessionValidator, apiKeys APIKeyValidator, token, ip string) (*Identity, *apperr.Error) {
+	if strings.HasPrefix(token, utils.APIKeyPrefix) {
+		return apiKeyIdentity(ctx, apiKeys, token, ip)
+	}
+
+	claims, err := utils.ValidateJWT(token)
+	if err != nil {
+		return nil, apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid or expired token").Wrap(err)
+	}
+
+	// Reject tokens whose session was logged out or whose user was deactivated
+	role, err := sessions.ValidateSession(ctx, claims.SessionID, claims.UserID)
+	if err != nil {
+		return nil, apperr.Unauthorized(apperr.CodeAuthRevoked, "Session is no longer valid").Wrap(err)
+	}
 
-		// Extract the token
-		token := strings.TrimPrefix(authHeader, "Bearer ")
-		claims, err := utils.ValidateJWT(token)
+	return &Identity{
+		UserID:            claims.UserID,
+		Email:             claims.Email,
+		Role:              role,
+		Username:          claims.Username,
+		EmailVerified:     claims.EmailVerified,
+		SessionID:         claims.SessionID,
+		ImpersonatorID:    claims.ImpersonatorID,
+		ImpersonatorEmail: claims.ImpersonatorEmail,
+	}, nil
+}
+
+// apiKeyIdentity authenticates an API key. The key's scopes further limit
+// what RequirePermission grants the service account's role.
+func apiKeyIdentity(ctx context.Context, apiKeys APIKeyValidator, rawKey, ip string) (*Identity, *apperr.Error) {
+	principal, err := apiKeys.ValidateAPIKey(ctx, rawKey, ip)
+	if err != nil {
+		return nil, apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid API key").Wrap(err)
+	}
+
+	return &Identity{
+		UserID:        principal.User.ID,
+		Email:         principal.User.Email,
+		Role:          principal.User.Role,
+		Username:      principal.User.Username,
+		EmailVerified: principal.User.EmailVerified,
+		APIKey:        true,
+		APIKeyID:      principal.KeyID,
+		APIKeyScopes:  principal.Scopes,
+	}, nil
+}
+
+// MFAPendingRequired middleware to accept only the limited token issued by a
+// password login that still needs its second factor
+func MFAPendingRequired() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, err := bearerToken(c)
 		if err != nil {
-			return c.Status(401).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Invalid or expired token",
-				Error: &models.ApiError{
-					Code:    "AUTH_INVALID",
-					Details: err.Error(),
-				},
-			})
+			return err
+		}
+
+		claims, err := utils.ValidateMFAPendingToken(token)
+		if err != nil {
+			return apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid or expired two-factor login token").Wrap(err)
 		}
 
-		// Store user info in context
 		c.Locals("user_id", claims.UserID)
-		c.Locals("user_email", claims.Email)
-		c.Locals("user_role", claims.Role)
-		c.Locals("user_username", claims.Username)
 
 		return c.Next()
 	}
 }
 
-// AdminRequired middleware to check for admin role
-func AdminRequired() fiber.Handler {
+// bearerToken extracts the token from the Authorization header
+func bearerToken(c *fiber.Ctx) (string, error) {
+	authHeader := c.Get("Authorization")
+	if authHeader == "" {
+		return "", apperr.Unauthorized(apperr.CodeAuthRequired, "Authorization header required").WithDetails("Authorization header is missing")
+	}
+
+	// Check if header starts with "Bearer "
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return "", apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid authorization header format").WithDetails("Authorization header must start with 'Bearer '")
+	}
+
+	return strings.TrimPrefix(authHeader, "Bearer "), nil
+}
+
+// RequirePermission middleware to check that the user's role grants every
+// given permission. Must run after AuthRequired. The resolved permissions are
+// kept in the context for HasPermission. Impersonation sessions never get any.
+func RequirePermission(resolver PermissionResolver, permissions ...string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		userRole := c.Locals("user_role")
-		if userRole == nil || userRole.(string) != "admin" {
-			return c.Status(403).JSON(models.ApiResponse{
-				Success: false,
-				Message: "Admin access required",
-				Error: &models.ApiError{
-					Code:    "PERMISSION_DENIED",
-					Details: "Admin role required to access this resource",
-				},
-			})
+		if IsImpersonating(c) {
+			return errImpersonationForbidden
+		}
+
+		role, _ := c.Locals("user_role").(string)
+		granted, err := resolver.RolePermissions(c.UserContext(), role)
+		if err != nil {
+			return apperr.Internal("Failed to check permissions", err)
+		}
+
+		c.Locals("user_permissions", granted)
+		for _, permission := range permissions {
+			if !HasPermission(c, permission) {
+				return apperr.Forbidden("Permission denied").WithDetails("Missing permission " + permission)
+			}
+		}
+
+		return c.Next()
+	}
+}
+
+// HasPermission reports whether the permissions resolved by RequirePermission
+// include permission and, for API key requests, whether the key's scopes do
+func HasPermission(c *fiber.Ctx, permission string) bool {
+	granted, _ := c.Locals("user_permissions").([]string)
+	if scopes, ok := c.Locals("api_key_scopes").([]string); ok && !rbac.Has(scopes, permission) {
+		return false
+	}
+	return rbac.Has(granted, permission)
+}
+
+var errImpersonationForbidden = apperr.New(fiber.StatusForbidden, apperr.CodeImpersonationForbidden, "Not allowed while impersonating a user").
+	WithDetails("This action cannot be performed in a login-as-customer session")
+
+// NoImpersonation middleware to refuse a route to impersonation sessions, e.g.
+// anything that moves money or changes the user's credentials. Must run after AuthRequired.
+func NoImpersonation() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if IsImpersonating(c) {
+			return errImpersonationForbidden
+		}
+		return c.Next()
+	}
+}
+
+// IsImpersonating reports whether the request comes from a staff member logged in as the user
+func IsImpersonating(c *fiber.Ctx) bool {
+	_, ok := c.Locals("impersonator_id").(int)
+	return ok
+}
+
+// VerifiedEmailRequired middleware to restrict a route to users with a verified email.
+// Must run after AuthRequired.
+func VerifiedEmailRequired() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		verified, _ := c.Locals("user_email_verified").(bool)
+		if !verified {
+			return apperr.New(fiber.StatusForbidden, apperr.CodeEmailNotVerified, "Email verification required").WithDetails("Verify your email address to access this resource")
 		}
 		return c.Next()
 	}
@@ -97,3 +270,19 @@ func GetCurrentUser(c *fiber.Ctx) map[string]interface{} {
 		"username": c.Locals("user_username"),
 	}
 }
+
+// GetAuditActor returns who is making the request, for audit events
+func GetAuditActor(c *fiber.Ctx) models.AuditActor {
+	actor := models.AuditActor{
+		IPAddress: c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}
+	if userID, ok := c.Locals("user_id").(int); ok {
+		actor.UserID = &userID
+	}
+	if impersonatorID, ok := c.Locals("impersonator_id").(int); ok {
+		actor.ImpersonatorID = &impersonatorID
+	}
+	actor.Email, _ = c.Locals("user_email").(string)
+	return actor
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zplus_web/backend/apperr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Deadline bounds the request's context to the timeout, so queries and
+// outgoing calls made with c.UserContext() are cancelled once it passes.
+// fasthttp does not report clients that hang up, so the deadline is what
+// stops abandoned work. Server errors returned once the deadline has passed
+// are answered with 504 instead.
+func Deadline(timeout time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.UserContext(), timeout)
+		defer cancel()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && toAppError(err).Status >= fiber.StatusInternalServerError {
+			return apperr.Timeout().Wrap(err)
+		}
+		return err
+	}
+}
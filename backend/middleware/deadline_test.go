@@ -0,0 +1,37 @@
+package middleware_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/routes"
+)
+
+// slow waits for the request's deadline and fails the way a cancelled query does
+type slow struct{}
+
+func (slow) RegisterRoutes(r *routes.Registry) {
+	wait := func(c *fiber.Ctx) error {
+		<-c.UserContext().Done()
+		return apperr.Internal("Failed to load", errors.New("pq: canceling statement due to user request"))
+	}
+	r.Public(fiber.MethodGet, "/slow", wait).WithTimeout(10 * time.Millisecond)
+	r.Public(fiber.MethodGet, "/fast", func(c *fiber.Ctx) error {
+		if _, ok := c.UserContext().Deadline(); !ok {
+			return fiber.ErrInternalServerError
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+}
+
+func TestDeadline(t *testing.T) {
+	app := handlertest.New(t).App(slow{})
+
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/slow", nil, "").Expect(t, fiber.StatusGatewayTimeout, "TIMEOUT")
+	handlertest.Do(t, app, fiber.MethodGet, "/api/v1/fast", nil, "").Expect(t, fiber.StatusNoContent, "")
+}
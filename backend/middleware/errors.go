@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorHandler answers every error a handler returns with a models.ApiResponse.
+// *apperr.Error values keep their status and code, repository and Fiber errors
+// are mapped to the matching codes, and anything else is a 500. Causes are
+// logged for server errors, and only sent to the client in development.
+// Rate limited requests are told when to retry.
+func ErrorHandler(development bool) fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		appErr := toAppError(err)
+		var limited *ratelimit.LimitedError
+		if errors.As(err, &limited) {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(limited.RetryAfter.Seconds())+1))
+		}
+		if appErr.Status >= fiber.StatusInternalServerError {
+			log.Printf("%s %s: %v", c.Method(), c.Path(), err)
+		}
+
+		details := appErr.Details
+		if development && appErr.Err != nil {
+			if details != "" {
+				details += ": "
+			}
+			details += appErr.Err.Error()
+		}
+
+		return c.Status(appErr.Status).JSON(models.ApiResponse{
+			Success: false,
+			Message: appErr.Message,
+			Data:    appErr.Data,
+			Error: &models.ApiError{
+				Code:    appErr.Code,
+				Details: details,
+				Fields:  appErr.Fields,
+			},
+		})
+	}
+}
+
+func toAppError(err error) *apperr.Error {
+	if appErr, ok := apperr.As(err); ok {
+		return appErr
+	}
+
+	var fiberErr *fiber.Error
+	var limited *ratelimit.LimitedError
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return apperr.NotFound("Not found")
+	case errors.Is(err, repository.ErrConflict):
+		return apperr.Conflict("Already exists")
+	case errors.Is(err, context.DeadlineExceeded):
+		return apperr.Timeout()
+	case errors.As(err, &limited):
+		return apperr.RateLimited("Too many requests").Wrap(err)
+	case errors.As(err, &fiberErr):
+		return apperr.New(fiberErr.Code, statusCode(fiberErr.Code), fiberErr.Message)
+	default:
+		return apperr.Internal("Internal server error", err)
+	}
+}
+
+// statusCode is the error code for Fiber's own errors, e.g. unknown routes
+// (NOT_FOUND) or oversized bodies (REQUEST_ENTITY_TOO_LARGE)
+func statusCode(status int) string {
+	switch status {
+	case fiber.StatusBadRequest:
+		return apperr.CodeValidation
+	case fiber.StatusNotFound:
+		return apperr.CodeNotFound
+	case fiber.StatusUnauthorized:
+		return apperr.CodeAuthRequired
+	case fiber.StatusForbidden:
+		return apperr.CodePermissionDenied
+	case fiber.StatusTooManyRequests:
+		return apperr.CodeRateLimited
+	case fiber.StatusGatewayTimeout:
+		return apperr.CodeTimeout
+	}
+	if status >= fiber.StatusInternalServerError {
+		return apperr.CodeInternal
+	}
+	return strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+}
@@ -0,0 +1,74 @@
+package middleware_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/middleware"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+)
+
+func newErrorApp(development bool) *fiber.App {
+	app := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+		ErrorHandler:          middleware.ErrorHandler(development),
+	})
+	app.Get("/internal", func(c *fiber.Ctx) error {
+		return apperr.Internal("Failed to load", errors.New("pq: relation \"users\" does not exist"))
+	})
+	app.Get("/invalid", func(c *fiber.Ctx) error {
+		req := struct {
+			Email    string `validate:"required,email"`
+			FullName string `validate:"max=3"`
+		}{Email: "nope", FullName: "Jane Doe"}
+		return apperr.Invalid("Validation failed", validator.New().Struct(req))
+	})
+	app.Get("/missing", func(c *fiber.Ctx) error {
+		return fmt.Errorf("failed to get order: %w", repository.ErrNotFound)
+	})
+	app.Get("/limited", func(c *fiber.Ctx) error {
+		return &ratelimit.LimitedError{RetryAfter: 30 * time.Second}
+	})
+	return app
+}
+
+func TestErrorHandler(t *testing.T) {
+	app := newErrorApp(false)
+
+	// Internal causes stay in the logs outside development
+	resp := handlertest.Do(t, app, fiber.MethodGet, "/internal", nil, "").Expect(t, fiber.StatusInternalServerError, "INTERNAL_ERROR")
+	if resp.Message != "Failed to load" || resp.Error.Details != "" || strings.Contains(string(resp.Body), "pq:") {
+		t.Errorf("internal error = %s", resp.Body)
+	}
+
+	resp = handlertest.Do(t, app, fiber.MethodGet, "/invalid", nil, "").Expect(t, fiber.StatusBadRequest, "VALIDATION_ERROR")
+	if resp.Error.Fields["email"] != "must be a valid email address" || resp.Error.Fields["full_name"] != "must be at most 3" {
+		t.Errorf("field errors = %v", resp.Error.Fields)
+	}
+
+	handlertest.Do(t, app, fiber.MethodGet, "/missing", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+	handlertest.Do(t, app, fiber.MethodGet, "/unknown", nil, "").Expect(t, fiber.StatusNotFound, "NOT_FOUND")
+
+	resp = handlertest.Do(t, app, fiber.MethodGet, "/limited", nil, "").Expect(t, fiber.StatusTooManyRequests, "RATE_LIMITED")
+	if retry := resp.Header.Get(fiber.HeaderRetryAfter); retry != "31" {
+		t.Errorf("Retry-After = %q", retry)
+	}
+}
+
+func TestErrorHandlerDevelopment(t *testing.T) {
+	app := newErrorApp(true)
+
+	resp := handlertest.Do(t, app, fiber.MethodGet, "/internal", nil, "").Expect(t, fiber.StatusInternalServerError, "INTERNAL_ERROR")
+	if !strings.Contains(resp.Error.Details, "does not exist") {
+		t.Errorf("development details = %q", resp.Error.Details)
+	}
+}
@@ -0,0 +1,305 @@
+// Package migrate applies the versioned SQL migrations in backend/migrations
+// and records them in the schema_migrations table.
+//
+// Migrations run in version order, each in its own transaction. A Postgres
+// advisory lock is held while migrating, so when several instances start at
+// once one of them migrates and the others wait for it, then find nothing to
+// do. The checksum of every applied migration is recorded, and Up refuses to
+// run when an applied migration file has been changed since.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lockKey identifies the advisory lock held while migrating
+const lockKey int64 = 7243091856
+
+const createTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		checksum VARCHAR(64) NOT NULL,
+		applied_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+	)`
+
+const recordApplied = `INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrNothingToRollBack is returned by Down and Redo when no migration has been applied
+var ErrNothingToRollBack = errors.New("no migration has been applied")
+
+// Migration is a pair of up and down SQL scripts
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // SHA-256 of the up script
+}
+
+// Status describes a migration known from the files, the database or both
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	// Modified means the up script changed after it was applied
+	Modified bool
+	// Missing means the migration was applied but its files are gone,
+	// e.g. the database was migrated by a newer build
+	Missing bool
+}
+
+func (s Status) Applied() bool {
+	return s.AppliedAt != nil
+}
+
+type applied struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New reads the migrations from files. Every migration needs both an up and
+// a down script, and versions must be unique.
+func New(db *sql.DB, files fs.FS) (*Migrator, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, name := range names {
+		match := fileName.FindStringSubmatch(path.Base(name))
+		if match == nil {
+			return nil, fmt.Errorf("migration file %s is not named <version>_<name>.(up|down).sql", name)
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration file %s: %w", name, err)
+		}
+		content, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			sum := sha256.Sum256(content)
+			m.Up = string(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrator := &Migrator{db: db}
+	for _, m := range byVersion {
+		if m.Checksum == "" {
+			return nil, fmt.Errorf("migration %s has no up script", m)
+		}
+		if m.Down == "" {
+			return nil, fmt.Errorf("migration %s has no down script", m)
+		}
+		migrator.migrations = append(migrator.migrations, *m)
+	}
+	sort.Slice(migrator.migrations, func(i, j int) bool {
+		return migrator.migrations[i].Version < migrator.migrations[j].Version
+	})
+	return migrator, nil
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Up applies every pending migration in version order and returns them
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		state, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if a, ok := state[migration.Version]; ok && a.checksum != migration.Checksum {
+				return fmt.Errorf("migration %s was changed after it was applied; add a new migration instead", migration)
+			}
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := state[migration.Version]; ok {
+				continue
+			}
+			if err := run(ctx, conn, migration.Up, recordApplied, migration.Version, migration.Name, migration.Checksum); err != nil {
+				return fmt.Errorf("migration %s failed: %w", migration, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down rolls back the most recently applied migration and returns it
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var last Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		var err error
+		last, err = m.down(ctx, conn)
+		return err
+	})
+	return last, err
+}
+
+// Redo rolls back the most recently applied migration and applies it again,
+// which is handy while writing a migration
+func (m *Migrator) Redo(ctx context.Context) (Migration, error) {
+	var last Migration
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		var err error
+		if last, err = m.down(ctx, conn); err != nil {
+			return err
+		}
+		if err := run(ctx, conn, last.Up, recordApplied, last.Version, last.Name, last.Checksum); err != nil {
+			return fmt.Errorf("migration %s failed: %w", last, err)
+		}
+		return nil
+	})
+	return last, err
+}
+
+// Status lists every migration in version order with whether it is applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		state, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name}
+			if a, ok := state[migration.Version]; ok {
+				status.AppliedAt = &a.appliedAt
+				status.Modified = a.checksum != migration.Checksum
+				delete(state, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+		for version, a := range state {
+			appliedAt := a.appliedAt
+			statuses = append(statuses, Status{Version: version, Name: a.name, AppliedAt: &appliedAt, Missing: true})
+		}
+		return nil
+	})
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, err
+}
+
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn) (Migration, error) {
+	var version int64
+	err := conn.QueryRowContext(ctx, `SELECT version FROM schema_migrations ORDER BY version DESC LIMIT 1`).Scan(&version)
+	if err == sql.ErrNoRows {
+		return Migration{}, ErrNothingToRollBack
+	}
+	if err != nil {
+		return Migration{}, err
+	}
+
+	for _, migration := range m.migrations {
+		if migration.Version != version {
+			continue
+		}
+		if err := run(ctx, conn, migration.Down, `DELETE FROM schema_migrations WHERE version = $1`, version); err != nil {
+			return migration, fmt.Errorf("rolling back migration %s failed: %w", migration, err)
+		}
+		return migration, nil
+	}
+	return Migration{}, fmt.Errorf("migration %d is applied but has no files to roll it back", version)
+}
+
+// locked runs fn on a connection holding the migration lock, after making
+// sure schema_migrations exists
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	// Advisory locks belong to a session, so everything runs on one connection
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("failed to acquire the migration lock: %w", err)
+	}
+	// Unlocked even when ctx is done, the connection goes back to the pool
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey)
+
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]applied, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	state := make(map[int64]applied)
+	for rows.Next() {
+		var version int64
+		var a applied
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		state[version] = a
+	}
+	return state, rows.Err()
+}
+
+// run executes a migration script and the statement recording it in one transaction
+func run(ctx context.Context, conn *sql.Conn, script, record string, args ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Without arguments lib/pq uses the simple query protocol, which accepts
+	// several statements at once
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
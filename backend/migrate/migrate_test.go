@@ -0,0 +1,435 @@
+package migrate_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"zplus_web/backend/migrate"
+)
+
+// files builds a migrations directory from file names and contents
+func files(scripts map[string]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for name, content := range scripts {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return fsys
+}
+
+func checksum(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		versions []string
+		wantErr  string
+	}{
+		{
+			name: "sorted by version",
+			files: map[string]string{
+				"0010_tags.up.sql":     "CREATE TABLE tags ()",
+				"0010_tags.down.sql":   "DROP TABLE tags",
+				"0002_orders.up.sql":   "CREATE TABLE orders ()",
+				"0002_orders.down.sql": "DROP TABLE orders",
+				"0001_users.up.sql":    "CREATE TABLE users ()",
+				"0001_users.down.sql":  "DROP TABLE users",
+				"README.md":            "not a migration",
+			},
+			versions: []string{"0001_users", "0002_orders", "0010_tags"},
+		},
+		{
+			name:  "no migrations",
+			files: map[string]string{},
+		},
+		{
+			name:    "badly named",
+			files:   map[string]string{"0001-users.up.sql": "", "0001-users.down.sql": ""},
+			wantErr: "is not named",
+		},
+		{
+			name:    "version out of range",
+			files:   map[string]string{"99999999999999999999_users.up.sql": "", "99999999999999999999_users.down.sql": ""},
+			wantErr: "value out of range",
+		},
+		{
+			name: "duplicate version",
+			files: map[string]string{
+				"0001_users.up.sql":    "CREATE TABLE users ()",
+				"0001_users.down.sql":  "DROP TABLE users",
+				"0001_orders.up.sql":   "CREATE TABLE orders ()",
+				"0001_orders.down.sql": "DROP TABLE orders",
+			},
+			wantErr: "migration version 1 is used by both",
+		},
+		{
+			name:    "missing down script",
+			files:   map[string]string{"0001_users.up.sql": "CREATE TABLE users ()"},
+			wantErr: "migration 0001_users has no down script",
+		},
+		{
+			name:    "missing up script",
+			files:   map[string]string{"0001_users.down.sql": "DROP TABLE users"},
+			wantErr: "migration 0001_users has no up script",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDB(t)
+			m, err := migrate.New(db.sql, files(tt.files))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("New = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			done, err := m.Up(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var versions []string
+			for _, migration := range done {
+				versions = append(versions, migration.String())
+				if migration.Up != tt.files[migration.String()+".up.sql"] || migration.Down != tt.files[migration.String()+".down.sql"] {
+					t.Errorf("%s has scripts %q and %q", migration, migration.Up, migration.Down)
+				}
+				if migration.Checksum != checksum(migration.Up) {
+					t.Errorf("%s has checksum %s", migration, migration.Checksum)
+				}
+			}
+			if !reflect.DeepEqual(versions, tt.versions) {
+				t.Errorf("applied %v, want %v", versions, tt.versions)
+			}
+		})
+	}
+}
+
+var scripts = map[string]string{
+	"0001_users.up.sql":    "CREATE TABLE users ()",
+	"0001_users.down.sql":  "DROP TABLE users",
+	"0002_orders.up.sql":   "CREATE TABLE orders ()",
+	"0002_orders.down.sql": "DROP TABLE orders",
+}
+
+func TestUpDownRedo(t *testing.T) {
+	ctx := t.Context()
+	db := newFakeDB(t)
+	m, err := migrate.New(db.sql, files(scripts))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Down(ctx); !errors.Is(err, migrate.ErrNothingToRollBack) {
+		t.Errorf("Down on an empty database = %v", err)
+	}
+
+	done, err := m.Up(ctx)
+	if err != nil || len(done) != 2 {
+		t.Fatalf("Up = %v, %v", done, err)
+	}
+	db.expect(t, "first Up", []int64{1, 2}, "CREATE TABLE users ()", "CREATE TABLE orders ()")
+
+	if done, err := m.Up(ctx); err != nil || len(done) != 0 {
+		t.Errorf("Up when up to date = %v, %v", done, err)
+	}
+	db.expect(t, "second Up", []int64{1, 2})
+
+	last, err := m.Down(ctx)
+	if err != nil || last.Version != 2 {
+		t.Fatalf("Down = %v, %v", last, err)
+	}
+	db.expect(t, "Down", []int64{1}, "DROP TABLE orders")
+
+	last, err = m.Redo(ctx)
+	if err != nil || last.Version != 1 {
+		t.Fatalf("Redo = %v, %v", last, err)
+	}
+	db.expect(t, "Redo", []int64{1}, "DROP TABLE users", "CREATE TABLE users ()")
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 || !statuses[0].Applied() || statuses[1].Applied() {
+		t.Errorf("Status = %+v", statuses)
+	}
+
+	if _, err := m.Down(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Redo(ctx); !errors.Is(err, migrate.ErrNothingToRollBack) {
+		t.Errorf("Redo on an empty database = %v", err)
+	}
+	db.expect(t, "last Down", nil, "DROP TABLE users")
+}
+
+func TestUpStopsAtFailure(t *testing.T) {
+	db := newFakeDB(t)
+	m, err := migrate.New(db.sql, files(map[string]string{
+		"0001_users.up.sql":    "CREATE TABLE users ()",
+		"0001_users.down.sql":  "DROP TABLE users",
+		"0002_orders.up.sql":   "FAIL",
+		"0002_orders.down.sql": "DROP TABLE orders",
+		"0003_tags.up.sql":     "CREATE TABLE tags ()",
+		"0003_tags.down.sql":   "DROP TABLE tags",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done, err := m.Up(t.Context())
+	if err == nil || !strings.Contains(err.Error(), "migration 0002_orders failed") {
+		t.Errorf("Up = %v", err)
+	}
+	if len(done) != 1 || done[0].Version != 1 {
+		t.Errorf("Up applied %v", done)
+	}
+	// The failed migration's transaction is rolled back, and later ones never run
+	db.expect(t, "failed Up", []int64{1}, "CREATE TABLE users ()")
+}
+
+func TestChecksums(t *testing.T) {
+	ctx := t.Context()
+	db := newFakeDB(t)
+	m, err := migrate.New(db.sql, files(scripts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	db.expect(t, "Up", []int64{1, 2}, "CREATE TABLE users ()", "CREATE TABLE orders ()")
+
+	// An applied migration edited afterwards, next to a new one
+	changed := files(scripts)
+	changed["0001_users.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE users (id INT)")}
+	changed["0003_tags.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE tags ()")}
+	changed["0003_tags.down.sql"] = &fstest.MapFile{Data: []byte("DROP TABLE tags")}
+	m, err = migrate.New(db.sql, changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Up(ctx); err == nil || !strings.Contains(err.Error(), "migration 0001_users was changed after it was applied") {
+		t.Errorf("Up with a changed migration = %v", err)
+	}
+	db.expect(t, "refused Up", []int64{1, 2})
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ applied, modified bool }{{true, true}, {true, false}, {false, false}}
+	for i, status := range statuses {
+		if status.Applied() != want[i].applied || status.Modified != want[i].modified {
+			t.Errorf("status of %d = %+v", status.Version, status)
+		}
+	}
+}
+
+func TestStatusMissing(t *testing.T) {
+	ctx := t.Context()
+	db := newFakeDB(t)
+	m, err := migrate.New(db.sql, files(scripts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	db.expect(t, "Up", []int64{1, 2}, "CREATE TABLE users ()", "CREATE TABLE orders ()")
+
+	// An older build that only knows the first migration
+	older := files(scripts)
+	delete(older, "0002_orders.up.sql")
+	delete(older, "0002_orders.down.sql")
+	m, err = migrate.New(db.sql, older)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 || statuses[0].Missing || !statuses[1].Missing || statuses[1].Name != "orders" {
+		t.Errorf("Status = %+v", statuses)
+	}
+	if _, err := m.Down(ctx); err == nil || !strings.Contains(err.Error(), "has no files to roll it back") {
+		t.Errorf("Down of a missing migration = %v", err)
+	}
+	db.expect(t, "refused Down", []int64{1, 2})
+}
+
+// fakeDB is a database/sql driver that understands the statements the
+// migrator sends, keeps schema_migrations in memory and logs every script
+// it runs. Scripts containing FAIL fail.
+type fakeDB struct {
+	sql *sql.DB
+
+	mu      sync.Mutex
+	applied map[int64][2]string // name, checksum
+	scripts []string
+	locks   int
+}
+
+func newFakeDB(t *testing.T) *fakeDB {
+	db := &fakeDB{applied: make(map[int64][2]string)}
+	db.sql = sql.OpenDB(db)
+	t.Cleanup(func() { db.sql.Close() })
+	return db
+}
+
+// expect checks the applied versions and the scripts run since the last call
+func (db *fakeDB) expect(t *testing.T, step string, versions []int64, scripts ...string) {
+	t.Helper()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var applied []int64
+	for version := range db.applied {
+		applied = append(applied, version)
+	}
+	sort.Slice(applied, func(i, j int) bool { return applied[i] < applied[j] })
+	if !reflect.DeepEqual(applied, versions) {
+		t.Errorf("after %s applied = %v, want %v", step, applied, versions)
+	}
+	if !reflect.DeepEqual(db.scripts, scripts) {
+		t.Errorf("%s ran %q, want %q", step, db.scripts, scripts)
+	}
+	if db.locks != 0 {
+		t.Errorf("%s left the migration lock held", step)
+	}
+	db.scripts = nil
+}
+
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: db}, nil }
+func (db *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	db *fakeDB
+	// Changes made in the open transaction, applied on commit
+	pending []func()
+	inTx    bool
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.inTx = true
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	c.db.mu.Lock()
+	for _, change := range c.pending {
+		change()
+	}
+	c.db.mu.Unlock()
+	c.pending, c.inTx = nil, false
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.pending, c.inTx = nil, false
+	return nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	var change func()
+	switch {
+	case strings.HasPrefix(query, "SELECT pg_advisory_lock"):
+		change = func() { c.db.locks++ }
+	case strings.HasPrefix(query, "SELECT pg_advisory_unlock"):
+		change = func() { c.db.locks-- }
+	case strings.Contains(query, "CREATE TABLE IF NOT EXISTS schema_migrations"):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "INSERT INTO schema_migrations"):
+		version := args[0].Value.(int64)
+		record := [2]string{args[1].Value.(string), args[2].Value.(string)}
+		change = func() { c.db.applied[version] = record }
+	case strings.HasPrefix(query, "DELETE FROM schema_migrations"):
+		version := args[0].Value.(int64)
+		change = func() { delete(c.db.applied, version) }
+	case strings.Contains(query, "FAIL"):
+		return nil, errors.New("syntax error at or near \"FAIL\"")
+	default:
+		change = func() { c.db.scripts = append(c.db.scripts, query) }
+	}
+
+	if c.inTx {
+		c.pending = append(c.pending, change)
+	} else {
+		c.db.mu.Lock()
+		change()
+		c.db.mu.Unlock()
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	rows := &fakeRows{}
+	switch {
+	case strings.HasPrefix(query, "SELECT version, name, checksum, applied_at"):
+		rows.columns = []string{"version", "name", "checksum", "applied_at"}
+		for version, record := range c.db.applied {
+			rows.values = append(rows.values, []driver.Value{version, record[0], record[1], time.Now()})
+		}
+	case strings.HasPrefix(query, "SELECT version FROM schema_migrations ORDER BY version DESC"):
+		rows.columns = []string{"version"}
+		var last int64
+		for version := range c.db.applied {
+			last = max(last, version)
+		}
+		if last != 0 {
+			rows.values = append(rows.values, []driver.Value{last})
+		}
+	default:
+		return nil, errors.New("unexpected query " + query)
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
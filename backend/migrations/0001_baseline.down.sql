@@ -0,0 +1,35 @@
+-- Drops the whole schema, in reverse order of creation
+DROP TABLE IF EXISTS content_sync_logs;
+DROP TABLE IF EXISTS wordpress_sites;
+DROP TABLE IF EXISTS customer_downloads;
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS point_transactions;
+DROP TABLE IF EXISTS customer_points;
+DROP TABLE IF EXISTS wallet_transactions;
+DROP TABLE IF EXISTS customer_wallets;
+DROP TABLE IF EXISTS product_files;
+DROP TABLE IF EXISTS software_products;
+DROP TABLE IF EXISTS product_categories;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS blog_post_categories;
+DROP TABLE IF EXISTS blog_posts;
+DROP TABLE IF EXISTS blog_categories;
+DROP TABLE IF EXISTS app_settings;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+DROP TABLE IF EXISTS audit_events;
+DROP TABLE IF EXISTS data_exports;
+DROP TABLE IF EXISTS login_lockout_events;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS user_mfa;
+DROP TABLE IF EXISTS password_history;
+DROP TABLE IF EXISTS magic_link_tokens;
+DROP TABLE IF EXISTS invitations;
+DROP TABLE IF EXISTS email_verification_tokens;
+DROP TABLE IF EXISTS password_reset_tokens;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS user_sessions;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,495 @@
+-- Baseline schema, formerly init.sql. Every statement is idempotent so it can
+-- also be recorded against databases that were created from init.sql.
+
+-- 1. Users table (enhanced)
+CREATE TABLE IF NOT EXISTS users (
+    id SERIAL PRIMARY KEY,
+    username VARCHAR(50) UNIQUE NOT NULL,
+    email VARCHAR(100) UNIQUE NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    role VARCHAR(50) DEFAULT 'user', -- name of a row in roles
+    full_name VARCHAR(100),
+    phone VARCHAR(20),
+    avatar_url VARCHAR(255),
+    is_active BOOLEAN DEFAULT true,
+    email_verified BOOLEAN DEFAULT false,
+    is_service_account BOOLEAN DEFAULT false, -- machine user, authenticates with API keys only
+    erased_at TIMESTAMP WITH TIME ZONE, -- personal data anonymized, the row is kept for accounting
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- User sessions (one row per login, i.e. per refresh token family)
+CREATE TABLE IF NOT EXISTS user_sessions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(255) UNIQUE NOT NULL, -- opaque session id, carried as "sid" in access tokens
+    user_agent VARCHAR(255),
+    ip_address VARCHAR(45),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    impersonator_id INTEGER REFERENCES users(id) ON DELETE CASCADE, -- staff member for "login as customer" sessions
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Rotating refresh tokens; a used token presented again revokes its whole session
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id SERIAL PRIMARY KEY,
+    session_id INTEGER REFERENCES user_sessions(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Social login identities (provider + subject is the account id at the provider)
+CREATE TABLE IF NOT EXISTS user_identities (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL, -- 'google', 'github', 'facebook', ...
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE(provider, subject)
+);
+
+-- API keys of service accounts (only the SHA-256 hash of a key is stored)
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL, -- shown in listings to tell keys apart
+    key_hash VARCHAR(64) UNIQUE NOT NULL,
+    scopes TEXT[] NOT NULL, -- permissions the key may use, see backend/rbac
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    last_used_ip VARCHAR(45),
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Password reset tokens (only the SHA-256 hash of the emailed token is stored)
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Email verification tokens (only the SHA-256 hash of the emailed token is stored)
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Staff invitations (only the SHA-256 hash of the emailed acceptance token is stored)
+CREATE TABLE IF NOT EXISTS invitations (
+    id SERIAL PRIMARY KEY,
+    email VARCHAR(100) NOT NULL,
+    role VARCHAR(50) NOT NULL, -- name of a row in roles
+    full_name VARCHAR(100),
+    token_hash VARCHAR(64) UNIQUE NOT NULL, -- replaced when the invitation is resent
+    invited_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    accepted_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    last_sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Passwordless login links (token and nonce hashes; the nonce binds a link to the requesting browser)
+CREATE TABLE IF NOT EXISTS magic_link_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    nonce_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Previous password hashes, so recent passwords cannot be reused
+CREATE TABLE IF NOT EXISTS password_history (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- TOTP second factor (enabled once the first code has been confirmed)
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    totp_secret VARCHAR(64) NOT NULL,
+    enabled BOOLEAN DEFAULT false,
+    last_used_step BIGINT DEFAULT 0,
+    enabled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Single-use 2FA recovery codes (only the SHA-256 hash is stored)
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Login lockout history (the live failure counters and locks are kept in Redis)
+CREATE TABLE IF NOT EXISTS login_lockout_events (
+    id SERIAL PRIMARY KEY,
+    subject_type VARCHAR(20) NOT NULL, -- 'account', 'ip'
+    subject VARCHAR(255) NOT NULL, -- email address or IP address
+    event VARCHAR(20) NOT NULL, -- 'locked', 'unlocked'
+    failures INTEGER DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL, -- admin who unlocked
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Personal data exports (GDPR / PDPD), the ZIP archive is kept until it expires
+CREATE TABLE IF NOT EXISTS data_exports (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'processing', -- 'processing', 'completed', 'failed'
+    error TEXT,
+    archive BYTEA,
+    size_bytes BIGINT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Audit trail of admin and security-sensitive actions
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    actor_email VARCHAR(100), -- kept when the actor is deleted
+    impersonator_id INTEGER REFERENCES users(id) ON DELETE SET NULL, -- set when the actor was impersonated
+    action VARCHAR(100) NOT NULL, -- e.g. 'user.role_change'
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(100),
+    before_data JSONB,
+    after_data JSONB,
+    ip_address VARCHAR(45),
+    user_agent TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Roles and the permissions they grant (see backend/rbac for permission names)
+CREATE TABLE IF NOT EXISTS roles (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) UNIQUE NOT NULL,
+    description TEXT,
+    is_system BOOLEAN DEFAULT false, -- built-in roles cannot be deleted
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INTEGER REFERENCES roles(id) ON DELETE CASCADE,
+    permission VARCHAR(100) NOT NULL, -- '<resource>:<action>', '<resource>:*' or '*'
+    PRIMARY KEY (role_id, permission)
+);
+
+-- Admin-managed application settings
+CREATE TABLE IF NOT EXISTS app_settings (
+    key VARCHAR(100) PRIMARY KEY,
+    value TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 2. Blog Management
+CREATE TABLE IF NOT EXISTS blog_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS blog_posts (
+    id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    content TEXT NOT NULL,
+    excerpt TEXT,
+    featured_image VARCHAR(255),
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) DEFAULT 'draft', -- 'draft', 'published', 'private'
+    is_featured BOOLEAN DEFAULT false,
+    view_count INTEGER DEFAULT 0,
+    published_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS blog_post_categories (
+    post_id INTEGER REFERENCES blog_posts(id) ON DELETE CASCADE,
+    category_id INTEGER REFERENCES blog_categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (post_id, category_id)
+);
+
+-- 3. Project Management
+CREATE TABLE IF NOT EXISTS projects (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    description TEXT NOT NULL,
+    short_description VARCHAR(500),
+    featured_image VARCHAR(255),
+    gallery_images TEXT[], -- Array of image URLs
+    technologies TEXT[], -- Array of technology names
+    project_url VARCHAR(255),
+    github_url VARCHAR(255),
+    demo_url VARCHAR(255),
+    status VARCHAR(20) DEFAULT 'development', -- 'planning', 'development', 'completed', 'maintenance'
+    start_date DATE,
+    end_date DATE,
+    is_featured BOOLEAN DEFAULT false,
+    sort_order INTEGER DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 4. Product Categories (must be created before software_products)
+CREATE TABLE IF NOT EXISTS product_categories (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT,
+    parent_id INTEGER REFERENCES product_categories(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 5. Software Products & Market
+CREATE TABLE IF NOT EXISTS software_products (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    description TEXT NOT NULL,
+    short_description VARCHAR(500),
+    featured_image VARCHAR(255),
+    gallery_images TEXT[],
+    price DECIMAL(10,2) NOT NULL,
+    discount_price DECIMAL(10,2),
+    version VARCHAR(50),
+    requirements TEXT,
+    features TEXT[],
+    category_id INTEGER REFERENCES product_categories(id),
+    download_url VARCHAR(255),
+    file_size BIGINT, -- in bytes
+    download_count INTEGER DEFAULT 0,
+    is_active BOOLEAN DEFAULT true,
+    is_featured BOOLEAN DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS product_files (
+    id SERIAL PRIMARY KEY,
+    product_id INTEGER REFERENCES software_products(id) ON DELETE CASCADE,
+    filename VARCHAR(255) NOT NULL,
+    original_filename VARCHAR(255) NOT NULL,
+    file_path VARCHAR(500) NOT NULL,
+    file_size BIGINT NOT NULL,
+    file_type VARCHAR(50),
+    is_main BOOLEAN DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 6. Customer Management
+CREATE TABLE IF NOT EXISTS customer_wallets (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER UNIQUE REFERENCES users(id) ON DELETE RESTRICT, -- financial records outlive the account, see user erasure
+    balance DECIMAL(10,2) DEFAULT 0.00,
+    total_deposited DECIMAL(10,2) DEFAULT 0.00,
+    total_spent DECIMAL(10,2) DEFAULT 0.00,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS wallet_transactions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE RESTRICT,
+    transaction_type VARCHAR(20) NOT NULL, -- 'deposit', 'purchase', 'refund'
+    amount DECIMAL(10,2) NOT NULL,
+    balance_after DECIMAL(10,2) NOT NULL,
+    description TEXT,
+    reference_id VARCHAR(100), -- order_id, payment_id, etc.
+    status VARCHAR(20) DEFAULT 'completed', -- 'pending', 'completed', 'failed'
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS customer_points (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER UNIQUE REFERENCES users(id) ON DELETE RESTRICT,
+    total_points INTEGER DEFAULT 0,
+    available_points INTEGER DEFAULT 0,
+    used_points INTEGER DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS point_transactions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE RESTRICT,
+    points INTEGER NOT NULL,
+    transaction_type VARCHAR(20) NOT NULL, -- 'earned', 'used', 'expired'
+    reason VARCHAR(255),
+    reference_id VARCHAR(100),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 7. Orders & Payments
+CREATE TABLE IF NOT EXISTS orders (
+    id SERIAL PRIMARY KEY,
+    order_number VARCHAR(50) UNIQUE NOT NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    total_amount DECIMAL(10,2) NOT NULL,
+    discount_amount DECIMAL(10,2) DEFAULT 0.00,
+    final_amount DECIMAL(10,2) NOT NULL,
+    payment_method VARCHAR(50),
+    payment_status VARCHAR(20) DEFAULT 'pending', -- 'pending', 'paid', 'failed', 'refunded'
+    order_status VARCHAR(20) DEFAULT 'processing', -- 'processing', 'completed', 'cancelled'
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_items (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER REFERENCES orders(id) ON DELETE CASCADE,
+    product_id INTEGER REFERENCES software_products(id) ON DELETE SET NULL,
+    product_name VARCHAR(255) NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    quantity INTEGER DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS customer_downloads (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    product_id INTEGER REFERENCES software_products(id) ON DELETE CASCADE,
+    order_id INTEGER REFERENCES orders(id) ON DELETE SET NULL,
+    download_token VARCHAR(255) UNIQUE NOT NULL,
+    download_count INTEGER DEFAULT 0,
+    max_downloads INTEGER DEFAULT 5,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_downloaded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 8. Content Management (WordPress Integration)
+CREATE TABLE IF NOT EXISTS wordpress_sites (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    url VARCHAR(255) NOT NULL,
+    api_endpoint VARCHAR(255) NOT NULL,
+    username VARCHAR(100),
+    application_password VARCHAR(255),
+    is_active BOOLEAN DEFAULT true,
+    last_sync_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS content_sync_logs (
+    id SERIAL PRIMARY KEY,
+    site_id INTEGER REFERENCES wordpress_sites(id) ON DELETE CASCADE,
+    sync_type VARCHAR(50) NOT NULL, -- 'post_create', 'post_update', 'post_delete'
+    local_content_id INTEGER,
+    remote_content_id INTEGER,
+    status VARCHAR(20) DEFAULT 'pending', -- 'pending', 'success', 'failed'
+    error_message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for performance
+CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role);
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id ON user_sessions(user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON email_verification_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_magic_link_tokens_user_id ON magic_link_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_password_history_user_id ON password_history(user_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invitations_pending_email ON invitations(email) WHERE accepted_at IS NULL AND revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON mfa_recovery_codes(user_id);
+CREATE INDEX IF NOT EXISTS idx_login_lockout_events_created_at ON login_lockout_events(created_at);
+CREATE INDEX IF NOT EXISTS idx_data_exports_user_id ON data_exports(user_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events(actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_blog_posts_status ON blog_posts(status);
+CREATE INDEX IF NOT EXISTS idx_blog_posts_published_at ON blog_posts(published_at);
+CREATE INDEX IF NOT EXISTS idx_projects_status ON projects(status);
+CREATE INDEX IF NOT EXISTS idx_software_products_active ON software_products(is_active);
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(user_id);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders(order_status);
+CREATE INDEX IF NOT EXISTS idx_wallet_transactions_user_id ON wallet_transactions(user_id);
+CREATE INDEX IF NOT EXISTS idx_point_transactions_user_id ON point_transactions(user_id);
+
+-- Insert initial data
+INSERT INTO roles (name, description, is_system) VALUES
+('admin', 'Full access to everything', true),
+('user', 'Registered customer without admin access', true),
+('editor', 'Writes and publishes blog posts and projects', true),
+('shop_manager', 'Handles orders and refunds', true),
+('support', 'Helps customers with their accounts and orders', true),
+('accountant', 'Reviews orders and adjusts wallets', true)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+JOIN (VALUES
+    ('admin', '*'),
+    ('editor', 'dashboard:view'),
+    ('editor', 'blog:write'),
+    ('editor', 'blog:publish'),
+    ('editor', 'projects:write'),
+    ('shop_manager', 'dashboard:view'),
+    ('shop_manager', 'orders:read'),
+    ('shop_manager', 'orders:refund'),
+    ('support', 'dashboard:view'),
+    ('support', 'users:read'),
+    ('support', 'users:impersonate'),
+    ('support', 'orders:read'),
+    ('support', 'security:manage'),
+    ('accountant', 'dashboard:view'),
+    ('accountant', 'orders:read'),
+    ('accountant', 'wallet:adjust')
+) AS p(role, permission) ON p.role = r.name
+ON CONFLICT DO NOTHING;
+
+-- Older databases used 'customer' for regular users
+UPDATE users SET role = 'user' WHERE role = 'customer';
+
+INSERT INTO users (username, email, password_hash, role, full_name, is_active, email_verified) 
+VALUES ('admin', 'admin@zplus.com', '$2a$10$example.hash.here', 'admin', 'System Administrator', true, true)
+ON CONFLICT (email) DO NOTHING;
+
+INSERT INTO blog_categories (name, slug, description) VALUES
+('Company News', 'company-news', 'News and updates about ZPlus'),
+('Technology', 'technology', 'Technical articles and insights'),
+('Projects', 'projects', 'Project showcases and case studies')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO product_categories (name, slug, description) VALUES
+('Web Applications', 'web-applications', 'Web-based software solutions'),
+('Mobile Apps', 'mobile-apps', 'Mobile application solutions'),
+('Desktop Software', 'desktop-software', 'Desktop application solutions')
+ON CONFLICT (slug) DO NOTHING;
\ No newline at end of file
@@ -0,0 +1 @@
+-- Nothing to undo: the columns and constraints belong to the baseline schema
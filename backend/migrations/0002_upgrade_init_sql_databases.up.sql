@@ -0,0 +1,27 @@
+-- Databases created from the first init.sql kept their old users and
+-- user_sessions tables, because the baseline only creates missing tables.
+-- Bring those tables up to the baseline; on newer databases this is a no-op.
+
+ALTER TABLE users ALTER COLUMN role TYPE VARCHAR(50);
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'user';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_service_account BOOLEAN DEFAULT false;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS user_agent VARCHAR(255);
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45);
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE user_sessions ADD COLUMN IF NOT EXISTS impersonator_id INTEGER REFERENCES users(id) ON DELETE CASCADE;
+
+-- Financial records outlive the account, see user erasure
+ALTER TABLE customer_wallets DROP CONSTRAINT IF EXISTS customer_wallets_user_id_fkey;
+ALTER TABLE customer_wallets ADD CONSTRAINT customer_wallets_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
+ALTER TABLE wallet_transactions DROP CONSTRAINT IF EXISTS wallet_transactions_user_id_fkey;
+ALTER TABLE wallet_transactions ADD CONSTRAINT wallet_transactions_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
+ALTER TABLE customer_points DROP CONSTRAINT IF EXISTS customer_points_user_id_fkey;
+ALTER TABLE customer_points ADD CONSTRAINT customer_points_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
+ALTER TABLE point_transactions DROP CONSTRAINT IF EXISTS point_transactions_user_id_fkey;
+ALTER TABLE point_transactions ADD CONSTRAINT point_transactions_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
@@ -0,0 +1,6 @@
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES ('security:manage'), ('users:impersonate')) AS p(permission)
+WHERE r.name = 'support'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+-- The baseline seeded the support role with security:manage and
+-- users:impersonate, which let it reset admins' second factor and act as
+-- customers. Support only needs to look up accounts and orders; grant the
+-- other two to a custom role where they are wanted.
+
+DELETE FROM role_permissions
+WHERE permission IN ('security:manage', 'users:impersonate')
+  AND role_id = (SELECT id FROM roles WHERE name = 'support');
@@ -0,0 +1,15 @@
+// Package migrations holds the SQL migrations of the database schema, applied
+// in version order by the migrate package.
+//
+// Each migration is a pair of files, <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Add a new pair with the next version for every
+// schema change; a migration must not be edited once it has been applied
+// anywhere, its checksum is recorded in schema_migrations.
+package migrations
+
+import "embed"
+
+// Files contains the migration files, compiled into the binary
+//
+//go:embed *.sql
+var Files embed.FS
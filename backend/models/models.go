@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 	"github.com/lib/pq"
 )
@@ -21,13 +22,177 @@ type User struct {
 	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// Role is a named set of permissions assigned to users through users.role
+type Role struct {
+	ID          int       `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	Description *string   `json:"description,omitempty" db:"description"`
+	IsSystem    bool      `json:"is_system" db:"is_system"`
+	Permissions []string  `json:"permissions" db:"-"`
+	UsersCount  int       `json:"users_count" db:"-"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
 // UserSession represents a user login session
 type UserSession struct {
-	ID        int       `json:"id" db:"id"`
-	UserID    int       `json:"user_id" db:"user_id"`
-	Token     string    `json:"token" db:"token"`
-	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	ID         int       `json:"id" db:"id"`
+	UserID     int       `json:"user_id" db:"user_id"`
+	Token      string    `json:"-" db:"token"`
+	UserAgent  *string   `json:"user_agent,omitempty" db:"user_agent"`
+	IPAddress  *string   `json:"ip_address,omitempty" db:"ip_address"`
+	ExpiresAt  time.Time `json:"expires_at" db:"expires_at"`
+	LastUsedAt time.Time `json:"last_used_at" db:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	Current    bool      `json:"current" db:"-"`
+}
+
+// AuthTokens is the access/refresh token pair issued at login and on refresh
+type AuthTokens struct {
+	AccessToken  string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// UserIdentity links a user to an account at a social login provider
+type UserIdentity struct {
+	ID          int        `json:"id" db:"id"`
+	UserID      int        `json:"user_id" db:"user_id"`
+	Provider    string     `json:"provider" db:"provider"`
+	Subject     string     `json:"-" db:"subject"`
+	Email       *string    `json:"email,omitempty" db:"email"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty" db:"last_login_at"`
+}
+
+// PasswordResetToken represents a single-use password reset request
+type PasswordResetToken struct {
+	ID        int        `json:"id" db:"id"`
+	UserID    int        `json:"user_id" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// MFAStatus describes a user's two-factor authentication state
+type MFAStatus struct {
+	Enabled bool `json:"enabled"`
+	// Enforced is set when policy requires 2FA for the user's role
+	Enforced bool `json:"enforced"`
+	// Required is set when logging in needs the second step (enabled, or enforced and still to enroll)
+	Required               bool `json:"required"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+// MFAEnrollment is the TOTP secret handed to an authenticator app
+type MFAEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// SecuritySettings are the admin-managed security policies
+type SecuritySettings struct {
+	RequireAdmin2FA bool `json:"require_admin_2fa"`
+}
+
+// LoginLockoutEvent records an account or IP address being locked out of login, or unlocked by an admin
+type LoginLockoutEvent struct {
+	ID          int        `json:"id" db:"id"`
+	SubjectType string     `json:"subject_type" db:"subject_type"`
+	Subject     string     `json:"subject" db:"subject"`
+	Event       string     `json:"event" db:"event"`
+	Failures    int        `json:"failures" db:"failures"`
+	LockedUntil *time.Time `json:"locked_until,omitempty" db:"locked_until"`
+	ActorID     *int       `json:"actor_id,omitempty" db:"actor_id"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// APIKey is a credential that lets a service account call the API without
+// logging in. Only a hash of the key is stored; Prefix identifies it in lists.
+type APIKey struct {
+	ID         int            `json:"id" db:"id"`
+	UserID     int            `json:"user_id" db:"user_id"`
+	Name       string         `json:"name" db:"name"`
+	Prefix     string         `json:"prefix" db:"prefix"`
+	Scopes     pq.StringArray `json:"scopes" db:"scopes"`
+	ExpiresAt  *time.Time     `json:"expires_at,omitempty" db:"expires_at"`
+	LastUsedAt *time.Time     `json:"last_used_at,omitempty" db:"last_used_at"`
+	LastUsedIP *string        `json:"last_used_ip,omitempty" db:"last_used_ip"`
+	RevokedAt  *time.Time     `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedBy  *int           `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+}
+
+// APIKeyPrincipal is the service account and scopes behind a valid API key
+type APIKeyPrincipal struct {
+	KeyID  int
+	Scopes []string
+	User   User
+}
+
+// AuditEvent records an admin or security-sensitive change: who made it, to
+// what, and the entity's state before and after
+type AuditEvent struct {
+	ID             int64           `json:"id" db:"id"`
+	ActorID        *int            `json:"actor_id,omitempty" db:"actor_id"`
+	ActorEmail     *string         `json:"actor_email,omitempty" db:"actor_email"`
+	ImpersonatorID *int            `json:"impersonator_id,omitempty" db:"impersonator_id"`
+	Action         string          `json:"action" db:"action"`
+	EntityType     string          `json:"entity_type" db:"entity_type"`
+	EntityID       *string         `json:"entity_id,omitempty" db:"entity_id"`
+	Before         json.RawMessage `json:"before,omitempty" db:"before_data"`
+	After          json.RawMessage `json:"after,omitempty" db:"after_data"`
+	IPAddress      *string         `json:"ip_address,omitempty" db:"ip_address"`
+	UserAgent      *string         `json:"user_agent,omitempty" db:"user_agent"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+}
+
+// Invitation invites a staff member by email; the invitee sets their own password when accepting
+type Invitation struct {
+	ID         int        `json:"id" db:"id"`
+	Email      string     `json:"email" db:"email"`
+	Role       string     `json:"role" db:"role"`
+	FullName   *string    `json:"full_name,omitempty" db:"full_name"`
+	InvitedBy  *int       `json:"invited_by,omitempty" db:"invited_by"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty" db:"accepted_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	LastSentAt time.Time  `json:"last_sent_at" db:"last_sent_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// DataExport is a user's request for a copy of their personal data, delivered as a ZIP of JSON files
+type DataExport struct {
+	ID          int        `json:"id" db:"id"`
+	UserID      int        `json:"user_id" db:"user_id"`
+	Status      string     `json:"status" db:"status"` // 'processing', 'completed', 'failed'
+	Error       *string    `json:"error,omitempty" db:"error"`
+	SizeBytes   *int64     `json:"size_bytes,omitempty" db:"size_bytes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" db:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// AuditActor identifies who performed an audited action. UserID is nil for
+// unauthenticated callers such as payment gateway callbacks.
+type AuditActor struct {
+	UserID *int
+	// ImpersonatorID is the staff member acting as UserID, if any
+	ImpersonatorID *int
+	Email          string
+	IPAddress      string
+	UserAgent      string
+}
+
+// AuditFilter narrows down audit event queries; zero values match everything
+type AuditFilter struct {
+	ActorID    *int
+	Action     string
+	EntityType string
+	EntityID   string
+	From       *time.Time
+	To         *time.Time
 }
 
 // BlogCategory represents a blog post category
@@ -268,6 +433,44 @@ type ApiResponse struct {
 type ApiError struct {
 	Code    string `json:"code"`
 	Details string `json:"details"`
+	// Fields maps request fields to what is wrong with them
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Pagination is returned next to every paginated list
+type Pagination struct {
+	CurrentPage  int  `json:"current_page"`
+	TotalPages   int  `json:"total_pages"`
+	TotalItems   int  `json:"total_items"`
+	ItemsPerPage int  `json:"items_per_page"`
+	HasNext      bool `json:"has_next"`
+	HasPrev      bool `json:"has_prev"`
+}
+
+// LoginResponse is the data returned by the endpoints that log a user in.
+// When a second factor is still needed only the mfa_* fields and
+// enrollment_required are set, and the client continues at /auth/2fa/login.
+type LoginResponse struct {
+	Token              string     `json:"token,omitempty"`
+	RefreshToken       string     `json:"refresh_token,omitempty"`
+	ExpiresIn          int        `json:"expires_in"`
+	User               *LoginUser `json:"user,omitempty"`
+	MFARequired        bool       `json:"mfa_required,omitempty"`
+	MFAToken           string     `json:"mfa_token,omitempty"`
+	EnrollmentRequired bool       `json:"enrollment_required,omitempty"`
+	// Permissions is set by the admin login
+	Permissions []string `json:"permissions,omitempty"`
+	// RecoveryCodes is set when 2FA was enabled as part of the login
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// LoginUser is the summary of the user returned with a login
+type LoginUser struct {
+	ID       int     `json:"id"`
+	Username string  `json:"username"`
+	Email    string  `json:"email"`
+	Role     string  `json:"role"`
+	FullName *string `json:"full_name,omitempty"`
 }
 
 // Request types
@@ -279,7 +482,7 @@ type LoginRequest struct {
 type RegisterRequest struct {
 	Username string `json:"username" validate:"required,min=3,max=50"`
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Password string `json:"password" validate:"required,max=128"`
 	FullName string `json:"full_name" validate:"required,max=100"`
 	Phone    string `json:"phone,omitempty" validate:"max=20"`
 }
@@ -290,9 +493,184 @@ type UpdateProfileRequest struct {
 	AvatarURL string `json:"avatar_url,omitempty" validate:"url"`
 }
 
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,max=128"`
+}
+
+type MagicLinkRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type MagicLinkLoginRequest struct {
+	Token string `json:"token" validate:"required"`
+	Nonce string `json:"nonce" validate:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type OAuthCallbackRequest struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+}
+
+type RoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=2,max=50"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name     string `json:"name" validate:"required,min=3,max=40"`
+	FullName string `json:"full_name" validate:"max=100"`
+	Role     string `json:"role" validate:"required"`
+}
+
+type CreateAPIKeyRequest struct {
+	UserID    int        `json:"user_id" validate:"required"`
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type EraseAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+type CreateInvitationRequest struct {
+	Email         string `json:"email" validate:"required,email,max=100"`
+	Role          string `json:"role" validate:"required"`
+	FullName      string `json:"full_name" validate:"max=100"`
+	ExpiresInDays int    `json:"expires_in_days" validate:"omitempty,min=1,max=30"`
+}
+
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Username string `json:"username" validate:"required,min=3,max=50"`
+	Password string `json:"password" validate:"required,max=128"`
+	FullName string `json:"full_name" validate:"max=100"`
+}
+
+type ImpersonateRequest struct {
+	Reason string `json:"reason" validate:"required,min=5,max=500"`
+}
+
+type UnlockLoginRequest struct {
+	SubjectType string `json:"subject_type" validate:"required,oneof=account ip"`
+	Subject     string `json:"subject" validate:"required"`
+}
+
 type ChangePasswordRequest struct {
 	CurrentPassword string `json:"current_password" validate:"required"`
-	NewPassword     string `json:"new_password" validate:"required,min=6"`
+	NewPassword     string `json:"new_password" validate:"required,max=128"`
+}
+
+type CreatePostRequest struct {
+	Title         string `json:"title" validate:"required,max=255"`
+	Slug          string `json:"slug" validate:"required,max=255"`
+	Content       string `json:"content" validate:"required"`
+	Excerpt       string `json:"excerpt,omitempty"`
+	FeaturedImage string `json:"featured_image,omitempty"`
+	Status        string `json:"status" validate:"required,oneof=draft published private"`
+	IsFeatured    bool   `json:"is_featured"`
+	Categories    []int  `json:"categories"`
+}
+
+type UpdatePostRequest struct {
+	Title         string `json:"title" validate:"required,max=255"`
+	Slug          string `json:"slug" validate:"required,max=255"`
+	Content       string `json:"content" validate:"required"`
+	Excerpt       string `json:"excerpt,omitempty"`
+	FeaturedImage string `json:"featured_image,omitempty"`
+	Status        string `json:"status" validate:"required,oneof=draft published private"`
+	IsFeatured    bool   `json:"is_featured"`
+}
+
+type CreateCategoryRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Slug        string `json:"slug" validate:"required,max=100"`
+	Description string `json:"description,omitempty"`
+}
+
+type CreateWordPressSiteRequest struct {
+	Name                string  `json:"name" validate:"required,max=255"`
+	URL                 string  `json:"url" validate:"required,url"`
+	APIEndpoint         string  `json:"api_endpoint" validate:"required,url"`
+	Username            *string `json:"username,omitempty"`
+	ApplicationPassword *string `json:"application_password,omitempty"`
+	IsActive            bool    `json:"is_active"`
+}
+
+type DepositRequest struct {
+	Amount        float64 `json:"amount" validate:"required,min=1000"`
+	PaymentMethod string  `json:"payment_method" validate:"required,oneof=vnpay momo zalopay banking"`
+}
+
+type DepositCallbackRequest struct {
+	TransactionID int    `json:"transaction_id"`
+	Status        string `json:"status"`
+	ReferenceID   string `json:"reference_id"`
+	Signature     string `json:"signature"`
+}
+
+type CreateProjectRequest struct {
+	Name             string         `json:"name" validate:"required,max=255"`
+	Slug             string         `json:"slug" validate:"required,max=255"`
+	Description      string         `json:"description" validate:"required"`
+	ShortDescription *string        `json:"short_description,omitempty"`
+	FeaturedImage    *string        `json:"featured_image,omitempty"`
+	GalleryImages    pq.StringArray `json:"gallery_images,omitempty"`
+	Technologies     pq.StringArray `json:"technologies,omitempty"`
+	ProjectURL       *string        `json:"project_url,omitempty"`
+	GithubURL        *string        `json:"github_url,omitempty"`
+	DemoURL          *string        `json:"demo_url,omitempty"`
+	Status           string         `json:"status" validate:"required,oneof=planning development completed maintenance"`
+	StartDate        *time.Time     `json:"start_date,omitempty"`
+	EndDate          *time.Time     `json:"end_date,omitempty"`
+	IsFeatured       bool           `json:"is_featured"`
+	SortOrder        int            `json:"sort_order"`
+}
+
+type UpdateProjectRequest struct {
+	Name             string         `json:"name" validate:"required,max=255"`
+	Slug             string         `json:"slug" validate:"required,max=255"`
+	Description      string         `json:"description" validate:"required"`
+	ShortDescription *string        `json:"short_description,omitempty"`
+	FeaturedImage    *string        `json:"featured_image,omitempty"`
+	GalleryImages    pq.StringArray `json:"gallery_images,omitempty"`
+	Technologies     pq.StringArray `json:"technologies,omitempty"`
+	ProjectURL       *string        `json:"project_url,omitempty"`
+	GithubURL        *string        `json:"github_url,omitempty"`
+	DemoURL          *string        `json:"demo_url,omitempty"`
+	Status           string         `json:"status" validate:"required,oneof=planning development completed maintenance"`
+	StartDate        *time.Time     `json:"start_date,omitempty"`
+	EndDate          *time.Time     `json:"end_date,omitempty"`
+	IsFeatured       bool           `json:"is_featured"`
+	SortOrder        int            `json:"sort_order"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" validate:"required"`
+}
+
+type WordPressWebhookPayload struct {
+	Action string                 `json:"action"`
+	PostID int                    `json:"post_id"`
+	Post   map[string]interface{} `json:"post"`
 }
 
 // Dashboard statistics
@@ -0,0 +1,249 @@
+// Package openapi generates the OpenAPI 3.1 document of the REST API from
+// the routes in the registry, so the spec always matches what is mounted.
+// Request and response bodies are described from the models each route is
+// documented with (see routes.Route.Describe).
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"zplus_web/backend/models"
+	"zplus_web/backend/routes"
+)
+
+// Document is an OpenAPI 3.1 document
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Tags       []Tag                            `json:"tags,omitempty"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	Responses       map[string]*Response       `json:"responses"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	// Permission is the RBAC permission an admin route requires
+	Permission string `json:"x-permission,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+const (
+	jsonType     = "application/json"
+	securityName = "bearerAuth"
+)
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)\??`)
+
+// Generate builds the document for every route in the registry
+func Generate(info Info, routeList []routes.Route) *Document {
+	s := newSchemas()
+	apiResponse := s.of(models.ApiResponse{})
+
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info:    info,
+		Paths:   make(map[string]map[string]*Operation),
+		Components: Components{
+			Schemas:   s.components,
+			Responses: errorResponses(apiResponse),
+			SecuritySchemes: map[string]*SecurityScheme{
+				securityName: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "An access token from a login, or a service account API key (zpk_...)",
+				},
+			},
+		},
+	}
+
+	tags := make(map[string]bool)
+	operationIDs := make(map[string]bool)
+	for _, route := range routeList {
+		pkg, handler := handlerName(route.Handlers)
+		op := &Operation{
+			OperationID: pkg + handler,
+			Summary:     route.Summary,
+			Tags:        []string{pkg},
+			Parameters:  pathParameters(route.Path),
+			Responses:   make(map[string]*Response),
+		}
+		if op.Summary == "" {
+			op.Summary = handler
+		}
+		if operationIDs[op.OperationID] {
+			op.OperationID += title(route.Method)
+		}
+		operationIDs[op.OperationID] = true
+		tags[pkg] = true
+
+		switch route.Access {
+		case routes.Authenticated:
+			op.Security = []map[string][]string{{securityName: {}}}
+			op.Responses["401"] = &Response{Ref: "#/components/responses/Unauthorized"}
+		case routes.Admin:
+			op.Security = []map[string][]string{{securityName: {}}}
+			op.Permission = route.Permission
+			op.Description = fmt.Sprintf("Requires the `%s` permission.", route.Permission)
+			op.Responses["401"] = &Response{Ref: "#/components/responses/Unauthorized"}
+			op.Responses["403"] = &Response{Ref: "#/components/responses/Forbidden"}
+		}
+
+		if route.Request != nil {
+			op.RequestBody = &RequestBody{Required: true, Content: s.content(route.Request)}
+		}
+		op.Responses["200"] = s.response(route.Response, apiResponse)
+		op.Responses["default"] = &Response{Ref: "#/components/responses/Error"}
+
+		path := pathParam.ReplaceAllString(route.Path, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*Operation)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = op
+	}
+
+	for _, name := range sortedKeys(tags) {
+		doc.Tags = append(doc.Tags, Tag{Name: name})
+	}
+	return doc
+}
+
+// content describes a request body: JSON of the documented type unless the
+// route gave a routes.Content
+func (s *schemas) content(body interface{}) map[string]*MediaType {
+	if content, ok := body.(routes.Content); ok {
+		return map[string]*MediaType{content.Type: {Schema: s.of(content.Schema)}}
+	}
+	return map[string]*MediaType{jsonType: {Schema: s.of(body)}}
+}
+
+// response describes a successful response. JSON responses are wrapped in
+// models.ApiResponse with the documented type as data.
+func (s *schemas) response(body interface{}, apiResponse *Schema) *Response {
+	if content, ok := body.(routes.Content); ok {
+		return &Response{Description: http.StatusText(http.StatusOK), Content: s.content(content)}
+	}
+
+	schema := apiResponse
+	if data := s.of(body); data != nil {
+		schema = &Schema{AllOf: []*Schema{apiResponse, {
+			Type:       "object",
+			Properties: map[string]*Schema{"data": data},
+		}}}
+	}
+	return &Response{
+		Description: http.StatusText(http.StatusOK),
+		Content:     map[string]*MediaType{jsonType: {Schema: schema}},
+	}
+}
+
+func errorResponses(apiResponse *Schema) map[string]*Response {
+	errorResponse := func(description string) *Response {
+		return &Response{
+			Description: description + "; success is false and error.code tells why",
+			Content:     map[string]*MediaType{jsonType: {Schema: apiResponse}},
+		}
+	}
+	return map[string]*Response{
+		"Unauthorized": errorResponse("Missing, invalid or revoked credentials"),
+		"Forbidden":    errorResponse("The caller's role lacks the permission"),
+		"Error":        errorResponse("The request failed"),
+	}
+}
+
+func pathParameters(path string) []Parameter {
+	var params []Parameter
+	for _, match := range pathParam.FindAllStringSubmatch(path, -1) {
+		schema := &Schema{Type: "string"}
+		if match[1] == "id" || strings.HasSuffix(match[1], "_id") {
+			schema = &Schema{Type: "integer", Format: "int32"}
+		}
+		params = append(params, Parameter{Name: match[1], In: "path", Required: true, Schema: schema})
+	}
+	return params
+}
+
+// handlerName returns the package and method name of the route's last
+// handler, e.g. "auth" and "Login" for (*auth.AuthHandler).Login
+func handlerName(handlers []fiber.Handler) (string, string) {
+	if len(handlers) == 0 {
+		return "", ""
+	}
+
+	// e.g. zplus_web/backend/handlers/auth.(*AuthHandler).Login-fm
+	name := runtime.FuncForPC(reflect.ValueOf(handlers[len(handlers)-1]).Pointer()).Name()
+	name = strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], "-fm")
+	pkg, _, _ := strings.Cut(name, ".")
+	return pkg, name[strings.LastIndex(name, ".")+1:]
+}
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"zplus_web/backend/cache"
+)
+
+// LimitedError is returned when an action is attempted before it is allowed again
+type LimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LimitedError) Error() string {
+	return fmt.Sprintf("too many requests, retry after %d seconds", int(e.RetryAfter.Seconds())+1)
+}
+
+// Limiter enforces a cooldown between two events for the same key and caps
+// the number of events per key within a window that starts at its first event.
+// The counters are kept in the cache, so they are shared between instances
+// through Redis and expire with their window.
+type Limiter struct {
+	cache    *cache.Cache
+	cooldown time.Duration
+	max      int
+	window   time.Duration
+}
+
+func NewLimiter(cache *cache.Cache, cooldown time.Duration, max int, window time.Duration) *Limiter {
+	return &Limiter{
+		cache:    cache,
+		cooldown: cooldown,
+		max:      max,
+		window:   window,
+	}
+}
+
+// Allow records an event for key and returns nil, or a *LimitedError when the
+// key is still cooling down or has used up its quota for the window
+func (l *Limiter) Allow(ctx context.Context, key string) error {
+	if l.cooldown > 0 {
+		if count, left := l.cache.Hit(ctx, "ratelimit:cooldown:"+key, l.cooldown); count > 1 {
+			return &LimitedError{RetryAfter: left}
+		}
+	}
+
+	if count, left := l.cache.Hit(ctx, "ratelimit:"+key, l.window); count > int64(l.max) {
+		return &LimitedError{RetryAfter: left}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"zplus_web/backend/cache"
+	"zplus_web/backend/ratelimit"
+)
+
+// retryAfter returns how long err asks to wait, or 0 when the event was allowed
+func retryAfter(t *testing.T, err error) time.Duration {
+	t.Helper()
+
+	if err == nil {
+		return 0
+	}
+	var limited *ratelimit.LimitedError
+	if !errors.As(err, &limited) {
+		t.Fatalf("error = %v, want a *LimitedError", err)
+	}
+	return limited.RetryAfter
+}
+
+func TestLimiterCooldown(t *testing.T) {
+	limiter := ratelimit.NewLimiter(cache.New(nil), 50*time.Millisecond, 5, time.Hour)
+
+	if wait := retryAfter(t, limiter.Allow(t.Context(), "a")); wait != 0 {
+		t.Fatalf("first event waits %v", wait)
+	}
+	if wait := retryAfter(t, limiter.Allow(t.Context(), "a")); wait <= 0 || wait > 50*time.Millisecond {
+		t.Errorf("event in cooldown waits %v", wait)
+	}
+	if wait := retryAfter(t, limiter.Allow(t.Context(), "b")); wait != 0 {
+		t.Errorf("other key waits %v", wait)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if wait := retryAfter(t, limiter.Allow(t.Context(), "a")); wait != 0 {
+		t.Errorf("event after cooldown waits %v", wait)
+	}
+}
+
+func TestLimiterWindow(t *testing.T) {
+	const window = 100 * time.Millisecond
+	limiter := ratelimit.NewLimiter(cache.New(nil), 0, 3, window)
+
+	for i := 0; i < 3; i++ {
+		if wait := retryAfter(t, limiter.Allow(t.Context(), "a")); wait != 0 {
+			t.Fatalf("event %d waits %v", i+1, wait)
+		}
+	}
+	wait := retryAfter(t, limiter.Allow(t.Context(), "a"))
+	if wait <= 0 || wait > window {
+		t.Fatalf("event over the quota waits %v, want up to %v", wait, window)
+	}
+
+	// The quota is back once the window has passed
+	time.Sleep(wait + 10*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if wait := retryAfter(t, limiter.Allow(t.Context(), "a")); wait != 0 {
+			t.Fatalf("event %d in the next window waits %v", i+1, wait)
+		}
+	}
+}
+
+func TestLimitedError(t *testing.T) {
+	err := &ratelimit.LimitedError{RetryAfter: 1500 * time.Millisecond}
+	if got, want := err.Error(), "too many requests, retry after 2 seconds"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package rbac
+
+import "strings"
+
+// Permission names, formatted as <resource>:<action>
+const (
+	DashboardView    = "dashboard:view"
+	UsersRead        = "users:read"
+	UsersWrite       = "users:write"
+	UsersImpersonate = "users:impersonate"
+	RolesManage      = "roles:manage"
+	BlogWrite        = "blog:write"
+	BlogPublish      = "blog:publish"
+	ProjectsWrite    = "projects:write"
+	OrdersRead       = "orders:read"
+	OrdersRefund     = "orders:refund"
+	WalletAdjust     = "wallet:adjust"
+	WordPressManage  = "wordpress:manage"
+	SettingsManage   = "settings:manage"
+	SecurityManage   = "security:manage"
+	AuditRead        = "audit:read"
+	APIKeysManage    = "api_keys:manage"
+
+	// Wildcard grants every permission; "<resource>:*" grants every action on a resource
+	Wildcard = "*"
+)
+
+// Permission describes a permission for the role management UI
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Catalog lists every permission that can be granted to a role
+var Catalog = []Permission{
+	{DashboardView, "View the admin dashboard"},
+	{UsersRead, "View user accounts"},
+	{UsersWrite, "Create, edit and delete user accounts"},
+	{UsersImpersonate, "Log in as a customer to reproduce their issues"},
+	{RolesManage, "Manage roles and assign them to users"},
+	{BlogWrite, "Write and edit blog posts and categories"},
+	{BlogPublish, "Publish blog posts"},
+	{ProjectsWrite, "Create, edit and delete projects"},
+	{OrdersRead, "View orders"},
+	{OrdersRefund, "Refund orders"},
+	{WalletAdjust, "Credit or debit customer wallets"},
+	{WordPressManage, "Manage WordPress sites and content sync"},
+	{SettingsManage, "Change application settings"},
+	{SecurityManage, "Unlock logins and reset two-factor authentication"},
+	{AuditRead, "View and export the audit log"},
+	{APIKeysManage, "Create service accounts and issue or revoke their API keys"},
+}
+
+// Valid reports whether a permission, or a wildcard, can be granted
+func Valid(permission string) bool {
+	if permission == Wildcard {
+		return true
+	}
+	for _, p := range Catalog {
+		if p.Name == permission || strings.HasSuffix(permission, ":*") && strings.HasPrefix(p.Name, strings.TrimSuffix(permission, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// Has reports whether the granted permissions include permission
+func Has(granted []string, permission string) bool {
+	resource := permission
+	if i := strings.Index(permission, ":"); i >= 0 {
+		resource = permission[:i]
+	}
+
+	for _, g := range granted {
+		if g == Wildcard || g == permission || g == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
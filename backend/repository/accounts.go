@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"zplus_web/backend/models"
+)
+
+// NewUser is an account to create. Nil optional fields are stored as NULL.
+type NewUser struct {
+	Username         string
+	Email            string
+	PasswordHash     string
+	FullName         *string
+	Phone            *string
+	AvatarURL        *string
+	Role             string
+	EmailVerified    bool
+	IsServiceAccount bool
+}
+
+// UserRepository stores accounts and their password history
+type UserRepository interface {
+	// Create inserts an active user, or returns ErrConflict when the username or email is taken
+	Create(ctx context.Context, user NewUser) (*models.User, error)
+	GetByID(ctx context.Context, id int) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	// GetLogin returns the user logging in with email together with the
+	// password hash. Service accounts cannot log in and are never found.
+	GetLogin(ctx context.Context, email string) (*models.User, error)
+	// GetForUpdate returns the user together with the password hash and locks the row
+	GetForUpdate(ctx context.Context, id int) (*models.User, error)
+	// GetByIDs returns the users with the given IDs in no particular order
+	GetByIDs(ctx context.Context, ids []int) ([]models.User, error)
+	// List returns a page of users that were not erased, newest first, and
+	// the total, optionally matching search against username, email and name
+	List(ctx context.Context, search string, limit, offset int) ([]models.User, int, error)
+	// ListAll returns every user that was not erased, newest first
+	ListAll(ctx context.Context) ([]models.User, error)
+	ListServiceAccounts(ctx context.Context) ([]models.User, error)
+	UsernameTaken(ctx context.Context, username string) (bool, error)
+	// EmailTaken compares addresses case-insensitively
+	EmailTaken(ctx context.Context, email string) (bool, error)
+	IsServiceAccount(ctx context.Context, id int) (bool, error)
+	UpdateProfile(ctx context.Context, id int, profile models.UpdateProfileRequest) (*models.User, error)
+	SetRole(ctx context.Context, id int, role string) error
+	SetPasswordHash(ctx context.Context, id int, hash string) error
+	// ReplacePasswordHash changes the hash only if it is still oldHash
+	ReplacePasswordHash(ctx context.Context, id int, oldHash, newHash string) error
+	// SetEmailVerified marks the user's email as verified
+	SetEmailVerified(ctx context.Context, id int) error
+	// PasswordHistory returns up to limit previous password hashes, newest first
+	PasswordHistory(ctx context.Context, userID, limit int) ([]string, error)
+	AddPasswordHistory(ctx context.Context, userID int, hash string) error
+	// TrimPasswordHistory drops all but the newest keep entries
+	TrimPasswordHistory(ctx context.Context, userID, keep int) error
+}
+
+// NewSession is a login session to create
+type NewSession struct {
+	UserID         int
+	Token          string
+	UserAgent      string
+	IPAddress      string
+	ExpiresAt      time.Time
+	ImpersonatorID *int
+}
+
+// RefreshToken is a stored refresh token and the session it renews
+type RefreshToken struct {
+	ID           int
+	SessionID    int
+	UsedAt       *time.Time
+	ExpiresAt    time.Time
+	UserID       int
+	SessionToken string
+}
+
+// SessionRepository stores login sessions and their refresh tokens. Deleting
+// a session deletes its refresh tokens.
+type SessionRepository interface {
+	// Create inserts a session and returns its ID
+	Create(ctx context.Context, session NewSession) (int, error)
+	CreateRefreshToken(ctx context.Context, sessionID int, tokenHash string, expiresAt time.Time) error
+	// GetRefreshTokenForUpdate returns the refresh token with the hash and locks it
+	GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, id int) error
+	// Extend moves the session's expiry and marks it as used now
+	Extend(ctx context.Context, id int, expiresAt time.Time) error
+	// GetSessionUser returns the active state and role of the user owning an
+	// unexpired session with the token
+	GetSessionUser(ctx context.Context, token string, userID int) (*models.User, error)
+	// ListByUser returns the unexpired sessions of a user, most recently used first
+	ListByUser(ctx context.Context, userID int) ([]models.UserSession, error)
+	// Tokens returns the tokens of every session of a user
+	Tokens(ctx context.Context, userID int) ([]string, error)
+	Delete(ctx context.Context, id int) error
+	DeleteByToken(ctx context.Context, token string) error
+	// DeleteUserSession deletes one session of a user and returns its token
+	DeleteUserSession(ctx context.Context, userID, id int) (string, error)
+	// DeleteOthers deletes every session of a user but the one with keepToken
+	// and returns the tokens of the deleted ones
+	DeleteOthers(ctx context.Context, userID int, keepToken string) ([]string, error)
+	// DeleteByUser deletes every session of a user and returns their tokens
+	DeleteByUser(ctx context.Context, userID int) ([]string, error)
+}
+
+// TokenRepository stores the hashes of single-use tokens sent by email, like
+// password reset and email verification links
+type TokenRepository interface {
+	Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error
+	// Consume marks an unused, unexpired token as used and returns its user.
+	// A single statement makes concurrent uses of one token race-free.
+	Consume(ctx context.Context, tokenHash string) (int, error)
+	// DeleteUnused drops the user's tokens that were not used yet
+	DeleteUnused(ctx context.Context, userID int) error
+}
+
+// MagicLinkRepository stores passwordless login links, each bound to the nonce
+// of the browser that asked for it
+type MagicLinkRepository interface {
+	Create(ctx context.Context, userID int, tokenHash, nonceHash string, expiresAt time.Time) error
+	// Consume marks an unused, unexpired link presented with its nonce as used and returns its user
+	Consume(ctx context.Context, tokenHash, nonceHash string) (int, error)
+	DeleteUnused(ctx context.Context, userID int) error
+}
+
+// MFASettings is a user's TOTP second factor
+type MFASettings struct {
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+}
+
+// MFARepository stores TOTP secrets and recovery codes
+type MFARepository interface {
+	// Status reports whether 2FA is enabled and how many recovery codes are unused
+	Status(ctx context.Context, userID int) (bool, int, error)
+	// SetPendingSecret stores a secret for an enrollment that still has to be
+	// confirmed, or returns ErrConflict when 2FA is already enabled
+	SetPendingSecret(ctx context.Context, userID int, secret string) error
+	// GetForUpdate returns the user's second factor and locks it
+	GetForUpdate(ctx context.Context, userID int) (*MFASettings, error)
+	Enable(ctx context.Context, userID int, step int64) error
+	SetLastUsedStep(ctx context.Context, userID int, step int64) error
+	// UseRecoveryCode marks an unused recovery code as used
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) error
+	// ReplaceRecoveryCodes drops every recovery code of the user and stores the new hashes
+	ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error
+	// Delete removes the second factor and the recovery codes
+	Delete(ctx context.Context, userID int) error
+}
+
+// IdentityRepository stores the social login identities linked to users
+type IdentityRepository interface {
+	// ListByUser returns a user's identities, oldest first
+	ListByUser(ctx context.Context, userID int) ([]models.UserIdentity, error)
+	// TouchLogin records a login with an identity, updating its email, and returns its user
+	TouchLogin(ctx context.Context, provider, subject, email string) (int, error)
+	Create(ctx context.Context, userID int, provider, subject, email string) error
+}
+
+// LockoutRepository keeps the history of login lockouts and unlocks
+type LockoutRepository interface {
+	Record(ctx context.Context, event models.LoginLockoutEvent) error
+	// List returns the newest events
+	List(ctx context.Context, limit int) ([]models.LoginLockoutEvent, error)
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"zplus_web/backend/models"
+)
+
+// NewInvitation is a staff invitation to create
+type NewInvitation struct {
+	Email     string
+	Role      string
+	FullName  *string
+	TokenHash string
+	InvitedBy *int
+	ExpiresAt time.Time
+}
+
+// InvitationRepository stores staff invitations. An invitation is pending
+// until it is accepted or revoked.
+type InvitationRepository interface {
+	// Create inserts an invitation, or returns ErrConflict when one is already pending for the email
+	Create(ctx context.Context, invitation NewInvitation) (*models.Invitation, error)
+	// ListPending returns the pending invitations, newest first
+	ListPending(ctx context.Context) ([]models.Invitation, error)
+	// Renew replaces the token of a pending invitation and extends it
+	Renew(ctx context.Context, id int, tokenHash string, expiresAt time.Time) (*models.Invitation, error)
+	// Revoke cancels a pending invitation and returns its email
+	Revoke(ctx context.Context, id int) (string, error)
+	// Accept marks the pending, unexpired invitation with the token as accepted.
+	// A single statement makes concurrent uses of one link race-free.
+	Accept(ctx context.Context, tokenHash string) (*models.Invitation, error)
+	SetAcceptedUser(ctx context.Context, id, userID int) error
+}
+
+// NewAPIKey is an API key to create
+type NewAPIKey struct {
+	UserID    int
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy *int
+}
+
+// APIKeyRepository stores the API keys of service accounts
+type APIKeyRepository interface {
+	Create(ctx context.Context, key NewAPIKey) (*models.APIKey, error)
+	// List returns the keys of a user, or of every user when userID is 0, newest first
+	List(ctx context.Context, userID int) ([]models.APIKey, error)
+	// Revoke revokes a key that is not revoked yet and returns its prefix
+	Revoke(ctx context.Context, id int) (string, error)
+	// GetByHash returns the key with the hash and the user it belongs to
+	GetByHash(ctx context.Context, keyHash string) (*models.APIKey, *models.User, error)
+	// TouchLastUsed records a use of the key unless one was recorded after since
+	TouchLastUsed(ctx context.Context, id int, ip string, since time.Time) error
+}
+
+// RoleRepository stores roles and the permissions they grant
+type RoleRepository interface {
+	// Permissions returns the permissions of the role with the name, sorted
+	Permissions(ctx context.Context, name string) ([]string, error)
+	Exists(ctx context.Context, name string) (bool, error)
+	// List returns every role with its permissions and number of users, by name
+	List(ctx context.Context) ([]models.Role, error)
+	GetByID(ctx context.Context, id int) (*models.Role, error)
+	// Create inserts a custom role and returns its ID, or ErrConflict when the name is taken
+	Create(ctx context.Context, name string, description *string) (int, error)
+	UpdateDescription(ctx context.Context, id int, description *string) error
+	// SetPermissions replaces the permissions of a role
+	SetPermissions(ctx context.Context, id int, permissions []string) error
+	Delete(ctx context.Context, id int) error
+}
+
+// AuditRepository stores the audit trail
+type AuditRepository interface {
+	Insert(ctx context.Context, event models.AuditEvent) error
+	Count(ctx context.Context, filter models.AuditFilter) (int, error)
+	// List returns the events matching the filter, newest first
+	List(ctx context.Context, filter models.AuditFilter, limit, offset int) ([]models.AuditEvent, error)
+}
+
+// SettingsRepository stores application settings as strings by key
+type SettingsRepository interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+}
+
+// PrivacyRepository stores data exports and erases accounts
+type PrivacyRepository interface {
+	// CreateExport starts an export, or returns ErrConflict when one of the user's is still processing
+	CreateExport(ctx context.Context, userID int) (*models.DataExport, error)
+	// ListExports returns a user's exports, newest first
+	ListExports(ctx context.Context, userID int) ([]models.DataExport, error)
+	// GetExportArchive returns one of a user's exports and its archive
+	GetExportArchive(ctx context.Context, userID, exportID int) (*models.DataExport, []byte, error)
+	CompleteExport(ctx context.Context, id int, archive []byte, expiresAt time.Time) error
+	FailExport(ctx context.Context, id int, message string) error
+	// FailProcessingExports fails every export that is still processing
+	FailProcessingExports(ctx context.Context) error
+	// DropExpiredArchives frees the archives nobody can download anymore
+	DropExpiredArchives(ctx context.Context) error
+	// EraseUser anonymizes an account that was not erased yet and deletes its
+	// personal data. Orders, wallet and point history and the audit trail
+	// are kept without the user's details.
+	EraseUser(ctx context.Context, userID int, username, email string) error
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"zplus_web/backend/models"
+)
+
+// ProductFilter narrows List; zero values match every active product
+type ProductFilter struct {
+	CategorySlug string
+	Featured     bool
+	Search       string
+}
+
+// ProductRepository stores software products, their categories and the
+// downloads customers are entitled to
+type ProductRepository interface {
+	// List returns a page of active products, featured first, and the total
+	List(ctx context.Context, filter ProductFilter, limit, offset int) ([]models.SoftwareProduct, int, error)
+	GetActiveBySlug(ctx context.Context, slug string) (*models.SoftwareProduct, error)
+	// GetByIDs returns the products with the given IDs, including inactive ones
+	GetByIDs(ctx context.Context, ids []int) ([]models.SoftwareProduct, error)
+	// Categories returns every category by name
+	Categories(ctx context.Context) ([]models.ProductCategory, error)
+	CategoriesByIDs(ctx context.Context, ids []int) ([]models.ProductCategory, error)
+	// DownloadsByUser returns a user's downloads with the product names, oldest first
+	DownloadsByUser(ctx context.Context, userID int) ([]models.CustomerDownload, error)
+}
+
+// OrderFilter narrows List; zero values match every order
+type OrderFilter struct {
+	UserID        *int
+	PaymentStatus string
+	OrderStatus   string
+}
+
+// OrderRepository stores orders and their items
+type OrderRepository interface {
+	// List returns a page of orders, newest first, and the total
+	List(ctx context.Context, filter OrderFilter, limit, offset int) ([]models.Order, int, error)
+	// ListByUser returns every order of a user, oldest first
+	ListByUser(ctx context.Context, userID int) ([]models.Order, error)
+	GetByID(ctx context.Context, id int) (*models.Order, error)
+	// ItemsByOrderIDs returns the items of several orders, keyed by order ID
+	ItemsByOrderIDs(ctx context.Context, orderIDs []int) (map[int][]models.OrderItem, error)
+}
+
+// WalletRepository stores customer wallets and their transactions
+type WalletRepository interface {
+	Get(ctx context.Context, userID int) (*models.CustomerWallet, error)
+	// GetForUpdate returns a user's wallet and locks it
+	GetForUpdate(ctx context.Context, userID int) (*models.CustomerWallet, error)
+	// Create inserts an empty wallet
+	Create(ctx context.Context, userID int) (*models.CustomerWallet, error)
+	// Deposit credits the wallet and returns the new balance
+	Deposit(ctx context.Context, userID int, amount float64) (float64, error)
+	// Spend debits the wallet and returns the new balance
+	Spend(ctx context.Context, userID int, amount float64) (float64, error)
+	CreateTransaction(ctx context.Context, transaction models.WalletTransaction) (*models.WalletTransaction, error)
+	// GetTransactionForUpdate returns a transaction and locks it
+	GetTransactionForUpdate(ctx context.Context, id int) (*models.WalletTransaction, error)
+	// CompleteTransaction marks a transaction completed with the balance it left
+	CompleteTransaction(ctx context.Context, id int, balanceAfter float64) (*models.WalletTransaction, error)
+	// ListTransactions returns a page of a user's transactions, optionally of
+	// one type, newest first, and the total
+	ListTransactions(ctx context.Context, userID int, transactionType string, limit, offset int) ([]models.WalletTransaction, int, error)
+	// TransactionsByUser returns every transaction of a user, oldest first
+	TransactionsByUser(ctx context.Context, userID int) ([]models.WalletTransaction, error)
+}
+
+// PointsRepository stores loyalty points and their history
+type PointsRepository interface {
+	Get(ctx context.Context, userID int) (*models.CustomerPoints, error)
+	// Create inserts an empty points balance
+	Create(ctx context.Context, userID int) (*models.CustomerPoints, error)
+	// Add credits points to the available and total points
+	Add(ctx context.Context, userID, points int) error
+	CreateTransaction(ctx context.Context, transaction models.PointTransaction) error
+	// TransactionsByUser returns every points transaction of a user, oldest first
+	TransactionsByUser(ctx context.Context, userID int) ([]models.PointTransaction, error)
+}
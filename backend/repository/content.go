@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"zplus_web/backend/models"
+)
+
+// PostFilter narrows ListPublished; zero values match every published post
+type PostFilter struct {
+	CategorySlug string
+	Featured     bool
+	Search       string
+}
+
+// PostRepository stores blog posts and their categories
+type PostRepository interface {
+	// ListPublished returns a page of published posts with their authors,
+	// newest publication first, and the total
+	ListPublished(ctx context.Context, filter PostFilter, limit, offset int) ([]models.BlogPost, int, error)
+	// List returns a page of all posts with their authors, newest first, and the total
+	List(ctx context.Context, limit, offset int) ([]models.BlogPost, int, error)
+	// ListByAuthor returns every post of a user, oldest first
+	ListByAuthor(ctx context.Context, authorID int) ([]models.BlogPost, error)
+	GetByID(ctx context.Context, id int) (*models.BlogPost, error)
+	// GetForUpdate returns a post and locks it
+	GetForUpdate(ctx context.Context, id int) (*models.BlogPost, error)
+	// GetPublishedBySlug returns a published post with its author
+	GetPublishedBySlug(ctx context.Context, slug string) (*models.BlogPost, error)
+	IncrementViews(ctx context.Context, id int) error
+	Create(ctx context.Context, post models.BlogPost) (*models.BlogPost, error)
+	// Update saves the post; a nil PublishedAt keeps the current one
+	Update(ctx context.Context, post models.BlogPost) (*models.BlogPost, error)
+	Delete(ctx context.Context, id int) error
+	// Categories returns every category by name
+	Categories(ctx context.Context) ([]models.BlogCategory, error)
+	CreateCategory(ctx context.Context, name, slug, description string) (*models.BlogCategory, error)
+	// CategoriesByPostIDs returns the categories of several posts by name, keyed by post ID
+	CategoriesByPostIDs(ctx context.Context, postIDs []int) (map[int][]models.BlogCategory, error)
+	// CountPublished counts the published posts of several categories, keyed by category ID
+	CountPublished(ctx context.Context, categoryIDs []int) (map[int]int, error)
+}
+
+// ProjectFilter narrows List; zero values match every project
+type ProjectFilter struct {
+	Status   string
+	Featured bool
+	Search   string
+}
+
+// ProjectRepository stores portfolio projects
+type ProjectRepository interface {
+	// List returns a page of projects in their sort order, and the total
+	List(ctx context.Context, filter ProjectFilter, limit, offset int) ([]models.Project, int, error)
+	// ListAll returns a page of all projects, newest first, and the total
+	ListAll(ctx context.Context, limit, offset int) ([]models.Project, int, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Project, error)
+	// GetForUpdate returns a project and locks it
+	GetForUpdate(ctx context.Context, id int) (*models.Project, error)
+	Create(ctx context.Context, project models.Project) (*models.Project, error)
+	Update(ctx context.Context, id int, project models.Project) (*models.Project, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// WordPressRepository stores connected WordPress sites and the sync log
+type WordPressRepository interface {
+	// ListActiveSites returns the active sites by name, without their passwords
+	ListActiveSites(ctx context.Context) ([]models.WordPressSite, error)
+	CreateSite(ctx context.Context, site models.WordPressSite) (*models.WordPressSite, error)
+	// GetSite returns a site including its application password
+	GetSite(ctx context.Context, id int) (*models.WordPressSite, error)
+	// TouchSync records that the site was synchronized now
+	TouchSync(ctx context.Context, id int) error
+	LogSync(ctx context.Context, entry models.ContentSyncLog) error
+	// ListLogs returns a page of a site's sync log, newest first, and the total
+	ListLogs(ctx context.Context, siteID, limit, offset int) ([]models.ContentSyncLog, int, error)
+}
@@ -0,0 +1,678 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type users struct {
+	s *Store
+}
+
+// public returns the user without the password hash
+func (row userRow) public() *models.User {
+	user := row.User
+	user.PasswordHash = ""
+	return &user
+}
+
+func (r users) find(match func(userRow) bool) (userRow, bool) {
+	for _, row := range r.s.db.users {
+		if match(row) {
+			return row, true
+		}
+	}
+	return userRow{}, false
+}
+
+func (r users) Create(ctx context.Context, u repository.NewUser) (*models.User, error) {
+	defer r.s.lock()()
+
+	if _, taken := r.find(func(row userRow) bool { return row.Username == u.Username || row.Email == u.Email }); taken {
+		return nil, repository.ErrConflict
+	}
+
+	now := time.Now()
+	row := userRow{
+		User: models.User{
+			ID: r.s.db.next("users"), Username: u.Username, Email: u.Email, PasswordHash: u.PasswordHash,
+			Role: u.Role, FullName: u.FullName, Phone: u.Phone, AvatarURL: u.AvatarURL,
+			IsActive: true, EmailVerified: u.EmailVerified, CreatedAt: now, UpdatedAt: now,
+		},
+		serviceAccount: u.IsServiceAccount,
+	}
+	r.s.db.users[row.ID] = row
+	return row.public(), nil
+}
+
+func (r users) GetByID(ctx context.Context, id int) (*models.User, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.users[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return row.public(), nil
+}
+
+func (r users) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	defer r.s.lock()()
+
+	row, ok := r.find(func(row userRow) bool { return row.Email == email })
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return row.public(), nil
+}
+
+func (r users) GetLogin(ctx context.Context, email string) (*models.User, error) {
+	defer r.s.lock()()
+
+	row, ok := r.find(func(row userRow) bool { return row.Email == email && !row.serviceAccount })
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	user := row.User
+	return &user, nil
+}
+
+func (r users) GetForUpdate(ctx context.Context, id int) (*models.User, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.users[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	user := row.User
+	return &user, nil
+}
+
+func (r users) GetByIDs(ctx context.Context, ids []int) ([]models.User, error) {
+	defer r.s.lock()()
+
+	users := []models.User{}
+	for _, row := range rows(r.s.db.users, func(row userRow) bool { return slices.Contains(ids, row.ID) }, nil) {
+		users = append(users, *row.public())
+	}
+	return users, nil
+}
+
+func (r users) list(keep func(userRow) bool, less func(a, b userRow) int) []models.User {
+	users := []models.User{}
+	for _, row := range rows(r.s.db.users, keep, less) {
+		users = append(users, *row.public())
+	}
+	return users
+}
+
+func newestUser(a, b userRow) int {
+	return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+}
+
+func (r users) List(ctx context.Context, search string, limit, offset int) ([]models.User, int, error) {
+	defer r.s.lock()()
+
+	users := r.list(func(row userRow) bool {
+		if row.erased {
+			return false
+		}
+		return search == "" || ilike(&row.Username, search) || ilike(&row.Email, search) || ilike(row.FullName, search)
+	}, newestUser)
+	return page(users, limit, offset), len(users), nil
+}
+
+func (r users) ListAll(ctx context.Context) ([]models.User, error) {
+	defer r.s.lock()()
+
+	return r.list(func(row userRow) bool { return !row.erased }, newestUser), nil
+}
+
+func (r users) ListServiceAccounts(ctx context.Context) ([]models.User, error) {
+	defer r.s.lock()()
+
+	return r.list(func(row userRow) bool { return row.serviceAccount }, func(a, b userRow) int {
+		return strings.Compare(a.Username, b.Username)
+	}), nil
+}
+
+func (r users) UsernameTaken(ctx context.Context, username string) (bool, error) {
+	defer r.s.lock()()
+
+	_, taken := r.find(func(row userRow) bool { return row.Username == username })
+	return taken, nil
+}
+
+func (r users) EmailTaken(ctx context.Context, email string) (bool, error) {
+	defer r.s.lock()()
+
+	_, taken := r.find(func(row userRow) bool { return strings.EqualFold(row.Email, email) })
+	return taken, nil
+}
+
+func (r users) IsServiceAccount(ctx context.Context, id int) (bool, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.users[id]
+	if !ok {
+		return false, repository.ErrNotFound
+	}
+	return row.serviceAccount, nil
+}
+
+// update applies change to a user and bumps updated_at
+func (r users) update(id int, change func(*userRow)) (userRow, error) {
+	row, ok := r.s.db.users[id]
+	if !ok {
+		return row, repository.ErrNotFound
+	}
+	change(&row)
+	row.UpdatedAt = time.Now()
+	r.s.db.users[id] = row
+	return row, nil
+}
+
+func (r users) UpdateProfile(ctx context.Context, id int, profile models.UpdateProfileRequest) (*models.User, error) {
+	defer r.s.lock()()
+
+	row, err := r.update(id, func(row *userRow) {
+		row.FullName = ptr(profile.FullName)
+		row.Phone = ptr(profile.Phone)
+		row.AvatarURL = ptr(profile.AvatarURL)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return row.public(), nil
+}
+
+func (r users) SetRole(ctx context.Context, id int, role string) error {
+	defer r.s.lock()()
+
+	_, err := r.update(id, func(row *userRow) { row.Role = role })
+	return err
+}
+
+func (r users) SetPasswordHash(ctx context.Context, id int, hash string) error {
+	defer r.s.lock()()
+
+	_, err := r.update(id, func(row *userRow) { row.PasswordHash = hash })
+	return err
+}
+
+func (r users) ReplacePasswordHash(ctx context.Context, id int, oldHash, newHash string) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.users[id]; ok && row.PasswordHash == oldHash {
+		row.PasswordHash = newHash
+		r.s.db.users[id] = row
+	}
+	return nil
+}
+
+func (r users) SetEmailVerified(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.users[id]; ok && !row.EmailVerified {
+		r.update(id, func(row *userRow) { row.EmailVerified = true })
+	}
+	return nil
+}
+
+// history returns the user's password history, newest first
+func (r users) history(userID int) []passwordHistoryRow {
+	return rows(r.s.db.passwordHistory, func(row passwordHistoryRow) bool { return row.userID == userID },
+		func(a, b passwordHistoryRow) int { return b.id - a.id })
+}
+
+func (r users) PasswordHistory(ctx context.Context, userID, limit int) ([]string, error) {
+	defer r.s.lock()()
+
+	hashes := []string{}
+	for _, row := range page(r.history(userID), limit, 0) {
+		hashes = append(hashes, row.hash)
+	}
+	return hashes, nil
+}
+
+func (r users) AddPasswordHistory(ctx context.Context, userID int, hash string) error {
+	defer r.s.lock()()
+
+	id := r.s.db.next("password_history")
+	r.s.db.passwordHistory[id] = passwordHistoryRow{id: id, userID: userID, hash: hash}
+	return nil
+}
+
+func (r users) TrimPasswordHistory(ctx context.Context, userID, keep int) error {
+	defer r.s.lock()()
+
+	history := r.history(userID)
+	for _, row := range page(history, len(history), keep) {
+		delete(r.s.db.passwordHistory, row.id)
+	}
+	return nil
+}
+
+type sessions struct {
+	s *Store
+}
+
+func (r sessions) Create(ctx context.Context, s repository.NewSession) (int, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.sessions {
+		if row.Token == s.Token {
+			return 0, errUnique("user_sessions", "token")
+		}
+	}
+
+	now := time.Now()
+	id := r.s.db.next("user_sessions")
+	r.s.db.sessions[id] = sessionRow{
+		UserSession: models.UserSession{
+			ID: id, UserID: s.UserID, Token: s.Token, UserAgent: ptr(s.UserAgent), IPAddress: ptr(s.IPAddress),
+			ExpiresAt: s.ExpiresAt, LastUsedAt: now, CreatedAt: now,
+		},
+		impersonatorID: s.ImpersonatorID,
+	}
+	return id, nil
+}
+
+func (r sessions) CreateRefreshToken(ctx context.Context, sessionID int, tokenHash string, expiresAt time.Time) error {
+	defer r.s.lock()()
+
+	id := r.s.db.next("refresh_tokens")
+	r.s.db.refreshTokens[id] = refreshTokenRow{id: id, sessionID: sessionID, hash: tokenHash, expiresAt: expiresAt}
+	return nil
+}
+
+func (r sessions) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*repository.RefreshToken, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.refreshTokens {
+		if row.hash != tokenHash {
+			continue
+		}
+		session, ok := r.s.db.sessions[row.sessionID]
+		if !ok {
+			break
+		}
+		return &repository.RefreshToken{
+			ID: row.id, SessionID: row.sessionID, UsedAt: row.usedAt, ExpiresAt: row.expiresAt,
+			UserID: session.UserID, SessionToken: session.Token,
+		}, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r sessions) MarkRefreshTokenUsed(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.refreshTokens[id]; ok {
+		row.usedAt = now()
+		r.s.db.refreshTokens[id] = row
+	}
+	return nil
+}
+
+func (r sessions) Extend(ctx context.Context, id int, expiresAt time.Time) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.sessions[id]; ok {
+		row.ExpiresAt = expiresAt
+		row.LastUsedAt = time.Now()
+		r.s.db.sessions[id] = row
+	}
+	return nil
+}
+
+func (r sessions) GetSessionUser(ctx context.Context, token string, userID int) (*models.User, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.sessions {
+		if row.Token != token || row.UserID != userID || !row.ExpiresAt.After(time.Now()) {
+			continue
+		}
+		user, ok := r.s.db.users[userID]
+		if !ok {
+			break
+		}
+		return &models.User{ID: userID, IsActive: user.IsActive, Role: user.Role}, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r sessions) ListByUser(ctx context.Context, userID int) ([]models.UserSession, error) {
+	defer r.s.lock()()
+
+	list := []models.UserSession{}
+	for _, row := range rows(r.s.db.sessions, func(row sessionRow) bool {
+		return row.UserID == userID && row.ExpiresAt.After(time.Now())
+	}, func(a, b sessionRow) int {
+		return newest(a.LastUsedAt, b.LastUsedAt, a.ID, b.ID)
+	}) {
+		list = append(list, row.UserSession)
+	}
+	return list, nil
+}
+
+// delete removes the sessions that match, and their refresh tokens, and
+// returns their tokens
+func (r sessions) delete(match func(sessionRow) bool) []string {
+	tokens := []string{}
+	for id, row := range r.s.db.sessions {
+		if !match(row) {
+			continue
+		}
+		delete(r.s.db.sessions, id)
+		for tokenID, token := range r.s.db.refreshTokens {
+			if token.sessionID == id {
+				delete(r.s.db.refreshTokens, tokenID)
+			}
+		}
+		tokens = append(tokens, row.Token)
+	}
+	return tokens
+}
+
+func (r sessions) Tokens(ctx context.Context, userID int) ([]string, error) {
+	defer r.s.lock()()
+
+	tokens := []string{}
+	for _, row := range rows(r.s.db.sessions, func(row sessionRow) bool { return row.UserID == userID }, nil) {
+		tokens = append(tokens, row.Token)
+	}
+	return tokens, nil
+}
+
+func (r sessions) Delete(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	r.delete(func(row sessionRow) bool { return row.ID == id })
+	return nil
+}
+
+func (r sessions) DeleteByToken(ctx context.Context, token string) error {
+	defer r.s.lock()()
+
+	r.delete(func(row sessionRow) bool { return row.Token == token })
+	return nil
+}
+
+func (r sessions) DeleteUserSession(ctx context.Context, userID, id int) (string, error) {
+	defer r.s.lock()()
+
+	tokens := r.delete(func(row sessionRow) bool { return row.ID == id && row.UserID == userID })
+	if len(tokens) == 0 {
+		return "", repository.ErrNotFound
+	}
+	return tokens[0], nil
+}
+
+func (r sessions) DeleteOthers(ctx context.Context, userID int, keepToken string) ([]string, error) {
+	defer r.s.lock()()
+
+	return r.delete(func(row sessionRow) bool { return row.UserID == userID && row.Token != keepToken }), nil
+}
+
+func (r sessions) DeleteByUser(ctx context.Context, userID int) ([]string, error) {
+	defer r.s.lock()()
+
+	return r.delete(func(row sessionRow) bool { return row.UserID == userID }), nil
+}
+
+// tokens implements repository.TokenRepository on one of the token tables
+type tokens struct {
+	s     *Store
+	table string
+}
+
+func (r tokens) rows() map[int]tokenRow {
+	if r.table == "password_reset_tokens" {
+		return r.s.db.passwordResets
+	}
+	return r.s.db.emailVerifications
+}
+
+// createToken inserts a token row, keeping token hashes unique
+func createToken(s *Store, table map[int]tokenRow, name string, row tokenRow) error {
+	for _, existing := range table {
+		if existing.hash == row.hash {
+			return errUnique(name, "token_hash")
+		}
+	}
+	table[s.db.next(name)] = row
+	return nil
+}
+
+// consumeToken marks the first unused, unexpired row that matches as used
+// and returns its user
+func consumeToken(table map[int]tokenRow, match func(tokenRow) bool) (int, error) {
+	for id, row := range table {
+		if match(row) && row.usedAt == nil && row.expiresAt.After(time.Now()) {
+			row.usedAt = now()
+			table[id] = row
+			return row.userID, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+func deleteUnusedTokens(table map[int]tokenRow, userID int) {
+	for id, row := range table {
+		if row.userID == userID && row.usedAt == nil {
+			delete(table, id)
+		}
+	}
+}
+
+func (r tokens) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	defer r.s.lock()()
+
+	return createToken(r.s, r.rows(), r.table, tokenRow{userID: userID, hash: tokenHash, expiresAt: expiresAt})
+}
+
+func (r tokens) Consume(ctx context.Context, tokenHash string) (int, error) {
+	defer r.s.lock()()
+
+	return consumeToken(r.rows(), func(row tokenRow) bool { return row.hash == tokenHash })
+}
+
+func (r tokens) DeleteUnused(ctx context.Context, userID int) error {
+	defer r.s.lock()()
+
+	deleteUnusedTokens(r.rows(), userID)
+	return nil
+}
+
+type magicLinks struct {
+	s *Store
+}
+
+func (r magicLinks) Create(ctx context.Context, userID int, tokenHash, nonceHash string, expiresAt time.Time) error {
+	defer r.s.lock()()
+
+	return createToken(r.s, r.s.db.magicLinks, "magic_link_tokens",
+		tokenRow{userID: userID, hash: tokenHash, nonceHash: nonceHash, expiresAt: expiresAt})
+}
+
+func (r magicLinks) Consume(ctx context.Context, tokenHash, nonceHash string) (int, error) {
+	defer r.s.lock()()
+
+	return consumeToken(r.s.db.magicLinks, func(row tokenRow) bool {
+		return row.hash == tokenHash && row.nonceHash == nonceHash
+	})
+}
+
+func (r magicLinks) DeleteUnused(ctx context.Context, userID int) error {
+	defer r.s.lock()()
+
+	deleteUnusedTokens(r.s.db.magicLinks, userID)
+	return nil
+}
+
+type mfa struct {
+	s *Store
+}
+
+func (r mfa) Status(ctx context.Context, userID int) (bool, int, error) {
+	defer r.s.lock()()
+
+	remaining := 0
+	for _, code := range r.s.db.recoveryCodes {
+		if code.userID == userID && !code.used {
+			remaining++
+		}
+	}
+	return r.s.db.mfa[userID].Enabled, remaining, nil
+}
+
+func (r mfa) SetPendingSecret(ctx context.Context, userID int, secret string) error {
+	defer r.s.lock()()
+
+	if r.s.db.mfa[userID].Enabled {
+		return repository.ErrConflict
+	}
+	r.s.db.mfa[userID] = repository.MFASettings{Secret: secret}
+	return nil
+}
+
+func (r mfa) GetForUpdate(ctx context.Context, userID int) (*repository.MFASettings, error) {
+	defer r.s.lock()()
+
+	settings, ok := r.s.db.mfa[userID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &settings, nil
+}
+
+func (r mfa) Enable(ctx context.Context, userID int, step int64) error {
+	defer r.s.lock()()
+
+	if settings, ok := r.s.db.mfa[userID]; ok {
+		settings.Enabled = true
+		settings.LastUsedStep = step
+		r.s.db.mfa[userID] = settings
+	}
+	return nil
+}
+
+func (r mfa) SetLastUsedStep(ctx context.Context, userID int, step int64) error {
+	defer r.s.lock()()
+
+	if settings, ok := r.s.db.mfa[userID]; ok {
+		settings.LastUsedStep = step
+		r.s.db.mfa[userID] = settings
+	}
+	return nil
+}
+
+func (r mfa) UseRecoveryCode(ctx context.Context, userID int, codeHash string) error {
+	defer r.s.lock()()
+
+	for id, code := range r.s.db.recoveryCodes {
+		if code.userID == userID && code.hash == codeHash && !code.used {
+			code.used = true
+			r.s.db.recoveryCodes[id] = code
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r mfa) deleteRecoveryCodes(userID int) {
+	for id, code := range r.s.db.recoveryCodes {
+		if code.userID == userID {
+			delete(r.s.db.recoveryCodes, id)
+		}
+	}
+}
+
+func (r mfa) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	defer r.s.lock()()
+
+	r.deleteRecoveryCodes(userID)
+	for _, hash := range codeHashes {
+		r.s.db.recoveryCodes[r.s.db.next("mfa_recovery_codes")] = recoveryCodeRow{userID: userID, hash: hash}
+	}
+	return nil
+}
+
+func (r mfa) Delete(ctx context.Context, userID int) error {
+	defer r.s.lock()()
+
+	r.deleteRecoveryCodes(userID)
+	delete(r.s.db.mfa, userID)
+	return nil
+}
+
+type identities struct {
+	s *Store
+}
+
+func (r identities) ListByUser(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.identities, func(identity models.UserIdentity) bool {
+		return identity.UserID == userID
+	}, func(a, b models.UserIdentity) int {
+		return oldest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}), nil
+}
+
+func (r identities) TouchLogin(ctx context.Context, provider, subject, email string) (int, error) {
+	defer r.s.lock()()
+
+	for id, identity := range r.s.db.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			identity.Email = ptr(email)
+			identity.LastLoginAt = now()
+			r.s.db.identities[id] = identity
+			return identity.UserID, nil
+		}
+	}
+	return 0, repository.ErrNotFound
+}
+
+func (r identities) Create(ctx context.Context, userID int, provider, subject, email string) error {
+	defer r.s.lock()()
+
+	for _, identity := range r.s.db.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return errUnique("user_identities", "provider_subject")
+		}
+	}
+
+	id := r.s.db.next("user_identities")
+	r.s.db.identities[id] = models.UserIdentity{
+		ID: id, UserID: userID, Provider: provider, Subject: subject, Email: ptr(email),
+		CreatedAt: time.Now(), LastLoginAt: now(),
+	}
+	return nil
+}
+
+type lockouts struct {
+	s *Store
+}
+
+func (r lockouts) Record(ctx context.Context, event models.LoginLockoutEvent) error {
+	defer r.s.lock()()
+
+	event.ID = r.s.db.next("login_lockout_events")
+	event.CreatedAt = time.Now()
+	r.s.db.lockouts[event.ID] = event
+	return nil
+}
+
+func (r lockouts) List(ctx context.Context, limit int) ([]models.LoginLockoutEvent, error) {
+	defer r.s.lock()()
+
+	return page(rows(r.s.db.lockouts, nil, func(a, b models.LoginLockoutEvent) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}), limit, 0), nil
+}
@@ -0,0 +1,508 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type invitations struct {
+	s *Store
+}
+
+func (row invitationRow) pending() bool {
+	return row.AcceptedAt == nil && row.RevokedAt == nil
+}
+
+func (r invitations) Create(ctx context.Context, i repository.NewInvitation) (*models.Invitation, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.invitations {
+		if row.Email == i.Email && row.pending() {
+			return nil, repository.ErrConflict
+		}
+	}
+
+	now := time.Now()
+	row := invitationRow{
+		Invitation: models.Invitation{
+			ID: r.s.db.next("invitations"), Email: i.Email, Role: i.Role, FullName: i.FullName, InvitedBy: i.InvitedBy,
+			ExpiresAt: i.ExpiresAt, LastSentAt: now, CreatedAt: now,
+		},
+		tokenHash: i.TokenHash,
+	}
+	r.s.db.invitations[row.ID] = row
+	return &row.Invitation, nil
+}
+
+func (r invitations) ListPending(ctx context.Context) ([]models.Invitation, error) {
+	defer r.s.lock()()
+
+	list := []models.Invitation{}
+	for _, row := range rows(r.s.db.invitations, invitationRow.pending, func(a, b invitationRow) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}) {
+		list = append(list, row.Invitation)
+	}
+	return list, nil
+}
+
+func (r invitations) Renew(ctx context.Context, id int, tokenHash string, expiresAt time.Time) (*models.Invitation, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.invitations[id]
+	if !ok || !row.pending() {
+		return nil, repository.ErrNotFound
+	}
+	row.tokenHash = tokenHash
+	row.ExpiresAt = expiresAt
+	row.LastSentAt = time.Now()
+	r.s.db.invitations[id] = row
+	return &row.Invitation, nil
+}
+
+func (r invitations) Revoke(ctx context.Context, id int) (string, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.invitations[id]
+	if !ok || !row.pending() {
+		return "", repository.ErrNotFound
+	}
+	row.RevokedAt = now()
+	r.s.db.invitations[id] = row
+	return row.Email, nil
+}
+
+func (r invitations) Accept(ctx context.Context, tokenHash string) (*models.Invitation, error) {
+	defer r.s.lock()()
+
+	for id, row := range r.s.db.invitations {
+		if row.tokenHash == tokenHash && row.pending() && row.ExpiresAt.After(time.Now()) {
+			row.AcceptedAt = now()
+			r.s.db.invitations[id] = row
+			return &row.Invitation, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r invitations) SetAcceptedUser(ctx context.Context, id, userID int) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.invitations[id]; ok {
+		row.acceptedUserID = &userID
+		r.s.db.invitations[id] = row
+	}
+	return nil
+}
+
+type apiKeys struct {
+	s *Store
+}
+
+func (r apiKeys) Create(ctx context.Context, k repository.NewAPIKey) (*models.APIKey, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.apiKeys {
+		if row.keyHash == k.KeyHash {
+			return nil, errUnique("api_keys", "key_hash")
+		}
+	}
+
+	row := apiKeyRow{
+		APIKey: models.APIKey{
+			ID: r.s.db.next("api_keys"), UserID: k.UserID, Name: k.Name, Prefix: k.Prefix,
+			Scopes: slices.Clone(k.Scopes), ExpiresAt: k.ExpiresAt, CreatedBy: k.CreatedBy, CreatedAt: time.Now(),
+		},
+		keyHash: k.KeyHash,
+	}
+	r.s.db.apiKeys[row.ID] = row
+	return &row.APIKey, nil
+}
+
+func (r apiKeys) List(ctx context.Context, userID int) ([]models.APIKey, error) {
+	defer r.s.lock()()
+
+	keys := []models.APIKey{}
+	for _, row := range rows(r.s.db.apiKeys, func(row apiKeyRow) bool {
+		return userID == 0 || row.UserID == userID
+	}, func(a, b apiKeyRow) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}) {
+		keys = append(keys, row.APIKey)
+	}
+	return keys, nil
+}
+
+func (r apiKeys) Revoke(ctx context.Context, id int) (string, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.apiKeys[id]
+	if !ok || row.RevokedAt != nil {
+		return "", repository.ErrNotFound
+	}
+	row.RevokedAt = now()
+	r.s.db.apiKeys[id] = row
+	return row.Prefix, nil
+}
+
+func (r apiKeys) GetByHash(ctx context.Context, keyHash string) (*models.APIKey, *models.User, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.apiKeys {
+		if row.keyHash != keyHash {
+			continue
+		}
+		user, ok := r.s.db.users[row.UserID]
+		if !ok {
+			break
+		}
+		key := row.APIKey
+		return &key, user.public(), nil
+	}
+	return nil, nil, repository.ErrNotFound
+}
+
+func (r apiKeys) TouchLastUsed(ctx context.Context, id int, ip string, since time.Time) error {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.apiKeys[id]
+	if ok && (row.LastUsedAt == nil || row.LastUsedAt.Before(since)) {
+		row.LastUsedAt = now()
+		row.LastUsedIP = ptr(ip)
+		r.s.db.apiKeys[id] = row
+	}
+	return nil
+}
+
+type roles struct {
+	s *Store
+}
+
+func (r roles) byName(name string) (models.Role, bool) {
+	for _, role := range r.s.db.roles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return models.Role{}, false
+}
+
+// withUsers returns the role with a sorted copy of its permissions and the number of users it has
+func (r roles) withUsers(role models.Role) models.Role {
+	role.Permissions = slices.Sorted(slices.Values(role.Permissions))
+	role.UsersCount = 0
+	for _, user := range r.s.db.users {
+		if user.Role == role.Name {
+			role.UsersCount++
+		}
+	}
+	return role
+}
+
+func (r roles) Permissions(ctx context.Context, name string) ([]string, error) {
+	defer r.s.lock()()
+
+	role, ok := r.byName(name)
+	if !ok {
+		return []string{}, nil
+	}
+	return slices.Sorted(slices.Values(role.Permissions)), nil
+}
+
+func (r roles) Exists(ctx context.Context, name string) (bool, error) {
+	defer r.s.lock()()
+
+	_, ok := r.byName(name)
+	return ok, nil
+}
+
+func (r roles) List(ctx context.Context) ([]models.Role, error) {
+	defer r.s.lock()()
+
+	list := []models.Role{}
+	for _, role := range rows(r.s.db.roles, nil, func(a, b models.Role) int { return strings.Compare(a.Name, b.Name) }) {
+		list = append(list, r.withUsers(role))
+	}
+	return list, nil
+}
+
+func (r roles) GetByID(ctx context.Context, id int) (*models.Role, error) {
+	defer r.s.lock()()
+
+	role, ok := r.s.db.roles[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	role = r.withUsers(role)
+	return &role, nil
+}
+
+func (r roles) Create(ctx context.Context, name string, description *string) (int, error) {
+	defer r.s.lock()()
+
+	if _, taken := r.byName(name); taken {
+		return 0, repository.ErrConflict
+	}
+
+	now := time.Now()
+	id := r.s.db.next("roles")
+	r.s.db.roles[id] = models.Role{ID: id, Name: name, Description: description, Permissions: []string{}, CreatedAt: now, UpdatedAt: now}
+	return id, nil
+}
+
+func (r roles) UpdateDescription(ctx context.Context, id int, description *string) error {
+	defer r.s.lock()()
+
+	role, ok := r.s.db.roles[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	role.Description = description
+	role.UpdatedAt = time.Now()
+	r.s.db.roles[id] = role
+	return nil
+}
+
+func (r roles) SetPermissions(ctx context.Context, id int, permissions []string) error {
+	defer r.s.lock()()
+
+	role, ok := r.s.db.roles[id]
+	if !ok {
+		return nil
+	}
+	role.Permissions = slices.Compact(slices.Sorted(slices.Values(permissions)))
+	r.s.db.roles[id] = role
+	return nil
+}
+
+func (r roles) Delete(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if _, ok := r.s.db.roles[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.db.roles, id)
+	return nil
+}
+
+type audit struct {
+	s *Store
+}
+
+func (r audit) Insert(ctx context.Context, event models.AuditEvent) error {
+	defer r.s.lock()()
+
+	id := r.s.db.next("audit_events")
+	event.ID = int64(id)
+	event.CreatedAt = time.Now()
+	r.s.db.audit[id] = event
+	return nil
+}
+
+func (r audit) matching(filter models.AuditFilter) []models.AuditEvent {
+	return rows(r.s.db.audit, func(event models.AuditEvent) bool {
+		switch {
+		case filter.ActorID != nil && (event.ActorID == nil || *event.ActorID != *filter.ActorID),
+			filter.Action != "" && event.Action != filter.Action,
+			filter.EntityType != "" && event.EntityType != filter.EntityType,
+			filter.EntityID != "" && (event.EntityID == nil || *event.EntityID != filter.EntityID),
+			filter.From != nil && event.CreatedAt.Before(*filter.From),
+			filter.To != nil && !event.CreatedAt.Before(*filter.To):
+			return false
+		}
+		return true
+	}, func(a, b models.AuditEvent) int {
+		return newest(a.CreatedAt, b.CreatedAt, int(a.ID), int(b.ID))
+	})
+}
+
+func (r audit) Count(ctx context.Context, filter models.AuditFilter) (int, error) {
+	defer r.s.lock()()
+
+	return len(r.matching(filter)), nil
+}
+
+func (r audit) List(ctx context.Context, filter models.AuditFilter, limit, offset int) ([]models.AuditEvent, error) {
+	defer r.s.lock()()
+
+	return page(r.matching(filter), limit, offset), nil
+}
+
+type settings struct {
+	s *Store
+}
+
+func (r settings) Get(ctx context.Context, key string) (string, error) {
+	defer r.s.lock()()
+
+	value, ok := r.s.db.settings[key]
+	if !ok {
+		return "", repository.ErrNotFound
+	}
+	return value, nil
+}
+
+func (r settings) Set(ctx context.Context, key, value string) error {
+	defer r.s.lock()()
+
+	r.s.db.settings[key] = value
+	return nil
+}
+
+type privacy struct {
+	s *Store
+}
+
+func (r privacy) CreateExport(ctx context.Context, userID int) (*models.DataExport, error) {
+	defer r.s.lock()()
+
+	for _, row := range r.s.db.exports {
+		if row.UserID == userID && row.Status == "processing" {
+			return nil, repository.ErrConflict
+		}
+	}
+
+	export := models.DataExport{ID: r.s.db.next("data_exports"), UserID: userID, Status: "processing", CreatedAt: time.Now()}
+	r.s.db.exports[export.ID] = exportRow{DataExport: export}
+	return &export, nil
+}
+
+func (r privacy) ListExports(ctx context.Context, userID int) ([]models.DataExport, error) {
+	defer r.s.lock()()
+
+	exports := []models.DataExport{}
+	for _, row := range rows(r.s.db.exports, func(row exportRow) bool { return row.UserID == userID }, func(a, b exportRow) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}) {
+		exports = append(exports, row.DataExport)
+	}
+	return exports, nil
+}
+
+func (r privacy) GetExportArchive(ctx context.Context, userID, exportID int) (*models.DataExport, []byte, error) {
+	defer r.s.lock()()
+
+	row, ok := r.s.db.exports[exportID]
+	if !ok || row.UserID != userID {
+		return nil, nil, repository.ErrNotFound
+	}
+	return &row.DataExport, row.archive, nil
+}
+
+func (r privacy) CompleteExport(ctx context.Context, id int, archive []byte, expiresAt time.Time) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.exports[id]; ok {
+		row.Status = "completed"
+		row.archive = archive
+		row.SizeBytes = ptr(int64(len(archive)))
+		row.ExpiresAt = &expiresAt
+		row.CompletedAt = now()
+		r.s.db.exports[id] = row
+	}
+	return nil
+}
+
+func (r privacy) FailExport(ctx context.Context, id int, message string) error {
+	defer r.s.lock()()
+
+	if row, ok := r.s.db.exports[id]; ok {
+		row.Status = "failed"
+		row.Error = &message
+		row.CompletedAt = now()
+		r.s.db.exports[id] = row
+	}
+	return nil
+}
+
+func (r privacy) FailProcessingExports(ctx context.Context) error {
+	defer r.s.lock()()
+
+	for id, row := range r.s.db.exports {
+		if row.Status == "processing" {
+			row.Status = "failed"
+			row.Error = ptr("interrupted by a server restart")
+			r.s.db.exports[id] = row
+		}
+	}
+	return nil
+}
+
+func (r privacy) DropExpiredArchives(ctx context.Context) error {
+	defer r.s.lock()()
+
+	for id, row := range r.s.db.exports {
+		if row.ExpiresAt != nil && row.ExpiresAt.Before(time.Now()) {
+			row.archive = nil
+			r.s.db.exports[id] = row
+		}
+	}
+	return nil
+}
+
+func (r privacy) EraseUser(ctx context.Context, userID int, username, email string) error {
+	defer r.s.lock()()
+
+	user, ok := r.s.db.users[userID]
+	if !ok || user.erased {
+		return repository.ErrNotFound
+	}
+	currentEmail := user.Email
+
+	user.Username, user.Email, user.PasswordHash = username, email, "!"
+	user.FullName, user.Phone, user.AvatarURL = nil, nil, nil
+	user.IsActive, user.EmailVerified, user.erased = false, false, true
+	user.UpdatedAt = time.Now()
+	r.s.db.users[userID] = user
+
+	db := r.s.db
+	deleteWhere(db.identities, func(row models.UserIdentity) bool { return row.UserID == userID })
+	deleteWhere(db.apiKeys, func(row apiKeyRow) bool { return row.UserID == userID })
+	deleteWhere(db.passwordHistory, func(row passwordHistoryRow) bool { return row.userID == userID })
+	for _, table := range []map[int]tokenRow{db.passwordResets, db.emailVerifications, db.magicLinks} {
+		deleteWhere(table, func(row tokenRow) bool { return row.userID == userID })
+	}
+	delete(db.mfa, userID)
+	deleteWhere(db.recoveryCodes, func(row recoveryCodeRow) bool { return row.userID == userID })
+	deleteWhere(db.downloads, func(row models.CustomerDownload) bool { return row.UserID == userID })
+	deleteWhere(db.exports, func(row exportRow) bool { return row.UserID == userID })
+	deleteWhere(db.lockouts, func(row models.LoginLockoutEvent) bool {
+		return row.SubjectType == "account" && row.Subject == currentEmail
+	})
+
+	for id, order := range db.orders {
+		if order.UserID != nil && *order.UserID == userID {
+			order.Notes = nil
+			db.orders[id] = order
+		}
+	}
+
+	// The audit trail is kept, without the user's contact details and snapshots of their profile
+	entityID := strconv.Itoa(userID)
+	for id, event := range db.audit {
+		if event.ActorID != nil && *event.ActorID == userID {
+			event.ActorEmail, event.IPAddress, event.UserAgent = nil, nil, nil
+		}
+		if event.EntityType == "user" && event.EntityID != nil && *event.EntityID == entityID {
+			event.Before, event.After = nil, nil
+		}
+		db.audit[id] = event
+	}
+
+	return nil
+}
+
+// deleteWhere deletes the rows of a table that match
+func deleteWhere[K comparable, V any](table map[K]V, match func(V) bool) {
+	for key, row := range table {
+		if match(row) {
+			delete(table, key)
+		}
+	}
+}
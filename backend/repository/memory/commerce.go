@@ -0,0 +1,315 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type products struct {
+	s *Store
+}
+
+func (r products) List(ctx context.Context, filter repository.ProductFilter, limit, offset int) ([]models.SoftwareProduct, int, error) {
+	defer r.s.lock()()
+
+	matching := rows(r.s.db.products, func(product models.SoftwareProduct) bool {
+		switch {
+		case !product.IsActive,
+			filter.CategorySlug != "" && (product.CategoryID == nil || r.s.db.productCategories[*product.CategoryID].Slug != filter.CategorySlug),
+			filter.Featured && !product.IsFeatured,
+			filter.Search != "" && !ilike(&product.Name, filter.Search) && !ilike(&product.Description, filter.Search):
+			return false
+		}
+		return true
+	}, func(a, b models.SoftwareProduct) int {
+		if a.IsFeatured != b.IsFeatured {
+			if a.IsFeatured {
+				return -1
+			}
+			return 1
+		}
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return page(matching, limit, offset), len(matching), nil
+}
+
+func (r products) GetActiveBySlug(ctx context.Context, slug string) (*models.SoftwareProduct, error) {
+	defer r.s.lock()()
+
+	for _, product := range r.s.db.products {
+		if product.Slug == slug && product.IsActive {
+			return &product, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r products) GetByIDs(ctx context.Context, ids []int) ([]models.SoftwareProduct, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.products, func(product models.SoftwareProduct) bool { return slices.Contains(ids, product.ID) }, nil), nil
+}
+
+func (r products) Categories(ctx context.Context) ([]models.ProductCategory, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.productCategories, nil, func(a, b models.ProductCategory) int {
+		return strings.Compare(a.Name, b.Name)
+	}), nil
+}
+
+func (r products) CategoriesByIDs(ctx context.Context, ids []int) ([]models.ProductCategory, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.productCategories, func(category models.ProductCategory) bool { return slices.Contains(ids, category.ID) }, nil), nil
+}
+
+func (r products) DownloadsByUser(ctx context.Context, userID int) ([]models.CustomerDownload, error) {
+	defer r.s.lock()()
+
+	downloads := rows(r.s.db.downloads, func(download models.CustomerDownload) bool {
+		return download.UserID == userID
+	}, func(a, b models.CustomerDownload) int {
+		return oldest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	for i, download := range downloads {
+		// Download tokens are credentials and are left out
+		downloads[i].DownloadToken = ""
+		if product, ok := r.s.db.products[download.ProductID]; ok {
+			downloads[i].Product = &models.SoftwareProduct{ID: product.ID, Name: product.Name}
+		}
+	}
+	return downloads, nil
+}
+
+type orders struct {
+	s *Store
+}
+
+func newestOrder(a, b models.Order) int {
+	return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+}
+
+func (r orders) List(ctx context.Context, filter repository.OrderFilter, limit, offset int) ([]models.Order, int, error) {
+	defer r.s.lock()()
+
+	matching := rows(r.s.db.orders, func(order models.Order) bool {
+		switch {
+		case filter.UserID != nil && (order.UserID == nil || *order.UserID != *filter.UserID),
+			filter.PaymentStatus != "" && order.PaymentStatus != filter.PaymentStatus,
+			filter.OrderStatus != "" && order.OrderStatus != filter.OrderStatus:
+			return false
+		}
+		return true
+	}, newestOrder)
+	return page(matching, limit, offset), len(matching), nil
+}
+
+func (r orders) ListByUser(ctx context.Context, userID int) ([]models.Order, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.orders, func(order models.Order) bool {
+		return order.UserID != nil && *order.UserID == userID
+	}, func(a, b models.Order) int {
+		return newestOrder(b, a)
+	}), nil
+}
+
+func (r orders) GetByID(ctx context.Context, id int) (*models.Order, error) {
+	defer r.s.lock()()
+
+	order, ok := r.s.db.orders[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &order, nil
+}
+
+func (r orders) ItemsByOrderIDs(ctx context.Context, orderIDs []int) (map[int][]models.OrderItem, error) {
+	defer r.s.lock()()
+
+	items := make(map[int][]models.OrderItem)
+	for _, item := range rows(r.s.db.orderItems, func(item models.OrderItem) bool {
+		return slices.Contains(orderIDs, item.OrderID)
+	}, func(a, b models.OrderItem) int {
+		return a.ID - b.ID
+	}) {
+		items[item.OrderID] = append(items[item.OrderID], item)
+	}
+	return items, nil
+}
+
+type wallets struct {
+	s *Store
+}
+
+func (r wallets) Get(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	defer r.s.lock()()
+
+	wallet, ok := r.s.db.wallets[userID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &wallet, nil
+}
+
+func (r wallets) GetForUpdate(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	return r.Get(ctx, userID)
+}
+
+func (r wallets) Create(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	defer r.s.lock()()
+
+	if _, ok := r.s.db.wallets[userID]; ok {
+		return nil, errUnique("customer_wallets", "user_id")
+	}
+
+	now := time.Now()
+	wallet := models.CustomerWallet{ID: r.s.db.next("customer_wallets"), UserID: userID, CreatedAt: now, UpdatedAt: now}
+	r.s.db.wallets[userID] = wallet
+	return &wallet, nil
+}
+
+// change applies a change to a user's wallet and returns the new balance
+func (r wallets) change(userID int, apply func(*models.CustomerWallet)) (float64, error) {
+	defer r.s.lock()()
+
+	wallet, ok := r.s.db.wallets[userID]
+	if !ok {
+		return 0, repository.ErrNotFound
+	}
+	apply(&wallet)
+	wallet.UpdatedAt = time.Now()
+	r.s.db.wallets[userID] = wallet
+	return wallet.Balance, nil
+}
+
+func (r wallets) Deposit(ctx context.Context, userID int, amount float64) (float64, error) {
+	return r.change(userID, func(wallet *models.CustomerWallet) {
+		wallet.Balance += amount
+		wallet.TotalDeposited += amount
+	})
+}
+
+func (r wallets) Spend(ctx context.Context, userID int, amount float64) (float64, error) {
+	return r.change(userID, func(wallet *models.CustomerWallet) {
+		wallet.Balance -= amount
+		wallet.TotalSpent += amount
+	})
+}
+
+func (r wallets) CreateTransaction(ctx context.Context, transaction models.WalletTransaction) (*models.WalletTransaction, error) {
+	defer r.s.lock()()
+
+	transaction.ID = r.s.db.next("wallet_transactions")
+	transaction.CreatedAt = time.Now()
+	r.s.db.walletTransactions[transaction.ID] = transaction
+	return &transaction, nil
+}
+
+func (r wallets) GetTransactionForUpdate(ctx context.Context, id int) (*models.WalletTransaction, error) {
+	defer r.s.lock()()
+
+	transaction, ok := r.s.db.walletTransactions[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &transaction, nil
+}
+
+func (r wallets) CompleteTransaction(ctx context.Context, id int, balanceAfter float64) (*models.WalletTransaction, error) {
+	defer r.s.lock()()
+
+	transaction, ok := r.s.db.walletTransactions[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	transaction.Status = "completed"
+	transaction.BalanceAfter = balanceAfter
+	r.s.db.walletTransactions[id] = transaction
+	return &transaction, nil
+}
+
+func (r wallets) ListTransactions(ctx context.Context, userID int, transactionType string, limit, offset int) ([]models.WalletTransaction, int, error) {
+	defer r.s.lock()()
+
+	matching := rows(r.s.db.walletTransactions, func(transaction models.WalletTransaction) bool {
+		return transaction.UserID == userID && (transactionType == "" || transaction.TransactionType == transactionType)
+	}, func(a, b models.WalletTransaction) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return page(matching, limit, offset), len(matching), nil
+}
+
+func (r wallets) TransactionsByUser(ctx context.Context, userID int) ([]models.WalletTransaction, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.walletTransactions, func(transaction models.WalletTransaction) bool {
+		return transaction.UserID == userID
+	}, func(a, b models.WalletTransaction) int {
+		return oldest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}), nil
+}
+
+type points struct {
+	s *Store
+}
+
+func (r points) Get(ctx context.Context, userID int) (*models.CustomerPoints, error) {
+	defer r.s.lock()()
+
+	points, ok := r.s.db.points[userID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &points, nil
+}
+
+func (r points) Create(ctx context.Context, userID int) (*models.CustomerPoints, error) {
+	defer r.s.lock()()
+
+	if _, ok := r.s.db.points[userID]; ok {
+		return nil, errUnique("customer_points", "user_id")
+	}
+
+	now := time.Now()
+	points := models.CustomerPoints{ID: r.s.db.next("customer_points"), UserID: userID, CreatedAt: now, UpdatedAt: now}
+	r.s.db.points[userID] = points
+	return &points, nil
+}
+
+func (r points) Add(ctx context.Context, userID, amount int) error {
+	defer r.s.lock()()
+
+	if points, ok := r.s.db.points[userID]; ok {
+		points.TotalPoints += amount
+		points.AvailablePoints += amount
+		points.UpdatedAt = time.Now()
+		r.s.db.points[userID] = points
+	}
+	return nil
+}
+
+func (r points) CreateTransaction(ctx context.Context, transaction models.PointTransaction) error {
+	defer r.s.lock()()
+
+	transaction.ID = r.s.db.next("point_transactions")
+	transaction.CreatedAt = time.Now()
+	r.s.db.pointTransactions[transaction.ID] = transaction
+	return nil
+}
+
+func (r points) TransactionsByUser(ctx context.Context, userID int) ([]models.PointTransaction, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.pointTransactions, func(transaction models.PointTransaction) bool {
+		return transaction.UserID == userID
+	}, func(a, b models.PointTransaction) int {
+		return oldest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}), nil
+}
@@ -0,0 +1,417 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type posts struct {
+	s *Store
+}
+
+// withAuthor returns the post with the username and name of its author
+func (r posts) withAuthor(post models.BlogPost) models.BlogPost {
+	if post.AuthorID != nil {
+		if user, ok := r.s.db.users[*post.AuthorID]; ok {
+			post.Author = &models.User{ID: user.ID, Username: user.Username, FullName: user.FullName}
+		}
+	}
+	return post
+}
+
+func (r posts) list(keep func(models.BlogPost) bool, less func(a, b models.BlogPost) int, limit, offset int) ([]models.BlogPost, int) {
+	matching := rows(r.s.db.posts, keep, less)
+	list := []models.BlogPost{}
+	for _, post := range page(matching, limit, offset) {
+		list = append(list, r.withAuthor(post))
+	}
+	return list, len(matching)
+}
+
+func (r posts) inCategory(postID int, slug string) bool {
+	for category := range r.s.db.postCategories {
+		if category[0] == postID && r.s.db.blogCategories[category[1]].Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+func (r posts) ListPublished(ctx context.Context, filter repository.PostFilter, limit, offset int) ([]models.BlogPost, int, error) {
+	defer r.s.lock()()
+
+	list, total := r.list(func(post models.BlogPost) bool {
+		switch {
+		case post.Status != "published",
+			filter.CategorySlug != "" && !r.inCategory(post.ID, filter.CategorySlug),
+			filter.Featured && !post.IsFeatured,
+			filter.Search != "" && !ilike(&post.Title, filter.Search) && !ilike(&post.Content, filter.Search):
+			return false
+		}
+		return true
+	}, func(a, b models.BlogPost) int {
+		var aTime, bTime time.Time
+		if a.PublishedAt != nil {
+			aTime = *a.PublishedAt
+		}
+		if b.PublishedAt != nil {
+			bTime = *b.PublishedAt
+		}
+		return newest(aTime, bTime, a.ID, b.ID)
+	}, limit, offset)
+	return list, total, nil
+}
+
+func (r posts) List(ctx context.Context, limit, offset int) ([]models.BlogPost, int, error) {
+	defer r.s.lock()()
+
+	list, total := r.list(nil, func(a, b models.BlogPost) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}, limit, offset)
+	return list, total, nil
+}
+
+func (r posts) ListByAuthor(ctx context.Context, authorID int) ([]models.BlogPost, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.posts, func(post models.BlogPost) bool {
+		return post.AuthorID != nil && *post.AuthorID == authorID
+	}, func(a, b models.BlogPost) int {
+		return oldest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	}), nil
+}
+
+func (r posts) GetByID(ctx context.Context, id int) (*models.BlogPost, error) {
+	defer r.s.lock()()
+
+	post, ok := r.s.db.posts[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &post, nil
+}
+
+func (r posts) GetForUpdate(ctx context.Context, id int) (*models.BlogPost, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r posts) GetPublishedBySlug(ctx context.Context, slug string) (*models.BlogPost, error) {
+	defer r.s.lock()()
+
+	for _, post := range r.s.db.posts {
+		if post.Slug == slug && post.Status == "published" {
+			post = r.withAuthor(post)
+			return &post, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r posts) IncrementViews(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if post, ok := r.s.db.posts[id]; ok {
+		post.ViewCount++
+		r.s.db.posts[id] = post
+	}
+	return nil
+}
+
+// slugTaken reports whether a post other than id uses slug
+func (r posts) slugTaken(slug string, id int) bool {
+	for _, post := range r.s.db.posts {
+		if post.Slug == slug && post.ID != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r posts) Create(ctx context.Context, post models.BlogPost) (*models.BlogPost, error) {
+	defer r.s.lock()()
+
+	if r.slugTaken(post.Slug, 0) {
+		return nil, errUnique("blog_posts", "slug")
+	}
+
+	now := time.Now()
+	post.ID = r.s.db.next("blog_posts")
+	post.ViewCount = 0
+	post.CreatedAt, post.UpdatedAt = now, now
+	post.Author, post.Categories = nil, nil
+	r.s.db.posts[post.ID] = post
+	return &post, nil
+}
+
+func (r posts) Update(ctx context.Context, post models.BlogPost) (*models.BlogPost, error) {
+	defer r.s.lock()()
+
+	current, ok := r.s.db.posts[post.ID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	if r.slugTaken(post.Slug, post.ID) {
+		return nil, errUnique("blog_posts", "slug")
+	}
+
+	current.Title, current.Slug, current.Content = post.Title, post.Slug, post.Content
+	current.Excerpt, current.FeaturedImage = post.Excerpt, post.FeaturedImage
+	current.Status, current.IsFeatured = post.Status, post.IsFeatured
+	if post.PublishedAt != nil {
+		current.PublishedAt = post.PublishedAt
+	}
+	current.UpdatedAt = time.Now()
+	r.s.db.posts[post.ID] = current
+	return &current, nil
+}
+
+func (r posts) Delete(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if _, ok := r.s.db.posts[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.db.posts, id)
+	for link := range r.s.db.postCategories {
+		if link[0] == id {
+			delete(r.s.db.postCategories, link)
+		}
+	}
+	return nil
+}
+
+func byName(a, b models.BlogCategory) int {
+	return strings.Compare(a.Name, b.Name)
+}
+
+func (r posts) Categories(ctx context.Context) ([]models.BlogCategory, error) {
+	defer r.s.lock()()
+
+	return rows(r.s.db.blogCategories, nil, byName), nil
+}
+
+func (r posts) CreateCategory(ctx context.Context, name, slug, description string) (*models.BlogCategory, error) {
+	defer r.s.lock()()
+
+	for _, category := range r.s.db.blogCategories {
+		if category.Slug == slug {
+			return nil, errUnique("blog_categories", "slug")
+		}
+	}
+
+	category := models.BlogCategory{
+		ID: r.s.db.next("blog_categories"), Name: name, Slug: slug, Description: &description, CreatedAt: time.Now(),
+	}
+	r.s.db.blogCategories[category.ID] = category
+	return &category, nil
+}
+
+func (r posts) CategoriesByPostIDs(ctx context.Context, postIDs []int) (map[int][]models.BlogCategory, error) {
+	defer r.s.lock()()
+
+	wanted := map[int]bool{}
+	for _, id := range postIDs {
+		wanted[id] = true
+	}
+
+	categories := make(map[int][]models.BlogCategory)
+	for _, category := range rows(r.s.db.blogCategories, nil, byName) {
+		for link := range r.s.db.postCategories {
+			if link[1] == category.ID && wanted[link[0]] {
+				categories[link[0]] = append(categories[link[0]], category)
+			}
+		}
+	}
+	return categories, nil
+}
+
+func (r posts) CountPublished(ctx context.Context, categoryIDs []int) (map[int]int, error) {
+	defer r.s.lock()()
+
+	wanted := map[int]bool{}
+	for _, id := range categoryIDs {
+		wanted[id] = true
+	}
+
+	counts := make(map[int]int)
+	for link := range r.s.db.postCategories {
+		if wanted[link[1]] && r.s.db.posts[link[0]].Status == "published" {
+			counts[link[1]]++
+		}
+	}
+	return counts, nil
+}
+
+type projects struct {
+	s *Store
+}
+
+func (r projects) List(ctx context.Context, filter repository.ProjectFilter, limit, offset int) ([]models.Project, int, error) {
+	defer r.s.lock()()
+
+	matching := rows(r.s.db.projects, func(project models.Project) bool {
+		switch {
+		case filter.Status != "" && project.Status != filter.Status,
+			filter.Featured && !project.IsFeatured,
+			filter.Search != "" && !ilike(&project.Name, filter.Search) && !ilike(&project.Description, filter.Search):
+			return false
+		}
+		return true
+	}, func(a, b models.Project) int {
+		if a.SortOrder != b.SortOrder {
+			return a.SortOrder - b.SortOrder
+		}
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return page(matching, limit, offset), len(matching), nil
+}
+
+func (r projects) ListAll(ctx context.Context, limit, offset int) ([]models.Project, int, error) {
+	defer r.s.lock()()
+
+	all := rows(r.s.db.projects, nil, func(a, b models.Project) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return page(all, limit, offset), len(all), nil
+}
+
+func (r projects) GetBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	defer r.s.lock()()
+
+	for _, project := range r.s.db.projects {
+		if project.Slug == slug {
+			return &project, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r projects) GetForUpdate(ctx context.Context, id int) (*models.Project, error) {
+	defer r.s.lock()()
+
+	project, ok := r.s.db.projects[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &project, nil
+}
+
+func (r projects) slugTaken(slug string, id int) bool {
+	for _, project := range r.s.db.projects {
+		if project.Slug == slug && project.ID != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r projects) Create(ctx context.Context, project models.Project) (*models.Project, error) {
+	defer r.s.lock()()
+
+	if r.slugTaken(project.Slug, 0) {
+		return nil, errUnique("projects", "slug")
+	}
+
+	now := time.Now()
+	project.ID = r.s.db.next("projects")
+	project.CreatedAt, project.UpdatedAt = now, now
+	r.s.db.projects[project.ID] = project
+	return &project, nil
+}
+
+func (r projects) Update(ctx context.Context, id int, project models.Project) (*models.Project, error) {
+	defer r.s.lock()()
+
+	current, ok := r.s.db.projects[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	if r.slugTaken(project.Slug, id) {
+		return nil, errUnique("projects", "slug")
+	}
+
+	project.ID, project.CreatedAt, project.UpdatedAt = id, current.CreatedAt, time.Now()
+	r.s.db.projects[id] = project
+	return &project, nil
+}
+
+func (r projects) Delete(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if _, ok := r.s.db.projects[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.db.projects, id)
+	return nil
+}
+
+type wordpress struct {
+	s *Store
+}
+
+func (r wordpress) ListActiveSites(ctx context.Context) ([]models.WordPressSite, error) {
+	defer r.s.lock()()
+
+	sites := rows(r.s.db.sites, func(site models.WordPressSite) bool { return site.IsActive }, func(a, b models.WordPressSite) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for i := range sites {
+		sites[i].ApplicationPassword = nil
+	}
+	return sites, nil
+}
+
+func (r wordpress) CreateSite(ctx context.Context, site models.WordPressSite) (*models.WordPressSite, error) {
+	defer r.s.lock()()
+
+	now := time.Now()
+	site.ID = r.s.db.next("wordpress_sites")
+	site.LastSyncAt = nil
+	site.CreatedAt, site.UpdatedAt = now, now
+	r.s.db.sites[site.ID] = site
+
+	site.ApplicationPassword = nil
+	return &site, nil
+}
+
+func (r wordpress) GetSite(ctx context.Context, id int) (*models.WordPressSite, error) {
+	defer r.s.lock()()
+
+	site, ok := r.s.db.sites[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return &site, nil
+}
+
+func (r wordpress) TouchSync(ctx context.Context, id int) error {
+	defer r.s.lock()()
+
+	if site, ok := r.s.db.sites[id]; ok {
+		site.LastSyncAt = now()
+		r.s.db.sites[id] = site
+	}
+	return nil
+}
+
+func (r wordpress) LogSync(ctx context.Context, entry models.ContentSyncLog) error {
+	defer r.s.lock()()
+
+	entry.ID = r.s.db.next("content_sync_logs")
+	entry.CreatedAt = time.Now()
+	r.s.db.syncLogs[entry.ID] = entry
+	return nil
+}
+
+func (r wordpress) ListLogs(ctx context.Context, siteID, limit, offset int) ([]models.ContentSyncLog, int, error) {
+	defer r.s.lock()()
+
+	logs := rows(r.s.db.syncLogs, func(log models.ContentSyncLog) bool { return log.SiteID == siteID }, func(a, b models.ContentSyncLog) int {
+		return newest(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return page(logs, limit, offset), len(logs), nil
+}
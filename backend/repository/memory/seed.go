@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"time"
+
+	"zplus_web/backend/models"
+)
+
+// The API has no endpoints that write these tables; tests fill them with the
+// Add methods below. IDs and missing timestamps are assigned like inserts do.
+
+// AddBlogCategory links a post to a category
+func (s *Store) AddBlogCategory(postID, categoryID int) {
+	defer s.lock()()
+
+	s.db.postCategories[[2]int{postID, categoryID}] = true
+}
+
+// AddProductCategory stores a product category and returns it with its ID
+func (s *Store) AddProductCategory(category models.ProductCategory) models.ProductCategory {
+	defer s.lock()()
+
+	category.ID = s.db.next("product_categories")
+	if category.CreatedAt.IsZero() {
+		category.CreatedAt = time.Now()
+	}
+	s.db.productCategories[category.ID] = category
+	return category
+}
+
+// AddProduct stores a software product and returns it with its ID
+func (s *Store) AddProduct(product models.SoftwareProduct) models.SoftwareProduct {
+	defer s.lock()()
+
+	product.ID = s.db.next("software_products")
+	if product.CreatedAt.IsZero() {
+		product.CreatedAt = time.Now()
+	}
+	product.UpdatedAt = product.CreatedAt
+	s.db.products[product.ID] = product
+	return product
+}
+
+// AddOrder stores an order with its items and returns it with its ID
+func (s *Store) AddOrder(order models.Order) models.Order {
+	defer s.lock()()
+
+	order.ID = s.db.next("orders")
+	if order.CreatedAt.IsZero() {
+		order.CreatedAt = time.Now()
+	}
+	order.UpdatedAt = order.CreatedAt
+
+	for i := range order.Items {
+		order.Items[i].ID = s.db.next("order_items")
+		order.Items[i].OrderID = order.ID
+		order.Items[i].CreatedAt = order.CreatedAt
+		s.db.orderItems[order.Items[i].ID] = order.Items[i]
+	}
+
+	stored := order
+	stored.Items = nil
+	s.db.orders[order.ID] = stored
+	return order
+}
+
+// AddDownload stores a download entitlement and returns it with its ID
+func (s *Store) AddDownload(download models.CustomerDownload) models.CustomerDownload {
+	defer s.lock()()
+
+	download.ID = s.db.next("customer_downloads")
+	if download.CreatedAt.IsZero() {
+		download.CreatedAt = time.Now()
+	}
+	s.db.downloads[download.ID] = download
+	return download
+}
@@ -0,0 +1,408 @@
+// Package memory implements the repositories in process memory. It backs the
+// handler and service tests, so it follows the behaviour of the postgres
+// package closely: the same rows are found, in the same order, and the same
+// uniqueness rules apply.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+// Store keeps every table in maps guarded by one mutex. A transaction holds
+// the mutex until it ends, so transactions never interleave.
+type Store struct {
+	mu   *sync.Mutex
+	db   *tables
+	inTx bool
+}
+
+// NewStore returns an empty store with the system roles the baseline
+// migration seeds
+func NewStore() *Store {
+	s := &Store{mu: &sync.Mutex{}, db: newTables()}
+
+	permissions := map[string][]string{
+		"admin":        {"*"},
+		"user":         {},
+		"editor":       {"blog:publish", "blog:write", "dashboard:view", "projects:write"},
+		"shop_manager": {"dashboard:view", "orders:read", "orders:refund"},
+		"support":      {"dashboard:view", "orders:read", "users:read"},
+		"accountant":   {"dashboard:view", "orders:read", "wallet:adjust"},
+	}
+	descriptions := map[string]string{
+		"admin":        "Full access to everything",
+		"user":         "Registered customer without admin access",
+		"editor":       "Writes and publishes blog posts and projects",
+		"shop_manager": "Handles orders and refunds",
+		"support":      "Helps customers with their accounts and orders",
+		"accountant":   "Reviews orders and adjusts wallets",
+	}
+	for _, name := range []string{"admin", "user", "editor", "shop_manager", "support", "accountant"} {
+		description := descriptions[name]
+		id := s.db.next("roles")
+		now := time.Now()
+		s.db.roles[id] = models.Role{
+			ID: id, Name: name, Description: &description, IsSystem: true,
+			Permissions: permissions[name], CreatedAt: now, UpdatedAt: now,
+		}
+	}
+
+	return s
+}
+
+type userRow struct {
+	models.User
+	serviceAccount bool
+	erased         bool
+}
+
+type passwordHistoryRow struct {
+	id     int
+	userID int
+	hash   string
+}
+
+type sessionRow struct {
+	models.UserSession
+	impersonatorID *int
+}
+
+type refreshTokenRow struct {
+	id        int
+	sessionID int
+	hash      string
+	usedAt    *time.Time
+	expiresAt time.Time
+}
+
+// tokenRow is a row of the password reset, email verification and login link tables
+type tokenRow struct {
+	userID    int
+	hash      string
+	nonceHash string
+	expiresAt time.Time
+	usedAt    *time.Time
+}
+
+type recoveryCodeRow struct {
+	userID int
+	hash   string
+	used   bool
+}
+
+type invitationRow struct {
+	models.Invitation
+	tokenHash      string
+	acceptedUserID *int
+}
+
+type apiKeyRow struct {
+	models.APIKey
+	keyHash string
+}
+
+type exportRow struct {
+	models.DataExport
+	archive []byte
+}
+
+type tables struct {
+	seq map[string]int
+
+	users              map[int]userRow
+	passwordHistory    map[int]passwordHistoryRow
+	sessions           map[int]sessionRow
+	refreshTokens      map[int]refreshTokenRow
+	passwordResets     map[int]tokenRow
+	emailVerifications map[int]tokenRow
+	magicLinks         map[int]tokenRow
+	mfa                map[int]repository.MFASettings
+	recoveryCodes      map[int]recoveryCodeRow
+	identities         map[int]models.UserIdentity
+	lockouts           map[int]models.LoginLockoutEvent
+	invitations        map[int]invitationRow
+	apiKeys            map[int]apiKeyRow
+	roles              map[int]models.Role
+	audit              map[int]models.AuditEvent
+	settings           map[string]string
+	exports            map[int]exportRow
+
+	posts          map[int]models.BlogPost
+	blogCategories map[int]models.BlogCategory
+	postCategories map[[2]int]bool
+	projects       map[int]models.Project
+	sites          map[int]models.WordPressSite
+	syncLogs       map[int]models.ContentSyncLog
+
+	products           map[int]models.SoftwareProduct
+	productCategories  map[int]models.ProductCategory
+	downloads          map[int]models.CustomerDownload
+	orders             map[int]models.Order
+	orderItems         map[int]models.OrderItem
+	wallets            map[int]models.CustomerWallet
+	walletTransactions map[int]models.WalletTransaction
+	points             map[int]models.CustomerPoints
+	pointTransactions  map[int]models.PointTransaction
+}
+
+func newTables() *tables {
+	return &tables{
+		seq:                map[string]int{},
+		users:              map[int]userRow{},
+		passwordHistory:    map[int]passwordHistoryRow{},
+		sessions:           map[int]sessionRow{},
+		refreshTokens:      map[int]refreshTokenRow{},
+		passwordResets:     map[int]tokenRow{},
+		emailVerifications: map[int]tokenRow{},
+		magicLinks:         map[int]tokenRow{},
+		mfa:                map[int]repository.MFASettings{},
+		recoveryCodes:      map[int]recoveryCodeRow{},
+		identities:         map[int]models.UserIdentity{},
+		lockouts:           map[int]models.LoginLockoutEvent{},
+		invitations:        map[int]invitationRow{},
+		apiKeys:            map[int]apiKeyRow{},
+		roles:              map[int]models.Role{},
+		audit:              map[int]models.AuditEvent{},
+		settings:           map[string]string{},
+		exports:            map[int]exportRow{},
+		posts:              map[int]models.BlogPost{},
+		blogCategories:     map[int]models.BlogCategory{},
+		postCategories:     map[[2]int]bool{},
+		projects:           map[int]models.Project{},
+		sites:              map[int]models.WordPressSite{},
+		syncLogs:           map[int]models.ContentSyncLog{},
+		products:           map[int]models.SoftwareProduct{},
+		productCategories:  map[int]models.ProductCategory{},
+		downloads:          map[int]models.CustomerDownload{},
+		orders:             map[int]models.Order{},
+		orderItems:         map[int]models.OrderItem{},
+		wallets:            map[int]models.CustomerWallet{},
+		walletTransactions: map[int]models.WalletTransaction{},
+		points:             map[int]models.CustomerPoints{},
+		pointTransactions:  map[int]models.PointTransaction{},
+	}
+}
+
+// next returns the next ID of a table
+func (t *tables) next(table string) int {
+	t.seq[table]++
+	return t.seq[table]
+}
+
+// clone copies every table. Rows are values and slices in them are replaced
+// rather than modified, so copying the maps is enough.
+func (t *tables) clone() *tables {
+	return &tables{
+		seq:                maps.Clone(t.seq),
+		users:              maps.Clone(t.users),
+		passwordHistory:    maps.Clone(t.passwordHistory),
+		sessions:           maps.Clone(t.sessions),
+		refreshTokens:      maps.Clone(t.refreshTokens),
+		passwordResets:     maps.Clone(t.passwordResets),
+		emailVerifications: maps.Clone(t.emailVerifications),
+		magicLinks:         maps.Clone(t.magicLinks),
+		mfa:                maps.Clone(t.mfa),
+		recoveryCodes:      maps.Clone(t.recoveryCodes),
+		identities:         maps.Clone(t.identities),
+		lockouts:           maps.Clone(t.lockouts),
+		invitations:        maps.Clone(t.invitations),
+		apiKeys:            maps.Clone(t.apiKeys),
+		roles:              maps.Clone(t.roles),
+		audit:              maps.Clone(t.audit),
+		settings:           maps.Clone(t.settings),
+		exports:            maps.Clone(t.exports),
+		posts:              maps.Clone(t.posts),
+		blogCategories:     maps.Clone(t.blogCategories),
+		postCategories:     maps.Clone(t.postCategories),
+		projects:           maps.Clone(t.projects),
+		sites:              maps.Clone(t.sites),
+		syncLogs:           maps.Clone(t.syncLogs),
+		products:           maps.Clone(t.products),
+		productCategories:  maps.Clone(t.productCategories),
+		downloads:          maps.Clone(t.downloads),
+		orders:             maps.Clone(t.orders),
+		orderItems:         maps.Clone(t.orderItems),
+		wallets:            maps.Clone(t.wallets),
+		walletTransactions: maps.Clone(t.walletTransactions),
+		points:             maps.Clone(t.points),
+		pointTransactions:  maps.Clone(t.pointTransactions),
+	}
+}
+
+// lock takes the store's mutex unless the caller is inside a transaction,
+// which already holds it, and returns the matching unlock
+func (s *Store) lock() func() {
+	if s.inTx {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *Store) Users() repository.UserRepository {
+	return users{s}
+}
+
+func (s *Store) Sessions() repository.SessionRepository {
+	return sessions{s}
+}
+
+func (s *Store) PasswordResets() repository.TokenRepository {
+	return tokens{s, "password_reset_tokens"}
+}
+
+func (s *Store) EmailVerifications() repository.TokenRepository {
+	return tokens{s, "email_verification_tokens"}
+}
+
+func (s *Store) MagicLinks() repository.MagicLinkRepository {
+	return magicLinks{s}
+}
+
+func (s *Store) MFA() repository.MFARepository {
+	return mfa{s}
+}
+
+func (s *Store) Identities() repository.IdentityRepository {
+	return identities{s}
+}
+
+func (s *Store) Lockouts() repository.LockoutRepository {
+	return lockouts{s}
+}
+
+func (s *Store) Invitations() repository.InvitationRepository {
+	return invitations{s}
+}
+
+func (s *Store) APIKeys() repository.APIKeyRepository {
+	return apiKeys{s}
+}
+
+func (s *Store) Roles() repository.RoleRepository {
+	return roles{s}
+}
+
+func (s *Store) Audit() repository.AuditRepository {
+	return audit{s}
+}
+
+func (s *Store) Settings() repository.SettingsRepository {
+	return settings{s}
+}
+
+func (s *Store) Privacy() repository.PrivacyRepository {
+	return privacy{s}
+}
+
+func (s *Store) Posts() repository.PostRepository {
+	return posts{s}
+}
+
+func (s *Store) Projects() repository.ProjectRepository {
+	return projects{s}
+}
+
+func (s *Store) WordPress() repository.WordPressRepository {
+	return wordpress{s}
+}
+
+func (s *Store) Products() repository.ProductRepository {
+	return products{s}
+}
+
+func (s *Store) Orders() repository.OrderRepository {
+	return orders{s}
+}
+
+func (s *Store) Wallets() repository.WalletRepository {
+	return wallets{s}
+}
+
+func (s *Store) Points() repository.PointsRepository {
+	return points{s}
+}
+
+// Transact runs fn while holding the store's mutex and restores every table
+// when fn fails
+func (s *Store) Transact(ctx context.Context, fn func(tx repository.Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.db.clone()
+	if err := fn(&Store{mu: s.mu, db: s.db, inTx: true}); err != nil {
+		*s.db = *snapshot
+		return err
+	}
+	return nil
+}
+
+// rows returns the rows of a table that match keep, sorted by less
+func rows[K comparable, V any](table map[K]V, keep func(V) bool, less func(a, b V) int) []V {
+	items := []V{}
+	for _, row := range table {
+		if keep == nil || keep(row) {
+			items = append(items, row)
+		}
+	}
+	if less != nil {
+		slices.SortFunc(items, less)
+	}
+	return items
+}
+
+// page returns the rows of items a LIMIT and OFFSET select
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// newest orders rows by creation time, newest first, then by ID
+func newest(aTime, bTime time.Time, aID, bID int) int {
+	if c := bTime.Compare(aTime); c != 0 {
+		return c
+	}
+	return bID - aID
+}
+
+// oldest orders rows by creation time, oldest first, then by ID
+func oldest(aTime, bTime time.Time, aID, bID int) int {
+	return newest(bTime, aTime, bID, aID)
+}
+
+// ilike reports whether value contains search ignoring case, like ILIKE '%search%'
+func ilike(value *string, search string) bool {
+	return value != nil && strings.Contains(strings.ToLower(*value), strings.ToLower(search))
+}
+
+// errUnique mirrors the error Postgres reports when a unique constraint rejects a row
+func errUnique(table, column string) error {
+	return fmt.Errorf("duplicate key value violates unique constraint %q: %w", table+"_"+column+"_key", repository.ErrConflict)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func now() *time.Time {
+	return ptr(time.Now())
+}
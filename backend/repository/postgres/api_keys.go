@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const apiKeyColumns = `
+	id, user_id, name, prefix, scopes, expires_at, last_used_at, last_used_ip, revoked_at, created_by, created_at`
+
+type apiKeys struct {
+	q querier
+}
+
+func scanAPIKey(row scanner) (models.APIKey, error) {
+	var key models.APIKey
+	err := row.Scan(
+		&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Scopes, &key.ExpiresAt,
+		&key.LastUsedAt, &key.LastUsedIP, &key.RevokedAt, &key.CreatedBy, &key.CreatedAt)
+	return key, err
+}
+
+func (r apiKeys) Create(ctx context.Context, k repository.NewAPIKey) (*models.APIKey, error) {
+	key, err := scanAPIKey(r.q.QueryRowContext(ctx, `
+		INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+		RETURNING`+apiKeyColumns,
+		k.UserID, k.Name, k.Prefix, k.KeyHash, pq.Array(k.Scopes), k.ExpiresAt, k.CreatedBy))
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r apiKeys) List(ctx context.Context, userID int) ([]models.APIKey, error) {
+	return query(ctx, r.q, scanAPIKey, `
+		SELECT`+apiKeyColumns+`
+		FROM api_keys
+		WHERE $1 = 0 OR user_id = $1
+		ORDER BY created_at DESC`, userID)
+}
+
+func (r apiKeys) Revoke(ctx context.Context, id int) (string, error) {
+	var prefix string
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING prefix`, id).Scan(&prefix)
+	return prefix, notFound(err)
+}
+
+func (r apiKeys) GetByHash(ctx context.Context, keyHash string) (*models.APIKey, *models.User, error) {
+	var key models.APIKey
+	var user models.User
+	err := r.q.QueryRowContext(ctx, `
+		SELECT k.id, k.user_id, k.scopes, k.expires_at, k.revoked_at,
+		       u.id, u.username, u.email, u.role, u.is_active, u.email_verified
+		FROM api_keys k
+		JOIN users u ON u.id = k.user_id
+		WHERE k.key_hash = $1`, keyHash).Scan(
+		&key.ID, &key.UserID, &key.Scopes, &key.ExpiresAt, &key.RevokedAt,
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.IsActive, &user.EmailVerified)
+	if err != nil {
+		return nil, nil, notFound(err)
+	}
+	return &key, &user, nil
+}
+
+func (r apiKeys) TouchLastUsed(ctx context.Context, id int, ip string, since time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE api_keys SET last_used_at = CURRENT_TIMESTAMP, last_used_ip = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)`,
+		id, ip, since)
+	return err
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"zplus_web/backend/models"
+)
+
+type audit struct {
+	q querier
+}
+
+func (r audit) Insert(ctx context.Context, event models.AuditEvent) error {
+	// JSONB takes the encoded text; nil stays NULL
+	var before, after interface{}
+	if event.Before != nil {
+		before = string(event.Before)
+	}
+	if event.After != nil {
+		after = string(event.After)
+	}
+
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO audit_events (actor_id, actor_email, impersonator_id, action, entity_type, entity_id, before_data, after_data, ip_address, user_agent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, CURRENT_TIMESTAMP)`,
+		event.ActorID, event.ActorEmail, event.ImpersonatorID, event.Action, event.EntityType, event.EntityID,
+		before, after, event.IPAddress, event.UserAgent)
+	return err
+}
+
+func (r audit) Count(ctx context.Context, filter models.AuditFilter) (int, error) {
+	whereClause, args := auditWhere(filter)
+
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM audit_events WHERE "+whereClause, args...).Scan(&total)
+	return total, err
+}
+
+func (r audit) List(ctx context.Context, filter models.AuditFilter, limit, offset int) ([]models.AuditEvent, error) {
+	whereClause, args := auditWhere(filter)
+	args = append(args, limit, offset)
+
+	return query(ctx, r.q, func(row scanner) (models.AuditEvent, error) {
+		var event models.AuditEvent
+		var before, after []byte
+		err := row.Scan(
+			&event.ID, &event.ActorID, &event.ActorEmail, &event.ImpersonatorID, &event.Action, &event.EntityType, &event.EntityID,
+			&before, &after, &event.IPAddress, &event.UserAgent, &event.CreatedAt)
+		event.Before = before
+		event.After = after
+		return event, err
+	}, fmt.Sprintf(`
+		SELECT id, actor_id, actor_email, impersonator_id, action, entity_type, entity_id,
+		       before_data, after_data, ip_address, user_agent, created_at
+		FROM audit_events
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args)), args...)
+}
+
+func auditWhere(filter models.AuditFilter) (string, []interface{}) {
+	conditions := []string{"1=1"}
+	args := []interface{}{}
+
+	if filter.ActorID != nil {
+		args = append(args, *filter.ActorID)
+		conditions = append(conditions, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		conditions = append(conditions, fmt.Sprintf("action = $%d", len(args)))
+	}
+	if filter.EntityType != "" {
+		args = append(args, filter.EntityType)
+		conditions = append(conditions, fmt.Sprintf("entity_type = $%d", len(args)))
+	}
+	if filter.EntityID != "" {
+		args = append(args, filter.EntityID)
+		conditions = append(conditions, fmt.Sprintf("entity_id = $%d", len(args)))
+	}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+type settings struct {
+	q querier
+}
+
+func (r settings) Get(ctx context.Context, key string) (string, error) {
+	var value string
+	err := r.q.QueryRowContext(ctx, "SELECT value FROM app_settings WHERE key = $1", key).Scan(&value)
+	return value, notFound(err)
+}
+
+func (r settings) Set(ctx context.Context, key, value string) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO app_settings (key, value, updated_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = CURRENT_TIMESTAMP`,
+		key, value)
+	return err
+}
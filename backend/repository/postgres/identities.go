@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"zplus_web/backend/models"
+)
+
+type identities struct {
+	q querier
+}
+
+func (r identities) ListByUser(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	return query(ctx, r.q, func(row scanner) (models.UserIdentity, error) {
+		var identity models.UserIdentity
+		err := row.Scan(
+			&identity.ID, &identity.UserID, &identity.Provider, &identity.Subject,
+			&identity.Email, &identity.CreatedAt, &identity.LastLoginAt)
+		return identity, err
+	}, `
+		SELECT id, user_id, provider, subject, email, created_at, last_login_at
+		FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at`, userID)
+}
+
+func (r identities) TouchLogin(ctx context.Context, provider, subject, email string) (int, error) {
+	var userID int
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE user_identities SET email = $3, last_login_at = CURRENT_TIMESTAMP
+		WHERE provider = $1 AND subject = $2
+		RETURNING user_id`, provider, subject, email).Scan(&userID)
+	return userID, notFound(err)
+}
+
+func (r identities) Create(ctx context.Context, userID int, provider, subject, email string) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO user_identities (user_id, provider, subject, email, created_at, last_login_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`,
+		userID, provider, subject, email)
+	return err
+}
+
+type lockouts struct {
+	q querier
+}
+
+func (r lockouts) Record(ctx context.Context, event models.LoginLockoutEvent) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO login_lockout_events (subject_type, subject, event, failures, locked_until, actor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)`,
+		event.SubjectType, event.Subject, event.Event, event.Failures, event.LockedUntil, event.ActorID)
+	return err
+}
+
+func (r lockouts) List(ctx context.Context, limit int) ([]models.LoginLockoutEvent, error) {
+	return query(ctx, r.q, func(row scanner) (models.LoginLockoutEvent, error) {
+		var event models.LoginLockoutEvent
+		err := row.Scan(
+			&event.ID, &event.SubjectType, &event.Subject, &event.Event, &event.Failures,
+			&event.LockedUntil, &event.ActorID, &event.CreatedAt)
+		return event, err
+	}, `
+		SELECT id, subject_type, subject, event, failures, locked_until, actor_id, created_at
+		FROM login_lockout_events
+		ORDER BY created_at DESC
+		LIMIT $1`, limit)
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const invitationColumns = `
+	id, email, role, full_name, invited_by, expires_at, accepted_at, revoked_at, last_sent_at, created_at`
+
+type invitations struct {
+	q querier
+}
+
+func scanInvitation(row scanner) (models.Invitation, error) {
+	var invitation models.Invitation
+	err := row.Scan(
+		&invitation.ID, &invitation.Email, &invitation.Role, &invitation.FullName, &invitation.InvitedBy,
+		&invitation.ExpiresAt, &invitation.AcceptedAt, &invitation.RevokedAt, &invitation.LastSentAt, &invitation.CreatedAt)
+	return invitation, err
+}
+
+func (r invitations) Create(ctx context.Context, i repository.NewInvitation) (*models.Invitation, error) {
+	// The partial unique index allows one pending invitation per address
+	invitation, err := scanInvitation(r.q.QueryRowContext(ctx, `
+		INSERT INTO invitations (email, role, full_name, token_hash, invited_by, expires_at, last_sent_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT DO NOTHING
+		RETURNING`+invitationColumns,
+		i.Email, i.Role, i.FullName, i.TokenHash, i.InvitedBy, i.ExpiresAt))
+	if err != nil {
+		return nil, conflict(err)
+	}
+	return &invitation, nil
+}
+
+func (r invitations) ListPending(ctx context.Context) ([]models.Invitation, error) {
+	return query(ctx, r.q, scanInvitation, `
+		SELECT`+invitationColumns+`
+		FROM invitations
+		WHERE accepted_at IS NULL AND revoked_at IS NULL
+		ORDER BY created_at DESC`)
+}
+
+func (r invitations) Renew(ctx context.Context, id int, tokenHash string, expiresAt time.Time) (*models.Invitation, error) {
+	invitation, err := scanInvitation(r.q.QueryRowContext(ctx, `
+		UPDATE invitations SET token_hash = $2, expires_at = $3, last_sent_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL
+		RETURNING`+invitationColumns,
+		id, tokenHash, expiresAt))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &invitation, nil
+}
+
+func (r invitations) Revoke(ctx context.Context, id int) (string, error) {
+	var email string
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE invitations SET revoked_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL
+		RETURNING email`, id).Scan(&email)
+	return email, notFound(err)
+}
+
+func (r invitations) Accept(ctx context.Context, tokenHash string) (*models.Invitation, error) {
+	invitation, err := scanInvitation(r.q.QueryRowContext(ctx, `
+		UPDATE invitations SET accepted_at = CURRENT_TIMESTAMP
+		WHERE token_hash = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		RETURNING`+invitationColumns, tokenHash))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &invitation, nil
+}
+
+func (r invitations) SetAcceptedUser(ctx context.Context, id, userID int) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE invitations SET accepted_user_id = $2 WHERE id = $1", id, userID)
+	return err
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"zplus_web/backend/repository"
+)
+
+type mfa struct {
+	q querier
+}
+
+func (r mfa) Status(ctx context.Context, userID int) (bool, int, error) {
+	var enabled bool
+	var remaining int
+	err := r.q.QueryRowContext(ctx, `
+		SELECT
+			COALESCE((SELECT enabled FROM user_mfa WHERE user_id = $1), false),
+			(SELECT COUNT(*) FROM mfa_recovery_codes WHERE user_id = $1 AND used_at IS NULL)`,
+		userID).Scan(&enabled, &remaining)
+	return enabled, remaining, err
+}
+
+func (r mfa) SetPendingSecret(ctx context.Context, userID int, secret string) error {
+	err := affected(r.q.ExecContext(ctx, `
+		INSERT INTO user_mfa (user_id, totp_secret, enabled, created_at)
+		VALUES ($1, $2, false, CURRENT_TIMESTAMP)
+		ON CONFLICT (user_id) DO UPDATE SET totp_secret = EXCLUDED.totp_secret, created_at = CURRENT_TIMESTAMP
+		WHERE user_mfa.enabled = false`,
+		userID, secret))
+	if err == repository.ErrNotFound {
+		return repository.ErrConflict
+	}
+	return err
+}
+
+func (r mfa) GetForUpdate(ctx context.Context, userID int) (*repository.MFASettings, error) {
+	var settings repository.MFASettings
+	err := r.q.QueryRowContext(ctx, `
+		SELECT totp_secret, enabled, last_used_step FROM user_mfa
+		WHERE user_id = $1
+		FOR UPDATE`, userID).Scan(&settings.Secret, &settings.Enabled, &settings.LastUsedStep)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &settings, nil
+}
+
+func (r mfa) Enable(ctx context.Context, userID int, step int64) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE user_mfa SET enabled = true, last_used_step = $2, enabled_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1`, userID, step)
+	return err
+}
+
+func (r mfa) SetLastUsedStep(ctx context.Context, userID int, step int64) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE user_mfa SET last_used_step = $2 WHERE user_id = $1", userID, step)
+	return err
+}
+
+func (r mfa) UseRecoveryCode(ctx context.Context, userID int, codeHash string) error {
+	var id int
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE mfa_recovery_codes SET used_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		RETURNING id`, userID, codeHash).Scan(&id)
+	return notFound(err)
+}
+
+func (r mfa) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+
+	for _, hash := range codeHashes {
+		_, err := r.q.ExecContext(ctx, `
+			INSERT INTO mfa_recovery_codes (user_id, code_hash, created_at)
+			VALUES ($1, $2, CURRENT_TIMESTAMP)`,
+			userID, hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r mfa) Delete(ctx context.Context, userID int) error {
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+	_, err := r.q.ExecContext(ctx, "DELETE FROM user_mfa WHERE user_id = $1", userID)
+	return err
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const orderColumns = `
+	id, order_number, user_id, total_amount, discount_amount, final_amount,
+	payment_method, payment_status, order_status, notes, created_at, updated_at`
+
+type orders struct {
+	q querier
+}
+
+func scanOrder(row scanner) (models.Order, error) {
+	var order models.Order
+	err := row.Scan(
+		&order.ID, &order.OrderNumber, &order.UserID, &order.TotalAmount, &order.DiscountAmount,
+		&order.FinalAmount, &order.PaymentMethod, &order.PaymentStatus, &order.OrderStatus,
+		&order.Notes, &order.CreatedAt, &order.UpdatedAt)
+	return order, err
+}
+
+func (r orders) List(ctx context.Context, filter repository.OrderFilter, limit, offset int) ([]models.Order, int, error) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	if filter.UserID != nil {
+		args = append(args, *filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filter.PaymentStatus != "" {
+		args = append(args, filter.PaymentStatus)
+		conditions = append(conditions, fmt.Sprintf("payment_status = $%d", len(args)))
+	}
+	if filter.OrderStatus != "" {
+		args = append(args, filter.OrderStatus)
+		conditions = append(conditions, fmt.Sprintf("order_status = $%d", len(args)))
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	orders, err := query(ctx, r.q, scanOrder, fmt.Sprintf(`
+		SELECT %s FROM orders
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d`, orderColumns, whereClause, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
+}
+
+func (r orders) ListByUser(ctx context.Context, userID int) ([]models.Order, error) {
+	return query(ctx, r.q, scanOrder, `
+		SELECT`+orderColumns+`
+		FROM orders WHERE user_id = $1
+		ORDER BY created_at`, userID)
+}
+
+func (r orders) GetByID(ctx context.Context, id int) (*models.Order, error) {
+	order, err := scanOrder(r.q.QueryRowContext(ctx, "SELECT"+orderColumns+" FROM orders WHERE id = $1", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &order, nil
+}
+
+func (r orders) ItemsByOrderIDs(ctx context.Context, orderIDs []int) (map[int][]models.OrderItem, error) {
+	items, err := query(ctx, r.q, func(row scanner) (models.OrderItem, error) {
+		var item models.OrderItem
+		err := row.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.ProductName, &item.Price, &item.Quantity, &item.CreatedAt)
+		return item, err
+	}, `
+		SELECT id, order_id, product_id, product_name, price, quantity, created_at
+		FROM order_items
+		WHERE order_id = ANY($1)
+		ORDER BY id`, pq.Array(orderIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	byOrder := make(map[int][]models.OrderItem)
+	for _, item := range items {
+		byOrder[item.OrderID] = append(byOrder[item.OrderID], item)
+	}
+	return byOrder, nil
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const postColumns = `
+	id, title, slug, content, excerpt, featured_image, author_id, status, is_featured, view_count, published_at, created_at, updated_at`
+
+// postWithAuthorQuery selects posts with the username and name of their author
+const postWithAuthorQuery = `
+	SELECT p.id, p.title, p.slug, p.content, p.excerpt, p.featured_image,
+	       p.author_id, p.status, p.is_featured, p.view_count,
+	       p.published_at, p.created_at, p.updated_at,
+	       u.username, u.full_name
+	FROM blog_posts p
+	LEFT JOIN users u ON p.author_id = u.id`
+
+type posts struct {
+	q querier
+}
+
+func scanPost(row scanner) (models.BlogPost, error) {
+	var post models.BlogPost
+	err := row.Scan(
+		&post.ID, &post.Title, &post.Slug, &post.Content, &post.Excerpt, &post.FeaturedImage,
+		&post.AuthorID, &post.Status, &post.IsFeatured, &post.ViewCount,
+		&post.PublishedAt, &post.CreatedAt, &post.UpdatedAt)
+	return post, err
+}
+
+func scanPostWithAuthor(row scanner) (models.BlogPost, error) {
+	var post models.BlogPost
+	var username, fullName *string
+	err := row.Scan(
+		&post.ID, &post.Title, &post.Slug, &post.Content, &post.Excerpt, &post.FeaturedImage,
+		&post.AuthorID, &post.Status, &post.IsFeatured, &post.ViewCount,
+		&post.PublishedAt, &post.CreatedAt, &post.UpdatedAt,
+		&username, &fullName)
+	if err != nil {
+		return post, err
+	}
+
+	if post.AuthorID != nil && username != nil {
+		post.Author = &models.User{ID: *post.AuthorID, Username: *username, FullName: fullName}
+	}
+	return post, nil
+}
+
+func (r posts) ListPublished(ctx context.Context, filter repository.PostFilter, limit, offset int) ([]models.BlogPost, int, error) {
+	conditions := []string{"p.status = 'published'"}
+	args := []interface{}{}
+
+	if filter.CategorySlug != "" {
+		args = append(args, filter.CategorySlug)
+		conditions = append(conditions, fmt.Sprintf("p.id IN (SELECT post_id FROM blog_post_categories bpc JOIN blog_categories bc ON bpc.category_id = bc.id WHERE bc.slug = $%d)", len(args)))
+	}
+	if filter.Featured {
+		conditions = append(conditions, "p.is_featured = true")
+	}
+	if filter.Search != "" {
+		args = append(args, "%"+filter.Search+"%")
+		conditions = append(conditions, fmt.Sprintf("(p.title ILIKE $%d OR p.content ILIKE $%d)", len(args), len(args)))
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM blog_posts p WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	posts, err := query(ctx, r.q, scanPostWithAuthor, fmt.Sprintf(postWithAuthorQuery+`
+		WHERE %s
+		ORDER BY p.published_at DESC
+		LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return posts, total, nil
+}
+
+func (r posts) List(ctx context.Context, limit, offset int) ([]models.BlogPost, int, error) {
+	var total int
+	if err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM blog_posts").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	posts, err := query(ctx, r.q, scanPostWithAuthor, postWithAuthorQuery+`
+		ORDER BY p.created_at DESC
+		LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return posts, total, nil
+}
+
+func (r posts) ListByAuthor(ctx context.Context, authorID int) ([]models.BlogPost, error) {
+	return query(ctx, r.q, scanPost, `
+		SELECT`+postColumns+`
+		FROM blog_posts WHERE author_id = $1
+		ORDER BY created_at`, authorID)
+}
+
+func (r posts) GetByID(ctx context.Context, id int) (*models.BlogPost, error) {
+	post, err := scanPost(r.q.QueryRowContext(ctx, "SELECT"+postColumns+" FROM blog_posts WHERE id = $1", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &post, nil
+}
+
+func (r posts) GetForUpdate(ctx context.Context, id int) (*models.BlogPost, error) {
+	post, err := scanPost(r.q.QueryRowContext(ctx, "SELECT"+postColumns+" FROM blog_posts WHERE id = $1 FOR UPDATE", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &post, nil
+}
+
+func (r posts) GetPublishedBySlug(ctx context.Context, slug string) (*models.BlogPost, error) {
+	post, err := scanPostWithAuthor(r.q.QueryRowContext(ctx, postWithAuthorQuery+`
+		WHERE p.slug = $1 AND p.status = 'published'`, slug))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &post, nil
+}
+
+func (r posts) IncrementViews(ctx context.Context, id int) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE blog_posts SET view_count = view_count + 1 WHERE id = $1", id)
+	return err
+}
+
+func (r posts) Create(ctx context.Context, p models.BlogPost) (*models.BlogPost, error) {
+	post, err := scanPost(r.q.QueryRowContext(ctx, `
+		INSERT INTO blog_posts (title, slug, content, excerpt, featured_image, author_id, status, is_featured, published_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING`+postColumns,
+		p.Title, p.Slug, p.Content, p.Excerpt, p.FeaturedImage, p.AuthorID, p.Status, p.IsFeatured, p.PublishedAt))
+	if err != nil {
+		return nil, duplicate(err)
+	}
+	return &post, nil
+}
+
+func (r posts) Update(ctx context.Context, p models.BlogPost) (*models.BlogPost, error) {
+	post, err := scanPost(r.q.QueryRowContext(ctx, `
+		UPDATE blog_posts
+		SET title = $2, slug = $3, content = $4, excerpt = $5, featured_image = $6,
+		    status = $7, is_featured = $8, published_at = COALESCE($9, published_at), updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING`+postColumns,
+		p.ID, p.Title, p.Slug, p.Content, p.Excerpt, p.FeaturedImage, p.Status, p.IsFeatured, p.PublishedAt))
+	if err != nil {
+		return nil, notFound(duplicate(err))
+	}
+	return &post, nil
+}
+
+func (r posts) Delete(ctx context.Context, id int) error {
+	return affected(r.q.ExecContext(ctx, "DELETE FROM blog_posts WHERE id = $1", id))
+}
+
+func scanBlogCategory(row scanner) (models.BlogCategory, error) {
+	var category models.BlogCategory
+	err := row.Scan(&category.ID, &category.Name, &category.Slug, &category.Description, &category.CreatedAt)
+	return category, err
+}
+
+func (r posts) Categories(ctx context.Context) ([]models.BlogCategory, error) {
+	return query(ctx, r.q, scanBlogCategory, `
+		SELECT id, name, slug, description, created_at
+		FROM blog_categories
+		ORDER BY name`)
+}
+
+func (r posts) CreateCategory(ctx context.Context, name, slug, description string) (*models.BlogCategory, error) {
+	category, err := scanBlogCategory(r.q.QueryRowContext(ctx, `
+		INSERT INTO blog_categories (name, slug, description, created_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+		RETURNING id, name, slug, description, created_at`,
+		name, slug, description))
+	if err != nil {
+		return nil, duplicate(err)
+	}
+	return &category, nil
+}
+
+func (r posts) CategoriesByPostIDs(ctx context.Context, postIDs []int) (map[int][]models.BlogCategory, error) {
+	rows, err := r.q.QueryContext(ctx, `
+		SELECT bpc.post_id, bc.id, bc.name, bc.slug, bc.description, bc.created_at
+		FROM blog_categories bc
+		JOIN blog_post_categories bpc ON bc.id = bpc.category_id
+		WHERE bpc.post_id = ANY($1)
+		ORDER BY bc.name`, pq.Array(postIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make(map[int][]models.BlogCategory)
+	for rows.Next() {
+		var postID int
+		var category models.BlogCategory
+		err := rows.Scan(&postID, &category.ID, &category.Name, &category.Slug, &category.Description, &category.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		categories[postID] = append(categories[postID], category)
+	}
+	return categories, rows.Err()
+}
+
+func (r posts) CountPublished(ctx context.Context, categoryIDs []int) (map[int]int, error) {
+	rows, err := r.q.QueryContext(ctx, `
+		SELECT bpc.category_id, COUNT(*)
+		FROM blog_post_categories bpc
+		JOIN blog_posts p ON p.id = bpc.post_id
+		WHERE bpc.category_id = ANY($1) AND p.status = 'published'
+		GROUP BY bpc.category_id`, pq.Array(categoryIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var categoryID, count int
+		if err := rows.Scan(&categoryID, &count); err != nil {
+			return nil, err
+		}
+		counts[categoryID] = count
+	}
+	return counts, rows.Err()
+}
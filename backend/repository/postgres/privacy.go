@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"zplus_web/backend/models"
+)
+
+const exportColumns = `
+	id, user_id, status, error, size_bytes, expires_at, completed_at, created_at`
+
+type privacy struct {
+	q querier
+}
+
+func scanExport(row scanner) (models.DataExport, error) {
+	var export models.DataExport
+	err := row.Scan(
+		&export.ID, &export.UserID, &export.Status, &export.Error, &export.SizeBytes,
+		&export.ExpiresAt, &export.CompletedAt, &export.CreatedAt)
+	return export, err
+}
+
+func (r privacy) CreateExport(ctx context.Context, userID int) (*models.DataExport, error) {
+	export, err := scanExport(r.q.QueryRowContext(ctx, `
+		INSERT INTO data_exports (user_id, status, created_at)
+		SELECT $1, 'processing', CURRENT_TIMESTAMP
+		WHERE NOT EXISTS (SELECT 1 FROM data_exports WHERE user_id = $1 AND status = 'processing')
+		RETURNING`+exportColumns, userID))
+	if err != nil {
+		return nil, conflict(err)
+	}
+	return &export, nil
+}
+
+func (r privacy) ListExports(ctx context.Context, userID int) ([]models.DataExport, error) {
+	return query(ctx, r.q, scanExport, `
+		SELECT`+exportColumns+`
+		FROM data_exports
+		WHERE user_id = $1
+		ORDER BY created_at DESC`, userID)
+}
+
+func (r privacy) GetExportArchive(ctx context.Context, userID, exportID int) (*models.DataExport, []byte, error) {
+	var export models.DataExport
+	var archive []byte
+	err := r.q.QueryRowContext(ctx, `
+		SELECT`+exportColumns+`, archive
+		FROM data_exports
+		WHERE id = $1 AND user_id = $2`, exportID, userID).Scan(
+		&export.ID, &export.UserID, &export.Status, &export.Error, &export.SizeBytes,
+		&export.ExpiresAt, &export.CompletedAt, &export.CreatedAt, &archive)
+	if err != nil {
+		return nil, nil, notFound(err)
+	}
+	return &export, archive, nil
+}
+
+func (r privacy) CompleteExport(ctx context.Context, id int, archive []byte, expiresAt time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE data_exports SET status = 'completed', archive = $2, size_bytes = $3, expires_at = $4, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, archive, len(archive), expiresAt)
+	return err
+}
+
+func (r privacy) FailExport(ctx context.Context, id int, message string) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE data_exports SET status = 'failed', error = $2, completed_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, message)
+	return err
+}
+
+func (r privacy) FailProcessingExports(ctx context.Context) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE data_exports SET status = 'failed', error = 'interrupted by a server restart'
+		WHERE status = 'processing'`)
+	return err
+}
+
+func (r privacy) DropExpiredArchives(ctx context.Context) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE data_exports SET archive = NULL WHERE expires_at < CURRENT_TIMESTAMP AND archive IS NOT NULL")
+	return err
+}
+
+func (r privacy) EraseUser(ctx context.Context, userID int, username, email string) error {
+	var currentEmail string
+	err := r.q.QueryRowContext(ctx, "SELECT email FROM users WHERE id = $1 AND erased_at IS NULL FOR UPDATE", userID).Scan(&currentEmail)
+	if err != nil {
+		return notFound(err)
+	}
+
+	_, err = r.q.ExecContext(ctx, `
+		UPDATE users SET username = $2, email = $3, password_hash = '!', full_name = NULL, phone = NULL,
+		       avatar_url = NULL, is_active = false, email_verified = false,
+		       erased_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, userID, username, email)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+
+	statements := []struct {
+		what  string
+		query string
+		args  []interface{}
+	}{
+		{"linked logins", "DELETE FROM user_identities WHERE user_id = $1", []interface{}{userID}},
+		{"API keys", "DELETE FROM api_keys WHERE user_id = $1", []interface{}{userID}},
+		{"password history", "DELETE FROM password_history WHERE user_id = $1", []interface{}{userID}},
+		{"password reset tokens", "DELETE FROM password_reset_tokens WHERE user_id = $1", []interface{}{userID}},
+		{"email verification tokens", "DELETE FROM email_verification_tokens WHERE user_id = $1", []interface{}{userID}},
+		{"login links", "DELETE FROM magic_link_tokens WHERE user_id = $1", []interface{}{userID}},
+		{"two-factor authentication", "DELETE FROM user_mfa WHERE user_id = $1", []interface{}{userID}},
+		{"recovery codes", "DELETE FROM mfa_recovery_codes WHERE user_id = $1", []interface{}{userID}},
+		{"downloads", "DELETE FROM customer_downloads WHERE user_id = $1", []interface{}{userID}},
+		{"data exports", "DELETE FROM data_exports WHERE user_id = $1", []interface{}{userID}},
+		{"lockout history", "DELETE FROM login_lockout_events WHERE subject_type = 'account' AND subject = $1", []interface{}{currentEmail}},
+		{"order notes", "UPDATE orders SET notes = NULL WHERE user_id = $1", []interface{}{userID}},
+		// The audit trail is kept, without the user's contact details and snapshots of their profile
+		{"audit events", `
+			UPDATE audit_events SET actor_email = NULL, ip_address = NULL, user_agent = NULL
+			WHERE actor_id = $1`, []interface{}{userID}},
+		{"audit events", `
+			UPDATE audit_events SET before_data = NULL, after_data = NULL
+			WHERE entity_type = 'user' AND entity_id = $1`, []interface{}{strconv.Itoa(userID)}},
+	}
+	for _, stmt := range statements {
+		if _, err = r.q.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return fmt.Errorf("failed to erase %s: %w", stmt.what, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"zplus_web/backend/ent"
+	"zplus_web/backend/ent/product"
+	"zplus_web/backend/ent/productcategory"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+// products reads the catalog with the ent client and customers' downloads with SQL
+type products struct {
+	q   querier
+	ent *ent.Client
+}
+
+func (r products) List(ctx context.Context, filter repository.ProductFilter, limit, offset int) ([]models.SoftwareProduct, int, error) {
+	q := r.ent.Product.Query().Where(product.IsActive(true))
+	if filter.CategorySlug != "" {
+		q.Where(product.HasCategoryWith(productcategory.Slug(filter.CategorySlug)))
+	}
+	if filter.Featured {
+		q.Where(product.IsFeatured(true))
+	}
+	if filter.Search != "" {
+		q.Where(product.Or(product.NameContainsFold(filter.Search), product.DescriptionContainsFold(filter.Search)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entities, err := q.
+		Order(ent.Desc(product.FieldIsFeatured), ent.Desc(product.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return productsFromEnt(entities), total, nil
+}
+
+func (r products) GetActiveBySlug(ctx context.Context, slug string) (*models.SoftwareProduct, error) {
+	entity, err := r.ent.Product.Query().
+		Where(product.Slug(slug), product.IsActive(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, repository.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &productsFromEnt([]*ent.Product{entity})[0], nil
+}
+
+func (r products) GetByIDs(ctx context.Context, ids []int) ([]models.SoftwareProduct, error) {
+	entities, err := r.ent.Product.Query().
+		Where(product.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return productsFromEnt(entities), nil
+}
+
+func productsFromEnt(entities []*ent.Product) []models.SoftwareProduct {
+	products := make([]models.SoftwareProduct, 0, len(entities))
+	for _, p := range entities {
+		products = append(products, models.SoftwareProduct{
+			ID:               p.ID,
+			Name:             p.Name,
+			Slug:             p.Slug,
+			Description:      p.Description,
+			ShortDescription: p.ShortDescription,
+			FeaturedImage:    p.FeaturedImage,
+			GalleryImages:    p.GalleryImages,
+			Price:            p.Price,
+			DiscountPrice:    p.DiscountPrice,
+			Version:          p.Version,
+			Requirements:     p.Requirements,
+			Features:         p.Features,
+			CategoryID:       p.CategoryID,
+			DownloadURL:      p.DownloadURL,
+			FileSize:         p.FileSize,
+			DownloadCount:    p.DownloadCount,
+			IsActive:         p.IsActive,
+			IsFeatured:       p.IsFeatured,
+			CreatedAt:        p.CreatedAt,
+			UpdatedAt:        p.UpdatedAt,
+		})
+	}
+	return products
+}
+
+func (r products) Categories(ctx context.Context) ([]models.ProductCategory, error) {
+	categories, err := r.ent.ProductCategory.Query().
+		Order(ent.Asc(productcategory.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return productCategoriesFromEnt(categories), nil
+}
+
+func (r products) CategoriesByIDs(ctx context.Context, ids []int) ([]models.ProductCategory, error) {
+	categories, err := r.ent.ProductCategory.Query().
+		Where(productcategory.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return productCategoriesFromEnt(categories), nil
+}
+
+func productCategoriesFromEnt(entities []*ent.ProductCategory) []models.ProductCategory {
+	categories := make([]models.ProductCategory, 0, len(entities))
+	for _, c := range entities {
+		categories = append(categories, models.ProductCategory{
+			ID:          c.ID,
+			Name:        c.Name,
+			Slug:        c.Slug,
+			Description: c.Description,
+			ParentID:    c.ParentID,
+			CreatedAt:   c.CreatedAt,
+		})
+	}
+	return categories
+}
+
+func (r products) DownloadsByUser(ctx context.Context, userID int) ([]models.CustomerDownload, error) {
+	// Download tokens are credentials and are left out
+	return query(ctx, r.q, func(row scanner) (models.CustomerDownload, error) {
+		var download models.CustomerDownload
+		var productName sql.NullString
+		err := row.Scan(
+			&download.ID, &download.UserID, &download.ProductID, &download.OrderID, &download.DownloadCount, &download.MaxDownloads,
+			&download.ExpiresAt, &download.LastDownloadedAt, &download.CreatedAt, &productName)
+		if productName.Valid {
+			download.Product = &models.SoftwareProduct{ID: download.ProductID, Name: productName.String}
+		}
+		return download, err
+	}, `
+		SELECT d.id, d.user_id, d.product_id, d.order_id, d.download_count, d.max_downloads,
+		       d.expires_at, d.last_downloaded_at, d.created_at, p.name
+		FROM customer_downloads d
+		LEFT JOIN software_products p ON p.id = d.product_id
+		WHERE d.user_id = $1
+		ORDER BY d.created_at`, userID)
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const projectColumns = `
+	id, name, slug, description, short_description, featured_image,
+	gallery_images, technologies, project_url, github_url, demo_url,
+	status, start_date, end_date, is_featured, sort_order, created_at, updated_at`
+
+type projects struct {
+	q querier
+}
+
+func scanProject(row scanner) (models.Project, error) {
+	var project models.Project
+	err := row.Scan(
+		&project.ID, &project.Name, &project.Slug, &project.Description, &project.ShortDescription,
+		&project.FeaturedImage, &project.GalleryImages, &project.Technologies, &project.ProjectURL,
+		&project.GithubURL, &project.DemoURL, &project.Status, &project.StartDate, &project.EndDate,
+		&project.IsFeatured, &project.SortOrder, &project.CreatedAt, &project.UpdatedAt)
+	return project, err
+}
+
+func (r projects) List(ctx context.Context, filter repository.ProjectFilter, limit, offset int) ([]models.Project, int, error) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.Featured {
+		conditions = append(conditions, "is_featured = true")
+	}
+	if filter.Search != "" {
+		args = append(args, "%"+filter.Search+"%")
+		conditions = append(conditions, fmt.Sprintf("(name ILIKE $%d OR description ILIKE $%d)", len(args), len(args)))
+	}
+
+	whereClause := strings.Join(conditions, " AND ")
+
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM projects WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	projects, err := query(ctx, r.q, scanProject, fmt.Sprintf(`
+		SELECT %s FROM projects
+		WHERE %s
+		ORDER BY sort_order ASC, created_at DESC
+		LIMIT $%d OFFSET $%d`, projectColumns, whereClause, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return projects, total, nil
+}
+
+func (r projects) ListAll(ctx context.Context, limit, offset int) ([]models.Project, int, error) {
+	var total int
+	if err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM projects").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	projects, err := query(ctx, r.q, scanProject, `
+		SELECT`+projectColumns+`
+		FROM projects
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return projects, total, nil
+}
+
+func (r projects) GetBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	project, err := scanProject(r.q.QueryRowContext(ctx, "SELECT"+projectColumns+" FROM projects WHERE slug = $1", slug))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &project, nil
+}
+
+func (r projects) GetForUpdate(ctx context.Context, id int) (*models.Project, error) {
+	project, err := scanProject(r.q.QueryRowContext(ctx, "SELECT"+projectColumns+" FROM projects WHERE id = $1 FOR UPDATE", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &project, nil
+}
+
+func (r projects) Create(ctx context.Context, p models.Project) (*models.Project, error) {
+	project, err := scanProject(r.q.QueryRowContext(ctx, `
+		INSERT INTO projects (name, slug, description, short_description, featured_image,
+		                      gallery_images, technologies, project_url, github_url, demo_url,
+		                      status, start_date, end_date, is_featured, sort_order, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING`+projectColumns,
+		p.Name, p.Slug, p.Description, p.ShortDescription, p.FeaturedImage,
+		p.GalleryImages, p.Technologies, p.ProjectURL, p.GithubURL, p.DemoURL,
+		p.Status, p.StartDate, p.EndDate, p.IsFeatured, p.SortOrder))
+	if err != nil {
+		return nil, duplicate(err)
+	}
+	return &project, nil
+}
+
+func (r projects) Update(ctx context.Context, id int, p models.Project) (*models.Project, error) {
+	project, err := scanProject(r.q.QueryRowContext(ctx, `
+		UPDATE projects
+		SET name = $1, slug = $2, description = $3, short_description = $4, featured_image = $5,
+		    gallery_images = $6, technologies = $7, project_url = $8, github_url = $9, demo_url = $10,
+		    status = $11, start_date = $12, end_date = $13, is_featured = $14, sort_order = $15,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $16
+		RETURNING`+projectColumns,
+		p.Name, p.Slug, p.Description, p.ShortDescription, p.FeaturedImage,
+		p.GalleryImages, p.Technologies, p.ProjectURL, p.GithubURL, p.DemoURL,
+		p.Status, p.StartDate, p.EndDate, p.IsFeatured, p.SortOrder, id))
+	if err != nil {
+		return nil, notFound(duplicate(err))
+	}
+	return &project, nil
+}
+
+func (r projects) Delete(ctx context.Context, id int) error {
+	return affected(r.q.ExecContext(ctx, "DELETE FROM projects WHERE id = $1", id))
+}
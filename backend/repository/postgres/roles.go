@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"github.com/lib/pq"
+	"zplus_web/backend/models"
+)
+
+const roleQuery = `
+	SELECT r.id, r.name, r.description, r.is_system, r.created_at, r.updated_at,
+		COALESCE(ARRAY(SELECT permission FROM role_permissions WHERE role_id = r.id ORDER BY permission), '{}'),
+		(SELECT COUNT(*) FROM users WHERE role = r.name)
+	FROM roles r`
+
+type roles struct {
+	q querier
+}
+
+func scanRole(row scanner) (models.Role, error) {
+	var role models.Role
+	err := row.Scan(
+		&role.ID, &role.Name, &role.Description, &role.IsSystem, &role.CreatedAt, &role.UpdatedAt,
+		pq.Array(&role.Permissions), &role.UsersCount)
+	return role, err
+}
+
+func (r roles) Permissions(ctx context.Context, name string) ([]string, error) {
+	return query(ctx, r.q, scanString, `
+		SELECT rp.permission
+		FROM role_permissions rp
+		JOIN roles r ON r.id = rp.role_id
+		WHERE r.name = $1
+		ORDER BY rp.permission`, name)
+}
+
+func (r roles) Exists(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := r.q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM roles WHERE name = $1)", name).Scan(&exists)
+	return exists, err
+}
+
+func (r roles) List(ctx context.Context) ([]models.Role, error) {
+	return query(ctx, r.q, scanRole, roleQuery+" ORDER BY r.name")
+}
+
+func (r roles) GetByID(ctx context.Context, id int) (*models.Role, error) {
+	role, err := scanRole(r.q.QueryRowContext(ctx, roleQuery+" WHERE r.id = $1", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &role, nil
+}
+
+func (r roles) Create(ctx context.Context, name string, description *string) (int, error) {
+	var id int
+	err := r.q.QueryRowContext(ctx, `
+		INSERT INTO roles (name, description, is_system, created_at, updated_at)
+		VALUES ($1, $2, false, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (name) DO NOTHING
+		RETURNING id`, name, description).Scan(&id)
+	return id, conflict(err)
+}
+
+func (r roles) UpdateDescription(ctx context.Context, id int, description *string) error {
+	return affected(r.q.ExecContext(ctx, `
+		UPDATE roles SET description = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`, id, description))
+}
+
+func (r roles) SetPermissions(ctx context.Context, id int, permissions []string) error {
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM role_permissions WHERE role_id = $1", id); err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		_, err := r.q.ExecContext(ctx, `
+			INSERT INTO role_permissions (role_id, permission)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING`, id, permission)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r roles) Delete(ctx context.Context, id int) error {
+	return affected(r.q.ExecContext(ctx, "DELETE FROM roles WHERE id = $1", id))
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type sessions struct {
+	q querier
+}
+
+func (r sessions) Create(ctx context.Context, s repository.NewSession) (int, error) {
+	var id int
+	err := r.q.QueryRowContext(ctx, `
+		INSERT INTO user_sessions (user_id, token, user_agent, ip_address, expires_at, last_used_at, impersonator_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, CURRENT_TIMESTAMP, $6, CURRENT_TIMESTAMP)
+		RETURNING id`,
+		s.UserID, s.Token, s.UserAgent, s.IPAddress, s.ExpiresAt, s.ImpersonatorID).Scan(&id)
+	return id, err
+}
+
+func (r sessions) CreateRefreshToken(ctx context.Context, sessionID int, tokenHash string, expiresAt time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (session_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)`,
+		sessionID, tokenHash, expiresAt)
+	return err
+}
+
+func (r sessions) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*repository.RefreshToken, error) {
+	var token repository.RefreshToken
+	err := r.q.QueryRowContext(ctx, `
+		SELECT rt.id, rt.session_id, rt.used_at, rt.expires_at, s.user_id, s.token
+		FROM refresh_tokens rt
+		JOIN user_sessions s ON s.id = rt.session_id
+		WHERE rt.token_hash = $1
+		FOR UPDATE OF rt`, tokenHash).Scan(
+		&token.ID, &token.SessionID, &token.UsedAt, &token.ExpiresAt, &token.UserID, &token.SessionToken)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &token, nil
+}
+
+func (r sessions) MarkRefreshTokenUsed(ctx context.Context, id int) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE refresh_tokens SET used_at = CURRENT_TIMESTAMP WHERE id = $1", id)
+	return err
+}
+
+func (r sessions) Extend(ctx context.Context, id int, expiresAt time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE user_sessions SET expires_at = $1, last_used_at = CURRENT_TIMESTAMP
+		WHERE id = $2`, expiresAt, id)
+	return err
+}
+
+func (r sessions) GetSessionUser(ctx context.Context, token string, userID int) (*models.User, error) {
+	user := models.User{ID: userID}
+	err := r.q.QueryRowContext(ctx, `
+		SELECT u.is_active, u.role
+		FROM user_sessions s
+		JOIN users u ON u.id = s.user_id
+		WHERE s.token = $1 AND s.user_id = $2 AND s.expires_at > CURRENT_TIMESTAMP`,
+		token, userID).Scan(&user.IsActive, &user.Role)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &user, nil
+}
+
+func (r sessions) ListByUser(ctx context.Context, userID int) ([]models.UserSession, error) {
+	return query(ctx, r.q, func(row scanner) (models.UserSession, error) {
+		var session models.UserSession
+		err := row.Scan(
+			&session.ID, &session.UserID, &session.Token, &session.UserAgent, &session.IPAddress,
+			&session.ExpiresAt, &session.LastUsedAt, &session.CreatedAt)
+		return session, err
+	}, `
+		SELECT id, user_id, token, user_agent, ip_address, expires_at, last_used_at, created_at
+		FROM user_sessions
+		WHERE user_id = $1 AND expires_at > CURRENT_TIMESTAMP
+		ORDER BY last_used_at DESC`, userID)
+}
+
+func (r sessions) Tokens(ctx context.Context, userID int) ([]string, error) {
+	return query(ctx, r.q, scanString, "SELECT token FROM user_sessions WHERE user_id = $1", userID)
+}
+
+func (r sessions) Delete(ctx context.Context, id int) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM user_sessions WHERE id = $1", id)
+	return err
+}
+
+func (r sessions) DeleteByToken(ctx context.Context, token string) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM user_sessions WHERE token = $1", token)
+	return err
+}
+
+func (r sessions) DeleteUserSession(ctx context.Context, userID, id int) (string, error) {
+	var token string
+	err := r.q.QueryRowContext(ctx, `
+		DELETE FROM user_sessions WHERE id = $1 AND user_id = $2
+		RETURNING token`, id, userID).Scan(&token)
+	return token, notFound(err)
+}
+
+func (r sessions) DeleteOthers(ctx context.Context, userID int, keepToken string) ([]string, error) {
+	return query(ctx, r.q, scanString, `
+		DELETE FROM user_sessions WHERE user_id = $1 AND token <> $2
+		RETURNING token`, userID, keepToken)
+}
+
+func (r sessions) DeleteByUser(ctx context.Context, userID int) ([]string, error) {
+	return query(ctx, r.q, scanString, "DELETE FROM user_sessions WHERE user_id = $1 RETURNING token", userID)
+}
@@ -0,0 +1,219 @@
+// Package postgres implements the repositories on PostgreSQL. The schema is
+// managed by the migrations in backend/migrations.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"zplus_web/backend/ent"
+	"zplus_web/backend/repository"
+)
+
+// querier is satisfied by *sql.DB and *sql.Tx so repositories run the same
+// statements inside and outside a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// scanner is satisfied by *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+type Store struct {
+	// db is nil inside a transaction
+	db  *sql.DB
+	q   querier
+	ent *ent.Client
+}
+
+// NewStore returns the repositories on db. The product catalog is read with
+// the ent client, outside of any transaction.
+func NewStore(db *sql.DB, client *ent.Client) *Store {
+	return &Store{db: db, q: db, ent: client}
+}
+
+func (s *Store) Users() repository.UserRepository {
+	return users{s.q}
+}
+
+func (s *Store) Sessions() repository.SessionRepository {
+	return sessions{s.q}
+}
+
+func (s *Store) PasswordResets() repository.TokenRepository {
+	return tokens{s.q, "password_reset_tokens"}
+}
+
+func (s *Store) EmailVerifications() repository.TokenRepository {
+	return tokens{s.q, "email_verification_tokens"}
+}
+
+func (s *Store) MagicLinks() repository.MagicLinkRepository {
+	return magicLinks{s.q}
+}
+
+func (s *Store) MFA() repository.MFARepository {
+	return mfa{s.q}
+}
+
+func (s *Store) Identities() repository.IdentityRepository {
+	return identities{s.q}
+}
+
+func (s *Store) Lockouts() repository.LockoutRepository {
+	return lockouts{s.q}
+}
+
+func (s *Store) Invitations() repository.InvitationRepository {
+	return invitations{s.q}
+}
+
+func (s *Store) APIKeys() repository.APIKeyRepository {
+	return apiKeys{s.q}
+}
+
+func (s *Store) Roles() repository.RoleRepository {
+	return roles{s.q}
+}
+
+func (s *Store) Audit() repository.AuditRepository {
+	return audit{s.q}
+}
+
+func (s *Store) Settings() repository.SettingsRepository {
+	return settings{s.q}
+}
+
+func (s *Store) Privacy() repository.PrivacyRepository {
+	return privacy{s.q}
+}
+
+func (s *Store) Posts() repository.PostRepository {
+	return posts{s.q}
+}
+
+func (s *Store) Projects() repository.ProjectRepository {
+	return projects{s.q}
+}
+
+func (s *Store) WordPress() repository.WordPressRepository {
+	return wordpress{s.q}
+}
+
+func (s *Store) Products() repository.ProductRepository {
+	return products{s.q, s.ent}
+}
+
+func (s *Store) Orders() repository.OrderRepository {
+	return orders{s.q}
+}
+
+func (s *Store) Wallets() repository.WalletRepository {
+	return wallets{s.q}
+}
+
+func (s *Store) Points() repository.PointsRepository {
+	return points{s.q}
+}
+
+// Transact runs fn in a database transaction
+func (s *Store) Transact(ctx context.Context, fn func(tx repository.Store) error) error {
+	if s.db == nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(&Store{q: tx, ent: s.ent}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// notFound reports a missing row as repository.ErrNotFound
+func notFound(err error) error {
+	if err == sql.ErrNoRows {
+		return repository.ErrNotFound
+	}
+	return err
+}
+
+// conflict reports an INSERT ... ON CONFLICT DO NOTHING RETURNING that
+// inserted nothing as repository.ErrConflict
+func conflict(err error) error {
+	if err == sql.ErrNoRows {
+		return repository.ErrConflict
+	}
+	return err
+}
+
+// duplicate reports a row rejected by a unique constraint as repository.ErrConflict
+func duplicate(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return fmt.Errorf("%s: %w", pqErr.Constraint, repository.ErrConflict)
+	}
+	return err
+}
+
+// affected returns repository.ErrNotFound when a statement changed no row
+func affected(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// collect scans every row with scan
+func collect[T any](rows *sql.Rows, scan func(scanner) (T, error)) ([]T, error) {
+	defer rows.Close()
+
+	items := []T{}
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// query runs a query and scans every row with scan
+func query[T any](ctx context.Context, q querier, scan func(scanner) (T, error), query string, args ...interface{}) ([]T, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collect(rows, scan)
+}
+
+func scanString(row scanner) (string, error) {
+	var s string
+	err := row.Scan(&s)
+	return s, err
+}
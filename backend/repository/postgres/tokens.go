@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// tokens implements repository.TokenRepository on one of the token tables,
+// which share their layout
+type tokens struct {
+	q     querier
+	table string
+}
+
+func (r tokens) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO `+r.table+` (user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)`,
+		userID, tokenHash, expiresAt)
+	return err
+}
+
+func (r tokens) Consume(ctx context.Context, tokenHash string) (int, error) {
+	var userID int
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE `+r.table+` SET used_at = CURRENT_TIMESTAMP
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		RETURNING user_id`, tokenHash).Scan(&userID)
+	return userID, notFound(err)
+}
+
+func (r tokens) DeleteUnused(ctx context.Context, userID int) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM "+r.table+" WHERE user_id = $1 AND used_at IS NULL", userID)
+	return err
+}
+
+type magicLinks struct {
+	q querier
+}
+
+func (r magicLinks) Create(ctx context.Context, userID int, tokenHash, nonceHash string, expiresAt time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO magic_link_tokens (user_id, token_hash, nonce_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP)`,
+		userID, tokenHash, nonceHash, expiresAt)
+	return err
+}
+
+func (r magicLinks) Consume(ctx context.Context, tokenHash, nonceHash string) (int, error) {
+	var userID int
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE magic_link_tokens SET used_at = CURRENT_TIMESTAMP
+		WHERE token_hash = $1 AND nonce_hash = $2 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		RETURNING user_id`, tokenHash, nonceHash).Scan(&userID)
+	return userID, notFound(err)
+}
+
+func (r magicLinks) DeleteUnused(ctx context.Context, userID int) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM magic_link_tokens WHERE user_id = $1 AND used_at IS NULL", userID)
+	return err
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+const userColumns = `
+	id, username, email, role, full_name, phone, avatar_url, is_active, email_verified, created_at, updated_at`
+
+type users struct {
+	q querier
+}
+
+func scanUser(row scanner) (models.User, error) {
+	var user models.User
+	err := row.Scan(
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.FullName, &user.Phone,
+		&user.AvatarURL, &user.IsActive, &user.EmailVerified, &user.CreatedAt, &user.UpdatedAt)
+	return user, err
+}
+
+// scanUserWithHash scans userColumns followed by password_hash
+func scanUserWithHash(row scanner) (models.User, error) {
+	var user models.User
+	err := row.Scan(
+		&user.ID, &user.Username, &user.Email, &user.Role, &user.FullName, &user.Phone,
+		&user.AvatarURL, &user.IsActive, &user.EmailVerified, &user.CreatedAt, &user.UpdatedAt,
+		&user.PasswordHash)
+	return user, err
+}
+
+func (r users) get(ctx context.Context, query string, args ...interface{}) (*models.User, error) {
+	user, err := scanUser(r.q.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &user, nil
+}
+
+func (r users) Create(ctx context.Context, u repository.NewUser) (*models.User, error) {
+	user, err := scanUser(r.q.QueryRowContext(ctx, `
+		INSERT INTO users (username, email, password_hash, full_name, phone, avatar_url, role, is_active, email_verified, is_service_account, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, true, $8, $9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT DO NOTHING
+		RETURNING`+userColumns,
+		u.Username, u.Email, u.PasswordHash, u.FullName, u.Phone, u.AvatarURL, u.Role, u.EmailVerified, u.IsServiceAccount))
+	if err != nil {
+		return nil, conflict(err)
+	}
+	return &user, nil
+}
+
+func (r users) GetByID(ctx context.Context, id int) (*models.User, error) {
+	return r.get(ctx, "SELECT"+userColumns+" FROM users WHERE id = $1", id)
+}
+
+func (r users) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return r.get(ctx, "SELECT"+userColumns+" FROM users WHERE email = $1", email)
+}
+
+func (r users) GetLogin(ctx context.Context, email string) (*models.User, error) {
+	user, err := scanUserWithHash(r.q.QueryRowContext(ctx,
+		"SELECT"+userColumns+", password_hash FROM users WHERE email = $1 AND NOT is_service_account", email))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &user, nil
+}
+
+func (r users) GetForUpdate(ctx context.Context, id int) (*models.User, error) {
+	user, err := scanUserWithHash(r.q.QueryRowContext(ctx,
+		"SELECT"+userColumns+", password_hash FROM users WHERE id = $1 FOR UPDATE", id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &user, nil
+}
+
+func (r users) GetByIDs(ctx context.Context, ids []int) ([]models.User, error) {
+	return query(ctx, r.q, scanUser, "SELECT"+userColumns+" FROM users WHERE id = ANY($1)", pq.Array(ids))
+}
+
+func (r users) List(ctx context.Context, search string, limit, offset int) ([]models.User, int, error) {
+	conditions := "erased_at IS NULL"
+	args := []interface{}{}
+	if search != "" {
+		conditions += " AND (username ILIKE $1 OR email ILIKE $1 OR full_name ILIKE $1)"
+		args = append(args, "%"+search+"%")
+	}
+
+	var total int
+	if err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM users WHERE "+conditions, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	users, err := query(ctx, r.q, scanUser, fmt.Sprintf(`
+		SELECT %s FROM users WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d`, userColumns, conditions, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (r users) ListAll(ctx context.Context) ([]models.User, error) {
+	return query(ctx, r.q, scanUser, "SELECT"+userColumns+" FROM users WHERE erased_at IS NULL ORDER BY created_at DESC")
+}
+
+func (r users) ListServiceAccounts(ctx context.Context) ([]models.User, error) {
+	return query(ctx, r.q, scanUser, "SELECT"+userColumns+" FROM users WHERE is_service_account ORDER BY username")
+}
+
+func (r users) UsernameTaken(ctx context.Context, username string) (bool, error) {
+	var exists bool
+	err := r.q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE username = $1)", username).Scan(&exists)
+	return exists, err
+}
+
+func (r users) EmailTaken(ctx context.Context, email string) (bool, error) {
+	var exists bool
+	err := r.q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE LOWER(email) = LOWER($1))", email).Scan(&exists)
+	return exists, err
+}
+
+func (r users) IsServiceAccount(ctx context.Context, id int) (bool, error) {
+	var isServiceAccount bool
+	err := r.q.QueryRowContext(ctx, "SELECT is_service_account FROM users WHERE id = $1", id).Scan(&isServiceAccount)
+	return isServiceAccount, notFound(err)
+}
+
+func (r users) UpdateProfile(ctx context.Context, id int, profile models.UpdateProfileRequest) (*models.User, error) {
+	return r.get(ctx, `
+		UPDATE users SET full_name = $1, phone = $2, avatar_url = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+		RETURNING`+userColumns,
+		profile.FullName, profile.Phone, profile.AvatarURL, id)
+}
+
+func (r users) SetRole(ctx context.Context, id int, role string) error {
+	return affected(r.q.ExecContext(ctx, "UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", role, id))
+}
+
+func (r users) SetPasswordHash(ctx context.Context, id int, hash string) error {
+	return affected(r.q.ExecContext(ctx, "UPDATE users SET password_hash = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", hash, id))
+}
+
+func (r users) ReplacePasswordHash(ctx context.Context, id int, oldHash, newHash string) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE users SET password_hash = $1 WHERE id = $2 AND password_hash = $3", newHash, id, oldHash)
+	return err
+}
+
+func (r users) SetEmailVerified(ctx context.Context, id int) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE users SET email_verified = true, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND NOT email_verified`, id)
+	return err
+}
+
+func (r users) PasswordHistory(ctx context.Context, userID, limit int) ([]string, error) {
+	return query(ctx, r.q, scanString, `
+		SELECT password_hash FROM password_history
+		WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2`, userID, limit)
+}
+
+func (r users) AddPasswordHistory(ctx context.Context, userID int, hash string) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO password_history (user_id, password_hash, created_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)`, userID, hash)
+	return err
+}
+
+func (r users) TrimPasswordHistory(ctx context.Context, userID, keep int) error {
+	_, err := r.q.ExecContext(ctx, `
+		DELETE FROM password_history WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM password_history WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2)`,
+		userID, keep)
+	return err
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"zplus_web/backend/models"
+)
+
+const walletColumns = `
+	id, user_id, balance, total_deposited, total_spent, created_at, updated_at`
+
+const walletTransactionColumns = `
+	id, user_id, transaction_type, amount, balance_after, description, reference_id, status, created_at`
+
+type wallets struct {
+	q querier
+}
+
+func scanWallet(row scanner) (models.CustomerWallet, error) {
+	var wallet models.CustomerWallet
+	err := row.Scan(
+		&wallet.ID, &wallet.UserID, &wallet.Balance, &wallet.TotalDeposited, &wallet.TotalSpent,
+		&wallet.CreatedAt, &wallet.UpdatedAt)
+	return wallet, err
+}
+
+func scanWalletTransaction(row scanner) (models.WalletTransaction, error) {
+	var transaction models.WalletTransaction
+	err := row.Scan(
+		&transaction.ID, &transaction.UserID, &transaction.TransactionType, &transaction.Amount,
+		&transaction.BalanceAfter, &transaction.Description, &transaction.ReferenceID,
+		&transaction.Status, &transaction.CreatedAt)
+	return transaction, err
+}
+
+func (r wallets) get(ctx context.Context, query string, args ...interface{}) (*models.CustomerWallet, error) {
+	wallet, err := scanWallet(r.q.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &wallet, nil
+}
+
+func (r wallets) Get(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	return r.get(ctx, "SELECT"+walletColumns+" FROM customer_wallets WHERE user_id = $1", userID)
+}
+
+func (r wallets) GetForUpdate(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	return r.get(ctx, "SELECT"+walletColumns+" FROM customer_wallets WHERE user_id = $1 FOR UPDATE", userID)
+}
+
+func (r wallets) Create(ctx context.Context, userID int) (*models.CustomerWallet, error) {
+	return r.get(ctx, `
+		INSERT INTO customer_wallets (user_id, balance, total_deposited, total_spent, created_at, updated_at)
+		VALUES ($1, 0.00, 0.00, 0.00, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING`+walletColumns, userID)
+}
+
+func (r wallets) Deposit(ctx context.Context, userID int, amount float64) (float64, error) {
+	var balance float64
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE customer_wallets
+		SET balance = balance + $1, total_deposited = total_deposited + $1, updated_at = CURRENT_TIMESTAMP
+		WHERE user_id = $2
+		RETURNING balance`, amount, userID).Scan(&balance)
+	return balance, notFound(err)
+}
+
+func (r wallets) Spend(ctx context.Context, userID int, amount float64) (float64, error) {
+	var balance float64
+	err := r.q.QueryRowContext(ctx, `
+		UPDATE customer_wallets
+		SET balance = balance - $1, total_spent = total_spent + $1, updated_at = CURRENT_TIMESTAMP
+		WHERE user_id = $2
+		RETURNING balance`, amount, userID).Scan(&balance)
+	return balance, notFound(err)
+}
+
+func (r wallets) CreateTransaction(ctx context.Context, t models.WalletTransaction) (*models.WalletTransaction, error) {
+	transaction, err := scanWalletTransaction(r.q.QueryRowContext(ctx, `
+		INSERT INTO wallet_transactions (user_id, transaction_type, amount, balance_after, description, reference_id, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP)
+		RETURNING`+walletTransactionColumns,
+		t.UserID, t.TransactionType, t.Amount, t.BalanceAfter, t.Description, t.ReferenceID, t.Status))
+	if err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+func (r wallets) GetTransactionForUpdate(ctx context.Context, id int) (*models.WalletTransaction, error) {
+	transaction, err := scanWalletTransaction(r.q.QueryRowContext(ctx, `
+		SELECT`+walletTransactionColumns+`
+		FROM wallet_transactions WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &transaction, nil
+}
+
+func (r wallets) CompleteTransaction(ctx context.Context, id int, balanceAfter float64) (*models.WalletTransaction, error) {
+	transaction, err := scanWalletTransaction(r.q.QueryRowContext(ctx, `
+		UPDATE wallet_transactions
+		SET status = 'completed', balance_after = $1
+		WHERE id = $2
+		RETURNING`+walletTransactionColumns, balanceAfter, id))
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &transaction, nil
+}
+
+func (r wallets) ListTransactions(ctx context.Context, userID int, transactionType string, limit, offset int) ([]models.WalletTransaction, int, error) {
+	whereClause := "user_id = $1"
+	args := []interface{}{userID}
+	if transactionType != "" {
+		args = append(args, transactionType)
+		whereClause += " AND transaction_type = $2"
+	}
+
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM wallet_transactions WHERE "+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	transactions, err := query(ctx, r.q, scanWalletTransaction, fmt.Sprintf(`
+		SELECT %s FROM wallet_transactions
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d`, walletTransactionColumns, whereClause, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return transactions, total, nil
+}
+
+func (r wallets) TransactionsByUser(ctx context.Context, userID int) ([]models.WalletTransaction, error) {
+	return query(ctx, r.q, scanWalletTransaction, `
+		SELECT`+walletTransactionColumns+`
+		FROM wallet_transactions WHERE user_id = $1
+		ORDER BY created_at`, userID)
+}
+
+const pointsColumns = `
+	id, user_id, total_points, available_points, used_points, created_at, updated_at`
+
+type points struct {
+	q querier
+}
+
+func (r points) get(ctx context.Context, query string, args ...interface{}) (*models.CustomerPoints, error) {
+	var points models.CustomerPoints
+	err := r.q.QueryRowContext(ctx, query, args...).Scan(
+		&points.ID, &points.UserID, &points.TotalPoints, &points.AvailablePoints, &points.UsedPoints,
+		&points.CreatedAt, &points.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &points, nil
+}
+
+func (r points) Get(ctx context.Context, userID int) (*models.CustomerPoints, error) {
+	return r.get(ctx, "SELECT"+pointsColumns+" FROM customer_points WHERE user_id = $1", userID)
+}
+
+func (r points) Create(ctx context.Context, userID int) (*models.CustomerPoints, error) {
+	return r.get(ctx, `
+		INSERT INTO customer_points (user_id, total_points, available_points, used_points, created_at, updated_at)
+		VALUES ($1, 0, 0, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING`+pointsColumns, userID)
+}
+
+func (r points) Add(ctx context.Context, userID, amount int) error {
+	_, err := r.q.ExecContext(ctx, `
+		UPDATE customer_points
+		SET total_points = total_points + $1, available_points = available_points + $1, updated_at = CURRENT_TIMESTAMP
+		WHERE user_id = $2`, amount, userID)
+	return err
+}
+
+func (r points) CreateTransaction(ctx context.Context, t models.PointTransaction) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO point_transactions (user_id, points, transaction_type, reason, reference_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)`,
+		t.UserID, t.Points, t.TransactionType, t.Reason, t.ReferenceID, t.ExpiresAt)
+	return err
+}
+
+func (r points) TransactionsByUser(ctx context.Context, userID int) ([]models.PointTransaction, error) {
+	return query(ctx, r.q, func(row scanner) (models.PointTransaction, error) {
+		var t models.PointTransaction
+		err := row.Scan(&t.ID, &t.UserID, &t.Points, &t.TransactionType, &t.Reason, &t.ReferenceID, &t.ExpiresAt, &t.CreatedAt)
+		return t, err
+	}, `
+		SELECT id, user_id, points, transaction_type, reason, reference_id, expires_at, created_at
+		FROM point_transactions WHERE user_id = $1
+		ORDER BY created_at`, userID)
+}
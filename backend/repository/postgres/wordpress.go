@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"zplus_web/backend/models"
+)
+
+const siteColumns = `
+	id, name, url, api_endpoint, username, is_active, last_sync_at, created_at, updated_at`
+
+type wordpress struct {
+	q querier
+}
+
+func scanSite(row scanner) (models.WordPressSite, error) {
+	var site models.WordPressSite
+	err := row.Scan(
+		&site.ID, &site.Name, &site.URL, &site.APIEndpoint, &site.Username,
+		&site.IsActive, &site.LastSyncAt, &site.CreatedAt, &site.UpdatedAt)
+	return site, err
+}
+
+func (r wordpress) ListActiveSites(ctx context.Context) ([]models.WordPressSite, error) {
+	return query(ctx, r.q, scanSite, `
+		SELECT`+siteColumns+`
+		FROM wordpress_sites
+		WHERE is_active = true
+		ORDER BY name`)
+}
+
+func (r wordpress) CreateSite(ctx context.Context, s models.WordPressSite) (*models.WordPressSite, error) {
+	site, err := scanSite(r.q.QueryRowContext(ctx, `
+		INSERT INTO wordpress_sites (name, url, api_endpoint, username, application_password, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING`+siteColumns,
+		s.Name, s.URL, s.APIEndpoint, s.Username, s.ApplicationPassword, s.IsActive))
+	if err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+func (r wordpress) GetSite(ctx context.Context, id int) (*models.WordPressSite, error) {
+	var site models.WordPressSite
+	err := r.q.QueryRowContext(ctx, `
+		SELECT id, name, url, api_endpoint, username, application_password, is_active, last_sync_at, created_at, updated_at
+		FROM wordpress_sites WHERE id = $1`, id).Scan(
+		&site.ID, &site.Name, &site.URL, &site.APIEndpoint, &site.Username, &site.ApplicationPassword,
+		&site.IsActive, &site.LastSyncAt, &site.CreatedAt, &site.UpdatedAt)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &site, nil
+}
+
+func (r wordpress) TouchSync(ctx context.Context, id int) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE wordpress_sites SET last_sync_at = CURRENT_TIMESTAMP WHERE id = $1", id)
+	return err
+}
+
+func (r wordpress) LogSync(ctx context.Context, entry models.ContentSyncLog) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO content_sync_logs (site_id, sync_type, local_content_id, remote_content_id, status, error_message, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP)`,
+		entry.SiteID, entry.SyncType, entry.LocalContentID, entry.RemoteContentID, entry.Status, entry.ErrorMessage)
+	return err
+}
+
+func (r wordpress) ListLogs(ctx context.Context, siteID, limit, offset int) ([]models.ContentSyncLog, int, error) {
+	var total int
+	err := r.q.QueryRowContext(ctx, "SELECT COUNT(*) FROM content_sync_logs WHERE site_id = $1", siteID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs, err := query(ctx, r.q, func(row scanner) (models.ContentSyncLog, error) {
+		var log models.ContentSyncLog
+		err := row.Scan(
+			&log.ID, &log.SiteID, &log.SyncType, &log.LocalContentID, &log.RemoteContentID,
+			&log.Status, &log.ErrorMessage, &log.CreatedAt)
+		return log, err
+	}, `
+		SELECT id, site_id, sync_type, local_content_id, remote_content_id, status, error_message, created_at
+		FROM content_sync_logs
+		WHERE site_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`, siteID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
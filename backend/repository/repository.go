@@ -0,0 +1,52 @@
+// Package repository defines the storage the services are built on. Every
+// table group has a repository interface; the postgres package implements
+// them on the database and the memory package keeps everything in process
+// memory so handlers and services can be tested without Postgres.
+//
+// Repositories return ErrNotFound when no row matches and ErrConflict when a
+// unique constraint rejects a row. Other errors come from the storage and are
+// wrapped by the services with what they were doing.
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNotFound means no row matched
+	ErrNotFound = errors.New("not found")
+	// ErrConflict means the row clashes with an existing one
+	ErrConflict = errors.New("conflict")
+)
+
+// Store gives access to every repository
+type Store interface {
+	Users() UserRepository
+	Sessions() SessionRepository
+	PasswordResets() TokenRepository
+	EmailVerifications() TokenRepository
+	MagicLinks() MagicLinkRepository
+	MFA() MFARepository
+	Identities() IdentityRepository
+	Lockouts() LockoutRepository
+	Invitations() InvitationRepository
+	APIKeys() APIKeyRepository
+	Roles() RoleRepository
+	Audit() AuditRepository
+	Settings() SettingsRepository
+	Privacy() PrivacyRepository
+	Posts() PostRepository
+	Projects() ProjectRepository
+	WordPress() WordPressRepository
+	Products() ProductRepository
+	Orders() OrderRepository
+	Wallets() WalletRepository
+	Points() PointsRepository
+
+	// Transact runs fn with a store whose repositories share one transaction.
+	// It commits when fn returns nil and rolls back otherwise. Rows read with
+	// the ForUpdate methods stay locked until the transaction ends. Calling
+	// Transact on the store passed to fn runs in the same transaction.
+	Transact(ctx context.Context, fn func(tx Store) error) error
+}
@@ -0,0 +1,181 @@
+package routes
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Access describes which guards protect a route
+type Access int
+
+const (
+	Public Access = iota
+	Authenticated
+	Admin
+)
+
+func (a Access) String() string {
+	switch a {
+	case Authenticated:
+		return "authenticated"
+	case Admin:
+		return "admin"
+	default:
+		return "public"
+	}
+}
+
+// Route is a single endpoint registered by a module
+type Route struct {
+	Method string
+	Path   string
+	Access Access
+	// Permission is required for Admin routes
+	Permission string
+	Handlers   []fiber.Handler
+	// Timeout overrides the registry's default request deadline when set
+	Timeout time.Duration
+
+	// Summary, Request and Response document the route in the OpenAPI spec.
+	// Request is a value of the JSON body type and Response a value of the
+	// type returned as ApiResponse data, e.g. models.LoginRequest{} or
+	// []models.Role{}, or a Content for anything else. Either is nil when
+	// there is nothing to document.
+	Summary  string
+	Request  interface{}
+	Response interface{}
+}
+
+// Content documents a body that is not JSON wrapped in models.ApiResponse,
+// e.g. a file upload, a CSV download or an HTML page. Schema optionally
+// gives the body's shape like Route.Request does.
+type Content struct {
+	Type   string
+	Schema interface{}
+}
+
+// Describe documents the route for the OpenAPI spec, see Route
+func (rt *Route) Describe(summary string, request, response interface{}) *Route {
+	rt.Summary = summary
+	rt.Request = request
+	rt.Response = response
+	return rt
+}
+
+// WithTimeout gives the route a longer (or shorter) deadline than the default,
+// e.g. for endpoints that call out to slow third parties
+func (rt *Route) WithTimeout(timeout time.Duration) *Route {
+	rt.Timeout = timeout
+	return rt
+}
+
+// Module is implemented by every handler that exposes HTTP endpoints
+type Module interface {
+	RegisterRoutes(r *Registry)
+}
+
+// Guards holds the middleware applied per access level.
+// Admin routes run the Authenticated chain first, then the guard built by Permission.
+// When Deadline is set every route runs it first, with the route's Timeout or
+// the default Timeout.
+type Guards struct {
+	Authenticated []fiber.Handler
+	Permission    func(permission string) fiber.Handler
+	Deadline      func(timeout time.Duration) fiber.Handler
+	Timeout       time.Duration
+}
+
+// Registry collects routes from modules and mounts them on a Fiber app
+type Registry struct {
+	apiPrefix string
+	guards    Guards
+	routes    []*Route
+}
+
+func NewRegistry(apiPrefix string, guards Guards) *Registry {
+	return &Registry{
+		apiPrefix: apiPrefix,
+		guards:    guards,
+	}
+}
+
+// Register lets each module add its routes to the registry
+func (r *Registry) Register(modules ...Module) {
+	for _, m := range modules {
+		m.RegisterRoutes(r)
+	}
+}
+
+// Public adds an unauthenticated route under the API prefix
+func (r *Registry) Public(method, path string, handlers ...fiber.Handler) *Route {
+	return r.add(method, r.apiPrefix+path, Public, "", handlers)
+}
+
+// Authenticated adds a route under the API prefix that requires a logged-in user
+func (r *Registry) Authenticated(method, path string, handlers ...fiber.Handler) *Route {
+	return r.add(method, r.apiPrefix+path, Authenticated, "", handlers)
+}
+
+// Admin adds a route under <API prefix>/admin that requires a logged-in user
+// whose role grants the permission
+func (r *Registry) Admin(permission, method, path string, handlers ...fiber.Handler) *Route {
+	return r.add(method, r.apiPrefix+"/admin"+path, Admin, permission, handlers)
+}
+
+// Root adds an unauthenticated route outside the API prefix (e.g. static files)
+func (r *Registry) Root(method, path string, handlers ...fiber.Handler) *Route {
+	return r.add(method, path, Public, "", handlers)
+}
+
+func (r *Registry) add(method, path string, access Access, permission string, handlers []fiber.Handler) *Route {
+	route := &Route{
+		Method:     method,
+		Path:       path,
+		Access:     access,
+		Permission: permission,
+		Handlers:   handlers,
+	}
+	r.routes = append(r.routes, route)
+	return route
+}
+
+// Routes returns every registered route sorted by path and method
+func (r *Registry) Routes() []Route {
+	routes := make([]Route, len(r.routes))
+	for i, route := range r.routes {
+		routes[i] = *route
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// Mount registers every route on the router with the guards for its access level.
+// Guards are attached per route so public routes never pass through auth middleware.
+func (r *Registry) Mount(router fiber.Router) {
+	for _, route := range r.routes {
+		var chain []fiber.Handler
+		if r.guards.Deadline != nil {
+			timeout := r.guards.Timeout
+			if route.Timeout > 0 {
+				timeout = route.Timeout
+			}
+			chain = append(chain, r.guards.Deadline(timeout))
+		}
+		switch route.Access {
+		case Authenticated:
+			chain = append(chain, r.guards.Authenticated...)
+		case Admin:
+			chain = append(chain, r.guards.Authenticated...)
+			chain = append(chain, r.guards.Permission(route.Permission))
+		}
+		chain = append(chain, route.Handlers...)
+		router.Add(route.Method, route.Path, chain...)
+	}
+}
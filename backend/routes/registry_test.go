@@ -0,0 +1,139 @@
+package routes_test
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"zplus_web/backend/routes"
+)
+
+// shop registers one route of each kind
+type shop struct{}
+
+func (shop) RegisterRoutes(r *routes.Registry) {
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusNoContent) }
+	r.Public(fiber.MethodGet, "/products", ok)
+	r.Authenticated(fiber.MethodPost, "/orders", ok)
+	r.Authenticated(fiber.MethodGet, "/orders", ok).WithTimeout(time.Minute)
+	r.Admin("orders:read", fiber.MethodGet, "/orders", ok)
+	r.Admin("orders:write", fiber.MethodDelete, "/orders/:id", ok)
+	r.Root(fiber.MethodGet, "/health", ok)
+}
+
+// newRegistry returns a registry whose guards admit the user named in the
+// X-User header and the permission named in X-Permission, and record the
+// deadline of every request
+func newRegistry(deadlines *[]time.Duration) *routes.Registry {
+	return routes.NewRegistry("/api/v1", routes.Guards{
+		Authenticated: []fiber.Handler{func(c *fiber.Ctx) error {
+			if c.Get("X-User") == "" {
+				return c.SendStatus(fiber.StatusUnauthorized)
+			}
+			return c.Next()
+		}},
+		Permission: func(permission string) fiber.Handler {
+			return func(c *fiber.Ctx) error {
+				if c.Get("X-Permission") != permission {
+					return c.SendStatus(fiber.StatusForbidden)
+				}
+				return c.Next()
+			}
+		},
+		Deadline: func(timeout time.Duration) fiber.Handler {
+			return func(c *fiber.Ctx) error {
+				*deadlines = append(*deadlines, timeout)
+				return c.Next()
+			}
+		},
+		Timeout: 10 * time.Second,
+	})
+}
+
+func TestRoutes(t *testing.T) {
+	registry := newRegistry(new([]time.Duration))
+	registry.Register(shop{})
+
+	type listed struct {
+		Method, Path string
+		Access       routes.Access
+		Permission   string
+	}
+	var got []listed
+	for _, route := range registry.Routes() {
+		got = append(got, listed{route.Method, route.Path, route.Access, route.Permission})
+	}
+	want := []listed{
+		{fiber.MethodGet, "/api/v1/admin/orders", routes.Admin, "orders:read"},
+		{fiber.MethodDelete, "/api/v1/admin/orders/:id", routes.Admin, "orders:write"},
+		{fiber.MethodGet, "/api/v1/orders", routes.Authenticated, ""},
+		{fiber.MethodPost, "/api/v1/orders", routes.Authenticated, ""},
+		{fiber.MethodGet, "/api/v1/products", routes.Public, ""},
+		{fiber.MethodGet, "/health", routes.Public, ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("routes = %+v, want %+v", got, want)
+	}
+
+	// Every listed route is mounted, and nothing else
+	app := fiber.New()
+	registry.Mount(app)
+	mounted := map[string]bool{}
+	for _, route := range app.GetRoutes(true) {
+		if route.Method != fiber.MethodHead {
+			mounted[route.Method+" "+route.Path] = true
+		}
+	}
+	if len(mounted) != len(want) {
+		t.Errorf("mounted %v, want %d routes", mounted, len(want))
+	}
+	for _, route := range want {
+		if !mounted[route.Method+" "+route.Path] {
+			t.Errorf("%s %s is not mounted", route.Method, route.Path)
+		}
+	}
+}
+
+func TestMountGuards(t *testing.T) {
+	var deadlines []time.Duration
+	registry := newRegistry(&deadlines)
+	registry.Register(shop{})
+	app := fiber.New()
+	registry.Mount(app)
+
+	tests := []struct {
+		method, path, user, permission string
+		status                         int
+		deadline                       time.Duration
+	}{
+		{fiber.MethodGet, "/api/v1/products", "", "", fiber.StatusNoContent, 10 * time.Second},
+		{fiber.MethodGet, "/health", "", "", fiber.StatusNoContent, 10 * time.Second},
+		{fiber.MethodPost, "/api/v1/orders", "", "", fiber.StatusUnauthorized, 10 * time.Second},
+		{fiber.MethodPost, "/api/v1/orders", "jane", "", fiber.StatusNoContent, 10 * time.Second},
+		{fiber.MethodGet, "/api/v1/orders", "jane", "", fiber.StatusNoContent, time.Minute},
+		{fiber.MethodGet, "/api/v1/admin/orders", "", "orders:read", fiber.StatusUnauthorized, 10 * time.Second},
+		{fiber.MethodGet, "/api/v1/admin/orders", "jane", "", fiber.StatusForbidden, 10 * time.Second},
+		{fiber.MethodGet, "/api/v1/admin/orders", "jane", "orders:write", fiber.StatusForbidden, 10 * time.Second},
+		{fiber.MethodGet, "/api/v1/admin/orders", "jane", "orders:read", fiber.StatusNoContent, 10 * time.Second},
+		{fiber.MethodDelete, "/api/v1/admin/orders/7", "jane", "orders:write", fiber.StatusNoContent, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		deadlines = nil
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		req.Header.Set("X-User", tt.user)
+		req.Header.Set("X-Permission", tt.permission)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s %s as %q with %q = %d, want %d", tt.method, tt.path, tt.user, tt.permission, resp.StatusCode, tt.status)
+		}
+		if len(deadlines) != 1 || deadlines[0] != tt.deadline {
+			t.Errorf("%s %s ran with deadlines %v, want %v", tt.method, tt.path, deadlines, tt.deadline)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+// apiKeyLastUsedInterval limits last-used tracking to one write per key per interval
+const apiKeyLastUsedInterval = time.Minute
+
+var serviceAccountNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+type APIKeyService struct {
+	store       repository.Store
+	roleService *RoleService
+}
+
+func NewAPIKeyService(store repository.Store, roleService *RoleService) *APIKeyService {
+	return &APIKeyService{
+		store:       store,
+		roleService: roleService,
+	}
+}
+
+// CreateServiceAccount creates a machine user. It has no usable password and
+// can only authenticate with API keys. Its role cannot have permissions the
+// actor's role lacks.
+func (s *APIKeyService) CreateServiceAccount(ctx context.Context, req models.CreateServiceAccountRequest, actor models.AuditActor) (*models.User, error) {
+	if !serviceAccountNamePattern.MatchString(req.Name) {
+		return nil, apperr.Validation("Validation failed", map[string]string{"name": "must use lowercase letters, digits and underscores"})
+	}
+
+	if err := s.checkRole(ctx, actor, req.Role); err != nil {
+		return nil, err
+	}
+
+	username := "svc_" + req.Name
+	var user *models.User
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		var err error
+		user, err = tx.Users().Create(ctx, repository.NewUser{
+			Username:         username,
+			Email:            username + "@service-accounts.invalid",
+			PasswordHash:     "!",
+			FullName:         optionalString(req.FullName),
+			Role:             req.Role,
+			EmailVerified:    true,
+			IsServiceAccount: true,
+		})
+		if errors.Is(err, repository.ErrConflict) {
+			return apperr.Conflict("Service account already exists").WithDetails(fmt.Sprintf("Service account %s already exists", req.Name))
+		} else if err != nil {
+			return fmt.Errorf("failed to create service account: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditServiceAccountCreate, "user", strconv.Itoa(user.ID), nil, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetServiceAccounts returns every service account
+func (s *APIKeyService) GetServiceAccounts(ctx context.Context) ([]models.User, error) {
+	users, err := s.store.Users().ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service accounts: %w", err)
+	}
+
+	return users, nil
+}
+
+// CreateKey issues an API key for a service account. The returned key is
+// shown once; only its hash is stored. The actor must hold every scope of the
+// key and every permission of the service account's role.
+func (s *APIKeyService) CreateKey(ctx context.Context, req models.CreateAPIKeyRequest, actor models.AuditActor) (string, *models.APIKey, error) {
+	for _, scope := range req.Scopes {
+		if !rbac.Valid(scope) {
+			return "", nil, apperr.Validation("Validation failed", map[string]string{"scopes": fmt.Sprintf("%q is not a permission", scope)})
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return "", nil, apperr.Validation("Validation failed", map[string]string{"expires_at": "must be in the future"})
+	}
+
+	isServiceAccount, err := s.store.Users().IsServiceAccount(ctx, req.UserID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", nil, apperr.NotFound("User not found")
+	} else if err != nil {
+		return "", nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !isServiceAccount {
+		return "", nil, apperr.Validation("Validation failed", map[string]string{"user_id": "must be a service account"}).WithDetails("API keys can only be issued to service accounts")
+	}
+
+	account, err := s.store.Users().GetByID(ctx, req.UserID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if err = s.checkRole(ctx, actor, account.Role); err != nil {
+		return "", nil, err
+	}
+
+	granted, err := s.actorPermissions(ctx, actor)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, scope := range req.Scopes {
+		if !rbac.Has(granted, scope) {
+			return "", nil, apperr.Forbidden("Permission denied").WithDetails("You cannot grant scope " + scope)
+		}
+	}
+
+	id, err := utils.GenerateSecureToken(6)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	secret, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+	prefix := utils.APIKeyPrefix + id
+	rawKey := prefix + "_" + secret
+
+	var key *models.APIKey
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		var err error
+		key, err = tx.APIKeys().Create(ctx, repository.NewAPIKey{
+			UserID:    req.UserID,
+			Name:      req.Name,
+			Prefix:    prefix,
+			KeyHash:   utils.HashToken(rawKey),
+			Scopes:    req.Scopes,
+			ExpiresAt: req.ExpiresAt,
+			CreatedBy: actor.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create API key: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditAPIKeyCreate, "api_key", strconv.Itoa(key.ID), nil, key)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return rawKey, key, nil
+}
+
+// checkRole refuses to let the actor hand out role, through a service account
+// or its keys, when the role has permissions the actor's own role does not
+func (s *APIKeyService) checkRole(ctx context.Context, actor models.AuditActor, role string) error {
+	actorRole, err := s.roleService.ActorRole(ctx, actor)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := s.roleService.CanManage(ctx, actorRole, role)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return apperr.Forbidden("Permission denied").WithDetails("Role " + role + " has permissions your role does not have")
+	}
+	return nil
+}
+
+// actorPermissions returns the permissions of the actor's current role
+func (s *APIKeyService) actorPermissions(ctx context.Context, actor models.AuditActor) ([]string, error) {
+	actorRole, err := s.roleService.ActorRole(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roleService.RolePermissions(ctx, actorRole)
+}
+
+// GetKeys returns the API keys of a service account, or of every service account when userID is 0
+func (s *APIKeyService) GetKeys(ctx context.Context, userID int) ([]models.APIKey, error) {
+	keys, err := s.store.APIKeys().List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeKey revokes an API key; requests using it are rejected immediately
+func (s *APIKeyService) RevokeKey(ctx context.Context, id int, actor models.AuditActor) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		prefix, err := tx.APIKeys().Revoke(ctx, id)
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.NotFound("API key not found").WithDetails("The API key does not exist or is already revoked")
+		} else if err != nil {
+			return fmt.Errorf("failed to revoke API key: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditAPIKeyRevoke, "api_key", strconv.Itoa(id), nil, map[string]string{"prefix": prefix})
+	})
+}
+
+// ValidateAPIKey returns the service account and scopes behind a raw API key.
+// Revoked and expired keys and deactivated accounts are rejected.
+func (s *APIKeyService) ValidateAPIKey(ctx context.Context, rawKey, ip string) (*models.APIKeyPrincipal, error) {
+	if !strings.HasPrefix(rawKey, utils.APIKeyPrefix) {
+		return nil, fmt.Errorf("invalid API key")
+	}
+
+	key, user, err := s.store.APIKeys().GetByHash(ctx, utils.HashToken(rawKey))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("invalid API key")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
+	}
+
+	switch {
+	case key.RevokedAt != nil:
+		return nil, fmt.Errorf("API key has been revoked")
+	case key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now()):
+		return nil, fmt.Errorf("API key has expired")
+	case !user.IsActive:
+		return nil, fmt.Errorf("user account is deactivated")
+	}
+
+	// Best effort, a failed write must not fail the request
+	s.store.APIKeys().TouchLastUsed(ctx, key.ID, truncate(ip, 45), time.Now().Add(-apiKeyLastUsedInterval))
+
+	principal := models.APIKeyPrincipal{KeyID: key.ID, User: *user, Scopes: key.Scopes}
+	return &principal, nil
+}
@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+)
+
+func TestCreateKeyGrants(t *testing.T) {
+	env := handlertest.New(t)
+	env.CreateRole(t, "integrator", rbac.APIKeysManage, rbac.OrdersRead)
+	admin := handlertest.Actor(env.CreateUser(t, "admin"))
+	integrator := handlertest.Actor(env.CreateUser(t, "integrator"))
+
+	account, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "reporting", Role: "integrator"}, integrator)
+	if err != nil {
+		t.Fatalf("service account with the creator's role = %v", err)
+	}
+	if _, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "wide", Role: "accountant"}, integrator); apperr.Code(err) != apperr.CodePermissionDenied {
+		t.Errorf("service account above the creator = %v", err)
+	}
+
+	tests := []struct {
+		actor  models.AuditActor
+		scopes []string
+		ok     bool
+	}{
+		{integrator, []string{rbac.OrdersRead}, true},
+		{integrator, []string{rbac.OrdersRead, rbac.APIKeysManage}, true},
+		{integrator, []string{rbac.OrdersRefund}, false},
+		{integrator, []string{"orders:*"}, false},
+		{integrator, []string{rbac.Wildcard}, false},
+		{admin, []string{rbac.Wildcard}, true},
+		{models.AuditActor{}, []string{rbac.OrdersRead}, false},
+	}
+	for _, tt := range tests {
+		_, _, err := env.APIKeys.CreateKey(t.Context(), models.CreateAPIKeyRequest{UserID: account.ID, Name: "key", Scopes: tt.scopes}, tt.actor)
+		if tt.ok && err != nil || !tt.ok && apperr.Code(err) != apperr.CodePermissionDenied {
+			t.Errorf("key with %v = %v, want ok %v", tt.scopes, err, tt.ok)
+		}
+	}
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	env := handlertest.New(t)
+	admin := handlertest.Actor(env.CreateUser(t, "admin"))
+	account, err := env.APIKeys.CreateServiceAccount(t.Context(), models.CreateServiceAccountRequest{Name: "sync", Role: "accountant"}, admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rawKey, key, err := env.APIKeys.CreateKey(t.Context(), models.CreateAPIKeyRequest{UserID: account.ID, Name: "sync", Scopes: []string{rbac.OrdersRead}}, admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	principal, err := env.APIKeys.ValidateAPIKey(t.Context(), rawKey, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("validate = %v", err)
+	}
+	if principal.User.ID != account.ID || principal.KeyID != key.ID || !slices.Equal(principal.Scopes, []string{rbac.OrdersRead}) {
+		t.Errorf("principal = %+v", principal)
+	}
+
+	if _, err := env.APIKeys.ValidateAPIKey(t.Context(), rawKey+"x", "127.0.0.1"); err == nil {
+		t.Error("accepted a wrong key")
+	}
+	if err := env.APIKeys.RevokeKey(t.Context(), key.ID, admin); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.APIKeys.ValidateAPIKey(t.Context(), rawKey, "127.0.0.1"); err == nil {
+		t.Error("accepted a revoked key")
+	}
+
+	past := time.Now().Add(-time.Hour)
+	_, _, err = env.APIKeys.CreateKey(t.Context(), models.CreateAPIKeyRequest{UserID: account.ID, Name: "old", Scopes: []string{rbac.OrdersRead}, ExpiresAt: &past}, admin)
+	if apperr.Code(err) != apperr.CodeValidation {
+		t.Errorf("key expiring in the past = %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+// Audited actions, formatted as <entity>.<change>
+const (
+	AuditUserCreate           = "user.create"
+	AuditUserUpdate           = "user.update"
+	AuditUserErase            = "user.erase"
+	AuditUserRoleChange       = "user.role_change"
+	AuditUserPasswordReset    = "user.password_reset"
+	AuditUserPasswordChange   = "user.password_change"
+	AuditUserMFAEnable        = "user.mfa_enable"
+	AuditUserMFADisable       = "user.mfa_disable"
+	AuditUserMFAReset         = "user.mfa_reset"
+	AuditUserImpersonate      = "user.impersonate"
+	AuditInvitationCreate     = "invitation.create"
+	AuditInvitationResend     = "invitation.resend"
+	AuditInvitationRevoke     = "invitation.revoke"
+	AuditInvitationAccept     = "invitation.accept"
+	AuditServiceAccountCreate = "service_account.create"
+	AuditAPIKeyCreate         = "api_key.create"
+	AuditAPIKeyRevoke         = "api_key.revoke"
+	AuditRoleCreate           = "role.create"
+	AuditRoleUpdate           = "role.update"
+	AuditRoleDelete           = "role.delete"
+	AuditSettingsUpdate       = "settings.update"
+	AuditLoginUnlock          = "login.unlock"
+	AuditDepositComplete      = "wallet.deposit_complete"
+	AuditBlogPostCreate       = "blog_post.create"
+	AuditBlogPostUpdate       = "blog_post.update"
+	AuditBlogPostDelete       = "blog_post.delete"
+	AuditProjectCreate        = "project.create"
+	AuditProjectUpdate        = "project.update"
+	AuditProjectDelete        = "project.delete"
+	AuditWordPressSiteCreate  = "wordpress_site.create"
+	AuditWordPressSync        = "wordpress_site.sync"
+	AuditWordPressPublish     = "wordpress_site.publish"
+)
+
+// auditExportLimit caps the number of rows in a CSV export
+const auditExportLimit = 50000
+
+type AuditService struct {
+	store repository.Store
+}
+
+func NewAuditService(store repository.Store) *AuditService {
+	return &AuditService{
+		store: store,
+	}
+}
+
+// Record writes an audit event for a change that was committed on its own.
+// Failures are logged rather than returned since the change already happened,
+// which is also why the write is not cut short by the request's deadline.
+func (s *AuditService) Record(ctx context.Context, actor models.AuditActor, action, entityType, entityID string, before, after interface{}) {
+	if err := recordAudit(context.WithoutCancel(ctx), s.store, actor, action, entityType, entityID, before, after); err != nil {
+		log.Printf("Failed to record audit event %s for %s %s: %v", action, entityType, entityID, err)
+	}
+}
+
+// GetEvents returns audit events matching the filter, newest first
+func (s *AuditService) GetEvents(ctx context.Context, filter models.AuditFilter, page, limit int) ([]models.AuditEvent, int, error) {
+	offset := (page - 1) * limit
+
+	total, err := s.store.Audit().Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	events, err := s.store.Audit().List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get audit events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// ExportEvents writes the audit events matching the filter as CSV, newest first
+func (s *AuditService) ExportEvents(ctx context.Context, filter models.AuditFilter, w io.Writer) error {
+	events, err := s.store.Audit().List(ctx, filter, auditExportLimit, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get audit events: %w", err)
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{
+		"id", "created_at", "actor_id", "actor_email", "impersonator_id", "action", "entity_type", "entity_id",
+		"ip_address", "user_agent", "before", "after",
+	})
+	for _, event := range events {
+		actorID, impersonatorID := "", ""
+		if event.ActorID != nil {
+			actorID = strconv.Itoa(*event.ActorID)
+		}
+		if event.ImpersonatorID != nil {
+			impersonatorID = strconv.Itoa(*event.ImpersonatorID)
+		}
+		writer.Write([]string{
+			strconv.FormatInt(event.ID, 10),
+			event.CreatedAt.UTC().Format(time.RFC3339),
+			actorID,
+			csvCell(stringValue(event.ActorEmail)),
+			impersonatorID,
+			csvCell(event.Action),
+			csvCell(event.EntityType),
+			csvCell(stringValue(event.EntityID)),
+			csvCell(stringValue(event.IPAddress)),
+			csvCell(stringValue(event.UserAgent)),
+			csvCell(string(event.Before)),
+			csvCell(string(event.After)),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// recordAudit writes an audit event to store, which may be the transaction
+// making the change. before and after are stored as JSON and may be nil.
+func recordAudit(ctx context.Context, store repository.Store, actor models.AuditActor, action, entityType, entityID string, before, after interface{}) error {
+	beforeJSON, err := auditJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditJSON(after)
+	if err != nil {
+		return err
+	}
+
+	err = store.Audit().Insert(ctx, models.AuditEvent{
+		ActorID:        actor.UserID,
+		ActorEmail:     optionalString(truncate(actor.Email, 100)),
+		ImpersonatorID: actor.ImpersonatorID,
+		Action:         action,
+		EntityType:     entityType,
+		EntityID:       optionalString(entityID),
+		Before:         beforeJSON,
+		After:          afterJSON,
+		IPAddress:      optionalString(truncate(actor.IPAddress, 45)),
+		UserAgent:      optionalString(actor.UserAgent),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// auditJSON encodes an entity state, keeping nil as NULL
+func auditJSON(v interface{}) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit data: %w", err)
+	}
+	if string(data) == "null" {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// csvCell stops spreadsheet applications from evaluating user-controlled values as formulas
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optionalString maps an empty string to nil, storing it as NULL
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
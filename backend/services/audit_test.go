@@ -0,0 +1,126 @@
+package services_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"testing"
+	"time"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/services"
+)
+
+func TestAuditFilters(t *testing.T) {
+	env := handlertest.New(t)
+	alice, bob := env.CreateUser(t, "admin"), env.CreateUser(t, "editor")
+
+	record := func(actor *models.User, action, entityType, entityID string) {
+		env.Audit.Record(t.Context(), handlertest.Actor(actor), action, entityType, entityID, nil, nil)
+		// Keep the events' timestamps apart for the date range
+		time.Sleep(2 * time.Millisecond)
+	}
+	record(alice, services.AuditBlogPostCreate, "blog_post", "1")
+	record(bob, services.AuditProjectCreate, "project", "7")
+	middle := time.Now()
+	time.Sleep(2 * time.Millisecond)
+	record(alice, services.AuditBlogPostUpdate, "blog_post", "1")
+	record(bob, services.AuditBlogPostUpdate, "blog_post", "2")
+
+	tests := []struct {
+		name   string
+		filter models.AuditFilter
+		want   []string // entity types and IDs, newest first
+	}{
+		{"all", models.AuditFilter{}, []string{"blog_post 2", "blog_post 1", "project 7", "blog_post 1"}},
+		{"actor", models.AuditFilter{ActorID: &alice.ID}, []string{"blog_post 1", "blog_post 1"}},
+		{"action", models.AuditFilter{Action: services.AuditBlogPostUpdate}, []string{"blog_post 2", "blog_post 1"}},
+		{"entity", models.AuditFilter{EntityType: "blog_post", EntityID: "2"}, []string{"blog_post 2"}},
+		{"from", models.AuditFilter{From: &middle}, []string{"blog_post 2", "blog_post 1"}},
+		{"to", models.AuditFilter{To: &middle}, []string{"project 7", "blog_post 1"}},
+		{"actor and to", models.AuditFilter{ActorID: &bob.ID, To: &middle}, []string{"project 7"}},
+		{"no match", models.AuditFilter{Action: services.AuditRoleDelete}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, total, err := env.Audit.GetEvents(t.Context(), tt.filter, 1, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, event := range events {
+				got = append(got, event.EntityType+" "+*event.EntityID)
+			}
+			if !slices.Equal(got, tt.want) || total != len(tt.want) {
+				t.Errorf("events = %v (total %d), want %v", got, total, tt.want)
+			}
+		})
+	}
+
+	// Pages split the newest first order, and the total counts every match
+	var pages [][]int64
+	for page := 1; page <= 3; page++ {
+		events, total, err := env.Audit.GetEvents(t.Context(), models.AuditFilter{}, page, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 4 {
+			t.Errorf("page %d total = %d, want 4", page, total)
+		}
+		var ids []int64
+		for _, event := range events {
+			ids = append(ids, event.ID)
+		}
+		pages = append(pages, ids)
+	}
+	if len(pages[0]) != 3 || len(pages[1]) != 1 || len(pages[2]) != 0 || pages[0][2] <= pages[1][0] {
+		t.Errorf("pages = %v", pages)
+	}
+}
+
+func TestAuditExport(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "admin")
+
+	actor := handlertest.Actor(user)
+	actor.UserAgent = "=HYPERLINK(\"http://evil.example\")"
+	actor.IPAddress = "203.0.113.7"
+	before := map[string]string{"title": "Draft, \"quoted\"\nwith a new line"}
+	after := map[string]string{"title": "+1"}
+	env.Audit.Record(t.Context(), actor, services.AuditBlogPostUpdate, "blog_post", "-5", before, after)
+
+	var buf bytes.Buffer
+	if err := env.Audit.ExportEvents(t.Context(), models.AuditFilter{}, &buf); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v\n%s", err, buf.String())
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d rows, want a header and 1 event", len(records))
+	}
+
+	row := map[string]string{}
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+	want := map[string]string{
+		"actor_email": user.Email,
+		"action":      services.AuditBlogPostUpdate,
+		"entity_type": "blog_post",
+		// Cells starting with a formula character are prefixed with a quote
+		"entity_id":  "'-5",
+		"ip_address": "203.0.113.7",
+		"user_agent": "'=HYPERLINK(\"http://evil.example\")",
+		// Commas, quotes and new lines survive the round trip
+		"before": `{"title":"Draft, \"quoted\"\nwith a new line"}`,
+		"after":  `{"title":"+1"}`,
+	}
+	for column, value := range want {
+		if row[column] != value {
+			t.Errorf("%s = %q, want %q", column, row[column], value)
+		}
+	}
+}
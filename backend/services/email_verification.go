@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/mailer"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+const emailVerificationTTL = 24 * time.Hour
+
+type EmailVerificationService struct {
+	store       repository.Store
+	userService *UserService
+	mailer      mailer.Mailer
+	limiter     *ratelimit.Limiter
+	appURL      string
+}
+
+func NewEmailVerificationService(store repository.Store, userService *UserService, m mailer.Mailer, limiter *ratelimit.Limiter, appURL string) *EmailVerificationService {
+	return &EmailVerificationService{
+		store:       store,
+		userService: userService,
+		mailer:      m,
+		limiter:     limiter,
+		appURL:      appURL,
+	}
+}
+
+// SendVerification emails a verification link to a newly registered user
+func (s *EmailVerificationService) SendVerification(ctx context.Context, user *models.User) error {
+	if user.EmailVerified {
+		return nil
+	}
+
+	if err := s.limiter.Allow(ctx, "verify:"+strconv.Itoa(user.ID)); err != nil {
+		return err
+	}
+
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	err = s.store.EmailVerifications().Create(ctx, user.ID, utils.HashToken(token), time.Now().Add(emailVerificationTTL))
+	if err != nil {
+		return fmt.Errorf("failed to store verification token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", s.appURL, token)
+	return s.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your ZPlus email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below "+
+			"within %d hours:\n\n%s\n\nIf you did not create an account, you can ignore this email.\n",
+			user.Username, int(emailVerificationTTL.Hours()), link),
+	})
+}
+
+// ResendVerification sends a new verification link to the given user.
+// Requests are throttled per user, the returned error is a *ratelimit.LimitedError when too frequent.
+func (s *EmailVerificationService) ResendVerification(ctx context.Context, userID int) error {
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return apperr.New(http.StatusBadRequest, apperr.CodeAlreadyVerified, "Email already verified")
+	}
+
+	return s.SendVerification(ctx, user)
+}
+
+// VerifyEmail consumes a verification token and marks the owner's email as verified
+func (s *EmailVerificationService) VerifyEmail(ctx context.Context, token string) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		userID, err := tx.EmailVerifications().Consume(ctx, utils.HashToken(token))
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.New(http.StatusBadRequest, apperr.CodeInvalidToken, "Invalid or expired verification token").WithDetails("The verification link is invalid, expired or has already been used")
+		} else if err != nil {
+			return fmt.Errorf("failed to consume verification token: %w", err)
+		}
+
+		if err = tx.Users().SetEmailVerified(ctx, userID); err != nil {
+			return fmt.Errorf("failed to verify email: %w", err)
+		}
+
+		// Any other outstanding links for this user are now pointless
+		if err = tx.EmailVerifications().DeleteUnused(ctx, userID); err != nil {
+			return fmt.Errorf("failed to clear verification tokens: %w", err)
+		}
+		return nil
+	})
+}
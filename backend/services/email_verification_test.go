@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	env := handlertest.New(t)
+	user, err := env.Users.CreateUser(t.Context(), models.RegisterRequest{
+		Username: "jane",
+		Email:    "jane@example.com",
+		Password: handlertest.Password,
+		FullName: "Jane Doe",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := env.EmailVerifications.SendVerification(t.Context(), user); err != nil {
+		t.Fatal(err)
+	}
+	first := env.Mail.Token(t, user.Email)
+
+	// Resending right away is throttled
+	var limited *ratelimit.LimitedError
+	if err := env.EmailVerifications.ResendVerification(t.Context(), user.ID); !errors.As(err, &limited) {
+		t.Fatalf("resend in cooldown = %v", err)
+	}
+
+	if err := env.EmailVerifications.VerifyEmail(t.Context(), first); err != nil {
+		t.Fatalf("verify = %v", err)
+	}
+	verified, err := env.Users.GetUserByID(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified.EmailVerified {
+		t.Error("email is not verified")
+	}
+
+	if err := env.EmailVerifications.VerifyEmail(t.Context(), first); apperr.Code(err) != apperr.CodeInvalidToken {
+		t.Errorf("reused token = %v", err)
+	}
+	if err := env.EmailVerifications.ResendVerification(t.Context(), user.ID); apperr.Code(err) != "ALREADY_VERIFIED" {
+		t.Errorf("resend after verification = %v", err)
+	}
+}
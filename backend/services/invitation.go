@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/mailer"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+// defaultInvitationTTL is used when an invitation does not set its own expiry
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// InvitationService invites staff members by email. The invitee chooses their
+// own username and password when accepting, so admins never handle passwords.
+// errInvitationNotFound also covers invitations that were accepted or revoked
+var errInvitationNotFound = apperr.NotFound("Invitation not found").WithDetails("The invitation does not exist or is no longer pending")
+
+type InvitationService struct {
+	store  repository.Store
+	mailer mailer.Mailer
+	appURL string
+}
+
+func NewInvitationService(store repository.Store, m mailer.Mailer, appURL string) *InvitationService {
+	return &InvitationService{
+		store:  store,
+		mailer: m,
+		appURL: appURL,
+	}
+}
+
+// CreateInvitation stores an invitation and emails its acceptance link. An
+// error mentioning the email means the invitation exists but must be resent.
+func (s *InvitationService) CreateInvitation(ctx context.Context, req models.CreateInvitationRequest, actor models.AuditActor) (*models.Invitation, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	ttl := defaultInvitationTTL
+	if req.ExpiresInDays > 0 {
+		ttl = time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	}
+
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	var invitation *models.Invitation
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		registered, err := tx.Users().EmailTaken(ctx, email)
+		if err != nil {
+			return fmt.Errorf("failed to check existing user: %w", err)
+		}
+		if registered {
+			return apperr.Conflict("A user with this email already exists").WithDetails(email + " is already registered")
+		}
+
+		invitation, err = tx.Invitations().Create(ctx, repository.NewInvitation{
+			Email:     email,
+			Role:      req.Role,
+			FullName:  optionalString(req.FullName),
+			TokenHash: utils.HashToken(token),
+			InvitedBy: actor.UserID,
+			ExpiresAt: time.Now().Add(ttl),
+		})
+		if errors.Is(err, repository.ErrConflict) {
+			return apperr.Conflict("Invitation already exists").WithDetails("An invitation for " + email + " is pending, resend or revoke it")
+		} else if err != nil {
+			return fmt.Errorf("failed to create invitation: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditInvitationCreate, "invitation", strconv.Itoa(invitation.ID), nil, invitation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.send(invitation, token); err != nil {
+		return invitation, err
+	}
+
+	return invitation, nil
+}
+
+// GetPendingInvitations returns the invitations that were neither accepted
+// nor revoked, including expired ones that can still be resent
+func (s *InvitationService) GetPendingInvitations(ctx context.Context) ([]models.Invitation, error) {
+	invitations, err := s.store.Invitations().ListPending(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// ResendInvitation emails a new acceptance link for a pending invitation. The
+// previous link stops working and the expiry is extended by the default period.
+func (s *InvitationService) ResendInvitation(ctx context.Context, id int, actor models.AuditActor) (*models.Invitation, error) {
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	var invitation *models.Invitation
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		invitation, err = tx.Invitations().Renew(ctx, id, utils.HashToken(token), time.Now().Add(defaultInvitationTTL))
+		if errors.Is(err, repository.ErrNotFound) {
+			return errInvitationNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to resend invitation: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditInvitationResend, "invitation", strconv.Itoa(id), nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.send(invitation, token); err != nil {
+		return invitation, err
+	}
+
+	return invitation, nil
+}
+
+// RevokeInvitation cancels a pending invitation; its link stops working immediately
+func (s *InvitationService) RevokeInvitation(ctx context.Context, id int, actor models.AuditActor) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		email, err := tx.Invitations().Revoke(ctx, id)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errInvitationNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to revoke invitation: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditInvitationRevoke, "invitation", strconv.Itoa(id), nil, map[string]string{"email": email})
+	})
+}
+
+// AcceptInvitation consumes an invitation link and creates the invitee's
+// account with the invited role and the username and password they chose
+func (s *InvitationService) AcceptInvitation(ctx context.Context, req models.AcceptInvitationRequest, actor models.AuditActor) (*models.User, error) {
+	var user *models.User
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		invitation, err := tx.Invitations().Accept(ctx, utils.HashToken(req.Token))
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.New(http.StatusBadRequest, apperr.CodeInvalidToken, "Invalid or expired invitation")
+		} else if err != nil {
+			return fmt.Errorf("failed to accept invitation: %w", err)
+		}
+
+		fullName := invitation.FullName
+		if req.FullName != "" {
+			fullName = &req.FullName
+		}
+
+		// Rolling back keeps the link usable so the invitee can pick another password
+		if err = utils.ValidatePassword(req.Password, req.Username, invitation.Email); err != nil {
+			return weakPassword(err)
+		}
+		hashedPassword, err := utils.HashPassword(req.Password)
+		if err != nil {
+			return fmt.Errorf("failed to hash password: %w", err)
+		}
+
+		// Opening the emailed link proves the address, so it starts out verified
+		user, err = tx.Users().Create(ctx, repository.NewUser{
+			Username:      req.Username,
+			Email:         invitation.Email,
+			PasswordHash:  hashedPassword,
+			FullName:      fullName,
+			Role:          invitation.Role,
+			EmailVerified: true,
+		})
+		if errors.Is(err, repository.ErrConflict) {
+			return apperr.Conflict("Username or email is already taken")
+		} else if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+
+		if err = tx.Invitations().SetAcceptedUser(ctx, invitation.ID, user.ID); err != nil {
+			return fmt.Errorf("failed to accept invitation: %w", err)
+		}
+
+		actor.UserID, actor.Email = &user.ID, user.Email
+		if err = recordAudit(ctx, tx, actor, AuditInvitationAccept, "invitation", strconv.Itoa(invitation.ID), nil, nil); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, actor, AuditUserCreate, "user", strconv.Itoa(user.ID), nil, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *InvitationService) send(invitation *models.Invitation, token string) error {
+	link := fmt.Sprintf("%s/accept-invitation?token=%s", s.appURL, token)
+	err := s.mailer.Send(mailer.Message{
+		To:      invitation.Email,
+		Subject: "You have been invited to ZPlus",
+		Body: fmt.Sprintf("Hi,\n\nYou have been invited to join ZPlus as %s. Open the link below "+
+			"before %s to choose your username and password:\n\n%s\n\n"+
+			"If you were not expecting this invitation, you can ignore this email.\n",
+			invitation.Role, invitation.ExpiresAt.UTC().Format("2 Jan 2006 15:04 MST"), link),
+	})
+	if err != nil {
+		return apperr.New(http.StatusBadGateway, apperr.CodeEmailFailed, "Invitation saved but the email could not be sent, try resending it").Wrap(err)
+	}
+	return nil
+}
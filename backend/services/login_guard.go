@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/cache"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+)
+
+const (
+	// loginFailureWindow is how long failed attempts are remembered
+	loginFailureWindow = 15 * time.Minute
+	// loginDelayAfter failures in a row, each further attempt has to wait 1s, 2s, 4s... up to loginMaxDelay
+	loginDelayAfter = 3
+	loginMaxDelay   = 30 * time.Second
+
+	// accountLockThreshold failures lock the account for accountLockDuration,
+	// doubled for every further lockout within accountMaxLockDuration
+	accountLockThreshold   = 10
+	accountLockDuration    = 15 * time.Minute
+	accountMaxLockDuration = 24 * time.Hour
+
+	// ipLockThreshold failures from one address, across any accounts, lock the address
+	ipLockThreshold = 50
+	ipLockDuration  = 15 * time.Minute
+)
+
+// Lockout subjects
+const (
+	LockoutAccount = "account"
+	LockoutIP      = "ip"
+)
+
+// LoginGuard throttles password logins with per-account and per-IP failure
+// counters kept in the cache, so they are shared between instances through
+// Redis and still enforced in memory while Redis is down
+type LoginGuard struct {
+	store repository.Store
+	cache *cache.Cache
+}
+
+func NewLoginGuard(store repository.Store, cache *cache.Cache) *LoginGuard {
+	return &LoginGuard{
+		store: store,
+		cache: cache,
+	}
+}
+
+// Check returns a *ratelimit.LimitedError when the account or the client address
+// is locked, or when the account must wait before its next attempt
+func (g *LoginGuard) Check(ctx context.Context, email, ip string) error {
+	keys := []string{
+		lockKey(LockoutIP, ip),
+		lockKey(LockoutAccount, normalizeLoginEmail(email)),
+		delayKey(normalizeLoginEmail(email)),
+	}
+
+	for _, key := range keys {
+		if wait := g.remaining(ctx, key); wait > 0 {
+			return &ratelimit.LimitedError{RetryAfter: wait}
+		}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed login, applies the progressive delay and
+// locks the account or address once its threshold is reached
+func (g *LoginGuard) RecordFailure(ctx context.Context, email, ip string) {
+	email = normalizeLoginEmail(email)
+
+	failures := g.cache.Incr(ctx, failuresKey(LockoutAccount, email), loginFailureWindow)
+	switch {
+	case failures >= accountLockThreshold:
+		lockouts := g.cache.Incr(ctx, "login:lockouts:"+email, accountMaxLockDuration)
+		duration := accountLockDuration
+		for i := int64(1); i < lockouts && duration < accountMaxLockDuration; i++ {
+			duration *= 2
+		}
+		if duration > accountMaxLockDuration {
+			duration = accountMaxLockDuration
+		}
+		g.lock(ctx, LockoutAccount, email, duration, failures)
+
+	case failures >= loginDelayAfter:
+		delay := time.Second << uint(failures-loginDelayAfter)
+		if delay > loginMaxDelay || delay <= 0 {
+			delay = loginMaxDelay
+		}
+		g.cache.Set(ctx, delayKey(email), untilValue(delay), delay)
+	}
+
+	ipFailures := g.cache.Incr(ctx, failuresKey(LockoutIP, ip), loginFailureWindow)
+	if ipFailures >= ipLockThreshold {
+		g.lock(ctx, LockoutIP, ip, ipLockDuration, ipFailures)
+	}
+}
+
+// RecordSuccess clears the account's failure count after a successful login
+func (g *LoginGuard) RecordSuccess(ctx context.Context, email string) {
+	email = normalizeLoginEmail(email)
+	g.cache.Delete(ctx, failuresKey(LockoutAccount, email), delayKey(email))
+}
+
+// Unlock lifts a lockout before it expires and records who did it
+func (g *LoginGuard) Unlock(ctx context.Context, subjectType, subject string, actorID int) error {
+	if subjectType == LockoutAccount {
+		subject = normalizeLoginEmail(subject)
+	}
+
+	if g.remaining(ctx, lockKey(subjectType, subject)) <= 0 {
+		return apperr.NotFound("No active lockout").WithDetails(fmt.Sprintf("The %s is not locked", subjectType))
+	}
+
+	keys := []string{lockKey(subjectType, subject), failuresKey(subjectType, subject)}
+	if subjectType == LockoutAccount {
+		keys = append(keys, delayKey(subject), "login:lockouts:"+subject)
+	}
+	g.cache.Delete(ctx, keys...)
+
+	return g.recordEvent(ctx, models.LoginLockoutEvent{
+		SubjectType: subjectType,
+		Subject:     subject,
+		Event:       "unlocked",
+		ActorID:     &actorID,
+	})
+}
+
+// GetLockoutEvents returns the most recent lockout and unlock events
+func (g *LoginGuard) GetLockoutEvents(ctx context.Context, limit int) ([]models.LoginLockoutEvent, error) {
+	events, err := g.store.Lockouts().List(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lockout events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (g *LoginGuard) lock(ctx context.Context, subjectType, subject string, duration time.Duration, failures int64) {
+	g.cache.Set(ctx, lockKey(subjectType, subject), untilValue(duration), duration)
+	g.cache.Delete(ctx, failuresKey(subjectType, subject))
+
+	lockedUntil := time.Now().Add(duration)
+	err := g.recordEvent(ctx, models.LoginLockoutEvent{
+		SubjectType: subjectType,
+		Subject:     subject,
+		Event:       "locked",
+		Failures:    int(failures),
+		LockedUntil: &lockedUntil,
+	})
+	if err != nil {
+		log.Printf("Failed to record lockout of %s %s: %v", subjectType, subject, err)
+	}
+}
+
+func (g *LoginGuard) recordEvent(ctx context.Context, event models.LoginLockoutEvent) error {
+	if err := g.store.Lockouts().Record(ctx, event); err != nil {
+		return fmt.Errorf("failed to record lockout event: %w", err)
+	}
+	return nil
+}
+
+// remaining returns how long the deadline stored under key is still in the future
+func (g *LoginGuard) remaining(ctx context.Context, key string) time.Duration {
+	value, err := g.cache.Get(ctx, key)
+	if err != nil {
+		return 0
+	}
+	until, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Until(time.Unix(until, 0))
+}
+
+func untilValue(d time.Duration) string {
+	return strconv.FormatInt(time.Now().Add(d).Unix(), 10)
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func failuresKey(subjectType, subject string) string {
+	return "login:failures:" + subjectType + ":" + subject
+}
+
+func lockKey(subjectType, subject string) string {
+	return "login:lock:" + subjectType + ":" + subject
+}
+
+func delayKey(email string) string {
+	return "login:delay:" + email
+}
@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/services"
+)
+
+// wait returns how long Check makes the login wait, 0 when it may go ahead
+func wait(t *testing.T, guard *services.LoginGuard, email, ip string) time.Duration {
+	t.Helper()
+
+	err := guard.Check(t.Context(), email, ip)
+	if err == nil {
+		return 0
+	}
+	var limited *ratelimit.LimitedError
+	if !errors.As(err, &limited) {
+		t.Fatalf("Check = %v", err)
+	}
+	return limited.RetryAfter
+}
+
+// near reports whether got is within the second the deadline is stored with
+func near(got, want time.Duration) bool {
+	return got > want-time.Second && got <= want
+}
+
+func TestLoginDelaySchedule(t *testing.T) {
+	guard := handlertest.New(t).LoginGuard
+	const email, ip = "jane@example.com", "10.0.0.1"
+
+	delays := []time.Duration{
+		0, 0, // the first two failures are free
+		1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second,
+		30 * time.Second, 30 * time.Second, // capped at loginMaxDelay
+	}
+	for i, want := range delays {
+		guard.RecordFailure(t.Context(), email, ip)
+		got := wait(t, guard, email, ip)
+		if want == 0 && got != 0 || want != 0 && !near(got, want) {
+			t.Errorf("after %d failures wait %v, want %v", i+1, got, want)
+		}
+	}
+
+	// The delay is per account, and a success clears it
+	if got := wait(t, guard, "john@example.com", ip); got != 0 {
+		t.Errorf("other account waits %v", got)
+	}
+	guard.RecordSuccess(t.Context(), "Jane@Example.com ")
+	if got := wait(t, guard, email, ip); got != 0 {
+		t.Errorf("wait after a success = %v", got)
+	}
+}
+
+func TestAccountLockout(t *testing.T) {
+	env := handlertest.New(t)
+	guard := env.LoginGuard
+	const email, ip = "jane@example.com", "10.0.0.1"
+
+	// Every further lockout within a day doubles the lock
+	for _, want := range []time.Duration{15 * time.Minute, 30 * time.Minute, time.Hour} {
+		for i := 0; i < 10; i++ {
+			guard.RecordFailure(t.Context(), email, ip)
+		}
+		if got := wait(t, guard, email, ip); !near(got, want) {
+			t.Errorf("lockout waits %v, want %v", got, want)
+		}
+		// Let the lock run out
+		env.Cache.Delete(t.Context(), "login:lock:account:"+email)
+	}
+
+	// An admin unlock starts the schedule over
+	for i := 0; i < 10; i++ {
+		guard.RecordFailure(t.Context(), email, ip)
+	}
+	if err := guard.Unlock(t.Context(), services.LockoutAccount, "JANE@example.com", 1); err != nil {
+		t.Fatalf("unlock = %v", err)
+	}
+	if got := wait(t, guard, email, ip); got != 0 {
+		t.Fatalf("wait after unlock = %v", got)
+	}
+	for i := 0; i < 10; i++ {
+		guard.RecordFailure(t.Context(), email, ip)
+	}
+	if got := wait(t, guard, email, ip); !near(got, 15*time.Minute) {
+		t.Errorf("lockout after an unlock waits %v", got)
+	}
+
+	events, err := guard.GetLockoutEvents(t.Context(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var locks, unlocks int
+	for _, event := range events {
+		switch {
+		case event.SubjectType != services.LockoutAccount:
+		case event.Event == "locked":
+			locks++
+		case event.Event == "unlocked":
+			unlocks++
+		}
+	}
+	if locks != 5 || unlocks != 1 {
+		t.Errorf("recorded %d locks and %d unlocks of the account, want 5 and 1", locks, unlocks)
+	}
+	if err := guard.Unlock(t.Context(), services.LockoutAccount, "john@example.com", 1); err == nil {
+		t.Error("unlocked an account that is not locked")
+	}
+}
+
+func TestIPLockout(t *testing.T) {
+	guard := handlertest.New(t).LoginGuard
+	const ip = "10.0.0.1"
+
+	// Spread over many accounts so no single account locks first
+	for i := 0; i < 50; i++ {
+		guard.RecordFailure(t.Context(), string(rune('a'+i%25))+"@example.com", ip)
+	}
+	if got := wait(t, guard, "new@example.com", ip); !near(got, 15*time.Minute) {
+		t.Errorf("locked address waits %v", got)
+	}
+	if got := wait(t, guard, "new@example.com", "10.0.0.2"); got != 0 {
+		t.Errorf("other address waits %v", got)
+	}
+}
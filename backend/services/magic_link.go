@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/mailer"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+const magicLinkTTL = 15 * time.Minute
+
+// MagicLinkService implements passwordless login with single-use links sent by
+// email. A link only works together with the nonce handed to the browser that
+// requested it, so a leaked or forwarded email cannot be used on another device.
+type MagicLinkService struct {
+	store       repository.Store
+	userService *UserService
+	mailer      mailer.Mailer
+	limiter     *ratelimit.Limiter
+	appURL      string
+}
+
+func NewMagicLinkService(store repository.Store, userService *UserService, m mailer.Mailer, limiter *ratelimit.Limiter, appURL string) *MagicLinkService {
+	return &MagicLinkService{
+		store:       store,
+		userService: userService,
+		mailer:      m,
+		limiter:     limiter,
+		appURL:      appURL,
+	}
+}
+
+// RequestLink emails a login link to the user if the address belongs to an
+// active account, and returns the nonce the requesting browser must keep.
+// A nonce is returned for unknown addresses and throttled requests too so
+// callers cannot probe which emails are registered.
+func (s *MagicLinkService) RequestLink(ctx context.Context, email string) (string, error) {
+	nonce, err := utils.GenerateSecureToken(16)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	if s.limiter.Allow(ctx, "magic:"+strings.ToLower(email)) != nil {
+		return nonce, nil
+	}
+
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if err != nil || !user.IsActive {
+		return nonce, nil
+	}
+
+	go s.sendLink(context.WithoutCancel(ctx), user, nonce)
+	return nonce, nil
+}
+
+// sendLink creates a login token bound to nonce and emails the link. It runs
+// after the request has been answered, so registered addresses take no
+// longer to answer than unknown ones, and failures are only logged.
+func (s *MagicLinkService) sendLink(ctx context.Context, user *models.User, nonce string) {
+	token, err := s.createToken(ctx, user.ID, nonce)
+	if err != nil {
+		log.Printf("Failed to create login link for user %d: %v", user.ID, err)
+		return
+	}
+
+	link := fmt.Sprintf("%s/magic-login?token=%s", s.appURL, token)
+	err = s.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Your ZPlus login link",
+		Body: fmt.Sprintf("Hi %s,\n\nOpen the link below within %d minutes, in the same browser "+
+			"where you asked for it, to log in to ZPlus:\n\n%s\n\n"+
+			"The link can be used once. If you did not request it, you can ignore this email.\n",
+			user.Username, int(magicLinkTTL.Minutes()), link),
+	})
+	if err != nil {
+		log.Printf("Failed to send login link to user %d: %v", user.ID, err)
+	}
+}
+
+// Login consumes a link token presented with the nonce of the browser that
+// requested it and returns the user to start a session for. Following the
+// link proves control of the mailbox, so the email is marked as verified.
+func (s *MagicLinkService) Login(ctx context.Context, token, nonce string) (*models.User, error) {
+	var userID int
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		var err error
+		userID, err = tx.MagicLinks().Consume(ctx, utils.HashToken(token), utils.HashToken(nonce))
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.New(http.StatusBadRequest, apperr.CodeInvalidToken, "Invalid or expired login link").WithDetails("The login link is invalid, expired or was opened in another browser")
+		} else if err != nil {
+			return fmt.Errorf("failed to consume login link: %w", err)
+		}
+
+		if err = tx.Users().SetEmailVerified(ctx, userID); err != nil {
+			return fmt.Errorf("failed to verify email: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, errAccountDeactivated
+	}
+
+	return user, nil
+}
+
+// createToken stores the hashes of a fresh token and its nonce and drops any
+// older unused links of the user
+func (s *MagicLinkService) createToken(ctx context.Context, userID int, nonce string) (string, error) {
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate login token: %w", err)
+	}
+
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := tx.MagicLinks().DeleteUnused(ctx, userID); err != nil {
+			return fmt.Errorf("failed to clear previous login links: %w", err)
+		}
+
+		err := tx.MagicLinks().Create(ctx, userID, utils.HashToken(token), utils.HashToken(nonce), time.Now().Add(magicLinkTTL))
+		if err != nil {
+			return fmt.Errorf("failed to store login token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+const recoveryCodeCount = 10
+
+// recoveryCodeAlphabet has 32 symbols without look-alikes (0/o, 1/l) so a random byte maps without bias
+const recoveryCodeAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+
+var (
+	errInvalidMFACode    = apperr.Unauthorized(apperr.CodeMFAInvalid, "Invalid two-factor code")
+	errMFAAlreadyEnabled = apperr.New(http.StatusBadRequest, apperr.CodeMFAAlreadyEnabled, "Two-factor authentication already enabled")
+	errMFANotEnabled     = apperr.New(http.StatusBadRequest, apperr.CodeMFANotEnabled, "Two-factor authentication not set up")
+)
+
+type MFAService struct {
+	store           repository.Store
+	settingsService *SettingsService
+	roleService     *RoleService
+	limiter         *ratelimit.Limiter
+	issuer          string
+}
+
+func NewMFAService(store repository.Store, settingsService *SettingsService, roleService *RoleService, limiter *ratelimit.Limiter, issuer string) *MFAService {
+	return &MFAService{
+		store:           store,
+		settingsService: settingsService,
+		roleService:     roleService,
+		limiter:         limiter,
+		issuer:          issuer,
+	}
+}
+
+// Status reports whether the user has two-factor authentication enabled and
+// whether a login must go through the second step, which is also the case for
+// staff without 2FA when the require_admin_2fa setting is on. Staff are the
+// roles with any admin permission, not just the admin role.
+func (s *MFAService) Status(ctx context.Context, user *models.User) (*models.MFAStatus, error) {
+	status := &models.MFAStatus{}
+	var err error
+	status.Enabled, status.RecoveryCodesRemaining, err = s.store.MFA().Status(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor status: %w", err)
+	}
+
+	permissions, err := s.roleService.RolePermissions(ctx, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	if len(permissions) > 0 {
+		settings, err := s.settingsService.GetSecuritySettings(ctx)
+		if err != nil {
+			return nil, err
+		}
+		status.Enforced = settings.RequireAdmin2FA
+	}
+
+	status.Required = status.Enabled || status.Enforced
+	return status, nil
+}
+
+// BeginEnrollment generates a new TOTP secret for the user. The secret is not
+// used for logins until ConfirmEnrollment proves the authenticator app has it.
+func (s *MFAService) BeginEnrollment(ctx context.Context, user *models.User) (*models.MFAEnrollment, error) {
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	err = s.store.MFA().SetPendingSecret(ctx, user.ID, secret)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, errMFAAlreadyEnabled
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to start enrollment: %w", err)
+	}
+
+	return &models.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication once the user enters a
+// valid code for the pending secret, and returns the initial recovery codes
+func (s *MFAService) ConfirmEnrollment(ctx context.Context, userID int, code string) ([]string, error) {
+	if err := s.limiter.Allow(ctx, "mfa:"+strconv.Itoa(userID)); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		settings, err := tx.MFA().GetForUpdate(ctx, userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return errMFANotEnabled.WithDetails("Two-factor enrollment has not been started")
+		} else if err != nil {
+			return fmt.Errorf("failed to get two-factor settings: %w", err)
+		}
+
+		if settings.Enabled {
+			return errMFAAlreadyEnabled
+		}
+
+		step, ok := utils.ValidateTOTP(settings.Secret, normalizeMFACode(code), time.Now())
+		if !ok {
+			return errInvalidMFACode
+		}
+
+		if err = tx.MFA().Enable(ctx, userID, step); err != nil {
+			return fmt.Errorf("failed to enable two-factor authentication: %w", err)
+		}
+
+		codes, err = replaceRecoveryCodes(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Verify checks a TOTP code or consumes a recovery code for a user with 2FA enabled.
+// A TOTP code is accepted once; attempts are throttled per user.
+func (s *MFAService) Verify(ctx context.Context, userID int, code string) error {
+	if err := s.limiter.Allow(ctx, "mfa:"+strconv.Itoa(userID)); err != nil {
+		return err
+	}
+
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		return verifyCode(ctx, tx, userID, normalizeMFACode(code))
+	})
+}
+
+// Disable turns two-factor authentication off after checking a current code
+func (s *MFAService) Disable(ctx context.Context, user *models.User, code string) error {
+	status, err := s.Status(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if !status.Enabled {
+		return errMFANotEnabled
+	}
+	if status.Enforced {
+		return apperr.New(http.StatusForbidden, apperr.CodeMFARequired, "Two-factor authentication cannot be disabled").WithDetails("Two-factor authentication is required for this account")
+	}
+
+	if err = s.Verify(ctx, user.ID, code); err != nil {
+		return err
+	}
+
+	return s.Reset(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes after checking a current code
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error) {
+	if err := s.limiter.Allow(ctx, "mfa:"+strconv.Itoa(userID)); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := verifyCode(ctx, tx, userID, normalizeMFACode(code)); err != nil {
+			return err
+		}
+
+		var err error
+		codes, err = replaceRecoveryCodes(ctx, tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Reset removes the user's second factor and recovery codes, e.g. when an admin
+// helps a user who lost their device
+func (s *MFAService) Reset(ctx context.Context, userID int) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := tx.MFA().Delete(ctx, userID); err != nil {
+			return fmt.Errorf("failed to disable two-factor authentication: %w", err)
+		}
+		return nil
+	})
+}
+
+// verifyCode accepts either a TOTP code newer than the last accepted one or an unused recovery code
+func verifyCode(ctx context.Context, tx repository.Store, userID int, code string) error {
+	settings, err := tx.MFA().GetForUpdate(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) || err == nil && !settings.Enabled {
+		return errMFANotEnabled
+	} else if err != nil {
+		return fmt.Errorf("failed to get two-factor settings: %w", err)
+	}
+
+	if step, ok := utils.ValidateTOTP(settings.Secret, code, time.Now()); ok {
+		if step <= settings.LastUsedStep {
+			return errInvalidMFACode
+		}
+		if err = tx.MFA().SetLastUsedStep(ctx, userID, step); err != nil {
+			return fmt.Errorf("failed to update two-factor settings: %w", err)
+		}
+		return nil
+	}
+
+	err = tx.MFA().UseRecoveryCode(ctx, userID, utils.HashToken(code))
+	if errors.Is(err, repository.ErrNotFound) {
+		return errInvalidMFACode
+	} else if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	return nil
+}
+
+// replaceRecoveryCodes deletes the user's recovery codes and stores hashes of fresh ones
+func replaceRecoveryCodes(ctx context.Context, tx repository.Store, userID int) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		codes[i] = code
+		hashes[i] = utils.HashToken(normalizeMFACode(code))
+	}
+
+	if err := tx.MFA().ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to store recovery codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode returns a code formatted as xxxxx-xxxxx
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = recoveryCodeAlphabet[int(b[i])%len(recoveryCodeAlphabet)]
+	}
+	return string(b[:5]) + "-" + string(b[5:]), nil
+}
+
+// normalizeMFACode ignores case, spaces and dashes so codes can be typed as displayed
+func normalizeMFACode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/utils"
+)
+
+// totpCode returns the code for the time step offset from now
+func totpCode(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(time.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// enroll turns on 2FA for the user and returns the secret and recovery codes
+func enroll(t *testing.T, env *handlertest.Env, user *models.User) (string, []string) {
+	t.Helper()
+
+	enrollment, err := env.MFA.BeginEnrollment(t.Context(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := env.MFA.ConfirmEnrollment(t.Context(), user.ID, totpCode(t, enrollment.Secret, -1))
+	if err != nil {
+		t.Fatalf("confirm enrollment = %v", err)
+	}
+	return enrollment.Secret, codes
+}
+
+func TestMFAVerify(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	secret, _ := enroll(t, env, user)
+
+	status, err := env.MFA.Status(t.Context(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Enabled || !status.Required {
+		t.Errorf("status = %+v", status)
+	}
+
+	// The code that confirmed the enrollment and any code after an accepted one cannot be replayed
+	if err := env.MFA.Verify(t.Context(), user.ID, totpCode(t, secret, -1)); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("confirmation code replayed = %v", err)
+	}
+	if err := env.MFA.Verify(t.Context(), user.ID, totpCode(t, secret, 0)); err != nil {
+		t.Fatalf("current code = %v", err)
+	}
+	if err := env.MFA.Verify(t.Context(), user.ID, totpCode(t, secret, 0)); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("current code replayed = %v", err)
+	}
+	if err := env.MFA.Verify(t.Context(), user.ID, "000000"); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("wrong code = %v", err)
+	}
+
+	// Five attempts within the window are allowed, then every attempt is refused
+	var limited *ratelimit.LimitedError
+	if err := env.MFA.Verify(t.Context(), user.ID, totpCode(t, secret, 1)); !errors.As(err, &limited) {
+		t.Errorf("sixth attempt = %v", err)
+	}
+}
+
+func TestMFARecoveryCodes(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	_, codes := enroll(t, env, user)
+	if len(codes) == 0 {
+		t.Fatal("no recovery codes")
+	}
+
+	// Codes are accepted as displayed or typed without the dash, once
+	typed := strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))
+	if err := env.MFA.Verify(t.Context(), user.ID, typed); err != nil {
+		t.Fatalf("recovery code = %v", err)
+	}
+	if err := env.MFA.Verify(t.Context(), user.ID, codes[0]); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("used recovery code = %v", err)
+	}
+
+	regenerated, err := env.MFA.RegenerateRecoveryCodes(t.Context(), user.ID, codes[1])
+	if err != nil {
+		t.Fatalf("regenerate = %v", err)
+	}
+	if len(regenerated) != len(codes) {
+		t.Errorf("regenerated %d codes, want %d", len(regenerated), len(codes))
+	}
+	if err := env.MFA.Verify(t.Context(), user.ID, codes[2]); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("replaced recovery code = %v", err)
+	}
+}
+
+func TestMFAEnrollment(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+
+	if _, err := env.MFA.ConfirmEnrollment(t.Context(), user.ID, "123456"); apperr.Code(err) != "MFA_NOT_ENABLED" {
+		t.Errorf("confirm without enrollment = %v", err)
+	}
+
+	enrollment, err := env.MFA.BeginEnrollment(t.Context(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(enrollment.ProvisioningURI, "otpauth://totp/") {
+		t.Errorf("provisioning URI = %q", enrollment.ProvisioningURI)
+	}
+	if _, err := env.MFA.ConfirmEnrollment(t.Context(), user.ID, "000000"); apperr.Code(err) != "MFA_INVALID" {
+		t.Errorf("confirm with a wrong code = %v", err)
+	}
+	status, err := env.MFA.Status(t.Context(), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Enabled {
+		t.Error("2FA enabled by a wrong code")
+	}
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/cache"
+	"zplus_web/backend/models"
+	"zplus_web/backend/oauth"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+// oauthStateTTL is how long a user has to finish signing in at the provider
+const oauthStateTTL = 10 * time.Minute
+
+var errInvalidLoginState = apperr.New(http.StatusBadRequest, apperr.CodeInvalidState, "Login session expired, please try again")
+
+// providerError reports a login provider that failed or answered with something unusable
+func providerError(err error) error {
+	return apperr.New(http.StatusBadGateway, apperr.CodeProviderError, "Login provider error").Wrap(err)
+}
+
+func unknownProvider(name string) error {
+	return apperr.NotFound("Login provider not found").WithDetails(fmt.Sprintf("Unknown login provider %q", name))
+}
+
+type OAuthService struct {
+	store       repository.Store
+	userService *UserService
+	registry    *oauth.Registry
+	cache       *cache.Cache
+	appURL      string
+}
+
+func NewOAuthService(store repository.Store, userService *UserService, registry *oauth.Registry, cache *cache.Cache, appURL string) *OAuthService {
+	return &OAuthService{
+		store:       store,
+		userService: userService,
+		registry:    registry,
+		cache:       cache,
+		appURL:      appURL,
+	}
+}
+
+// Providers returns the names of the configured login providers
+func (s *OAuthService) Providers() []string {
+	return s.registry.Names()
+}
+
+// AuthorizationURL starts a login: it remembers a one-time state with the
+// PKCE verifier and returns the provider URL to send the user to
+func (s *OAuthService) AuthorizationURL(ctx context.Context, providerName string) (string, error) {
+	provider, ok := s.registry.Get(providerName)
+	if !ok {
+		return "", unknownProvider(providerName)
+	}
+
+	state, err := utils.GenerateSecureToken(24)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	verifier, err := oauth.GenerateVerifier()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, oauth.CodeChallenge(verifier), s.redirectURI(providerName))
+	if err != nil {
+		return "", providerError(err)
+	}
+
+	s.cache.Set(ctx, oauthStateKey(state), providerName+" "+verifier, oauthStateTTL)
+	return authURL, nil
+}
+
+// CompleteLogin exchanges the authorization code returned to the frontend and
+// returns the user linked to the provider identity. Unknown identities are
+// linked to the account with the same email when both sides have verified it,
+// otherwise a new account is created.
+func (s *OAuthService) CompleteLogin(ctx context.Context, providerName, code, state string) (*models.User, error) {
+	stored, err := s.cache.Get(ctx, oauthStateKey(state))
+	if err != nil {
+		return nil, errInvalidLoginState
+	}
+	s.cache.Delete(ctx, oauthStateKey(state))
+
+	parts := strings.SplitN(stored, " ", 2)
+	if len(parts) != 2 || parts[0] != providerName {
+		return nil, errInvalidLoginState
+	}
+
+	provider, ok := s.registry.Get(providerName)
+	if !ok {
+		return nil, unknownProvider(providerName)
+	}
+
+	accessToken, err := provider.Exchange(ctx, code, parts[1], s.redirectURI(providerName))
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	profile, err := provider.FetchProfile(ctx, accessToken)
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	user, err := s.resolveUser(ctx, providerName, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, errAccountDeactivated
+	}
+
+	return user, nil
+}
+
+// GetUserIdentities returns the provider identities linked to a user
+func (s *OAuthService) GetUserIdentities(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	identities, err := s.store.Identities().ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+func (s *OAuthService) resolveUser(ctx context.Context, providerName string, profile *oauth.Profile) (*models.User, error) {
+	userID, err := s.store.Identities().TouchLogin(ctx, providerName, profile.Subject, profile.Email)
+	if err == nil {
+		return s.userService.GetUserByID(ctx, userID)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+
+	if profile.Email == "" {
+		return nil, providerError(errors.New("login provider did not return an email address"))
+	}
+
+	user, err := s.userService.GetUserByEmail(ctx, profile.Email)
+	switch {
+	case err == nil:
+		// Linking on an unverified address on either side would let whoever
+		// registered the address first take over the other account
+		if !profile.EmailVerified || !user.EmailVerified {
+			return nil, apperr.New(http.StatusConflict, apperr.CodeAccountExists, "An account with this email already exists").
+				WithDetails("Log in with your password and verify your email first")
+		}
+	case apperr.IsNotFound(err):
+		user, err = s.userService.CreateExternalUser(ctx, profile.Email, profile.Name, profile.AvatarURL, profile.EmailVerified)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err = s.store.Identities().Create(ctx, user.ID, providerName, profile.Subject, profile.Email); err != nil {
+		return nil, fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	return user, nil
+}
+
+// redirectURI is the frontend page the provider sends the user back to; it
+// posts the code and state to the callback endpoint
+func (s *OAuthService) redirectURI(providerName string) string {
+	return s.appURL + "/auth/callback/" + providerName
+}
+
+func oauthStateKey(state string) string {
+	return "oauth:state:" + state
+}
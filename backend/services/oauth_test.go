@@ -0,0 +1,126 @@
+package services_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/config"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/oauth"
+)
+
+// oauthEnv has the providers acme and other, both served by one fake that
+// checks the PKCE verifier of every code exchange against the challenge of
+// the login the code was issued for, and returns the profile of the code
+type oauthEnv struct {
+	*handlertest.Env
+	challenges map[string]string
+}
+
+func newOAuthEnv(t *testing.T, profiles map[string]map[string]interface{}) *oauthEnv {
+	challenges := map[string]string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		code := r.FormValue("code")
+		if _, ok := profiles[code]; !ok || oauth.CodeChallenge(r.FormValue("code_verifier")) != challenges[code] {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token-" + code})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(profiles[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-")])
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var providers []config.OAuthProvider
+	for _, name := range []string{"acme", "other"} {
+		providers = append(providers, config.OAuthProvider{
+			Name:        name,
+			ClientID:    "client",
+			AuthURL:     server.URL + "/authorize",
+			TokenURL:    server.URL + "/token",
+			UserInfoURL: server.URL + "/userinfo",
+		})
+	}
+	return &oauthEnv{Env: handlertest.New(t, providers...), challenges: challenges}
+}
+
+// authorize starts a login at provider that will be answered with code and returns its state
+func (env *oauthEnv) authorize(t *testing.T, provider, code string) string {
+	t.Helper()
+
+	authURL, err := env.OAuth.AuthorizationURL(t.Context(), provider)
+	if err != nil {
+		t.Fatalf("authorization URL = %v", err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env.challenges[code] = u.Query().Get("code_challenge")
+	return u.Query().Get("state")
+}
+
+func TestOAuthCompleteLogin(t *testing.T) {
+	env := newOAuthEnv(t, map[string]map[string]interface{}{
+		"first":  {"sub": "42", "email": "jane@example.com", "email_verified": true, "name": "Jane Doe"},
+		"second": {"sub": "42", "email": "jane@new.example.com", "email_verified": true},
+	})
+
+	user, err := env.OAuth.CompleteLogin(t.Context(), "acme", "first", env.authorize(t, "acme", "first"))
+	if err != nil {
+		t.Fatalf("first login = %v", err)
+	}
+	if user.Email != "jane@example.com" || !user.EmailVerified || user.FullName == nil || *user.FullName != "Jane Doe" {
+		t.Errorf("created user = %+v", user)
+	}
+
+	// The identity is found by its subject even after the email changed at the provider
+	again, err := env.OAuth.CompleteLogin(t.Context(), "acme", "second", env.authorize(t, "acme", "second"))
+	if err != nil {
+		t.Fatalf("second login = %v", err)
+	}
+	if again.ID != user.ID {
+		t.Errorf("second login signed in user %d, want %d", again.ID, user.ID)
+	}
+	identities, err := env.OAuth.GetUserIdentities(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(identities) != 1 || identities[0].Provider != "acme" {
+		t.Errorf("identities = %+v", identities)
+	}
+}
+
+func TestOAuthState(t *testing.T) {
+	env := newOAuthEnv(t, map[string]map[string]interface{}{
+		"code": {"sub": "42", "email": "jane@example.com", "email_verified": true},
+	})
+
+	// A state only works once and only for the provider it was made for
+	state := env.authorize(t, "acme", "code")
+	if _, err := env.OAuth.CompleteLogin(t.Context(), "other", "code", state); apperr.Code(err) != "INVALID_STATE" {
+		t.Errorf("state of another provider = %v", err)
+	}
+	if _, err := env.OAuth.CompleteLogin(t.Context(), "acme", "code", state); apperr.Code(err) != "INVALID_STATE" {
+		t.Errorf("state after a failed login = %v", err)
+	}
+
+	// The code is bound to the verifier of the login that asked for it
+	env.authorize(t, "acme", "code")
+	if _, err := env.OAuth.CompleteLogin(t.Context(), "acme", "code", env.authorize(t, "acme", "other-code")); apperr.Code(err) != "PROVIDER_ERROR" {
+		t.Errorf("code of another login = %v", err)
+	}
+
+	if _, err := env.OAuth.AuthorizationURL(t.Context(), "nope"); !apperr.IsNotFound(err) {
+		t.Errorf("unknown provider = %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type OrderService struct {
+	store repository.Store
+}
+
+func NewOrderService(store repository.Store) *OrderService {
+	return &OrderService{store: store}
+}
+
+// GetOrders retrieves a page of orders, newest first
+func (s *OrderService) GetOrders(ctx context.Context, page, limit int, filter repository.OrderFilter) ([]models.Order, int, error) {
+	offset := (page - 1) * limit
+
+	orders, total, err := s.store.Orders().List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get orders: %w", err)
+	}
+
+	return orders, total, nil
+}
+
+// GetOrder retrieves a single order by ID
+func (s *OrderService) GetOrder(ctx context.Context, id int) (*models.Order, error) {
+	order, err := s.store.Orders().GetByID(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apperr.NotFound("Order not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	return order, nil
+}
+
+// GetItemsByOrderIDs retrieves the items of several orders in one query, keyed by order ID
+func (s *OrderService) GetItemsByOrderIDs(ctx context.Context, orderIDs []int) (map[int][]models.OrderItem, error) {
+	items, err := s.store.Orders().ItemsByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order items: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/mailer"
+	"zplus_web/backend/models"
+	"zplus_web/backend/ratelimit"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+const passwordResetTTL = time.Hour
+
+type PasswordResetService struct {
+	store          repository.Store
+	userService    *UserService
+	sessionService *SessionService
+	mailer         mailer.Mailer
+	limiter        *ratelimit.Limiter
+	appURL         string
+}
+
+func NewPasswordResetService(store repository.Store, userService *UserService, sessionService *SessionService, m mailer.Mailer, limiter *ratelimit.Limiter, appURL string) *PasswordResetService {
+	return &PasswordResetService{
+		store:          store,
+		userService:    userService,
+		sessionService: sessionService,
+		mailer:         m,
+		limiter:        limiter,
+		appURL:         appURL,
+	}
+}
+
+// RequestReset emails a reset link to the user if the address belongs to an active account.
+// Unknown addresses and throttled requests are ignored so callers cannot probe which emails are registered.
+// The link is created and sent in the background, so the response takes as long either way.
+func (s *PasswordResetService) RequestReset(ctx context.Context, email string) error {
+	if s.limiter.Allow(ctx, "reset:"+strings.ToLower(email)) != nil {
+		return nil
+	}
+
+	user, err := s.userService.GetUserByEmail(ctx, email)
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	go s.sendReset(context.WithoutCancel(ctx), user)
+	return nil
+}
+
+// sendReset creates a reset token for the user and emails the link. Failures
+// are only logged since the request has already been answered.
+func (s *PasswordResetService) sendReset(ctx context.Context, user *models.User) {
+	token, err := s.createToken(ctx, user.ID)
+	if err != nil {
+		log.Printf("Failed to create password reset token for user %d: %v", user.ID, err)
+		return
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.appURL, token)
+	err = s.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your ZPlus password",
+		Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. "+
+			"Open the link below within %d minutes to choose a new one:\n\n%s\n\n"+
+			"If you did not request this, you can ignore this email.\n",
+			user.Username, int(passwordResetTTL.Minutes()), link),
+	})
+	if err != nil {
+		log.Printf("Failed to send password reset email to user %d: %v", user.ID, err)
+	}
+}
+
+// ResetPassword consumes a reset token and sets the new password in one
+// transaction, so a rejected password or failed update leaves the link usable.
+// All existing sessions of the user are invalidated once it is committed.
+func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPassword string, actor models.AuditActor) error {
+	var userID int
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		var err error
+		userID, err = consumeResetToken(ctx, tx, token)
+		if err != nil {
+			return err
+		}
+
+		if err = s.userService.setPassword(ctx, tx, userID, newPassword); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actor, AuditUserPasswordReset, "user", strconv.Itoa(userID), nil, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sessionService.InvalidateUserSessions(ctx, userID)
+}
+
+// createToken stores the hash of a fresh token and drops any older unused tokens of the user
+func (s *PasswordResetService) createToken(ctx context.Context, userID int) (string, error) {
+	token, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := tx.PasswordResets().DeleteUnused(ctx, userID); err != nil {
+			return fmt.Errorf("failed to clear previous reset tokens: %w", err)
+		}
+
+		if err := tx.PasswordResets().Create(ctx, userID, utils.HashToken(token), time.Now().Add(passwordResetTTL)); err != nil {
+			return fmt.Errorf("failed to store reset token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// consumeResetToken marks a valid token as used and returns its owner
+func consumeResetToken(ctx context.Context, tx repository.Store, token string) (int, error) {
+	userID, err := tx.PasswordResets().Consume(ctx, utils.HashToken(token))
+	if errors.Is(err, repository.ErrNotFound) {
+		return 0, apperr.New(http.StatusBadRequest, apperr.CodeInvalidToken, "Invalid or expired reset token").WithDetails("The reset link is invalid, expired or has already been used")
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to consume reset token: %w", err)
+	}
+
+	return userID, nil
+}
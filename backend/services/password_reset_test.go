@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+)
+
+func TestResetPassword(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	tokens, err := env.Sessions.StartSession(t.Context(), user, "test", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := env.PasswordResets.RequestReset(t.Context(), "nobody@example.com"); err != nil {
+		t.Fatalf("unknown email: %v", err)
+	}
+	if err := env.PasswordResets.RequestReset(t.Context(), user.Email); err != nil {
+		t.Fatal(err)
+	}
+	token := env.Mail.Token(t, user.Email)
+	if messages := env.Mail.Messages("nobody@example.com"); len(messages) != 0 {
+		t.Errorf("sent %d emails to an unknown address", len(messages))
+	}
+
+	// A rejected password rolls back the use of the token
+	err = env.PasswordResets.ResetPassword(t.Context(), token, "short", handlertest.Actor(user))
+	if apperr.Code(err) != apperr.CodeValidation {
+		t.Fatalf("weak password = %v", err)
+	}
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, handlertest.Password); err != nil {
+		t.Errorf("old password after a failed reset: %v", err)
+	}
+
+	const newPassword = "Correct-h0rse-battery"
+	if err := env.PasswordResets.ResetPassword(t.Context(), token, newPassword, handlertest.Actor(user)); err != nil {
+		t.Fatalf("reset = %v", err)
+	}
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, newPassword); err != nil {
+		t.Errorf("new password: %v", err)
+	}
+	if _, err := env.Sessions.Refresh(t.Context(), tokens.RefreshToken); err == nil {
+		t.Error("session survived the reset")
+	}
+
+	err = env.PasswordResets.ResetPassword(t.Context(), token, "An0ther-passw0rd!", handlertest.Actor(user))
+	if apperr.Code(err) != apperr.CodeInvalidToken {
+		t.Errorf("reused token = %v", err)
+	}
+}
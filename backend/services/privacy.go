@@ -0,0 +1,276 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+// dataExportTTL is how long a finished export can be downloaded
+const dataExportTTL = 7 * 24 * time.Hour
+
+// PrivacyService implements the data subject rights of GDPR and Vietnam's
+// PDPD: exporting everything stored about a user and erasing their account.
+type PrivacyService struct {
+	store          repository.Store
+	sessionService *SessionService
+}
+
+func NewPrivacyService(store repository.Store, sessionService *SessionService) *PrivacyService {
+	return &PrivacyService{
+		store:          store,
+		sessionService: sessionService,
+	}
+}
+
+// RequestExport queues an export of the user's data. The ZIP archive is built
+// in the background; poll GetExports until it is completed.
+func (s *PrivacyService) RequestExport(ctx context.Context, userID int) (*models.DataExport, error) {
+	// Drop archives nobody can download anymore
+	if err := s.store.Privacy().DropExpiredArchives(ctx); err != nil {
+		log.Printf("Failed to clean up expired data exports: %v", err)
+	}
+
+	export, err := s.store.Privacy().CreateExport(ctx, userID)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, apperr.Conflict("An export is already in progress")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to create export: %w", err)
+	}
+
+	// The export outlives the request that asked for it
+	go s.runExport(context.WithoutCancel(ctx), export.ID, userID)
+
+	return export, nil
+}
+
+// GetExports returns the user's exports, newest first
+func (s *PrivacyService) GetExports(ctx context.Context, userID int) ([]models.DataExport, error) {
+	exports, err := s.store.Privacy().ListExports(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+var errExportNotAvailable = apperr.New(http.StatusConflict, apperr.CodeExportNotAvailable, "Export not available")
+
+// GetExportArchive returns the ZIP archive of one of the user's completed exports
+func (s *PrivacyService) GetExportArchive(ctx context.Context, userID, exportID int) ([]byte, error) {
+	export, archive, err := s.store.Privacy().GetExportArchive(ctx, userID, exportID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apperr.NotFound("Export not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+
+	switch {
+	case export.Status != "completed":
+		return nil, errExportNotAvailable.WithDetails("The export is not ready, its status is " + export.Status)
+	case export.ExpiresAt != nil && export.ExpiresAt.Before(time.Now()), archive == nil:
+		return nil, errExportNotAvailable.WithDetails("The export has expired, request a new one")
+	}
+
+	return archive, nil
+}
+
+// FailInterruptedExports marks exports that were being built when the server
+// stopped as failed, so their users can request a new one
+func (s *PrivacyService) FailInterruptedExports(ctx context.Context) error {
+	if err := s.store.Privacy().FailProcessingExports(ctx); err != nil {
+		return fmt.Errorf("failed to update exports: %w", err)
+	}
+	return nil
+}
+
+func (s *PrivacyService) runExport(ctx context.Context, exportID, userID int) {
+	archive, err := s.buildExport(ctx, userID)
+	if err != nil {
+		log.Printf("Data export %d for user %d failed: %v", exportID, userID, err)
+		if err := s.store.Privacy().FailExport(ctx, exportID, truncate(err.Error(), 500)); err != nil {
+			log.Printf("Failed to update data export %d: %v", exportID, err)
+		}
+		return
+	}
+
+	if err = s.store.Privacy().CompleteExport(ctx, exportID, archive, time.Now().Add(dataExportTTL)); err != nil {
+		log.Printf("Failed to store data export %d: %v", exportID, err)
+	}
+}
+
+// buildExport collects everything stored about a user into a ZIP of JSON files
+func (s *PrivacyService) buildExport(ctx context.Context, userID int) ([]byte, error) {
+	sections := []struct {
+		name    string
+		collect func(context.Context, int) (interface{}, error)
+	}{
+		{"profile.json", s.exportProfile},
+		{"orders.json", s.exportOrders},
+		{"wallet.json", s.exportWallet},
+		{"points.json", s.exportPoints},
+		{"downloads.json", s.exportDownloads},
+		{"posts.json", s.exportPosts},
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, section := range sections {
+		data, err := section.collect(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		w, err := archive.Create(section.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", section.name, err)
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", section.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *PrivacyService) exportProfile(ctx context.Context, userID int) (interface{}, error) {
+	user, err := s.store.Users().GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export profile: %w", err)
+	}
+
+	identities, err := s.store.Identities().ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export linked accounts: %w", err)
+	}
+
+	return map[string]interface{}{
+		"user":            user,
+		"linked_accounts": identities,
+	}, nil
+}
+
+func (s *PrivacyService) exportOrders(ctx context.Context, userID int) (interface{}, error) {
+	orders, err := s.store.Orders().ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export orders: %w", err)
+	}
+
+	orderIDs := make([]int, len(orders))
+	for i, order := range orders {
+		orderIDs[i] = order.ID
+	}
+	items, err := s.store.Orders().ItemsByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export order items: %w", err)
+	}
+	for i := range orders {
+		orders[i].Items = items[orders[i].ID]
+		if orders[i].Items == nil {
+			orders[i].Items = []models.OrderItem{}
+		}
+	}
+
+	return orders, nil
+}
+
+func (s *PrivacyService) exportWallet(ctx context.Context, userID int) (interface{}, error) {
+	wallet, err := s.store.Wallets().Get(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to export wallet: %w", err)
+	}
+
+	transactions, err := s.store.Wallets().TransactionsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export wallet transactions: %w", err)
+	}
+
+	return map[string]interface{}{
+		"wallet":       wallet,
+		"transactions": transactions,
+	}, nil
+}
+
+func (s *PrivacyService) exportPoints(ctx context.Context, userID int) (interface{}, error) {
+	points, err := s.store.Points().Get(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to export points: %w", err)
+	}
+
+	transactions, err := s.store.Points().TransactionsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export point transactions: %w", err)
+	}
+
+	return map[string]interface{}{
+		"points":       points,
+		"transactions": transactions,
+	}, nil
+}
+
+func (s *PrivacyService) exportDownloads(ctx context.Context, userID int) (interface{}, error) {
+	// Download tokens are credentials and are left out
+	downloads, err := s.store.Products().DownloadsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export downloads: %w", err)
+	}
+
+	return downloads, nil
+}
+
+func (s *PrivacyService) exportPosts(ctx context.Context, userID int) (interface{}, error) {
+	posts, err := s.store.Posts().ListByAuthor(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export posts: %w", err)
+	}
+
+	return posts, nil
+}
+
+// EraseUser anonymizes a user's account instead of deleting it. Personal data
+// is removed or overwritten while orders, wallet and point history are kept
+// for accounting, now linked to an anonymous account. Credentials, sessions,
+// linked logins and download entitlements are deleted.
+func (s *PrivacyService) EraseUser(ctx context.Context, userID int, actor models.AuditActor) error {
+	err := s.store.Transact(ctx, func(tx repository.Store) error {
+		placeholder := "deleted_" + strconv.Itoa(userID)
+		err := tx.Privacy().EraseUser(ctx, userID, placeholder, placeholder+"@erased.invalid")
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.NotFound("User not found")
+		} else if err != nil {
+			return err
+		}
+
+		// A user erasing their own account is not recorded with their details either
+		if actor.UserID != nil && *actor.UserID == userID {
+			actor.Email, actor.IPAddress, actor.UserAgent = "", "", ""
+		}
+		return recordAudit(ctx, tx, actor, AuditUserErase, "user", strconv.Itoa(userID), nil, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.sessionService.InvalidateUserSessions(ctx, userID); err != nil {
+		log.Printf("Failed to invalidate sessions of erased user %d: %v", userID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package services_test
+
+import (
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/services"
+)
+
+func TestEraseUser(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	tokens, err := env.Sessions.StartSession(t.Context(), user, "test", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := env.Store.AddOrder(models.Order{OrderNumber: "ORD-1", UserID: &user.ID, TotalAmount: 1000, FinalAmount: 1000, PaymentStatus: "paid", OrderStatus: "completed"})
+
+	actor := handlertest.Actor(user)
+	actor.IPAddress = "203.0.113.7"
+	if err := env.Privacy.EraseUser(t.Context(), user.ID, actor); err != nil {
+		t.Fatalf("erase = %v", err)
+	}
+
+	erased, err := env.Users.GetUserByID(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if erased.Email == user.Email || erased.Username == user.Username || erased.FullName != nil || erased.IsActive {
+		t.Errorf("erased user = %+v", erased)
+	}
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, handlertest.Password); err == nil {
+		t.Error("erased user can still log in")
+	}
+	if _, err := env.Sessions.Refresh(t.Context(), tokens.RefreshToken); err == nil {
+		t.Error("erased user's session survived")
+	}
+
+	// Orders stay for accounting, linked to the anonymous account
+	orders, _, err := env.Orders.GetOrders(t.Context(), 1, 10, repository.OrderFilter{UserID: &user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].ID != order.ID {
+		t.Errorf("orders after erasure = %+v", orders)
+	}
+
+	// Erasing yourself is audited without your details
+	events, _, err := env.Audit.GetEvents(t.Context(), models.AuditFilter{Action: services.AuditUserErase}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].ActorEmail != nil || events[0].IPAddress != nil {
+		t.Errorf("erasure audit events = %+v", events)
+	}
+
+	// The address can be used for a new account
+	if _, err := env.Users.CreateUser(t.Context(), models.RegisterRequest{Username: "again", Email: user.Email, Password: handlertest.Password, FullName: "Again"}); err != nil {
+		t.Errorf("registering the erased address = %v", err)
+	}
+
+	if err := env.Privacy.EraseUser(t.Context(), 999, actor); !apperr.IsNotFound(err) {
+		t.Errorf("erase unknown user = %v", err)
+	}
+}
+
+func TestFailInterruptedExports(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+
+	// An export the server was building when it stopped
+	export, err := env.Store.Privacy().CreateExport(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.Privacy.RequestExport(t.Context(), user.ID); err == nil {
+		t.Fatal("second export started while one is processing")
+	}
+
+	if err := env.Privacy.FailInterruptedExports(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	exports, err := env.Privacy.GetExports(t.Context(), user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exports) != 1 || exports[0].ID != export.ID || exports[0].Status != "failed" {
+		t.Errorf("exports = %+v", exports)
+	}
+	if _, err := env.Privacy.GetExportArchive(t.Context(), user.ID, export.ID); err == nil {
+		t.Error("got the archive of a failed export")
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+type ProductService struct {
+	store repository.Store
+}
+
+func NewProductService(store repository.Store) *ProductService {
+	return &ProductService{store: store}
+}
+
+// GetProducts retrieves active products with optional filtering by category slug
+func (s *ProductService) GetProducts(ctx context.Context, page, limit int, category, featured, search string) ([]models.SoftwareProduct, int, error) {
+	offset := (page - 1) * limit
+
+	filter := repository.ProductFilter{
+		CategorySlug: category,
+		Featured:     featured == "true",
+		Search:       search,
+	}
+	products, total, err := s.store.Products().List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	return products, total, nil
+}
+
+// GetProductBySlug retrieves a single active product by slug
+func (s *ProductService) GetProductBySlug(ctx context.Context, slug string) (*models.SoftwareProduct, error) {
+	product, err := s.store.Products().GetActiveBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apperr.NotFound("Product not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	return product, nil
+}
+
+// GetProductsByIDs retrieves the products with the given IDs in one query,
+// including inactive ones still referenced by orders
+func (s *ProductService) GetProductsByIDs(ctx context.Context, ids []int) ([]models.SoftwareProduct, error) {
+	products, err := s.store.Products().GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	return products, nil
+}
+
+// GetCategories retrieves all product categories
+func (s *ProductService) GetCategories(ctx context.Context) ([]models.ProductCategory, error) {
+	categories, err := s.store.Products().Categories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetCategoriesByIDs retrieves the product categories with the given IDs in one query
+func (s *ProductService) GetCategoriesByIDs(ctx context.Context, ids []int) ([]models.ProductCategory, error) {
+	categories, err := s.store.Products().CategoriesByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product categories: %w", err)
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/cache"
+	"zplus_web/backend/models"
+	"zplus_web/backend/rbac"
+	"zplus_web/backend/repository"
+)
+
+// rolePermissionsCacheTTL bounds how long other instances may use a role's old permissions
+const rolePermissionsCacheTTL = time.Minute
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// errRoleLocked reports a change to a role that must keep its current state
+var errRoleLocked = apperr.StateConflict("Role cannot be changed")
+
+type RoleService struct {
+	store repository.Store
+	cache *cache.Cache
+}
+
+func NewRoleService(store repository.Store, cache *cache.Cache) *RoleService {
+	return &RoleService{
+		store: store,
+		cache: cache,
+	}
+}
+
+// RolePermissions returns the permissions granted to a role. Unknown roles
+// have none. Results are cached and evicted when the role changes.
+func (s *RoleService) RolePermissions(ctx context.Context, role string) ([]string, error) {
+	if cached, err := s.cache.Get(ctx, rolePermissionsCacheKey(role)); err == nil {
+		return strings.Fields(cached), nil
+	}
+
+	permissions, err := s.store.Roles().Permissions(ctx, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	s.cache.Set(ctx, rolePermissionsCacheKey(role), strings.Join(permissions, " "), rolePermissionsCacheTTL)
+	return permissions, nil
+}
+
+// CanManage reports whether a user with actorRole may act on an account with
+// targetRole, which requires every permission of the target role to be granted
+// to the actor's role too. Otherwise users:write could erase an admin.
+func (s *RoleService) CanManage(ctx context.Context, actorRole, targetRole string) (bool, error) {
+	actorPermissions, err := s.RolePermissions(ctx, actorRole)
+	if err != nil {
+		return false, err
+	}
+	targetPermissions, err := s.RolePermissions(ctx, targetRole)
+	if err != nil {
+		return false, err
+	}
+
+	for _, permission := range targetPermissions {
+		if !rbac.Has(actorPermissions, permission) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ActorRole returns the current role of the actor. Without a user nothing can
+// be granted, so it is empty and has no permissions.
+func (s *RoleService) ActorRole(ctx context.Context, actor models.AuditActor) (string, error) {
+	if actor.UserID == nil {
+		return "", nil
+	}
+
+	user, err := s.store.Users().GetByID(ctx, *actor.UserID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.Role, nil
+}
+
+// checkGrant refuses permissions the actor's own role does not have, so that
+// roles:manage cannot be used to hand out more than the actor holds
+func (s *RoleService) checkGrant(ctx context.Context, actorRole string, permissions []string) error {
+	granted, err := s.RolePermissions(ctx, actorRole)
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		if !rbac.Has(granted, permission) {
+			return apperr.Forbidden("Permission denied").WithDetails("Permission " + permission + " is not granted to your role")
+		}
+	}
+	return nil
+}
+
+// checkManage refuses changes to the actor's own role and to roles with
+// permissions the actor's role does not have
+func (s *RoleService) checkManage(ctx context.Context, actorRole, role string) error {
+	if role == actorRole {
+		return apperr.Forbidden("Permission denied").WithDetails("You cannot change your own role")
+	}
+
+	allowed, err := s.CanManage(ctx, actorRole, role)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return apperr.Forbidden("Permission denied").WithDetails("Role " + role + " has permissions your role does not have")
+	}
+	return nil
+}
+
+// evict drops a role's cached permissions. The role has already changed, so
+// this runs even when the request has run out of time.
+func (s *RoleService) evict(ctx context.Context, role string) {
+	s.cache.Delete(context.WithoutCancel(ctx), rolePermissionsCacheKey(role))
+}
+
+// RoleExists reports whether a role with the given name exists
+func (s *RoleService) RoleExists(ctx context.Context, name string) (bool, error) {
+	exists, err := s.store.Roles().Exists(ctx, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to check role: %w", err)
+	}
+	return exists, nil
+}
+
+// GetRoles returns every role with its permissions and number of users
+func (s *RoleService) GetRoles(ctx context.Context) ([]models.Role, error) {
+	roles, err := s.store.Roles().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// GetRoleByID retrieves a role with its permissions
+func (s *RoleService) GetRoleByID(ctx context.Context, id int) (*models.Role, error) {
+	role, err := s.store.Roles().GetByID(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apperr.NotFound("Role not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return role, nil
+}
+
+// CreateRole creates a custom role with permissions the actor's role has too
+func (s *RoleService) CreateRole(ctx context.Context, req models.RoleRequest, actor models.AuditActor) (*models.Role, error) {
+	if err := validateRole(req); err != nil {
+		return nil, err
+	}
+
+	actorRole, err := s.ActorRole(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGrant(ctx, actorRole, req.Permissions); err != nil {
+		return nil, err
+	}
+
+	var roleID int
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		var err error
+		roleID, err = tx.Roles().Create(ctx, req.Name, req.Description)
+		if errors.Is(err, repository.ErrConflict) {
+			return apperr.Conflict("Role already exists").WithDetails(fmt.Sprintf("Role %s already exists", req.Name))
+		} else if err != nil {
+			return fmt.Errorf("failed to create role: %w", err)
+		}
+
+		if err = tx.Roles().SetPermissions(ctx, roleID, req.Permissions); err != nil {
+			return fmt.Errorf("failed to set role permissions: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditRoleCreate, "role", strconv.Itoa(roleID), nil, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.evict(ctx, req.Name)
+	return s.GetRoleByID(ctx, roleID)
+}
+
+// UpdateRole changes a role's description and permissions. Roles cannot be
+// renamed since users reference them by name, and the admin role always keeps
+// every permission so the system cannot be locked out. The actor may neither
+// change their own role nor grant permissions their role does not have.
+func (s *RoleService) UpdateRole(ctx context.Context, id int, req models.RoleRequest, actor models.AuditActor) (*models.Role, error) {
+	if err := validateRole(req); err != nil {
+		return nil, err
+	}
+
+	role, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.Name != req.Name {
+		return nil, apperr.Validation("Validation failed", map[string]string{"name": "cannot be changed, roles cannot be renamed"})
+	}
+	if role.Name == "admin" {
+		return nil, errRoleLocked.WithDetails("The admin role cannot be modified")
+	}
+
+	actorRole, err := s.ActorRole(ctx, actor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkManage(ctx, actorRole, role.Name); err != nil {
+		return nil, err
+	}
+	if err := s.checkGrant(ctx, actorRole, req.Permissions); err != nil {
+		return nil, err
+	}
+
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := tx.Roles().UpdateDescription(ctx, id, req.Description); err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+
+		if err := tx.Roles().SetPermissions(ctx, id, req.Permissions); err != nil {
+			return fmt.Errorf("failed to update role permissions: %w", err)
+		}
+
+		before := models.RoleRequest{Name: role.Name, Description: role.Description, Permissions: role.Permissions}
+		return recordAudit(ctx, tx, actor, AuditRoleUpdate, "role", strconv.Itoa(id), before, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.evict(ctx, role.Name)
+	return s.GetRoleByID(ctx, id)
+}
+
+// DeleteRole removes a custom role that no user has
+func (s *RoleService) DeleteRole(ctx context.Context, id int, actor models.AuditActor) error {
+	role, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if role.IsSystem {
+		return errRoleLocked.WithDetails("System roles cannot be deleted")
+	}
+	if role.UsersCount > 0 {
+		return errRoleLocked.WithDetails(fmt.Sprintf("Role is assigned to %d users", role.UsersCount))
+	}
+
+	actorRole, err := s.ActorRole(ctx, actor)
+	if err != nil {
+		return err
+	}
+	if err := s.checkManage(ctx, actorRole, role.Name); err != nil {
+		return err
+	}
+
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		if err := tx.Roles().Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete role: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditRoleDelete, "role", strconv.Itoa(id), role, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.evict(ctx, role.Name)
+	return nil
+}
+
+func validateRole(req models.RoleRequest) error {
+	if !roleNamePattern.MatchString(req.Name) {
+		return apperr.Validation("Validation failed", map[string]string{"name": "must use lowercase letters, digits and underscores"})
+	}
+	for _, permission := range req.Permissions {
+		if !rbac.Valid(permission) {
+			return apperr.Validation("Validation failed", map[string]string{"permissions": fmt.Sprintf("%q is not a permission", permission)})
+		}
+	}
+	return nil
+}
+
+func rolePermissionsCacheKey(role string) string {
+	return "role:permissions:" + role
+}
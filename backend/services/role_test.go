@@ -0,0 +1,86 @@
+package services_test
+
+import (
+	"slices"
+	"testing"
+
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/models"
+)
+
+func TestRolePermissions(t *testing.T) {
+	env := handlertest.New(t)
+	actor := handlertest.Actor(env.CreateUser(t, "admin"))
+
+	role, err := env.Roles.CreateRole(t.Context(), models.RoleRequest{Name: "writer", Permissions: []string{"blog:write"}}, actor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	permissions, err := env.Roles.RolePermissions(t.Context(), "writer")
+	if err != nil || !slices.Equal(permissions, []string{"blog:write"}) {
+		t.Fatalf("permissions = %v, %v", permissions, err)
+	}
+
+	// A change applies right away even though the permissions are cached
+	_, err = env.Roles.UpdateRole(t.Context(), role.ID, models.RoleRequest{Name: "writer", Permissions: []string{"blog:publish", "blog:write"}}, actor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	permissions, err = env.Roles.RolePermissions(t.Context(), "writer")
+	if err != nil || len(permissions) != 2 {
+		t.Errorf("permissions after update = %v, %v", permissions, err)
+	}
+
+	if permissions, err := env.Roles.RolePermissions(t.Context(), "nobody"); err != nil || len(permissions) != 0 {
+		t.Errorf("unknown role permissions = %v, %v", permissions, err)
+	}
+}
+
+func TestCanManage(t *testing.T) {
+	env := handlertest.New(t)
+	env.CreateRole(t, "blogger", "blog:*")
+	env.CreateRole(t, "user_manager", "users:read", "users:write")
+
+	tests := []struct {
+		actor, target string
+		want          bool
+	}{
+		{"admin", "admin", true},
+		{"admin", "support", true},
+		{"user_manager", "user", true},
+		{"user_manager", "user_manager", true},
+		{"user_manager", "admin", false},
+		{"user_manager", "support", false},
+		{"blogger", "editor", false},
+		{"editor", "blogger", false},
+		{"blogger", "user", true},
+		{"support", "admin", false},
+	}
+	for _, tt := range tests {
+		got, err := env.Roles.CanManage(t.Context(), tt.actor, tt.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("CanManage(%s, %s) = %v, want %v", tt.actor, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestStaffMFAEnforced(t *testing.T) {
+	env := handlertest.New(t)
+	if _, err := env.Settings.UpdateSecuritySettings(t.Context(), models.SecuritySettings{RequireAdmin2FA: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every role with an admin permission counts, not only admin
+	for role, want := range map[string]bool{"admin": true, "support": true, "editor": true, "user": false} {
+		status, err := env.MFA.Status(t.Context(), env.CreateUser(t, role))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Enforced != want || status.Required != want {
+			t.Errorf("%s: enforced %v, required %v, want %v", role, status.Enforced, status.Required, want)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/cache"
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+	"zplus_web/backend/utils"
+)
+
+const (
+	// refreshTokenTTL is how long a session survives without being refreshed
+	refreshTokenTTL = 30 * 24 * time.Hour
+	// sessionCacheTTL bounds how long a deactivated user can keep using a cached session
+	sessionCacheTTL = 30 * time.Second
+)
+
+var errInvalidRefreshToken = apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid or expired refresh token")
+
+type SessionService struct {
+	store       repository.Store
+	userService *UserService
+	cache       *cache.Cache
+}
+
+func NewSessionService(store repository.Store, userService *UserService, cache *cache.Cache) *SessionService {
+	return &SessionService{
+		store:       store,
+		userService: userService,
+		cache:       cache,
+	}
+}
+
+// StartSession creates a session for an authenticated user and issues its first token pair
+func (s *SessionService) StartSession(ctx context.Context, user *models.User, userAgent, ipAddress string) (*models.AuthTokens, error) {
+	sessionToken, err := utils.GenerateSecureToken(24)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+	refreshToken, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	expiresAt := time.Now().Add(refreshTokenTTL)
+
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		sessionID, err := tx.Sessions().Create(ctx, repository.NewSession{
+			UserID:    user.ID,
+			Token:     sessionToken,
+			UserAgent: truncate(userAgent, 255),
+			IPAddress: truncate(ipAddress, 45),
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		if err = tx.Sessions().CreateRefreshToken(ctx, sessionID, utils.HashToken(refreshToken), expiresAt); err != nil {
+			return fmt.Errorf("failed to store refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user, sessionToken, refreshToken)
+}
+
+// StartImpersonation opens a short-lived session for user on behalf of a staff
+// member. It has no refresh token and ends when the access token expires.
+func (s *SessionService) StartImpersonation(ctx context.Context, user *models.User, impersonator models.AuditActor, userAgent, ipAddress string) (*models.AuthTokens, error) {
+	if impersonator.UserID == nil {
+		return nil, fmt.Errorf("impersonation requires a staff member")
+	}
+
+	sessionToken, err := utils.GenerateSecureToken(24)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+
+	_, err = s.store.Sessions().Create(ctx, repository.NewSession{
+		UserID:         user.ID,
+		Token:          sessionToken,
+		UserAgent:      truncate(userAgent, 255),
+		IPAddress:      truncate(ipAddress, 45),
+		ExpiresAt:      time.Now().Add(utils.ImpersonationTokenTTL),
+		ImpersonatorID: impersonator.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	accessToken, err := utils.GenerateImpersonationToken(
+		user.ID, user.Email, user.Role, user.Username, user.EmailVerified, sessionToken,
+		*impersonator.UserID, impersonator.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &models.AuthTokens{
+		AccessToken: accessToken,
+		ExpiresIn:   int(utils.ImpersonationTokenTTL.Seconds()),
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair. Every refresh token
+// can be used once; presenting an already used one is treated as theft and
+// revokes the whole session, logging out both the attacker and the victim.
+func (s *SessionService) Refresh(ctx context.Context, refreshToken string) (*models.AuthTokens, error) {
+	newRefreshToken, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	newExpiresAt := time.Now().Add(refreshTokenTTL)
+
+	// A reused token revokes the session, which has to be committed, so the
+	// transaction reports reuse through token instead of failing
+	var token *repository.RefreshToken
+	err = s.store.Transact(ctx, func(tx repository.Store) error {
+		token, err = tx.Sessions().GetRefreshTokenForUpdate(ctx, utils.HashToken(refreshToken))
+		if errors.Is(err, repository.ErrNotFound) {
+			return errInvalidRefreshToken
+		} else if err != nil {
+			return fmt.Errorf("failed to get refresh token: %w", err)
+		}
+
+		if token.UsedAt != nil {
+			if err := tx.Sessions().Delete(ctx, token.SessionID); err != nil {
+				return fmt.Errorf("failed to revoke session: %w", err)
+			}
+			return nil
+		}
+
+		if time.Now().After(token.ExpiresAt) {
+			return errInvalidRefreshToken
+		}
+
+		if err := tx.Sessions().MarkRefreshTokenUsed(ctx, token.ID); err != nil {
+			return fmt.Errorf("failed to rotate refresh token: %w", err)
+		}
+
+		if err := tx.Sessions().CreateRefreshToken(ctx, token.SessionID, utils.HashToken(newRefreshToken), newExpiresAt); err != nil {
+			return fmt.Errorf("failed to store refresh token: %w", err)
+		}
+
+		if err := tx.Sessions().Extend(ctx, token.SessionID, newExpiresAt); err != nil {
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessionToken := token.SessionToken
+	if token.UsedAt != nil {
+		s.evict(ctx, sessionToken)
+		log.Printf("Refresh token reuse detected for user %d, session %d revoked", token.UserID, token.SessionID)
+		return nil, apperr.Unauthorized(apperr.CodeAuthTokenReused, "Session revoked").WithDetails("Refresh token was already used, the session has been revoked")
+	}
+
+	// Reload the user so role or verification changes show up in the new access token
+	user, err := s.userService.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		s.InvalidateSession(ctx, sessionToken)
+		return nil, errAccountDeactivated
+	}
+
+	return s.issueTokens(user, sessionToken, newRefreshToken)
+}
+
+// ValidateSession checks that the session behind an access token still exists
+// and that its user is active, and returns the user's current role so role
+// changes apply before the access token expires. Positive results are cached briefly in Redis.
+func (s *SessionService) ValidateSession(ctx context.Context, sessionToken string, userID int) (string, error) {
+	if sessionToken == "" {
+		return "", fmt.Errorf("token has no session")
+	}
+
+	prefix := strconv.Itoa(userID) + ":"
+	cached, err := s.cache.Get(ctx, sessionCacheKey(sessionToken))
+	if err == nil && strings.HasPrefix(cached, prefix) {
+		return strings.TrimPrefix(cached, prefix), nil
+	}
+
+	user, err := s.store.Sessions().GetSessionUser(ctx, sessionToken, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", fmt.Errorf("session has been revoked or has expired")
+	} else if err != nil {
+		return "", fmt.Errorf("failed to validate session: %w", err)
+	}
+
+	if !user.IsActive {
+		return "", fmt.Errorf("user account is deactivated")
+	}
+
+	s.cache.Set(ctx, sessionCacheKey(sessionToken), prefix+user.Role, sessionCacheTTL)
+	return user.Role, nil
+}
+
+// RefreshUserSessions drops the cached validation of a user's sessions so a
+// role change or deactivation applies to their next request
+func (s *SessionService) RefreshUserSessions(ctx context.Context, userID int) error {
+	tokens, err := s.store.Sessions().Tokens(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	s.evict(ctx, tokens...)
+	return nil
+}
+
+// ListUserSessions returns the active sessions of a user, newest activity first
+func (s *SessionService) ListUserSessions(ctx context.Context, userID int, currentSessionToken string) ([]models.UserSession, error) {
+	sessions, err := s.store.Sessions().ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].Token == currentSessionToken
+	}
+
+	return sessions, nil
+}
+
+// RevokeUserSession removes one of the user's own sessions by id
+func (s *SessionService) RevokeUserSession(ctx context.Context, userID, sessionID int) error {
+	sessionToken, err := s.store.Sessions().DeleteUserSession(ctx, userID, sessionID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return apperr.NotFound("Session not found")
+	} else if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	s.evict(ctx, sessionToken)
+	return nil
+}
+
+// RevokeOtherSessions removes every session of the user except the current one
+func (s *SessionService) RevokeOtherSessions(ctx context.Context, userID int, currentSessionToken string) (int, error) {
+	tokens, err := s.store.Sessions().DeleteOthers(ctx, userID, currentSessionToken)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	s.evict(ctx, tokens...)
+	return len(tokens), nil
+}
+
+// InvalidateSession removes a session together with its refresh tokens
+func (s *SessionService) InvalidateSession(ctx context.Context, sessionToken string) error {
+	err := s.store.Sessions().DeleteByToken(ctx, sessionToken)
+	s.evict(ctx, sessionToken)
+	return err
+}
+
+// InvalidateUserSessions removes every session belonging to a user
+func (s *SessionService) InvalidateUserSessions(ctx context.Context, userID int) error {
+	tokens, err := s.store.Sessions().DeleteByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate sessions: %w", err)
+	}
+
+	s.evict(ctx, tokens...)
+	return nil
+}
+
+func (s *SessionService) issueTokens(user *models.User, sessionToken, refreshToken string) (*models.AuthTokens, error) {
+	accessToken, err := utils.GenerateJWTWithDetails(user.ID, user.Email, user.Role, user.Username, user.EmailVerified, sessionToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &models.AuthTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(utils.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
+
+func (s *SessionService) evict(ctx context.Context, sessionTokens ...string) {
+	keys := make([]string, len(sessionTokens))
+	for i, token := range sessionTokens {
+		keys[i] = sessionCacheKey(token)
+	}
+	// The sessions are already gone, so evict even when the request has run out of time
+	s.cache.Delete(context.WithoutCancel(ctx), keys...)
+}
+
+func sessionCacheKey(sessionToken string) string {
+	return "session:" + sessionToken
+}
@@ -0,0 +1,44 @@
+package services_test
+
+import (
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/handlers/handlertest"
+)
+
+func TestRefreshRotation(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	first, err := env.Sessions.StartSession(t.Context(), user, "test", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := env.Sessions.Refresh(t.Context(), first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh = %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken || second.AccessToken == "" {
+		t.Errorf("refresh did not rotate the tokens")
+	}
+
+	// Presenting the rotated token again revokes the session for everyone
+	if _, err := env.Sessions.Refresh(t.Context(), first.RefreshToken); apperr.Code(err) != "AUTH_TOKEN_REUSED" {
+		t.Fatalf("reused refresh token = %v", err)
+	}
+	if _, err := env.Sessions.Refresh(t.Context(), second.RefreshToken); apperr.Code(err) != apperr.CodeAuthInvalid {
+		t.Errorf("refresh of a revoked session = %v", err)
+	}
+	sessions, err := env.Sessions.ListUserSessions(t.Context(), user.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("%d sessions left after reuse", len(sessions))
+	}
+
+	if _, err := env.Sessions.Refresh(t.Context(), "unknown"); apperr.Code(err) != apperr.CodeAuthInvalid {
+		t.Errorf("unknown refresh token = %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
+)
+
+// Setting keys stored in app_settings
+const (
+	settingRequireAdmin2FA = "security.require_admin_2fa"
+)
+
+type SettingsService struct {
+	store repository.Store
+}
+
+func NewSettingsService(store repository.Store) *SettingsService {
+	return &SettingsService{store: store}
+}
+
+// GetSecuritySettings returns the security settings, unset values take their defaults
+func (s *SettingsService) GetSecuritySettings(ctx context.Context) (*models.SecuritySettings, error) {
+	requireAdmin2FA, err := s.getBool(ctx, settingRequireAdmin2FA, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SecuritySettings{
+		RequireAdmin2FA: requireAdmin2FA,
+	}, nil
+}
+
+// UpdateSecuritySettings stores the security settings
+func (s *SettingsService) UpdateSecuritySettings(ctx context.Context, settings models.SecuritySettings) (*models.SecuritySettings, error) {
+	if err := s.set(ctx, settingRequireAdmin2FA, strconv.FormatBool(settings.RequireAdmin2FA)); err != nil {
+		return nil, err
+	}
+
+	return s.GetSecuritySettings(ctx)
+}
+
+func (s *SettingsService) getBool(ctx context.Context, key string, defaultValue bool) (bool, error) {
+	value, err := s.store.Settings().Get(ctx, key)
+	if errors.Is(err, repository.ErrNotFound) {
+		return defaultValue, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get setting %s: %w", key, err)
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return defaultValue, nil
+	}
+	return parsed, nil
+}
+
+func (s *SettingsService) set(ctx context.Context, key, value string) error {
+	if err := s.store.Settings().Set(ctx, key, value); err != nil {
+		return fmt.Errorf("failed to update setting %s: %w", key, err)
+	}
+	return nil
+}
@@ -1,234 +1,366 @@
 package services
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
-	"time"
+	"log"
+	"strconv"
+	"strings"
 
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/events"
 	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
 	"zplus_web/backend/utils"
 )
 
 type UserService struct {
-	db *sql.DB
+	store  repository.Store
+	events *events.Bus
 }
 
-func NewUserService(db *sql.DB) *UserService {
-	return &UserService{db: db}
+var (
+	errInvalidCredentials = apperr.Unauthorized(apperr.CodeAuthInvalid, "Invalid credentials").WithDetails("Invalid email or password")
+	errAccountDeactivated = apperr.Unauthorized(apperr.CodeAuthInvalid, "User account is deactivated")
+)
+
+// weakPassword reports a password the policy rejects, listing every rule it breaks
+func weakPassword(err error) error {
+	return apperr.Validation("Password is too weak", nil).WithDetails(err.Error())
+}
+
+func NewUserService(store repository.Store, bus *events.Bus) *UserService {
+	return &UserService{store: store, events: bus}
 }
 
 // CreateUser creates a new user with hashed password
-func (s *UserService) CreateUser(req models.RegisterRequest) (*models.User, error) {
+func (s *UserService) CreateUser(ctx context.Context, req models.RegisterRequest) (*models.User, error) {
+	if err := utils.ValidatePassword(req.Password, req.Username, req.Email); err != nil {
+		return nil, weakPassword(err)
+	}
+
 	// Hash the password
 	hashedPassword, err := utils.HashPassword(req.Password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	// Check if user already exists
-	var existingID int
-	err = s.db.QueryRow("SELECT id FROM users WHERE email = $1 OR username = $2", req.Email, req.Username).Scan(&existingID)
-	if err == nil {
-		return nil, fmt.Errorf("user with email or username already exists")
-	} else if err != sql.ErrNoRows {
-		return nil, fmt.Errorf("failed to check existing user: %w", err)
+	user, err := s.store.Users().Create(ctx, repository.NewUser{
+		Username:     req.Username,
+		Email:        req.Email,
+		PasswordHash: hashedPassword,
+		FullName:     &req.FullName,
+		Phone:        &req.Phone,
+		Role:         "user",
+	})
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, apperr.Conflict("User with this email or username already exists")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Create the user
-	var user models.User
-	err = s.db.QueryRow(`
-		INSERT INTO users (username, email, password_hash, full_name, phone, role, is_active, email_verified, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, 'user', true, false, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		RETURNING id, username, email, role, full_name, phone, avatar_url, is_active, email_verified, created_at, updated_at`,
-		req.Username, req.Email, hashedPassword, req.FullName, req.Phone).Scan(
-		&user.ID, &user.Username, &user.Email, &user.Role, &user.FullName, &user.Phone,
-		&user.AvatarURL, &user.IsActive, &user.EmailVerified, &user.CreatedAt, &user.UpdatedAt)
+	s.events.Publish(events.UserRegistered, *user)
+
+	return user, nil
+}
 
+// CreateExternalUser creates a user who signed up through a social login provider.
+// The account gets a random password, a password can be set later with a reset.
+func (s *UserService) CreateExternalUser(ctx context.Context, email, fullName, avatarURL string, emailVerified bool) (*models.User, error) {
+	randomPassword, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate password: %w", err)
+	}
+	hashedPassword, err := utils.HashPassword(randomPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	username, err := s.availableUsername(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Create(ctx, repository.NewUser{
+		Username:      username,
+		Email:         email,
+		PasswordHash:  hashedPassword,
+		FullName:      optionalString(fullName),
+		AvatarURL:     optionalString(truncate(avatarURL, 255)),
+		Role:          "user",
+		EmailVerified: emailVerified,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	return &user, nil
+	s.events.Publish(events.UserRegistered, *user)
+
+	return user, nil
+}
+
+// availableUsername derives a free username from the local part of an email address
+func (s *UserService) availableUsername(ctx context.Context, email string) (string, error) {
+	base := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r == '.' || r == '-':
+			return '_'
+		}
+		return -1
+	}, strings.ToLower(strings.SplitN(email, "@", 2)[0]))
+	if len(base) < 3 {
+		base = "user_" + base
+	}
+	base = truncate(base, 40)
+
+	candidate := base
+	for i := 0; i < 5; i++ {
+		exists, err := s.store.Users().UsernameTaken(ctx, candidate)
+		if err != nil {
+			return "", fmt.Errorf("failed to check username: %w", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+
+		suffix, err := utils.GenerateSecureToken(3)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate username: %w", err)
+		}
+		candidate = base + "_" + strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(suffix))
+	}
+
+	return "", fmt.Errorf("failed to find a free username")
 }
 
 // AuthenticateUser validates user credentials and returns user info
-func (s *UserService) AuthenticateUser(email, password string) (*models.User, error) {
-	var user models.User
-	err := s.db.QueryRow(`
-		SELECT id, username, email, password_hash, role, full_name, phone, avatar_url, is_active, email_verified, created_at, updated_at
-		FROM users WHERE email = $1`, email).Scan(
-		&user.ID, &user.Username, &user.Email, &user.PasswordHash, &user.Role,
-		&user.FullName, &user.Phone, &user.AvatarURL, &user.IsActive, &user.EmailVerified,
-		&user.CreatedAt, &user.UpdatedAt)
-
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+func (s *UserService) AuthenticateUser(ctx context.Context, email, password string) (*models.User, error) {
+	user, err := s.store.Users().GetLogin(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, errInvalidCredentials
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
 	// Check if user is active
 	if !user.IsActive {
-		return nil, fmt.Errorf("user account is deactivated")
+		return nil, errAccountDeactivated
 	}
 
 	// Verify password
 	if !utils.CheckPasswordHash(password, user.PasswordHash) {
-		return nil, fmt.Errorf("invalid password")
+		return nil, errInvalidCredentials
+	}
+
+	// The plain password is only known here, so hashes made with an older
+	// algorithm or cost are upgraded as users log in
+	if utils.PasswordNeedsRehash(user.PasswordHash) {
+		if err := s.rehashPassword(ctx, user.ID, user.PasswordHash, password); err != nil {
+			log.Printf("Failed to upgrade password hash of user %d: %v", user.ID, err)
+		}
 	}
 
-	return &user, nil
+	return user, nil
 }
 
-// CreateSession creates a new user session
-func (s *UserService) CreateSession(userID int, token string, expiresAt time.Time) error {
-	_, err := s.db.Exec(`
-		INSERT INTO user_sessions (user_id, token, expires_at, created_at)
-		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)`,
-		userID, token, expiresAt)
-	return err
+// rehashPassword replaces a hash with one made with the configured algorithm,
+// unless the password was changed in the meantime
+func (s *UserService) rehashPassword(ctx context.Context, userID int, oldHash, password string) error {
+	newHash, err := utils.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err = s.store.Users().ReplacePasswordHash(ctx, userID, oldHash, newHash); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
 }
 
-// InvalidateSession removes a user session
-func (s *UserService) InvalidateSession(token string) error {
-	_, err := s.db.Exec("DELETE FROM user_sessions WHERE token = $1", token)
-	return err
+// GetUserByEmail retrieves a user by email address
+func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	return userOrNotFound(s.store.Users().GetByEmail(ctx, email))
 }
 
 // GetUserByID retrieves a user by ID
-func (s *UserService) GetUserByID(id int) (*models.User, error) {
-	var user models.User
-	err := s.db.QueryRow(`
-		SELECT id, username, email, role, full_name, phone, avatar_url, is_active, email_verified, created_at, updated_at
-		FROM users WHERE id = $1`, id).Scan(
-		&user.ID, &user.Username, &user.Email, &user.Role,
-		&user.FullName, &user.Phone, &user.AvatarURL, &user.IsActive, &user.EmailVerified,
-		&user.CreatedAt, &user.UpdatedAt)
-
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+func (s *UserService) GetUserByID(ctx context.Context, id int) (*models.User, error) {
+	return userOrNotFound(s.store.Users().GetByID(ctx, id))
+}
+
+func userOrNotFound(user *models.User, err error) (*models.User, error) {
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apperr.NotFound("User not found")
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
-
-	return &user, nil
+	return user, nil
 }
 
 // UpdateUser updates user profile information
-func (s *UserService) UpdateUser(userID int, req models.UpdateProfileRequest) (*models.User, error) {
-	var user models.User
-	err := s.db.QueryRow(`
-		UPDATE users SET full_name = $1, phone = $2, avatar_url = $3, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $4
-		RETURNING id, username, email, role, full_name, phone, avatar_url, is_active, email_verified, created_at, updated_at`,
-		req.FullName, req.Phone, req.AvatarURL, userID).Scan(
-		&user.ID, &user.Username, &user.Email, &user.Role,
-		&user.FullName, &user.Phone, &user.AvatarURL, &user.IsActive, &user.EmailVerified,
-		&user.CreatedAt, &user.UpdatedAt)
-
+func (s *UserService) UpdateUser(ctx context.Context, userID int, req models.UpdateProfileRequest) (*models.User, error) {
+	user, err := s.store.Users().UpdateProfile(ctx, userID, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
-	return &user, nil
+	return user, nil
 }
 
-// ChangePassword changes user password
-func (s *UserService) ChangePassword(userID int, currentPassword, newPassword string) error {
-	// Get current password hash
-	var currentHash string
-	err := s.db.QueryRow("SELECT password_hash FROM users WHERE id = $1", userID).Scan(&currentHash)
-	if err != nil {
-		return fmt.Errorf("failed to get current password: %w", err)
-	}
+// ChangePassword changes user password after checking the current one
+func (s *UserService) ChangePassword(ctx context.Context, userID int, currentPassword, newPassword string, actor models.AuditActor) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		currentHash, err := s.checkNewPassword(ctx, tx, userID, newPassword)
+		if err != nil {
+			return err
+		}
 
-	// Verify current password
-	if !utils.CheckPasswordHash(currentPassword, currentHash) {
-		return fmt.Errorf("current password is incorrect")
-	}
+		// Verify current password
+		if !utils.CheckPasswordHash(currentPassword, currentHash) {
+			return apperr.Unauthorized(apperr.CodeAuthInvalid, "Password is incorrect").WithDetails("The current password is incorrect")
+		}
 
-	// Hash new password
-	newHash, err := utils.HashPassword(newPassword)
-	if err != nil {
-		return fmt.Errorf("failed to hash new password: %w", err)
-	}
+		if err = s.storePassword(ctx, tx, userID, currentHash, newPassword); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, tx, actor, AuditUserPasswordChange, "user", strconv.Itoa(userID), nil, nil)
+	})
+}
 
-	// Update password
-	_, err = s.db.Exec("UPDATE users SET password_hash = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", newHash, userID)
+// SetPassword replaces a user's password without checking the old one
+func (s *UserService) SetPassword(ctx context.Context, userID int, newPassword string) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		return s.setPassword(ctx, tx, userID, newPassword)
+	})
+}
+
+// setPassword is SetPassword within the caller's transaction
+func (s *UserService) setPassword(ctx context.Context, tx repository.Store, userID int, newPassword string) error {
+	currentHash, err := s.checkNewPassword(ctx, tx, userID, newPassword)
 	if err != nil {
-		return fmt.Errorf("failed to update password: %w", err)
+		return err
 	}
 
-	return nil
+	return s.storePassword(ctx, tx, userID, currentHash, newPassword)
 }
 
-// GetAllUsers retrieves all users from the database
-func (s *UserService) GetAllUsers() ([]models.User, error) {
-	rows, err := s.db.Query(`
-		SELECT id, username, email, role, full_name, phone, avatar_url, 
-		       is_active, email_verified, created_at, updated_at
-		FROM users ORDER BY created_at DESC`)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query users: %w", err)
+// checkNewPassword locks the user's row, validates a new password against the
+// policy and the user's recent passwords, and returns the current hash
+func (s *UserService) checkNewPassword(ctx context.Context, tx repository.Store, userID int, newPassword string) (string, error) {
+	user, err := tx.Users().GetForUpdate(ctx, userID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", apperr.NotFound("User not found")
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get current password: %w", err)
+	}
+	currentHash := user.PasswordHash
+
+	var violations []string
+	if err := utils.ValidatePassword(newPassword, user.Username, user.Email); err != nil {
+		violations = err.(*utils.PasswordPolicyError).Violations
 	}
-	defer rows.Close()
 
-	var users []models.User
-	for rows.Next() {
-		var user models.User
-		err := rows.Scan(
-			&user.ID, &user.Username, &user.Email, &user.Role,
-			&user.FullName, &user.Phone, &user.AvatarURL, &user.IsActive, 
-			&user.EmailVerified, &user.CreatedAt, &user.UpdatedAt)
+	// The current password counts as the first of the last History passwords
+	if history := utils.CurrentPasswordPolicy().History; history > 0 {
+		hashes, err := tx.Users().PasswordHistory(ctx, userID, history-1)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan user: %w", err)
+			return "", fmt.Errorf("failed to get password history: %w", err)
 		}
-		users = append(users, user)
-	}
+		previous := append([]string{currentHash}, hashes...)
 
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating users: %w", err)
+		for _, hash := range previous {
+			if utils.CheckPasswordHash(newPassword, hash) {
+				violations = append(violations, fmt.Sprintf("must not be one of your last %d passwords", history))
+				break
+			}
+		}
 	}
 
-	return users, nil
+	if len(violations) > 0 {
+		return "", weakPassword(&utils.PasswordPolicyError{Violations: violations})
+	}
+	return currentHash, nil
 }
 
-// DeleteUser deletes a user by ID
-func (s *UserService) DeleteUser(userID int) error {
-	result, err := s.db.Exec("DELETE FROM users WHERE id = $1", userID)
+// storePassword sets a new password and moves the replaced hash into the
+// password history, keeping only as many entries as the policy checks
+func (s *UserService) storePassword(ctx context.Context, tx repository.Store, userID int, oldHash, newPassword string) error {
+	newHash, err := utils.HashPassword(newPassword)
 	if err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
+		return fmt.Errorf("failed to hash new password: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+	if err = tx.Users().SetPasswordHash(ctx, userID, newHash); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("user not found")
+	keep := utils.CurrentPasswordPolicy().History - 1
+	if keep > 0 {
+		if err = tx.Users().AddPasswordHistory(ctx, userID, oldHash); err != nil {
+			return fmt.Errorf("failed to store password history: %w", err)
+		}
+	} else {
+		keep = 0
+	}
+
+	if err = tx.Users().TrimPasswordHistory(ctx, userID, keep); err != nil {
+		return fmt.Errorf("failed to trim password history: %w", err)
 	}
 
 	return nil
 }
 
-// UpdateUserRole updates a user's role
-func (s *UserService) UpdateUserRole(userID int, role string) error {
-	result, err := s.db.Exec(`
-		UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP 
-		WHERE id = $2`, role, userID)
+// GetAllUsers retrieves all users from the database
+func (s *UserService) GetAllUsers(ctx context.Context) ([]models.User, error) {
+	users, err := s.store.Users().ListAll(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to update user role: %w", err)
+		return nil, fmt.Errorf("failed to query users: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	return users, nil
+}
+
+// GetUsers retrieves a page of users, optionally matching a search term
+func (s *UserService) GetUsers(ctx context.Context, page, limit int, search string) ([]models.User, int, error) {
+	offset := (page - 1) * limit
+
+	users, total, err := s.store.Users().List(ctx, search, limit, offset)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return nil, 0, fmt.Errorf("failed to query users: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("user not found")
+	return users, total, nil
+}
+
+// GetUsersByIDs retrieves the users with the given IDs in one query, in no particular order
+func (s *UserService) GetUsersByIDs(ctx context.Context, ids []int) ([]models.User, error) {
+	users, err := s.store.Users().GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
 	}
 
-	return nil
-}
\ No newline at end of file
+	return users, nil
+}
+
+// UpdateUserRole updates a user's role and records who changed it
+func (s *UserService) UpdateUserRole(ctx context.Context, userID int, role string, actor models.AuditActor) error {
+	return s.store.Transact(ctx, func(tx repository.Store) error {
+		user, err := tx.Users().GetForUpdate(ctx, userID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return apperr.NotFound("User not found")
+		} else if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		if err = tx.Users().SetRole(ctx, userID, role); err != nil {
+			return fmt.Errorf("failed to update user role: %w", err)
+		}
+
+		return recordAudit(ctx, tx, actor, AuditUserRoleChange, "user", strconv.Itoa(userID),
+			map[string]string{"role": user.Role}, map[string]string{"role": role})
+	})
+}
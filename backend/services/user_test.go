@@ -0,0 +1,103 @@
+package services_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"zplus_web/backend/apperr"
+	"zplus_web/backend/config"
+	"zplus_web/backend/handlers/handlertest"
+	"zplus_web/backend/utils"
+)
+
+// configurePasswords switches password hashing for one test and restores the
+// handlertest configuration afterwards
+func configurePasswords(t *testing.T, hash string, cost int) {
+	t.Helper()
+	cfg := config.Config{
+		PasswordHash:        hash,
+		PasswordBcryptCost:  cost,
+		PasswordMinLength:   8,
+		PasswordMinClasses:  3,
+		PasswordCheckCommon: true,
+		PasswordHistory:     5,
+	}
+	if err := utils.ConfigurePasswords(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cfg.PasswordHash, cfg.PasswordBcryptCost = utils.PasswordHashBcrypt, 4
+		utils.ConfigurePasswords(&cfg)
+	})
+}
+
+func TestRehashOnLogin(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+	storedHash := func() string {
+		t.Helper()
+		login, err := env.Store.Users().GetLogin(t.Context(), user.Email)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return login.PasswordHash
+	}
+	original := storedHash()
+
+	// A failed login leaves the hash alone
+	configurePasswords(t, utils.PasswordHashArgon2id, 4)
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, "Wr0ng-password!"); err == nil {
+		t.Fatal("wrong password accepted")
+	}
+	if storedHash() != original {
+		t.Error("a failed login rewrote the hash")
+	}
+
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, handlertest.Password); err != nil {
+		t.Fatal(err)
+	}
+	upgraded := storedHash()
+	if !strings.HasPrefix(upgraded, "$argon2id$") || utils.PasswordNeedsRehash(upgraded) {
+		t.Fatalf("hash after login = %s", upgraded)
+	}
+
+	// A current hash is kept, and the upgraded one still logs in
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, handlertest.Password); err != nil {
+		t.Fatal(err)
+	}
+	if storedHash() != upgraded {
+		t.Error("an up-to-date hash was rewritten")
+	}
+}
+
+func TestPasswordHistory(t *testing.T) {
+	env := handlertest.New(t)
+	user := env.CreateUser(t, "user")
+
+	// With a history of 5, the current password and the 4 before it are refused
+	passwords := []string{handlertest.Password, "First-passw0rd", "Second-passw0rd", "Third-passw0rd", "Fourth-passw0rd"}
+	for _, password := range passwords[1:] {
+		if err := env.Users.SetPassword(t.Context(), user.ID, password); err != nil {
+			t.Fatalf("SetPassword(%s) = %v", password, err)
+		}
+	}
+	for _, password := range passwords {
+		err := env.Users.ChangePassword(t.Context(), user.ID, "Fourth-passw0rd", password, handlertest.Actor(user))
+		var appErr *apperr.Error
+		if !errors.As(err, &appErr) || appErr.Code != apperr.CodeValidation || !strings.Contains(appErr.Details, "last 5 passwords") {
+			t.Errorf("reusing %s = %v", password, err)
+		}
+	}
+
+	// One more change and the oldest password is free again
+	if err := env.Users.SetPassword(t.Context(), user.ID, "Fifth-passw0rd"); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.Users.ChangePassword(t.Context(), user.ID, "Fifth-passw0rd", handlertest.Password, handlertest.Actor(user)); err != nil {
+		t.Errorf("reusing the sixth last password = %v", err)
+	}
+	if _, err := env.Users.AuthenticateUser(t.Context(), user.Email, handlertest.Password); err != nil {
+		t.Errorf("login with the reused password = %v", err)
+	}
+}
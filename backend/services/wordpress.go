@@ -2,22 +2,25 @@ package services
 
 import (
 	"bytes"
-	"database/sql"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 
+	"zplus_web/backend/events"
 	"zplus_web/backend/models"
+	"zplus_web/backend/repository"
 )
 
 type WordPressService struct {
-	db *sql.DB
+	store  repository.Store
+	events *events.Bus
 }
 
-func NewWordPressService(db *sql.DB) *WordPressService {
-	return &WordPressService{db: db}
+func NewWordPressService(store repository.Store, bus *events.Bus) *WordPressService {
+	return &WordPressService{store: store, events: bus}
 }
 
 // WordPress API structures
@@ -40,94 +43,76 @@ type WordPressPost struct {
 }
 
 // GetWordPressSites retrieves all configured WordPress sites
-func (s *WordPressService) GetWordPressSites() ([]models.WordPressSite, error) {
-	rows, err := s.db.Query(`
-		SELECT id, name, url, api_endpoint, username, is_active, last_sync_at, created_at, updated_at
-		FROM wordpress_sites
-		WHERE is_active = true
-		ORDER BY name`)
-
+func (s *WordPressService) GetWordPressSites(ctx context.Context) ([]models.WordPressSite, error) {
+	sites, err := s.store.WordPress().ListActiveSites(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get WordPress sites: %w", err)
 	}
-	defer rows.Close()
-
-	var sites []models.WordPressSite
-	for rows.Next() {
-		var site models.WordPressSite
-		err := rows.Scan(
-			&site.ID, &site.Name, &site.URL, &site.APIEndpoint, &site.Username,
-			&site.IsActive, &site.LastSyncAt, &site.CreatedAt, &site.UpdatedAt)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan WordPress site: %w", err)
-		}
-
-		sites = append(sites, site)
-	}
 
 	return sites, nil
 }
 
 // CreateWordPressSite creates a new WordPress site configuration
-func (s *WordPressService) CreateWordPressSite(site models.WordPressSite) (*models.WordPressSite, error) {
-	var created models.WordPressSite
-
-	err := s.db.QueryRow(`
-		INSERT INTO wordpress_sites (name, url, api_endpoint, username, application_password, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-		RETURNING id, name, url, api_endpoint, username, is_active, last_sync_at, created_at, updated_at`,
-		site.Name, site.URL, site.APIEndpoint, site.Username, site.ApplicationPassword, site.IsActive).Scan(
-		&created.ID, &created.Name, &created.URL, &created.APIEndpoint, &created.Username,
-		&created.IsActive, &created.LastSyncAt, &created.CreatedAt, &created.UpdatedAt)
-
+func (s *WordPressService) CreateWordPressSite(ctx context.Context, site models.WordPressSite) (*models.WordPressSite, error) {
+	created, err := s.store.WordPress().CreateSite(ctx, site)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create WordPress site: %w", err)
 	}
 
-	return &created, nil
+	return created, nil
 }
 
-// SyncPostsFromWordPress syncs posts from WordPress to local blog
-func (s *WordPressService) SyncPostsFromWordPress(siteID int, blogService *BlogService) error {
+// SyncPostsFromWordPress syncs posts from WordPress to local blog; updated
+// posts are audited as changes by the actor
+func (s *WordPressService) SyncPostsFromWordPress(ctx context.Context, siteID int, blogService *BlogService, actor models.AuditActor) error {
 	// Get site configuration
-	site, err := s.getWordPressSite(siteID)
+	site, err := s.store.WordPress().GetSite(ctx, siteID)
 	if err != nil {
 		return fmt.Errorf("failed to get WordPress site: %w", err)
 	}
 
 	// Fetch posts from WordPress API
-	posts, err := s.fetchWordPressPosts(site)
+	posts, err := s.fetchWordPressPosts(ctx, site)
 	if err != nil {
+		s.events.Publish(events.WordPressSyncProgress, events.WordPressSyncPayload{SiteID: siteID, Done: true, Error: err.Error()})
 		return fmt.Errorf("failed to fetch WordPress posts: %w", err)
 	}
 
-	// Process each post
+	// Process each post, reporting progress to live subscribers
+	progress := events.WordPressSyncPayload{SiteID: siteID, Total: len(posts)}
+	s.events.Publish(events.WordPressSyncProgress, progress)
 	for _, wpPost := range posts {
-		err := s.syncSinglePost(siteID, wpPost, blogService)
+		progress.Processed++
+		err := s.syncSinglePost(ctx, siteID, wpPost, blogService, actor)
 		if err != nil {
 			// Log error but continue with other posts
-			s.logSyncError(siteID, "post_sync", &wpPost.ID, nil, err.Error())
-			continue
+			progress.Failed++
+			s.logSyncError(ctx, siteID, "post_sync", &wpPost.ID, nil, err.Error())
+		}
+		if progress.Processed < progress.Total {
+			s.events.Publish(events.WordPressSyncProgress, progress)
 		}
 	}
 
 	// Update last sync time
-	s.updateLastSyncTime(siteID)
+	s.store.WordPress().TouchSync(ctx, siteID)
+
+	progress.Done = true
+	s.events.Publish(events.WordPressSyncProgress, progress)
 
 	return nil
 }
 
 // SyncPostToWordPress syncs a local blog post to WordPress
-func (s *WordPressService) SyncPostToWordPress(siteID, postID int) error {
+func (s *WordPressService) SyncPostToWordPress(ctx context.Context, siteID, postID int) error {
 	// Get site configuration
-	site, err := s.getWordPressSite(siteID)
+	site, err := s.store.WordPress().GetSite(ctx, siteID)
 	if err != nil {
 		return fmt.Errorf("failed to get WordPress site: %w", err)
 	}
 
 	// Get local post
-	post, err := s.getLocalPost(postID)
+	post, err := s.store.Posts().GetByID(ctx, postID)
 	if err != nil {
 		return fmt.Errorf("failed to get local post: %w", err)
 	}
@@ -136,24 +121,24 @@ func (s *WordPressService) SyncPostToWordPress(siteID, postID int) error {
 	wpPost := s.convertToWordPressPost(post)
 
 	// Send to WordPress
-	err = s.sendPostToWordPress(site, wpPost)
+	err = s.sendPostToWordPress(ctx, site, wpPost)
 	if err != nil {
-		s.logSyncError(siteID, "post_create", &postID, nil, err.Error())
+		s.logSyncError(ctx, siteID, "post_create", &postID, nil, err.Error())
 		return fmt.Errorf("failed to sync post to WordPress: %w", err)
 	}
 
 	// Log successful sync
-	s.logSyncSuccess(siteID, "post_create", &postID, nil)
+	s.logSyncSuccess(ctx, siteID, "post_create", &postID, nil)
 
 	return nil
 }
 
 // TestWordPressConnection tests connection to a WordPress site
-func (s *WordPressService) TestWordPressConnection(site models.WordPressSite) error {
+func (s *WordPressService) TestWordPressConnection(ctx context.Context, site models.WordPressSite) error {
 	client := &http.Client{Timeout: 10 * time.Second}
 
 	// Test API endpoint
-	req, err := http.NewRequest("GET", site.APIEndpoint+"/posts?per_page=1", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", site.APIEndpoint+"/posts?per_page=1", nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -177,68 +162,23 @@ func (s *WordPressService) TestWordPressConnection(site models.WordPressSite) er
 }
 
 // GetSyncLogs retrieves synchronization logs
-func (s *WordPressService) GetSyncLogs(siteID int, page, limit int) ([]models.ContentSyncLog, int, error) {
+func (s *WordPressService) GetSyncLogs(ctx context.Context, siteID int, page, limit int) ([]models.ContentSyncLog, int, error) {
 	offset := (page - 1) * limit
 
-	// Get total count
-	var total int
-	err := s.db.QueryRow("SELECT COUNT(*) FROM content_sync_logs WHERE site_id = $1", siteID).Scan(&total)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count sync logs: %w", err)
-	}
-
-	// Get logs with pagination
-	rows, err := s.db.Query(`
-		SELECT id, site_id, sync_type, local_content_id, remote_content_id, status, error_message, created_at
-		FROM content_sync_logs
-		WHERE site_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3`, siteID, limit, offset)
-
+	logs, total, err := s.store.WordPress().ListLogs(ctx, siteID, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get sync logs: %w", err)
 	}
-	defer rows.Close()
-
-	var logs []models.ContentSyncLog
-	for rows.Next() {
-		var log models.ContentSyncLog
-		err := rows.Scan(
-			&log.ID, &log.SiteID, &log.SyncType, &log.LocalContentID, &log.RemoteContentID,
-			&log.Status, &log.ErrorMessage, &log.CreatedAt)
-
-		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan sync log: %w", err)
-		}
-
-		logs = append(logs, log)
-	}
 
 	return logs, total, nil
 }
 
 // Helper methods
 
-func (s *WordPressService) getWordPressSite(siteID int) (*models.WordPressSite, error) {
-	var site models.WordPressSite
-
-	err := s.db.QueryRow(`
-		SELECT id, name, url, api_endpoint, username, application_password, is_active, last_sync_at, created_at, updated_at
-		FROM wordpress_sites WHERE id = $1`, siteID).Scan(
-		&site.ID, &site.Name, &site.URL, &site.APIEndpoint, &site.Username, &site.ApplicationPassword,
-		&site.IsActive, &site.LastSyncAt, &site.CreatedAt, &site.UpdatedAt)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &site, nil
-}
-
-func (s *WordPressService) fetchWordPressPosts(site *models.WordPressSite) ([]WordPressPost, error) {
+func (s *WordPressService) fetchWordPressPosts(ctx context.Context, site *models.WordPressSite) ([]WordPressPost, error) {
 	client := &http.Client{Timeout: 30 * time.Second}
 
-	req, err := http.NewRequest("GET", site.APIEndpoint+"/posts?per_page=100", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", site.APIEndpoint+"/posts?per_page=100", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -272,13 +212,13 @@ func (s *WordPressService) fetchWordPressPosts(site *models.WordPressSite) ([]Wo
 	return posts, nil
 }
 
-func (s *WordPressService) syncSinglePost(siteID int, wpPost WordPressPost, blogService *BlogService) error {
+func (s *WordPressService) syncSinglePost(ctx context.Context, siteID int, wpPost WordPressPost, blogService *BlogService, actor models.AuditActor) error {
 	// Check if post already exists locally
-	existingPost, _ := blogService.GetPostBySlug(wpPost.Slug)
+	existingPost, _ := blogService.GetPostBySlug(ctx, wpPost.Slug)
 
 	if existingPost != nil {
 		// Update existing post
-		_, err := blogService.UpdatePost(
+		_, err := blogService.UpdatePost(ctx, 
 			existingPost.ID,
 			wpPost.Title.Rendered,
 			wpPost.Slug,
@@ -287,18 +227,19 @@ func (s *WordPressService) syncSinglePost(siteID int, wpPost WordPressPost, blog
 			"", // featured_image placeholder
 			wpPost.Status,
 			false, // is_featured
+			actor,
 		)
 		if err != nil {
 			return err
 		}
 
 		// Log successful sync
-		s.logSyncSuccess(siteID, "post_update", &existingPost.ID, &wpPost.ID)
+		s.logSyncSuccess(ctx, siteID, "post_update", &existingPost.ID, &wpPost.ID)
 	} else {
 		// Create new post (need author ID - use system user or first admin)
 		authorID := 1 // Default to first user/admin
 
-		_, err := blogService.CreatePost(
+		_, err := blogService.CreatePost(ctx, 
 			authorID,
 			wpPost.Title.Rendered,
 			wpPost.Slug,
@@ -313,29 +254,12 @@ func (s *WordPressService) syncSinglePost(siteID int, wpPost WordPressPost, blog
 		}
 
 		// Log successful sync
-		s.logSyncSuccess(siteID, "post_create", nil, &wpPost.ID)
+		s.logSyncSuccess(ctx, siteID, "post_create", nil, &wpPost.ID)
 	}
 
 	return nil
 }
 
-func (s *WordPressService) getLocalPost(postID int) (*models.BlogPost, error) {
-	var post models.BlogPost
-
-	err := s.db.QueryRow(`
-		SELECT id, title, slug, content, excerpt, featured_image, author_id, status, is_featured, view_count, published_at, created_at, updated_at
-		FROM blog_posts WHERE id = $1`, postID).Scan(
-		&post.ID, &post.Title, &post.Slug, &post.Content, &post.Excerpt, &post.FeaturedImage,
-		&post.AuthorID, &post.Status, &post.IsFeatured, &post.ViewCount,
-		&post.PublishedAt, &post.CreatedAt, &post.UpdatedAt)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &post, nil
-}
-
 func (s *WordPressService) convertToWordPressPost(post *models.BlogPost) map[string]interface{} {
 	wpPost := map[string]interface{}{
 		"title":   post.Title,
@@ -351,7 +275,7 @@ func (s *WordPressService) convertToWordPressPost(post *models.BlogPost) map[str
 	return wpPost
 }
 
-func (s *WordPressService) sendPostToWordPress(site *models.WordPressSite, wpPost map[string]interface{}) error {
+func (s *WordPressService) sendPostToWordPress(ctx context.Context, site *models.WordPressSite, wpPost map[string]interface{}) error {
 	client := &http.Client{Timeout: 30 * time.Second}
 
 	postData, err := json.Marshal(wpPost)
@@ -359,7 +283,7 @@ func (s *WordPressService) sendPostToWordPress(site *models.WordPressSite, wpPos
 		return err
 	}
 
-	req, err := http.NewRequest("POST", site.APIEndpoint+"/posts", bytes.NewBuffer(postData))
+	req, err := http.NewRequestWithContext(ctx, "POST", site.APIEndpoint+"/posts", bytes.NewBuffer(postData))
 	if err != nil {
 		return err
 	}
@@ -385,20 +309,23 @@ func (s *WordPressService) sendPostToWordPress(site *models.WordPressSite, wpPos
 	return nil
 }
 
-func (s *WordPressService) updateLastSyncTime(siteID int) {
-	s.db.Exec("UPDATE wordpress_sites SET last_sync_at = CURRENT_TIMESTAMP WHERE id = $1", siteID)
-}
-
-func (s *WordPressService) logSyncSuccess(siteID int, syncType string, localContentID, remoteContentID *int) {
-	s.db.Exec(`
-		INSERT INTO content_sync_logs (site_id, sync_type, local_content_id, remote_content_id, status, created_at)
-		VALUES ($1, $2, $3, $4, 'success', CURRENT_TIMESTAMP)`,
-		siteID, syncType, localContentID, remoteContentID)
+func (s *WordPressService) logSyncSuccess(ctx context.Context, siteID int, syncType string, localContentID, remoteContentID *int) {
+	s.store.WordPress().LogSync(ctx, models.ContentSyncLog{
+		SiteID:          siteID,
+		SyncType:        syncType,
+		LocalContentID:  localContentID,
+		RemoteContentID: remoteContentID,
+		Status:          "success",
+	})
 }
 
-func (s *WordPressService) logSyncError(siteID int, syncType string, localContentID, remoteContentID *int, errorMsg string) {
-	s.db.Exec(`
-		INSERT INTO content_sync_logs (site_id, sync_type, local_content_id, remote_content_id, status, error_message, created_at)
-		VALUES ($1, $2, $3, $4, 'failed', $5, CURRENT_TIMESTAMP)`,
-		siteID, syncType, localContentID, remoteContentID, errorMsg)
+func (s *WordPressService) logSyncError(ctx context.Context, siteID int, syncType string, localContentID, remoteContentID *int, errorMsg string) {
+	s.store.WordPress().LogSync(ctx, models.ContentSyncLog{
+		SiteID:          siteID,
+		SyncType:        syncType,
+		LocalContentID:  localContentID,
+		RemoteContentID: remoteContentID,
+		Status:          "failed",
+		ErrorMessage:    &errorMsg,
+	})
 }
\ No newline at end of file
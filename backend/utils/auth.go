@@ -1,38 +1,48 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
 )
 
-var jwtSecret = []byte("your-secret-key") // TODO: Move to environment variable
+// AccessTokenTTL is the lifetime of access tokens, clients renew them with a refresh token
+const AccessTokenTTL = 15 * time.Minute
 
-type Claims struct {
-	UserID   int    `json:"user_id"`
-	Email    string `json:"email"`
-	Role     string `json:"role"`
-	Username string `json:"username"`
-	jwt.RegisteredClaims
-}
+// APIKeyPrefix starts every service account API key, which tells keys apart from JWTs
+const APIKeyPrefix = "zpk_"
 
-// HashPassword hashes a password using bcrypt
-func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	return string(bytes), err
-}
+// MFAPendingTokenTTL is how long a user has to enter the second factor after the password
+const MFAPendingTokenTTL = 5 * time.Minute
 
-// CheckPasswordHash compares a password with its hash
-func CheckPasswordHash(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+// ImpersonationTokenTTL is the lifetime of a "login as customer" token, which cannot be refreshed
+const ImpersonationTokenTTL = 10 * time.Minute
+
+// PurposeMFAPending marks a token that only proves the password step of a two-factor login
+const PurposeMFAPending = "mfa_pending"
+
+type Claims struct {
+	UserID        int    `json:"user_id"`
+	Email         string `json:"email"`
+	Role          string `json:"role"`
+	Username      string `json:"username"`
+	EmailVerified bool   `json:"email_verified"`
+	SessionID     string `json:"sid,omitempty"`
+	Purpose       string `json:"purpose,omitempty"`
+	// ImpersonatorID is the staff member acting as the user, zero for normal logins
+	ImpersonatorID    int    `json:"imp_id,omitempty"`
+	ImpersonatorEmail string `json:"imp_email,omitempty"`
+	jwt.RegisteredClaims
 }
 
 // GenerateJWT generates a JWT token for a user (simple version)
 func GenerateJWT(userID int) (string, time.Time, error) {
-	expirationTime := time.Now().Add(24 * time.Hour) // 24 hours expiration
+	expirationTime := time.Now().Add(AccessTokenTTL)
 	claims := &Claims{
 		UserID: userID,
 		RegisteredClaims: jwt.RegisteredClaims{
@@ -42,19 +52,20 @@ func GenerateJWT(userID int) (string, time.Time, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(jwtSecret)
+	tokenString, err := signToken(claims)
 	return tokenString, expirationTime, err
 }
 
-// GenerateJWTWithDetails generates a JWT token for a user with full details
-func GenerateJWTWithDetails(userID int, email, role, username string) (string, error) {
-	expirationTime := time.Now().Add(24 * time.Hour) // 24 hours expiration
+// GenerateJWTWithDetails generates a short-lived access token for a user session with full details
+func GenerateJWTWithDetails(userID int, email, role, username string, emailVerified bool, sessionID string) (string, error) {
+	expirationTime := time.Now().Add(AccessTokenTTL)
 	claims := &Claims{
-		UserID:   userID,
-		Email:    email,
-		Role:     role,
-		Username: username,
+		UserID:        userID,
+		Email:         email,
+		Role:          role,
+		Username:      username,
+		EmailVerified: emailVerified,
+		SessionID:     sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -62,16 +73,79 @@ func GenerateJWTWithDetails(userID int, email, role, username string) (string, e
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return signToken(claims)
+}
+
+// GenerateImpersonationToken issues an access token for a user session opened by
+// a staff member, carrying both the user and the impersonator
+func GenerateImpersonationToken(userID int, email, role, username string, emailVerified bool, sessionID string, impersonatorID int, impersonatorEmail string) (string, error) {
+	expirationTime := time.Now().Add(ImpersonationTokenTTL)
+	claims := &Claims{
+		UserID:            userID,
+		Email:             email,
+		Role:              role,
+		Username:          username,
+		EmailVerified:     emailVerified,
+		SessionID:         sessionID,
+		ImpersonatorID:    impersonatorID,
+		ImpersonatorEmail: impersonatorEmail,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "zplus-web",
+		},
+	}
+
+	return signToken(claims)
+}
+
+// GenerateMFAPendingToken issues the limited token returned by a password login
+// that still needs a second factor. It carries no session and is rejected as an access token.
+func GenerateMFAPendingToken(userID int) (string, error) {
+	claims := &Claims{
+		UserID:  userID,
+		Purpose: PurposeMFAPending,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(MFAPendingTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "zplus-web",
+		},
+	}
+
+	return signToken(claims)
 }
 
-// ValidateJWT validates a JWT token and returns the claims
+// ValidateJWT validates an access token and returns the claims
 func ValidateJWT(tokenString string) (*Claims, error) {
+	claims, err := parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Purpose != "" {
+		return nil, errors.New("token is not an access token")
+	}
+
+	return claims, nil
+}
+
+// ValidateMFAPendingToken validates a token issued by GenerateMFAPendingToken
+func ValidateMFAPendingToken(tokenString string) (*Claims, error) {
+	claims, err := parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Purpose != PurposeMFAPending {
+		return nil, errors.New("token is not a two-factor login token")
+	}
+
+	return claims, nil
+}
+
+func parseToken(tokenString string) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecret, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, verificationKey)
 
 	if err != nil {
 		return nil, err
@@ -93,3 +167,18 @@ func GenerateRandomString(length int) string {
 	}
 	return string(b)
 }
+
+// GenerateSecureToken returns a URL-safe token built from n cryptographically random bytes
+func GenerateSecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken returns the hex SHA-256 digest of a token so only the hash is stored
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
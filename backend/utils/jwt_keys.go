@@ -0,0 +1,299 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"zplus_web/backend/config"
+)
+
+// SigningKey is a key used to sign and/or verify access tokens, identified by its kid
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	// ExpiresAt is the moment the key stops being accepted; zero means never
+	ExpiresAt time.Time
+
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// CanSign reports whether the key holds private material
+func (k *SigningKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+func (k *SigningKey) expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && now.After(k.ExpiresAt)
+}
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+var (
+	keysMu    sync.RWMutex
+	activeKey *SigningKey
+	keysByID  = map[string]*SigningKey{}
+)
+
+// NewHMACKey builds an HS256 key from a shared secret
+func NewHMACKey(kid string, secret []byte, expiresAt time.Time) *SigningKey {
+	return &SigningKey{
+		ID:        kid,
+		Algorithm: jwt.SigningMethodHS256.Alg(),
+		ExpiresAt: expiresAt,
+		signKey:   secret,
+		verifyKey: secret,
+	}
+}
+
+// ParseSigningKey builds a key from its material: the raw secret for HS256,
+// or a PEM private key (sign and verify) or public key (verify only) for RS256 and EdDSA
+func ParseSigningKey(kid, alg string, material []byte, expiresAt time.Time) (*SigningKey, error) {
+	key := &SigningKey{ID: kid, Algorithm: alg, ExpiresAt: expiresAt}
+
+	switch alg {
+	case jwt.SigningMethodHS256.Alg():
+		secret := []byte(strings.TrimSpace(string(material)))
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("key %s: HS256 secret must be at least 32 bytes", kid)
+		}
+		return NewHMACKey(kid, secret, expiresAt), nil
+
+	case jwt.SigningMethodRS256.Alg():
+		if private, err := jwt.ParseRSAPrivateKeyFromPEM(material); err == nil {
+			key.signKey = private
+			key.verifyKey = &private.PublicKey
+		} else if public, err := jwt.ParseRSAPublicKeyFromPEM(material); err == nil {
+			key.verifyKey = public
+		} else {
+			return nil, fmt.Errorf("key %s: invalid RSA PEM: %w", kid, err)
+		}
+
+	case jwt.SigningMethodEdDSA.Alg():
+		if private, err := jwt.ParseEdPrivateKeyFromPEM(material); err == nil {
+			edPrivate := private.(ed25519.PrivateKey)
+			key.signKey = edPrivate
+			key.verifyKey = edPrivate.Public()
+		} else if public, err := jwt.ParseEdPublicKeyFromPEM(material); err == nil {
+			key.verifyKey = public
+		} else {
+			return nil, fmt.Errorf("key %s: invalid Ed25519 PEM: %w", kid, err)
+		}
+
+	default:
+		return nil, fmt.Errorf("key %s: unsupported algorithm %q", kid, alg)
+	}
+
+	return key, nil
+}
+
+// SetSigningKeys replaces the key set. New tokens are signed with activeKID,
+// every other non-expired key is still accepted for verification.
+func SetSigningKeys(activeKID string, keys []*SigningKey) error {
+	byID := make(map[string]*SigningKey, len(keys))
+	for _, key := range keys {
+		if _, exists := byID[key.ID]; exists {
+			return fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		byID[key.ID] = key
+	}
+
+	active, ok := byID[activeKID]
+	if !ok {
+		return fmt.Errorf("active key %q is not configured", activeKID)
+	}
+	if !active.CanSign() {
+		return fmt.Errorf("active key %q has no private key", activeKID)
+	}
+	if active.expired(time.Now()) {
+		return fmt.Errorf("active key %q has expired", activeKID)
+	}
+
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	activeKey = active
+	keysByID = byID
+	return nil
+}
+
+// ConfigureJWT loads the signing keys described by the configuration.
+//
+// JWT_KEYS is a comma-separated list of kid:alg:file[:expires] entries, where
+// file holds the HS256 secret or a PEM key and expires is an RFC 3339 time
+// after which the key is no longer accepted. JWT_ACTIVE_KID picks the signing
+// key (default: the first entry). Without JWT_KEYS, JWT_SECRET is used as a
+// single HS256 key. It must be at least 32 bytes and not an example value
+// outside development, where a random secret is generated if it is missing.
+func ConfigureJWT(cfg *config.Config) error {
+	var keys []*SigningKey
+
+	for _, entry := range strings.Split(cfg.JWTKeys, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 4)
+		if len(parts) < 3 {
+			return fmt.Errorf("invalid JWT_KEYS entry %q, expected kid:alg:file[:expires]", entry)
+		}
+
+		var expiresAt time.Time
+		if len(parts) == 4 {
+			t, err := time.Parse(time.RFC3339, parts[3])
+			if err != nil {
+				return fmt.Errorf("invalid expiry for key %s: %w", parts[0], err)
+			}
+			expiresAt = t
+		}
+
+		material, err := os.ReadFile(parts[2])
+		if err != nil {
+			return fmt.Errorf("failed to read key %s: %w", parts[0], err)
+		}
+
+		key, err := ParseSigningKey(parts[0], parts[1], material, expiresAt)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		secret := []byte(cfg.JWTSecret)
+		if len(secret) == 0 {
+			if cfg.Env != "development" {
+				return errors.New("JWT_KEYS or JWT_SECRET must be set")
+			}
+			generated, err := GenerateSecureToken(32)
+			if err != nil {
+				return err
+			}
+			log.Println("Warning: no JWT key configured, using a random secret (tokens will not survive a restart)")
+			secret = []byte(generated)
+		}
+		if err := checkSecret(secret); err != nil {
+			if cfg.Env != "development" {
+				return err
+			}
+			log.Printf("Warning: %v", err)
+		}
+		keys = append(keys, NewHMACKey("default", secret, time.Time{}))
+	}
+
+	activeKID := cfg.JWTActiveKID
+	if activeKID == "" {
+		activeKID = keys[0].ID
+	}
+
+	return SetSigningKeys(activeKID, keys)
+}
+
+// placeholderSecrets are the example JWT_SECRET values from the docs and setup scripts
+var placeholderSecrets = []string{
+	"your-secret-key",
+	"your-super-secret-jwt-key-change-in-production",
+}
+
+// checkSecret rejects a JWT_SECRET that is too short or a known placeholder
+func checkSecret(secret []byte) error {
+	for _, placeholder := range placeholderSecrets {
+		if string(secret) == placeholder {
+			return errors.New("JWT_SECRET is still the example value, set a random secret")
+		}
+	}
+	if len(secret) < 32 {
+		return errors.New("JWT_SECRET must be at least 32 bytes")
+	}
+	return nil
+}
+
+// signToken signs claims with the active key and sets the kid header
+func signToken(claims jwt.Claims) (string, error) {
+	keysMu.RLock()
+	key := activeKey
+	keysMu.RUnlock()
+
+	if key == nil {
+		return "", errors.New("JWT signing keys are not configured")
+	}
+
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.signKey)
+}
+
+// verificationKey resolves the key named by the token's kid header
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	keysMu.RLock()
+	key, ok := keysByID[kid]
+	keysMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if key.expired(time.Now()) {
+		return nil, fmt.Errorf("signing key %q has expired", kid)
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method %s for key %q", token.Method.Alg(), kid)
+	}
+
+	return key.verifyKey, nil
+}
+
+// JWKS returns the public keys as a JSON Web Key Set. HMAC secrets are never published.
+func JWKS() map[string]interface{} {
+	keysMu.RLock()
+	defer keysMu.RUnlock()
+
+	now := time.Now()
+	jwks := []map[string]interface{}{}
+	for _, key := range keysByID {
+		if key.expired(now) {
+			continue
+		}
+
+		switch public := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwks = append(jwks, map[string]interface{}{
+				"kty": "RSA",
+				"use": "sig",
+				"alg": key.Algorithm,
+				"kid": key.ID,
+				"n":   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks = append(jwks, map[string]interface{}{
+				"kty": "OKP",
+				"crv": "Ed25519",
+				"use": "sig",
+				"alg": key.Algorithm,
+				"kid": key.ID,
+				"x":   base64.RawURLEncoding.EncodeToString(public),
+			})
+		}
+	}
+
+	sort.Slice(jwks, func(i, j int) bool {
+		return jwks[i]["kid"].(string) < jwks[j]["kid"].(string)
+	})
+
+	return map[string]interface{}{"keys": jwks}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"zplus_web/backend/config"
+)
+
+func TestConfigureJWTSecret(t *testing.T) {
+	strong := strings.Repeat("k", 32)
+	tests := []struct {
+		env, secret string
+		ok          bool
+	}{
+		{"production", strong, true},
+		{"production", "", false},
+		{"production", "too-short", false},
+		{"production", "your-super-secret-jwt-key-change-in-production", false},
+		{"development", "", true},
+		{"development", "too-short", true},
+		{"development", "your-secret-key", true},
+	}
+	for _, tt := range tests {
+		err := ConfigureJWT(&config.Config{Env: tt.env, JWTSecret: tt.secret})
+		if (err == nil) != tt.ok {
+			t.Errorf("ConfigureJWT(%s, %q) = %v, want ok %v", tt.env, tt.secret, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	_ "embed"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"zplus_web/backend/config"
+)
+
+// Password hashing algorithms
+const (
+	PasswordHashBcrypt   = "bcrypt"
+	PasswordHashArgon2id = "argon2id"
+)
+
+// argon2id parameters, the second recommended option of RFC 9106.
+// Hashes made with other parameters are upgraded on the next login.
+const (
+	argon2Memory  = 64 * 1024 // KiB
+	argon2Time    = 3
+	argon2Threads = 4
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+)
+
+// maxPasswordLength keeps hashing cost bounded; bcrypt itself stops at 72 bytes
+const maxPasswordLength = 128
+
+// PasswordPolicy is the set of rules new passwords must follow
+type PasswordPolicy struct {
+	MinLength   int
+	MinClasses  int  // how many of lowercase, uppercase, digits and symbols must appear
+	CheckCommon bool // reject passwords from the bundled list of common and breached passwords
+	History     int  // number of previous passwords that cannot be reused
+}
+
+// PasswordPolicyError lists every rule a password breaks
+type PasswordPolicyError struct {
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return "password does not meet the policy: " + strings.Join(e.Violations, "; ")
+}
+
+var (
+	passwordPolicy = PasswordPolicy{MinLength: 8, MinClasses: 3, CheckCommon: true, History: 5}
+	passwordHash   = PasswordHashBcrypt
+	bcryptCost     = bcrypt.DefaultCost
+)
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+var commonPasswords = func() map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			set[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return set
+}()
+
+// ConfigurePasswords sets the password policy and hashing algorithm from the configuration
+func ConfigurePasswords(cfg *config.Config) error {
+	switch cfg.PasswordHash {
+	case PasswordHashBcrypt:
+		if cfg.PasswordBcryptCost < bcrypt.MinCost || cfg.PasswordBcryptCost > bcrypt.MaxCost {
+			return fmt.Errorf("invalid PASSWORD_BCRYPT_COST %d, must be between %d and %d",
+				cfg.PasswordBcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case PasswordHashArgon2id:
+	default:
+		return fmt.Errorf("invalid PASSWORD_HASH %q, use %s or %s", cfg.PasswordHash, PasswordHashBcrypt, PasswordHashArgon2id)
+	}
+	if cfg.PasswordMinLength < 1 || cfg.PasswordMinLength > maxPasswordLength {
+		return fmt.Errorf("invalid PASSWORD_MIN_LENGTH %d", cfg.PasswordMinLength)
+	}
+	if cfg.PasswordMinClasses < 0 || cfg.PasswordMinClasses > 4 {
+		return fmt.Errorf("invalid PASSWORD_MIN_CLASSES %d, must be between 0 and 4", cfg.PasswordMinClasses)
+	}
+	if cfg.PasswordHistory < 0 {
+		return fmt.Errorf("invalid PASSWORD_HISTORY %d", cfg.PasswordHistory)
+	}
+
+	passwordHash = cfg.PasswordHash
+	bcryptCost = cfg.PasswordBcryptCost
+	passwordPolicy = PasswordPolicy{
+		MinLength:   cfg.PasswordMinLength,
+		MinClasses:  cfg.PasswordMinClasses,
+		CheckCommon: cfg.PasswordCheckCommon,
+		History:     cfg.PasswordHistory,
+	}
+	return nil
+}
+
+// CurrentPasswordPolicy returns the configured password policy
+func CurrentPasswordPolicy() PasswordPolicy {
+	return passwordPolicy
+}
+
+// ValidatePassword checks a new password against the policy. userInputs are
+// values such as the email and username the password must not contain.
+// Reuse of previous passwords is checked by the caller, which has the history.
+// The returned error is a *PasswordPolicyError.
+func ValidatePassword(password string, userInputs ...string) error {
+	var violations []string
+	policy := passwordPolicy
+
+	length := len([]rune(password))
+	if length < policy.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters long", policy.MinLength))
+	}
+	if length > maxPasswordLength || passwordHash == PasswordHashBcrypt && len(password) > 72 {
+		violations = append(violations, "is too long")
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+	if classes < policy.MinClasses {
+		violations = append(violations, fmt.Sprintf(
+			"must contain at least %d of lowercase letters, uppercase letters, digits and symbols", policy.MinClasses))
+	}
+
+	if policy.CheckCommon && isCommonPassword(password) {
+		violations = append(violations, "is too common, it appears in lists of breached passwords")
+	}
+
+	lowered := strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(strings.SplitN(input, "@", 2)[0])
+		if len(input) >= 4 && strings.Contains(lowered, input) {
+			violations = append(violations, "must not contain your username or email address")
+			break
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// isCommonPassword also catches common passwords with digits or symbols appended, like "Password123!"
+func isCommonPassword(password string) bool {
+	lowered := strings.ToLower(password)
+	if _, ok := commonPasswords[lowered]; ok {
+		return true
+	}
+	base := strings.TrimRightFunc(lowered, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	_, ok := commonPasswords[base]
+	return ok && base != ""
+}
+
+// HashPassword hashes a password with the configured algorithm
+func HashPassword(password string) (string, error) {
+	if passwordHash == PasswordHashArgon2id {
+		return hashArgon2id(password)
+	}
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	return string(bytes), err
+}
+
+// CheckPasswordHash compares a password with its hash, which may use any supported algorithm
+func CheckPasswordHash(password, hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		return checkArgon2id(password, hash)
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	return err == nil
+}
+
+// PasswordNeedsRehash reports whether a hash was made with another algorithm
+// or other parameters than the configured ones, so it should be replaced
+// the next time the password is known
+func PasswordNeedsRehash(hash string) bool {
+	if passwordHash == PasswordHashArgon2id {
+		memory, iterations, threads, _, _, err := decodeArgon2id(hash)
+		return err != nil || memory != argon2Memory || iterations != argon2Time || threads != argon2Threads
+	}
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != bcryptCost
+}
+
+func hashArgon2id(password string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func checkArgon2id(password, hash string) bool {
+	memory, iterations, threads, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(computed, key) == 1
+}
+
+// decodeArgon2id parses a hash in the PHC string format
+func decodeArgon2id(hash string) (memory, iterations uint32, threads uint8, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return 0, 0, 0, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return 0, 0, 0, nil, nil, fmt.Errorf("unsupported argon2 version")
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return 0, 0, 0, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return 0, 0, 0, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return 0, 0, 0, nil, nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	return memory, iterations, threads, salt, key, nil
+}
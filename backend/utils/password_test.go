@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// usePasswords switches the hashing algorithm and bcrypt cost for one test,
+// with the default policy
+func usePasswords(t *testing.T, hash string, cost int) {
+	t.Helper()
+	oldHash, oldCost, oldPolicy := passwordHash, bcryptCost, passwordPolicy
+	t.Cleanup(func() { passwordHash, bcryptCost, passwordPolicy = oldHash, oldCost, oldPolicy })
+
+	passwordHash, bcryptCost = hash, cost
+	passwordPolicy = PasswordPolicy{MinLength: 8, MinClasses: 3, CheckCommon: true, History: 5}
+}
+
+func TestValidatePassword(t *testing.T) {
+	usePasswords(t, PasswordHashBcrypt, bcrypt.MinCost)
+
+	tests := []struct {
+		password   string
+		userInputs []string
+		violation  string // "" when the password is accepted
+	}{
+		{"Tr0ub4dor&3-horse", nil, ""},
+		{"Sh0rt!", nil, "at least 8 characters"},
+		// Length counts characters, not bytes
+		{"Ääkkösiä1", nil, ""},
+		{"Aa1!" + strings.Repeat("x", 68), nil, ""},
+		{"Aa1!" + strings.Repeat("x", 69), nil, "too long"},
+		{"Ää1!" + strings.Repeat("x", 67), nil, "too long"},
+		{"alllowercase", nil, "at least 3 of"},
+		{"lowercase and 42", nil, ""},
+		{"UPPER-CASE!", nil, "at least 3 of"},
+		{"password", nil, "too common"},
+		{"Password123!", nil, "too common"},
+		{"Qwerty!!2024", nil, "too common"},
+		{"2024!!!!", nil, "at least 3 of"},
+		{"Jane.Doe-2024", []string{"jane.doe@example.com"}, "must not contain your username or email"},
+		{"Xjanedoe42!", []string{"JaneDoe"}, "must not contain your username or email"},
+		// Inputs shorter than 4 characters are too likely to match by chance
+		{"Bob-the-builder1", []string{"bob", "bob@example.com"}, ""},
+	}
+	for _, tt := range tests {
+		err := ValidatePassword(tt.password, tt.userInputs...)
+		if tt.violation == "" {
+			if err != nil {
+				t.Errorf("ValidatePassword(%q) = %v", tt.password, err)
+			}
+			continue
+		}
+		var policyErr *PasswordPolicyError
+		if !errors.As(err, &policyErr) || !strings.Contains(strings.Join(policyErr.Violations, "; "), tt.violation) {
+			t.Errorf("ValidatePassword(%q) = %v, want %q", tt.password, err, tt.violation)
+		}
+	}
+
+	// Every broken rule is listed
+	err := ValidatePassword("pass")
+	var policyErr *PasswordPolicyError
+	if !errors.As(err, &policyErr) || len(policyErr.Violations) != 3 {
+		t.Errorf("ValidatePassword(pass) = %v", err)
+	}
+
+	// argon2id has no 72 byte limit
+	usePasswords(t, PasswordHashArgon2id, bcrypt.MinCost)
+	if err := ValidatePassword("Aa1!" + strings.Repeat("x", 100)); err != nil {
+		t.Errorf("long password with argon2id = %v", err)
+	}
+	if err := ValidatePassword("Aa1!" + strings.Repeat("x", 125)); err == nil {
+		t.Error("password over 128 characters was accepted")
+	}
+}
+
+func TestIsCommonPassword(t *testing.T) {
+	tests := map[string]bool{
+		"password":     true,
+		"PASSWORD":     true,
+		"Password123!": true,
+		"letmein2024":  true,
+		"123!":         false,
+		"":             false,
+		"passwordx1":   false,
+		"1password":    false,
+	}
+	for password, want := range tests {
+		if got := isCommonPassword(password); got != want {
+			t.Errorf("isCommonPassword(%q) = %v, want %v", password, got, want)
+		}
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	usePasswords(t, PasswordHashArgon2id, bcrypt.MinCost)
+
+	hash, err := HashPassword("Tr0ub4dor&3-horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=4$") {
+		t.Errorf("hash = %s", hash)
+	}
+	if !CheckPasswordHash("Tr0ub4dor&3-horse", hash) {
+		t.Error("the password does not match its hash")
+	}
+	if CheckPasswordHash("Tr0ub4dor&3-horsE", hash) {
+		t.Error("a wrong password matches")
+	}
+	if again, _ := hashArgon2id("Tr0ub4dor&3-horse"); again == hash {
+		t.Error("two hashes of a password share a salt")
+	}
+
+	salt, key := strings.Split(hash, "$")[4], strings.Split(hash, "$")[5]
+	malformed := []string{
+		"",
+		"$argon2id$v=19$m=65536,t=3,p=4$" + salt,
+		"$argon2i$v=19$m=65536,t=3,p=4$" + salt + "$" + key,
+		"$argon2id$v=16$m=65536,t=3,p=4$" + salt + "$" + key,
+		"$argon2id$v=19$m=lots,t=3,p=4$" + salt + "$" + key,
+		"$argon2id$v=19$m=65536,t=3,p=4$not*base64$" + key,
+		"$argon2id$v=19$m=65536,t=3,p=4$" + salt + "$not*base64",
+	}
+	for _, hash := range malformed {
+		if _, _, _, _, _, err := decodeArgon2id(hash); err == nil {
+			t.Errorf("decodeArgon2id(%q) succeeded", hash)
+		}
+		if CheckPasswordHash("Tr0ub4dor&3-horse", hash) {
+			t.Errorf("malformed hash %q matches", hash)
+		}
+	}
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	usePasswords(t, PasswordHashBcrypt, bcrypt.MinCost)
+	bcryptHash, err := HashPassword("Tr0ub4dor&3-horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PasswordNeedsRehash(bcryptHash) {
+		t.Error("a current bcrypt hash needs a rehash")
+	}
+
+	// A new cost applies on the next login
+	usePasswords(t, PasswordHashBcrypt, bcrypt.MinCost+1)
+	if !PasswordNeedsRehash(bcryptHash) {
+		t.Error("a bcrypt hash with the old cost does not need a rehash")
+	}
+
+	// Switching to argon2id upgrades every bcrypt hash, which still verify meanwhile
+	usePasswords(t, PasswordHashArgon2id, bcrypt.MinCost)
+	if !PasswordNeedsRehash(bcryptHash) {
+		t.Error("a bcrypt hash does not need a rehash after switching to argon2id")
+	}
+	if !CheckPasswordHash("Tr0ub4dor&3-horse", bcryptHash) {
+		t.Error("a bcrypt hash no longer verifies after switching to argon2id")
+	}
+	argonHash, err := HashPassword("Tr0ub4dor&3-horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PasswordNeedsRehash(argonHash) {
+		t.Error("a current argon2id hash needs a rehash")
+	}
+	if weaker := strings.Replace(argonHash, "m=65536,t=3", "m=19456,t=2", 1); !PasswordNeedsRehash(weaker) {
+		t.Error("an argon2id hash with other parameters does not need a rehash")
+	}
+
+	usePasswords(t, PasswordHashBcrypt, bcrypt.MinCost)
+	if !PasswordNeedsRehash(argonHash) {
+		t.Error("an argon2id hash does not need a rehash after switching to bcrypt")
+	}
+}